@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -24,7 +25,10 @@ const (
 	getESDTBalance        = "/:address/esdt/:tokenIdentifier"
 	getESDTTokensWithRole = "/:address/esdts-with-role/:role"
 	getRegisteredNFTs     = "/:address/registered-nfts"
+	getESDTAllowances     = "/:address/esdt-allowances"
 	getESDTNFTData        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getContractInfo       = "/:address/contract"
+	checkContractUpgrade  = "/:address/upgrade-check"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -37,7 +41,11 @@ type FacadeHandler interface {
 	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
 	GetESDTsWithRole(address string, role string) ([]string, error)
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetESDTAllowances(address string) ([]*api.ESDTAllowance, error)
+	GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error)
 	GetKeyValuePairs(address string) (map[string]string, error)
+	GetContractInfo(address string) (*api.ContractInfo, error)
+	CheckContractUpgrade(address string, request api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error)
 	IsInterfaceNil() bool
 }
 
@@ -51,6 +59,7 @@ type esdtNFTTokenData struct {
 	TokenIdentifier string   `json:"tokenIdentifier"`
 	Balance         string   `json:"balance"`
 	Properties      string   `json:"properties,omitempty"`
+	Type            string   `json:"type,omitempty"`
 	Name            string   `json:"name,omitempty"`
 	Nonce           uint64   `json:"nonce,omitempty"`
 	Creator         string   `json:"creator,omitempty"`
@@ -72,6 +81,9 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetAllESDTData)
 	router.RegisterHandler(http.MethodGet, getRegisteredNFTs, GetNFTTokenIDsRegisteredByAddress)
 	router.RegisterHandler(http.MethodGet, getESDTTokensWithRole, GetESDTTokensWithRole)
+	router.RegisterHandler(http.MethodGet, getESDTAllowances, GetESDTAllowances)
+	router.RegisterHandler(http.MethodGet, getContractInfo, GetContractInfo)
+	router.RegisterHandler(http.MethodPost, checkContractUpgrade, CheckContractUpgrade)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -643,6 +655,19 @@ func GetAllESDTData(c *gin.Context) {
 		formattedTokens[tokenID] = tokenData
 	}
 
+	err = addESDTTokenTypes(facade, formattedTokens)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
@@ -652,3 +677,156 @@ func GetAllESDTData(c *gin.Context) {
 		},
 	)
 }
+
+// addESDTTokenTypes fills the type of the tokens known by the node. The tokens created
+// by NFT create (NFTs, SFTs and meta ESDTs) are listed by identifier and nonce, while their type is registered by
+// identifier
+func addESDTTokenTypes(facade FacadeHandler, formattedTokens map[string]*esdtNFTTokenData) error {
+	uniqueIdentifiers := make(map[string]struct{})
+	tokenIdentifiers := make([]string, 0, len(formattedTokens))
+	for tokenID := range formattedTokens {
+		tokenIdentifier := getTokenIdentifierWithoutNonce(tokenID)
+		if _, exists := uniqueIdentifiers[tokenIdentifier]; exists {
+			continue
+		}
+		uniqueIdentifiers[tokenIdentifier] = struct{}{}
+		tokenIdentifiers = append(tokenIdentifiers, tokenIdentifier)
+	}
+
+	tokenTypes, err := facade.GetESDTTokenTypes(tokenIdentifiers)
+	if err != nil {
+		return err
+	}
+
+	for tokenID, tokenData := range formattedTokens {
+		tokenType, found := tokenTypes[getTokenIdentifierWithoutNonce(tokenID)]
+		if !found {
+			continue
+		}
+
+		tokenData.Type = tokenType.Type
+	}
+
+	return nil
+}
+
+func getTokenIdentifierWithoutNonce(tokenID string) string {
+	splitToken := strings.Split(tokenID, "-")
+	if len(splitToken) < 3 {
+		return tokenID
+	}
+
+	return splitToken[0] + "-" + splitToken[1]
+}
+
+// GetESDTAllowances returns the esdt allowances given by the provided address to other spenders
+func GetESDTAllowances(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowances.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	allowances, err := facade.GetESDTAllowances(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTAllowances.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"allowances": allowances},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetContractInfo returns the code, the code metadata, the owner and the deploy/upgrade history of a smart contract
+func GetContractInfo(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrGetContractInfo.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	contract, err := facade.GetContractInfo(addr)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetContractInfo.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"contract": contract}, "", shared.ReturnCodeSuccess)
+}
+
+// CheckContractUpgrade simulates the upgrade of a smart contract with the provided code and returns the outcome
+func CheckContractUpgrade(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrCheckContractUpgrade.Error(), errors.ErrEmptyAddress.Error()),
+		)
+		return
+	}
+
+	request := api.UpgradeCheckRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	result, err := facade.CheckContractUpgrade(addr, request)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrCheckContractUpgrade.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"result": result}, "", shared.ReturnCodeSuccess)
+}
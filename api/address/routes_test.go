@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,11 +19,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -61,6 +64,7 @@ type esdtNFTTokenData struct {
 	TokenIdentifier string   `json:"tokenIdentifier"`
 	Balance         string   `json:"balance"`
 	Properties      string   `json:"properties"`
+	Type            string   `json:"type"`
 	Name            string   `json:"name"`
 	Nonce           uint64   `json:"nonce"`
 	Creator         string   `json:"creator"`
@@ -88,6 +92,16 @@ type esdtsWithRoleResponse struct {
 	Code  string                    `json:"code"`
 }
 
+type esdtAllowancesResponseData struct {
+	Allowances []*api.ESDTAllowance `json:"allowances"`
+}
+
+type esdtAllowancesResponse struct {
+	Data  esdtAllowancesResponseData `json:"data"`
+	Error string                     `json:"error"`
+	Code  string                     `json:"code"`
+}
+
 type esdtTokenResponse struct {
 	Data  esdtTokenResponseData `json:"data"`
 	Error string                `json:"error"`
@@ -794,6 +808,54 @@ func TestGetNFTTokenIDsRegisteredByAddress_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedTokens, esdtResponseObj.Data.Tokens)
 }
 
+func TestGetESDTAllowances_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTAllowancesCalled: func(_ string) ([]*api.ESDTAllowance, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt-allowances", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtAllowancesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTAllowances.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetESDTAllowances_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedAllowances := []*api.ESDTAllowance{
+		{Spender: "spender", Token: "ABC-0o9i8u", Allowance: "100"},
+	}
+	facade := mock.Facade{
+		GetESDTAllowancesCalled: func(address string) ([]*api.ESDTAllowance, error) {
+			assert.Equal(t, "address", address)
+			return expectedAllowances, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt-allowances", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtAllowancesResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedAllowances, response.Data.Allowances)
+}
+
 func TestGetESDTTokens_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -859,6 +921,40 @@ func TestGetFullESDTTokens_ShouldWork(t *testing.T) {
 	assert.Equal(t, 2, len(esdtTokenResponseObj.Data.Tokens))
 }
 
+func TestGetFullESDTTokens_ShouldAddTokenTypes(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	metaESDT := "META-abcdef-0a"
+	fungible := "FUNG-abcdef"
+	facade := mock.Facade{
+		GetAllESDTTokensCalled: func(address string) (map[string]*esdt.ESDigitalToken, error) {
+			return map[string]*esdt.ESDigitalToken{
+				metaESDT: {Value: big.NewInt(10), TokenMetaData: &esdt.MetaData{Nonce: 10}},
+				fungible: {Value: big.NewInt(100)},
+			}, nil
+		},
+		GetESDTTokenTypesCalled: func(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error) {
+			assert.ElementsMatch(t, []string{"META-abcdef", fungible}, tokenIdentifiers)
+			return map[string]*api.ESDTTokenType{
+				"META-abcdef": {Type: core.MetaESDT},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/esdt", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtTokenResponseObj := esdtTokensCompleteResponse{}
+	loadResponse(resp.Body, &esdtTokenResponseObj)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, core.MetaESDT, esdtTokenResponseObj.Data.Tokens[metaESDT].Type)
+	assert.Equal(t, "", esdtTokenResponseObj.Data.Tokens[fungible].Type)
+}
+
 func TestGetKeyValuePairs_InvalidAppContextShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -943,6 +1039,164 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+type contractInfoResponseData struct {
+	Contract *api.ContractInfo `json:"contract"`
+}
+
+type contractInfoResponse struct {
+	Data  contractInfoResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+type upgradeCheckResponseData struct {
+	Result *api.UpgradeCheckResult `json:"result"`
+}
+
+type upgradeCheckResponse struct {
+	Data  upgradeCheckResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+func TestGetContractInfo_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetContractInfoCalled: func(_ string) (*api.ContractInfo, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1contract/contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetContractInfo.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetContractInfo_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	contract := &api.ContractInfo{
+		Address:  "erd1contract",
+		Code:     "0061736d",
+		CodeHash: "aabb",
+		CodeMetadata: api.ContractCodeMetadata{
+			Upgradeable: true,
+			Readable:    true,
+		},
+		Owner: "erd1owner",
+		History: []*api.ContractHistoryEntry{
+			{TxHash: "ccdd", BlockNonce: 7, Action: "deploy"},
+		},
+	}
+	facade := mock.Facade{
+		GetContractInfoCalled: func(address string) (*api.ContractInfo, error) {
+			assert.Equal(t, contract.Address, address)
+			return contract, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/erd1contract/contract", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := contractInfoResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, contract, response.Data.Contract)
+}
+
+func TestCheckContractUpgrade_InvalidBodyShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		CheckContractUpgradeCalled: func(_ string, _ api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/address/erd1contract/upgrade-check", bytes.NewBufferString("invalid"))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestCheckContractUpgrade_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		CheckContractUpgradeCalled: func(_ string, _ api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("POST", "/address/erd1contract/upgrade-check", bytes.NewBufferString(`{"code":"aa"}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrCheckContractUpgrade.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestCheckContractUpgrade_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := api.UpgradeCheckRequest{
+		Code:         "0061736d",
+		CodeMetadata: "0100",
+		Arguments:    []string{"05"},
+		GasLimit:     5000000,
+	}
+	expectedResult := &api.UpgradeCheckResult{
+		AddedFunctions:   []string{"added"},
+		RemovedFunctions: []string{"removed"},
+		ChangedFunctions: []string{"get: (i32) -> () -> () -> ()"},
+	}
+	facade := mock.Facade{
+		CheckContractUpgradeCalled: func(address string, request api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error) {
+			assert.Equal(t, "erd1contract", address)
+			assert.Equal(t, expectedRequest, request)
+			return expectedResult, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	body, _ := json.Marshal(expectedRequest)
+	req, _ := http.NewRequest("POST", "/address/erd1contract/upgrade-check", bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := upgradeCheckResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedResult, response.Data.Result)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -958,6 +1212,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/esdt-allowances", Open: true},
+					{Name: "/:address/contract", Open: true},
+					{Name: "/:address/upgrade-check", Open: true},
 				},
 			},
 		},
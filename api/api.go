@@ -6,6 +6,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
@@ -95,6 +96,12 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		proof.Routes(wrappedProofRouter)
 	}
 
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -76,12 +76,33 @@ var ErrGetTransaction = errors.New("getting transaction failed")
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetEvents signals an error happening when trying to fetch the indexed events
+var ErrGetEvents = errors.New("getting events failed")
+
+// ErrGetContractInfo signals an error happening when trying to inspect a smart contract
+var ErrGetContractInfo = errors.New("getting contract info failed")
+
+// ErrCheckContractUpgrade signals an error happening when trying to check a smart contract upgrade
+var ErrCheckContractUpgrade = errors.New("checking contract upgrade failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetSyncStatus signals that an error occurred while getting the sync status
+var ErrGetSyncStatus = errors.New("error getting sync status")
+
+// ErrGetEpochStartCheckpoint signals that an error occurred while getting the epoch start checkpoint
+var ErrGetEpochStartCheckpoint = errors.New("error getting epoch start checkpoint")
+
+// ErrGetStorageUsage signals that an error occurred while getting the storage usage
+var ErrGetStorageUsage = errors.New("error getting storage usage")
+
+// ErrCompactStorage signals that an error occurred while starting the storage compaction
+var ErrCompactStorage = errors.New("error starting storage compaction")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
@@ -97,6 +118,24 @@ var ErrGetProof = errors.New("getting proof failed")
 // ErrVerifyProof signals an error happening when trying to verify a Merkle proof
 var ErrVerifyProof = errors.New("verifying proof failed")
 
+// ErrGetESDTSupplyDelta signals an error happening when trying to fetch the supply delta of an esdt token
+var ErrGetESDTSupplyDelta = errors.New("getting esdt supply delta failed")
+
+// ErrGetESDTAllowances signals an error happening when trying to fetch the esdt allowances given by an address
+var ErrGetESDTAllowances = errors.New("getting esdt allowances failed")
+
+// ErrEmptyCommitHash signals that an empty proposal commit hash was provided
+var ErrEmptyCommitHash = errors.New("commit hash is empty")
+
+// ErrGetGovernanceProposals signals an error happening when trying to fetch the governance proposals
+var ErrGetGovernanceProposals = errors.New("getting governance proposals failed")
+
+// ErrGetGovernanceProposal signals an error happening when trying to fetch a governance proposal
+var ErrGetGovernanceProposal = errors.New("getting governance proposal failed")
+
+// ErrGetGovernanceVotes signals an error happening when trying to fetch the governance votes of an address
+var ErrGetGovernanceVotes = errors.New("getting governance votes failed")
+
 // ErrNilHttpServer signals that a nil http server has been provided
 var ErrNilHttpServer = errors.New("nil http server")
 
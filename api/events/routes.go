@@ -0,0 +1,149 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getEventsPath = "/query"
+
+	queryParamAddress    = "address"
+	queryParamIdentifier = "identifier"
+	queryParamTopic      = "topic"
+	queryParamFromBlock  = "fromBlock"
+	queryParamToBlock    = "toBlock"
+	queryParamOffset     = "offset"
+	queryParamLimit      = "limit"
+)
+
+var log = logger.GetOrCreate("api/events")
+
+// EventsService interface defines methods that can be used from `elrondFacade` context variable
+type EventsService interface {
+	GetEvents(query api.EventsQuery) (*api.EventsPage, error)
+}
+
+// Routes defines events related routes
+func Routes(routes *wrapper.RouterWrapper) {
+	routes.RegisterHandler(http.MethodGet, getEventsPath, getEvents)
+}
+
+func getEvents(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	query, err := parseEventsQuery(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	page, err := ef.GetEvents(query)
+	log.Debug(fmt.Sprintf("GetEvents took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"events": page.Events, "total": page.Total}, "", shared.ReturnCodeSuccess)
+}
+
+func parseEventsQuery(c *gin.Context) (api.EventsQuery, error) {
+	urlQuery := c.Request.URL.Query()
+	query := api.EventsQuery{
+		Address:    urlQuery.Get(queryParamAddress),
+		Identifier: urlQuery.Get(queryParamIdentifier),
+		Topic:      urlQuery.Get(queryParamTopic),
+	}
+	if query.Address == "" {
+		return api.EventsQuery{}, errors.ErrValidationEmptyAddress
+	}
+
+	var err error
+	query.FromBlock, err = getUintQueryParam(c, queryParamFromBlock, 64)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+	query.ToBlock, err = getUintQueryParam(c, queryParamToBlock, 64)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+
+	offset, err := getUintQueryParam(c, queryParamOffset, 32)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+	limit, err := getUintQueryParam(c, queryParamLimit, 32)
+	if err != nil {
+		return api.EventsQuery{}, err
+	}
+	query.Offset = uint32(offset)
+	query.Limit = uint32(limit)
+
+	return query, nil
+}
+
+func getUintQueryParam(c *gin.Context, name string, bitSize int) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, bitSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errors.ErrInvalidQueryParameter, name)
+	}
+
+	return value, nil
+}
+
+func getFacade(c *gin.Context) (EventsService, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(EventsService)
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
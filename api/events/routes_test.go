@@ -0,0 +1,207 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type eventsResponseData struct {
+	Events []*api.IndexedEvent `json:"events"`
+	Total  uint32              `json:"total"`
+}
+
+type eventsResponse struct {
+	Data  eventsResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetEvents_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/events/query?address=erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetEvents_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/events/query?address=erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetEvents_InvalidQueryParametersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.EventsPage, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	urls := map[string]error{
+		"/events/query":                                 apiErrors.ErrValidationEmptyAddress,
+		"/events/query?address=erd1&fromBlock=a":        apiErrors.ErrInvalidQueryParameter,
+		"/events/query?address=erd1&toBlock=-1":         apiErrors.ErrInvalidQueryParameter,
+		"/events/query?address=erd1&offset=b":           apiErrors.ErrInvalidQueryParameter,
+		"/events/query?address=erd1&limit=999999999999": apiErrors.ErrInvalidQueryParameter,
+	}
+	for url, expectedErr := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()), url)
+	}
+}
+
+func TestGetEvents_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetEventsCalled: func(_ api.EventsQuery) (*api.EventsPage, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/events/query?address=erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetEvents_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := api.EventsQuery{
+		Address:    "erd1",
+		Identifier: "deposit",
+		Topic:      "aabb",
+		FromBlock:  10,
+		ToBlock:    20,
+		Offset:     5,
+		Limit:      2,
+	}
+	expectedEvent := &api.IndexedEvent{
+		TxHash:     "abcd",
+		BlockNonce: 12,
+		Address:    "erd1",
+		Identifier: "deposit",
+		Topics:     [][]byte{{0xaa, 0xbb}},
+	}
+	facade := mock.Facade{
+		GetEventsCalled: func(query api.EventsQuery) (*api.EventsPage, error) {
+			assert.Equal(t, expectedQuery, query)
+			return &api.EventsPage{
+				Events: []*api.IndexedEvent{expectedEvent},
+				Total:  6,
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	url := "/events/query?address=erd1&identifier=deposit&topic=aabb&fromBlock=10&toBlock=20&offset=5&limit=2"
+	req, _ := http.NewRequest("GET", url, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*api.IndexedEvent{expectedEvent}, response.Data.Events)
+	assert.Equal(t, uint32(6), response.Data.Total)
+}
+
+func startNodeServer(handler events.EventsService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("/events")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithFacade(handler))
+	}
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginEventsRoute := ws.Group("/events")
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", ginEventsRoute, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
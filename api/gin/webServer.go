@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
 	"github.com/ElrondNetwork/elrond-go/api/gin/disabled"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
@@ -261,6 +262,12 @@ func (ws *webServer) registerRoutes(gws *gin.Engine) {
 		proof.Routes(wrappedProofRouter)
 	}
 
+	eventsRoutes := gws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(gws)
 	}
@@ -28,7 +28,7 @@ type Facade struct {
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler              func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationHandler func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler             func(txs []*transaction.Transaction) (uint64, error)
@@ -40,6 +40,10 @@ type Facade struct {
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetSyncStatusCalled                     func() (*api.SyncStatus, error)
+	GetEpochStartCheckpointCalled           func() (*api.EpochStartCheckpoint, error)
+	GetStorageUsageCalled                   func() (*api.StorageUsage, error)
+	CompactStorageUnitsCalled               func(units []string) error
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
@@ -52,10 +56,20 @@ type Facade struct {
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string) ([]string, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetEventsCalled                         func(query api.EventsQuery) (*api.EventsPage, error)
+	GetContractInfoCalled                   func(address string) (*api.ContractInfo, error)
+	CheckContractUpgradeCalled              func(address string, request api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error)
 	GetTotalStakedValueHandler              func() (*api.StakeValues, error)
 	GetAllIssuedESDTsCalled                 func(tokenType string) ([]string, error)
+	GetTokenSupplyDeltaCalled                    func(token string) (*api.ESDTSupplyDelta, error)
+	GetESDTAllowancesCalled                 func(address string) ([]*api.ESDTAllowance, error)
+	GetESDTTokenTypesCalled                 func(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetGovernanceProposalsCalled            func() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposalCalled             func(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotesCalled                func(address string) ([]*api.GovernanceVote, error)
+	GetStakingQueueCalled                   func() ([]*api.StakingQueueEntry, error)
 	GetProofCalled                          func(string, string) ([][]byte, error)
 	GetProofCurrentRootHashCalled           func(string) ([][]byte, []byte, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
@@ -202,6 +216,33 @@ func (f *Facade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return make([]string, 0), nil
 }
 
+// GetTokenSupplyDelta -
+func (f *Facade) GetTokenSupplyDelta(token string) (*api.ESDTSupplyDelta, error) {
+	if f.GetTokenSupplyDeltaCalled != nil {
+		return f.GetTokenSupplyDeltaCalled(token)
+	}
+
+	return nil, nil
+}
+
+// GetESDTAllowances -
+func (f *Facade) GetESDTAllowances(address string) ([]*api.ESDTAllowance, error) {
+	if f.GetESDTAllowancesCalled != nil {
+		return f.GetESDTAllowancesCalled(address)
+	}
+
+	return make([]*api.ESDTAllowance, 0), nil
+}
+
+// GetESDTTokenTypes -
+func (f *Facade) GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error) {
+	if f.GetESDTTokenTypesCalled != nil {
+		return f.GetESDTTokenTypesCalled(tokenIdentifiers)
+	}
+
+	return make(map[string]*api.ESDTTokenType), nil
+}
+
 // GetAccount -
 func (f *Facade) GetAccount(address string) (api.AccountResponse, error) {
 	return f.GetAccountHandler(address)
@@ -222,8 +263,11 @@ func (f *Facade) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	relayer string,
+	relayerNonce uint64,
+	relayerSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
-	return f.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
+	return f.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options, relayer, relayerNonce, relayerSignatureHex)
 }
 
 // GetTransaction is the mock implementation of a handler's GetTransaction method
@@ -281,6 +325,42 @@ func (f *Facade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return f.GetDelegatorsListHandler()
 }
 
+// GetGovernanceProposals -
+func (f *Facade) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *Facade) GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(commitHash)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (f *Facade) GetGovernanceVotes(address string) ([]*api.GovernanceVote, error) {
+	if f.GetGovernanceVotesCalled != nil {
+		return f.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (f *Facade) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	if f.GetStakingQueueCalled != nil {
+		return f.GetStakingQueueCalled()
+	}
+
+	return nil, nil
+}
+
 // ComputeTransactionGasLimit -
 func (f *Facade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return f.ComputeTransactionGasLimitHandler(tx)
@@ -311,6 +391,42 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetSyncStatus -
+func (f *Facade) GetSyncStatus() (*api.SyncStatus, error) {
+	if f.GetSyncStatusCalled != nil {
+		return f.GetSyncStatusCalled()
+	}
+
+	return nil, nil
+}
+
+// GetEpochStartCheckpoint -
+func (f *Facade) GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error) {
+	if f.GetEpochStartCheckpointCalled != nil {
+		return f.GetEpochStartCheckpointCalled()
+	}
+
+	return nil, nil
+}
+
+// GetStorageUsage -
+func (f *Facade) GetStorageUsage() (*api.StorageUsage, error) {
+	if f.GetStorageUsageCalled != nil {
+		return f.GetStorageUsageCalled()
+	}
+
+	return nil, nil
+}
+
+// CompactStorageUnits -
+func (f *Facade) CompactStorageUnits(units []string) error {
+	if f.CompactStorageUnitsCalled != nil {
+		return f.CompactStorageUnitsCalled(units)
+	}
+
+	return nil
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *Facade) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -334,6 +450,21 @@ func (f *Facade) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	return f.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetEvents -
+func (f *Facade) GetEvents(query api.EventsQuery) (*api.EventsPage, error) {
+	return f.GetEventsCalled(query)
+}
+
+// GetContractInfo -
+func (f *Facade) GetContractInfo(address string) (*api.ContractInfo, error) {
+	return f.GetContractInfoCalled(address)
+}
+
+// CheckContractUpgrade -
+func (f *Facade) CheckContractUpgrade(address string, request api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error) {
+	return f.CheckContractUpgradeCalled(address, request)
+}
+
 // GetBlockByHash -
 func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
@@ -13,16 +14,22 @@ import (
 )
 
 const (
-	getConfigPath        = "/config"
-	getStatusPath        = "/status"
-	economicsPath        = "/economics"
-	enableEpochsPath     = "/enable-epochs"
-	getESDTsPath         = "/esdts"
-	getFFTsPath          = "/esdt/fungible-tokens"
-	getSFTsPath          = "/esdt/semi-fungible-tokens"
-	getNFTsPath          = "/esdt/non-fungible-tokens"
-	directStakedInfoPath = "/direct-staked-info"
-	delegatedInfoPath    = "/delegated-info"
+	getConfigPath          = "/config"
+	getStatusPath          = "/status"
+	economicsPath          = "/economics"
+	enableEpochsPath       = "/enable-epochs"
+	getESDTsPath           = "/esdts"
+	getFFTsPath            = "/esdt/fungible-tokens"
+	getSFTsPath            = "/esdt/semi-fungible-tokens"
+	getNFTsPath            = "/esdt/non-fungible-tokens"
+	getMetaESDTsPath       = "/esdt/meta-esdt-tokens"
+	getESDTSupplyDeltaPath = "/esdt/supply-delta/:token"
+	directStakedInfoPath   = "/direct-staked-info"
+	delegatedInfoPath      = "/delegated-info"
+	stakingQueuePath       = "/staking-queue"
+	governanceProposals    = "/governance/proposals"
+	governanceProposal     = "/governance/proposal/:commitHash"
+	governanceVotes        = "/governance/votes/:address"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -30,8 +37,13 @@ type FacadeHandler interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetStakingQueue() ([]*api.StakingQueueEntry, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
+	GetTokenSupplyDelta(token string) (*api.ESDTSupplyDelta, error)
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotes(address string) ([]*api.GovernanceVote, error)
 	IsInterfaceNil() bool
 }
 
@@ -45,8 +57,14 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getFFTsPath, getHandlerFuncForEsdt(core.FungibleESDT))
 	router.RegisterHandler(http.MethodGet, getSFTsPath, getHandlerFuncForEsdt(core.SemiFungibleESDT))
 	router.RegisterHandler(http.MethodGet, getNFTsPath, getHandlerFuncForEsdt(core.NonFungibleESDT))
+	router.RegisterHandler(http.MethodGet, getMetaESDTsPath, getHandlerFuncForEsdt(core.MetaESDT))
+	router.RegisterHandler(http.MethodGet, getESDTSupplyDeltaPath, GetESDTSupplyDelta)
 	router.RegisterHandler(http.MethodGet, directStakedInfoPath, DirectStakedInfo)
 	router.RegisterHandler(http.MethodGet, delegatedInfoPath, DelegatedInfo)
+	router.RegisterHandler(http.MethodGet, stakingQueuePath, StakingQueue)
+	router.RegisterHandler(http.MethodGet, governanceProposals, GovernanceProposals)
+	router.RegisterHandler(http.MethodGet, governanceProposal, GovernanceProposal)
+	router.RegisterHandler(http.MethodGet, governanceVotes, GovernanceVotes)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -198,6 +216,50 @@ func getHandlerFuncForEsdt(tokenType string) func(c *gin.Context) {
 	}
 }
 
+// GetESDTSupplyDelta is the endpoint that will return the quantities of a token minted and burned in the shard of the
+// node. The total supply of the token is the sum of the deltas returned by all the shards
+func GetESDTSupplyDelta(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	token := c.Param("token")
+	if token == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTSupplyDelta.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	supplyDelta, err := facade.GetTokenSupplyDelta(token)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTSupplyDelta.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"supplyDelta": supplyDelta},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // DirectStakedInfo is the endpoint that will return the directed staked info list
 func DirectStakedInfo(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -257,3 +319,149 @@ func DelegatedInfo(c *gin.Context) {
 		},
 	)
 }
+
+// StakingQueue is the endpoint that will return the BLS keys waiting in the staking queue
+func StakingQueue(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	queue, err := facade.GetStakingQueue()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"queue": queue},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GovernanceProposals is the endpoint that will return the governance proposals
+func GovernanceProposals(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	proposals, err := facade.GetGovernanceProposals()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposals.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposals": proposals},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GovernanceProposal is the endpoint that will return a governance proposal together with its vote tallies
+func GovernanceProposal(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	commitHash := c.Param("commitHash")
+	if commitHash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposal.Error(), errors.ErrEmptyCommitHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proposal, err := facade.GetGovernanceProposal(commitHash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposal.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"proposal": proposal},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GovernanceVotes is the endpoint that will return the governance votes cast by an address
+func GovernanceVotes(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceVotes.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	votes, err := facade.GetGovernanceVotes(address)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGovernanceVotes.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"votes": votes},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
@@ -32,6 +32,38 @@ type esdtTokensResponseData struct {
 	Tokens []string `json:"tokens"`
 }
 
+type stakingQueueResponse struct {
+	Data struct {
+		Queue []*api.StakingQueueEntry `json:"queue"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*api.GovernanceProposal `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *api.GovernanceProposalTally `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVotesResponse struct {
+	Data struct {
+		Votes []*api.GovernanceVote `json:"votes"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type esdtTokensResponse struct {
 	Data  esdtTokensResponseData `json:"data"`
 	Error string                 `json:"error"`
@@ -237,6 +269,83 @@ func TestGetAllIssuedESDTs_ShouldWork(t *testing.T) {
 	assert.Equal(t, tokens, response.Data.Tokens)
 }
 
+func TestGetAllIssuedMetaESDTs_ShouldWork(t *testing.T) {
+	tokens := []string{"LKMEX-aab910"}
+	facade := mock.Facade{
+		GetAllIssuedESDTsCalled: func(tokenType string) ([]string, error) {
+			assert.Equal(t, core.MetaESDT, tokenType)
+			return tokens, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/meta-esdt-tokens", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtTokensResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusOK)
+
+	assert.Equal(t, tokens, response.Data.Tokens)
+}
+
+type esdtSupplyDeltaResponseData struct {
+	SupplyDelta *api.ESDTSupplyDelta `json:"supplyDelta"`
+}
+
+type esdtSupplyDeltaResponse struct {
+	Data  esdtSupplyDeltaResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string
+}
+
+func TestGetESDTSupplyDelta_ShouldWork(t *testing.T) {
+	supplyDelta := &api.ESDTSupplyDelta{
+		Token:   "TKN-abcdef",
+		ShardID: 1,
+		Delta:   "70",
+		Minted:  "100",
+		Burned:  "30",
+	}
+	facade := mock.Facade{
+		GetTokenSupplyDeltaCalled: func(token string) (*api.ESDTSupplyDelta, error) {
+			assert.Equal(t, "TKN-abcdef", token)
+			return supplyDelta, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/supply-delta/TKN-abcdef", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtSupplyDeltaResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusOK)
+	assert.Equal(t, supplyDelta, response.Data.SupplyDelta)
+}
+
+func TestGetESDTSupplyDelta_Error(t *testing.T) {
+	localErr := fmt.Errorf("%s", "local error")
+	facade := mock.Facade{
+		GetTokenSupplyDeltaCalled: func(_ string) (*api.ESDTSupplyDelta, error) {
+			return nil, localErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/supply-delta/TKN-abcdef", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := esdtSupplyDeltaResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, resp.Code, http.StatusInternalServerError)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetESDTSupplyDelta.Error()))
+	assert.True(t, strings.Contains(response.Error, localErr.Error()))
+}
+
 func TestGetAllIssuedESDTs_Error(t *testing.T) {
 	localErr := fmt.Errorf("%s", "local error")
 	facade := mock.Facade{
@@ -423,6 +532,246 @@ func TestDelegatedInfo_CannotGetDelegatedList(t *testing.T) {
 	assert.True(t, strings.Contains(respStr, expectedError.Error()))
 }
 
+func TestStakingQueue_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/network/staking-queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+}
+
+func TestStakingQueue_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	queue := []*api.StakingQueueEntry{
+		{
+			BLSKey:                  "blsKey1",
+			Owner:                   "owner1",
+			RewardAddress:           "owner1",
+			Index:                   1,
+			RegisterNonce:           100,
+			ExpectedActivationEpoch: 12,
+		},
+		{
+			BLSKey:        "blsKey2",
+			Owner:         "owner2",
+			RewardAddress: "reward2",
+			Index:         2,
+			RegisterNonce: 200,
+		},
+	}
+	facade := mock.Facade{
+		GetStakingQueueCalled: func() ([]*api.StakingQueueEntry, error) {
+			return queue, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/staking-queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stakingQueueResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, queue, response.Data.Queue)
+}
+
+func TestStakingQueue_CannotGetQueue(t *testing.T) {
+	t.Parallel()
+
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetStakingQueueCalled: func() ([]*api.StakingQueueEntry, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/staking-queue", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedError.Error(), response.Error)
+}
+
+func TestGovernanceProposals_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proposal := &api.GovernanceProposal{
+		CommitHash:     "commit1",
+		Issuer:         "issuer1",
+		StartVoteNonce: 10,
+		EndVoteNonce:   20,
+		Status:         "open",
+		Yes:            "1000",
+		No:             "200",
+		Veto:           "30",
+		NumVoters:      4,
+	}
+	facade := mock.Facade{
+		GetGovernanceProposalsCalled: func() ([]*api.GovernanceProposal, error) {
+			return []*api.GovernanceProposal{proposal}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceProposalsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*api.GovernanceProposal{proposal}, response.Data.Proposals)
+}
+
+func TestGovernanceProposals_CannotGetProposals(t *testing.T) {
+	t.Parallel()
+
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetGovernanceProposalsCalled: func() ([]*api.GovernanceProposal, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetGovernanceProposals.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedError.Error()))
+}
+
+func TestGovernanceProposal_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tally := &api.GovernanceProposalTally{
+		Proposal: &api.GovernanceProposal{
+			CommitHash: "commit1",
+			Status:     "closed",
+			Passed:     true,
+			Yes:        "1000",
+			No:         "0",
+			Veto:       "0",
+		},
+		Validators: &api.GovernanceVotingPower{Yes: "700", No: "0", Veto: "0"},
+		Funds:      &api.GovernanceVotingPower{Yes: "300", No: "0", Veto: "0"},
+	}
+	facade := mock.Facade{
+		GetGovernanceProposalCalled: func(commitHash string) (*api.GovernanceProposalTally, error) {
+			assert.Equal(t, "commit1", commitHash)
+			return tally, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/proposal/commit1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceProposalResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, tally, response.Data.Proposal)
+}
+
+func TestGovernanceProposal_CannotGetProposal(t *testing.T) {
+	t.Parallel()
+
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetGovernanceProposalCalled: func(commitHash string) (*api.GovernanceProposalTally, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/proposal/commit1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetGovernanceProposal.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedError.Error()))
+}
+
+func TestGovernanceVotes_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vote := &api.GovernanceVote{
+		CommitHash:  "commit1",
+		UsedPower:   "100",
+		UsedBalance: "0",
+		Yes:         "100",
+		No:          "0",
+		Veto:        "0",
+	}
+	facade := mock.Facade{
+		GetGovernanceVotesCalled: func(address string) ([]*api.GovernanceVote, error) {
+			assert.Equal(t, "erd1address", address)
+			return []*api.GovernanceVote{vote}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/votes/erd1address", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := governanceVotesResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []*api.GovernanceVote{vote}, response.Data.Votes)
+}
+
+func TestGovernanceVotes_CannotGetVotes(t *testing.T) {
+	t.Parallel()
+
+	expectedError := fmt.Errorf("%s", "expected error")
+	facade := mock.Facade{
+		GetGovernanceVotesCalled: func(address string) ([]*api.GovernanceVote, error) {
+			return nil, expectedError
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/governance/votes/erd1address", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetGovernanceVotes.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedError.Error()))
+}
+
 func TestGetEnableEpochs_NilContextShouldErr(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -528,10 +877,16 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
 					{Name: "/esdts", Open: true},
+					{Name: "/esdt/meta-esdt-tokens", Open: true},
+					{Name: "/esdt/supply-delta/:token", Open: true},
 					{Name: "/total-staked", Open: true},
 					{Name: "/enable-epochs", Open: true},
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
+					{Name: "/staking-queue", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposal/:commitHash", Open: true},
+					{Name: "/governance/votes/:address", Open: true},
 				},
 			},
 		},
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -17,14 +18,21 @@ import (
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statisticsPath      = "/statistics"
-	statusPath          = "/status"
+	pidQueryParam            = "pid"
+	debugPath                = "/debug"
+	heartbeatStatusPath      = "/heartbeatstatus"
+	metricsPath              = "/metrics"
+	p2pStatusPath            = "/p2pstatus"
+	peerInfoPath             = "/peerinfo"
+	statisticsPath           = "/statistics"
+	statusPath               = "/status"
+	syncPath                 = "/sync"
+	syncForkDetectorPath     = "/sync/forkdetector"
+	syncRollBacksPath        = "/sync/rollbacks"
+	syncHeaderSuppliersPath  = "/sync/suppliers"
+	epochStartCheckpointPath = "/epoch-start-checkpoint"
+	storagePath              = "/storage"
+	storageCompactPath       = "/storage/compact"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -40,6 +48,10 @@ type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetSyncStatus() (*api.SyncStatus, error)
+	GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error)
+	GetStorageUsage() (*api.StorageUsage, error)
+	CompactStorageUnits(units []string) error
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -51,6 +63,12 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// CompactStorageRequest represents the structure of the request used to start the online compaction of the storage
+// units. The compactable units are listed in the storage usage report
+type CompactStorageRequest struct {
+	Units []string `json:"units"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -84,6 +102,13 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, syncPath, SyncStatus)
+	router.RegisterHandler(http.MethodGet, syncForkDetectorPath, SyncForkDetector)
+	router.RegisterHandler(http.MethodGet, syncRollBacksPath, SyncRollBacks)
+	router.RegisterHandler(http.MethodGet, syncHeaderSuppliersPath, SyncHeaderSuppliers)
+	router.RegisterHandler(http.MethodGet, epochStartCheckpointPath, EpochStartCheckpoint)
+	router.RegisterHandler(http.MethodGet, storagePath, StorageUsage)
+	router.RegisterHandler(http.MethodPost, storageCompactPath, CompactStorage)
 	// placeholder for custom routes
 }
 
@@ -313,6 +338,186 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// SyncStatus returns the sync state, the fork detector status, the last roll backs and the header suppliers
+func SyncStatus(c *gin.Context) {
+	status, ok := getSyncStatus(c)
+	if !ok {
+		return
+	}
+
+	respondWithSyncData(c, gin.H{"status": status})
+}
+
+// SyncForkDetector returns the headers, the checkpoints and the final nonces known by the fork detector
+func SyncForkDetector(c *gin.Context) {
+	status, ok := getSyncStatus(c)
+	if !ok {
+		return
+	}
+
+	respondWithSyncData(c, gin.H{"forkDetector": status.ForkDetector})
+}
+
+// SyncRollBacks returns the last roll backs done by the sync mechanism, together with their reasons
+func SyncRollBacks(c *gin.Context) {
+	status, ok := getSyncStatus(c)
+	if !ok {
+		return
+	}
+
+	respondWithSyncData(c, gin.H{"rollBacks": status.RollBacks})
+}
+
+// SyncHeaderSuppliers returns the peers which supplied headers to the node
+func SyncHeaderSuppliers(c *gin.Context) {
+	status, ok := getSyncStatus(c)
+	if !ok {
+		return
+	}
+
+	respondWithSyncData(c, gin.H{"headerSuppliers": status.HeaderSuppliers})
+}
+
+func getSyncStatus(c *gin.Context) (*api.SyncStatus, bool) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return nil, false
+	}
+
+	status, err := facade.GetSyncStatus()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetSyncStatus.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	return status, true
+}
+
+func respondWithSyncData(c *gin.Context, response gin.H) {
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  response,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// EpochStartCheckpoint returns the epoch start metablock of the current epoch, usable as a trusted checkpoint by the
+// nodes bootstrapping from the network
+func EpochStartCheckpoint(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	checkpoint, err := facade.GetEpochStartCheckpoint()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetEpochStartCheckpoint.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"checkpoint": checkpoint},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// StorageUsage returns the size on disk of the storage units, per unit and per epoch, together with the status of the
+// last online compaction
+func StorageUsage(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	usage, err := facade.GetStorageUsage()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetStorageUsage.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"storage": usage},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// CompactStorage starts the online compaction of the storage units provided in the request body. The compaction runs
+// in background and its progress is reported by the storage usage endpoint
+func CompactStorage(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = CompactStorageRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = facade.CompactStorageUnits(request.Units)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCompactStorage.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"units": request.Units},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
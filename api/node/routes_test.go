@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -461,6 +462,226 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestSyncStatus_GetSyncStatusErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetSyncStatusCalled: func() (*api.SyncStatus, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/sync", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetSyncStatus.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSyncStatus_RoutesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	status := &api.SyncStatus{
+		State: &api.SyncState{IsSynchronized: true, CurrentNonce: 7},
+		ForkDetector: &api.ForkDetectorStatus{
+			Headers: []*api.ForkDetectorHeader{{Nonce: 8, Round: 9, State: "received"}},
+		},
+		RollBacks:       []*api.SyncRollBack{{Reason: "fork detected", FromNonce: 8, ToNonce: 7}},
+		HeaderSuppliers: []*api.SyncHeaderSupplier{{Pid: "pid", NumHeaders: 2}},
+	}
+	facade := &mock.Facade{
+		GetSyncStatusCalled: func() (*api.SyncStatus, error) {
+			return status, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+
+	routes := map[string]string{
+		"/node/sync":              "status",
+		"/node/sync/forkdetector": "forkDetector",
+		"/node/sync/rollbacks":    "rollBacks",
+		"/node/sync/suppliers":    "headerSuppliers",
+	}
+	for route, key := range routes {
+		req, _ := http.NewRequest("GET", route, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code, route)
+		assert.Equal(t, "", response.Error, route)
+
+		responseData, ok := response.Data.(map[string]interface{})
+		require.True(t, ok, route)
+		assert.NotNil(t, responseData[key], route)
+	}
+}
+
+func TestEpochStartCheckpoint_GetEpochStartCheckpointErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetEpochStartCheckpointCalled: func() (*api.EpochStartCheckpoint, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/epoch-start-checkpoint", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetEpochStartCheckpoint.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestEpochStartCheckpoint_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetEpochStartCheckpointCalled: func() (*api.EpochStartCheckpoint, error) {
+			return &api.EpochStartCheckpoint{Epoch: 7, MetaBlockHash: "abcd", FlagValue: "7:abcd"}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/epoch-start-checkpoint", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	checkpoint, ok := responseData["checkpoint"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "7:abcd", checkpoint["flagValue"])
+}
+
+func TestStorageUsage_GetStorageUsageErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetStorageUsageCalled: func() (*api.StorageUsage, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetStorageUsage.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestStorageUsage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetStorageUsageCalled: func() (*api.StorageUsage, error) {
+			return &api.StorageUsage{DatabasePath: "db", TotalSize: 1024}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/storage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	usage, ok := responseData["storage"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "db", usage["databasePath"])
+	assert.Equal(t, float64(1024), usage["totalSize"])
+}
+
+func TestCompactStorage_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWithFacade(&mock.Facade{})
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer([]byte("invalid")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestCompactStorage_CompactStorageUnitsErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		CompactStorageUnitsCalled: func(units []string) error {
+			return expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer([]byte(`{"units":["TransactionUnit"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrCompactStorage.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestCompactStorage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var compactedUnits []string
+	facade := &mock.Facade{
+		CompactStorageUnitsCalled: func(units []string) error {
+			compactedUnits = units
+			return nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("POST", "/node/storage/compact", bytes.NewBuffer([]byte(`{"units":["TransactionUnit","userAccountTrie"]}`)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, []string{"TransactionUnit", "userAccountTrie"}, compactedUnits)
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -559,6 +780,13 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/sync", Open: true},
+					{Name: "/sync/forkdetector", Open: true},
+					{Name: "/sync/rollbacks", Open: true},
+					{Name: "/sync/suppliers", Open: true},
+					{Name: "/epoch-start-checkpoint", Open: true},
+					{Name: "/storage", Open: true},
+					{Name: "/storage/compact", Open: true},
 				},
 			},
 		},
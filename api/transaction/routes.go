@@ -34,7 +34,7 @@ const (
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
@@ -76,6 +76,9 @@ type SendTxRequest struct {
 	ChainID          string `form:"chainID" json:"chainID"`
 	Version          uint32 `form:"version" json:"version"`
 	Options          uint32 `json:"options,omitempty"`
+	Relayer          string `json:"relayer,omitempty"`
+	RelayerNonce     uint64 `json:"relayerNonce,omitempty"`
+	RelayerSignature string `json:"relayerSignature,omitempty"`
 }
 
 // TxResponse represents the structure on which the response will be validated against
@@ -195,6 +198,9 @@ func SimulateTransaction(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.Relayer,
+		gtx.RelayerNonce,
+		gtx.RelayerSignature,
 	)
 	if err != nil {
 		c.JSON(
@@ -280,6 +286,9 @@ func SendTransaction(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.Relayer,
+		gtx.RelayerNonce,
+		gtx.RelayerSignature,
 	)
 	if err != nil {
 		c.JSON(
@@ -373,6 +382,9 @@ func SendMultipleTransactions(c *gin.Context) {
 			receivedTx.ChainID,
 			receivedTx.Version,
 			receivedTx.Options,
+			receivedTx.Relayer,
+			receivedTx.RelayerNonce,
+			receivedTx.RelayerSignature,
 		)
 		if err != nil {
 			continue
@@ -504,6 +516,9 @@ func ComputeTransactionGasLimit(c *gin.Context) {
 		gtx.ChainID,
 		gtx.Version,
 		gtx.Options,
+		gtx.Relayer,
+		gtx.RelayerNonce,
+		gtx.RelayerSignature,
 	)
 	if err != nil {
 		c.JSON(
@@ -6,6 +6,7 @@ generate() {
     generateForTermUi
     generateForLogViewer
     generateForSeedNode
+    generateForHardforkVerifier
 }
 
 generateForNode() {
@@ -78,6 +79,20 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForHardforkVerifier() {
+    HELP="
+# Hardfork verifier CLI
+
+The **Hardfork export verifier** exposes the following Command Line Interface:
+$(code)
+\$ hardforkverifier --help
+
+$(./hardforkverifier/hardforkverifier --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./hardforkverifier/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/update/genesis/portable"
+	"github.com/urfave/cli"
+)
+
+type cfg struct {
+	exportFolder          string
+	hasher                string
+	marshalizer           string
+	expectedMetaBlockHash string
+}
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// exportFolder defines the flag for the folder holding the hardfork export
+	exportFolder = cli.StringFlag{
+		Name:        "export-folder",
+		Usage:       "The hardfork export folder, or its portable sub-folder, that will be verified",
+		Value:       "export",
+		Destination: &argsConfig.exportFolder,
+	}
+	// hasher defines the flag for the hasher used by the chain
+	hasher = cli.StringFlag{
+		Name:        "hasher",
+		Usage:       "The hasher used by the chain for the trie nodes and the blocks. Available options: blake2b, sha256, keccak",
+		Value:       "blake2b",
+		Destination: &argsConfig.hasher,
+	}
+	// marshalizer defines the flag for the marshalizer used by the chain
+	marshalizer = cli.StringFlag{
+		Name:        "marshalizer",
+		Usage:       "The internal marshalizer used by the chain",
+		Value:       marshalFactory.GogoProtobuf,
+		Destination: &argsConfig.marshalizer,
+	}
+	// expectedMetaBlockHash defines the flag for the hash of the epoch start metablock known from a trusted source
+	expectedMetaBlockHash = cli.StringFlag{
+		Name: "expected-metablock-hash",
+		Usage: "The hex encoded hash of the epoch start metablock, as known from a trusted source (explorer, own node). " +
+			"If set, the verification fails if the exported metablock has a different hash",
+		Value:       "",
+		Destination: &argsConfig.expectedMetaBlockHash,
+	}
+
+	argsConfig = &cfg{}
+
+	log = logger.GetOrCreate("hardforkverifier")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Hardfork export verifier"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary verifies offline a hardfork export written in the portable format, by rebuilding the tries " +
+		"and comparing their root hashes with the ones from the exported epoch start metablock"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		exportFolder,
+		hasher,
+		marshalizer,
+		expectedMetaBlockHash,
+	}
+
+	app.Action = func(_ *cli.Context) error {
+		return process()
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("hardfork export verification failed", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func process() error {
+	hsh, err := hasherFactory.NewHasher(argsConfig.hasher)
+	if err != nil {
+		return err
+	}
+
+	marsh, err := marshalFactory.NewMarshalizer(argsConfig.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	expectedHash, err := hex.DecodeString(argsConfig.expectedMetaBlockHash)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the expected metablock hash", err)
+	}
+
+	folder := argsConfig.exportFolder
+	_, err = os.Stat(filepath.Join(folder, portable.ManifestFileName))
+	if os.IsNotExist(err) {
+		folder = filepath.Join(folder, portable.FolderName)
+	}
+
+	verifier, err := portable.NewVerifier(portable.ArgsVerifier{
+		Folder:                          folder,
+		Marshalizer:                     marsh,
+		Hasher:                          hsh,
+		ExpectedEpochStartMetaBlockHash: expectedHash,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("verifying hardfork export", "folder", folder)
+	report, err := verifier.Verify()
+	if err != nil {
+		return err
+	}
+
+	for _, problem := range report.Problems {
+		log.Error("problem found", "description", problem)
+	}
+
+	log.Info("hardfork export verification done",
+		"epoch", report.Epoch,
+		"epoch start metablock hash", report.EpochStartMetaBlockHash,
+		"num tries", report.NumTries,
+		"num leaves", report.NumLeaves,
+		"num problems", len(report.Problems),
+	)
+	if !report.IsValid() {
+		return fmt.Errorf("%d problems found", len(report.Problems))
+	}
+
+	if len(expectedHash) == 0 {
+		log.Info("the export is consistent: compare the epoch start metablock hash with the one from a trusted source")
+		return nil
+	}
+
+	log.Info("the export is valid")
+
+	return nil
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"strconv"
+	"strings"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/urfave/cli"
 )
 
@@ -292,6 +297,50 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// importDbReportFile defines a flag for the optional per block report written in the import-db process
+	importDbReportFile = cli.StringFlag{
+		Name: "import-db-report-file",
+		Usage: "This flag, if set, will append to the provided file a JSON line for each block processed in the import-db " +
+			"process. The node stops at the first block that does not match its header, after reporting the touched accounts",
+		Value: "",
+	}
+	// importDbBisectStartNonce defines a flag for the first nonce of the import-db bisect interval
+	importDbBisectStartNonce = cli.Uint64Flag{
+		Name:  "import-db-bisect-start-nonce",
+		Usage: "This flag will specify the first nonce reported, with the touched accounts, in import-db bisect mode. " +
+			"The blocks are still replayed from the import start, so the import-db-start-epoch flag should be set to " +
+			"the epoch containing this nonce in order to skip replaying the previous epochs",
+		Value: 0,
+	}
+	// importDbBisectEndNonce defines a flag for the last nonce of the import-db bisect interval
+	importDbBisectEndNonce = cli.Uint64Flag{
+		Name: "import-db-bisect-end-nonce",
+		Usage: "This flag, if set, will enable the import-db bisect mode: only the blocks between the bisect start " +
+			"and end nonces are reported, with the touched accounts, and the node stops after the end nonce",
+		Value: 0,
+	}
+	// exportStateSnapshots defines a flag for exporting the state tries in snapshot files at each epoch start
+	exportStateSnapshots = cli.BoolFlag{
+		Name:  "export-state-snapshots",
+		Usage: "This flag, if set, will make the node write a verifiable snapshot file of its state tries at every epoch start",
+	}
+	// stateSnapshotFile defines a flag for the optional state snapshot file used when bootstrapping from the network
+	stateSnapshotFile = cli.StringFlag{
+		Name: "state-snapshot-file",
+		Usage: "This flag, if set, will make the node load the state tries from the provided snapshot file when it starts " +
+			"in epoch, instead of syncing them node by node from the network. The tries are verified against the epoch " +
+			"start metablock",
+		Value: "",
+	}
+	// trustedCheckpoint defines a flag for the trusted epoch start metablock used when bootstrapping from the network
+	trustedCheckpoint = cli.StringFlag{
+		Name: "trusted-checkpoint",
+		Usage: "This flag, if set, will make the node accept only the provided epoch start metablock when it starts " +
+			"in epoch, instead of waiting for a majority of the connected peers to agree on it. The format is " +
+			"`epoch:hash`, where the hash is hex encoded. The current checkpoint of a synced node is returned by its " +
+			"/node/epoch-start-checkpoint route",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -303,6 +352,21 @@ var (
 		Name:  "full-archive",
 		Usage: "Boolean option for settings an observer as full archive, which will sync the entire database of its shard",
 	}
+	// lightHistory defines a flag that, if set, will make the node keep all the blocks data while pruning the state tries
+	lightHistory = cli.BoolFlag{
+		Name: "light-history",
+		Usage: "Boolean option for settings an observer as light history, which will keep the blocks, transactions, " +
+			"receipts, logs and db lookup extensions indexes of all epochs while pruning the state tries",
+	}
+	// replicaOf defines a flag for the working directory of the node whose databases are read by a replica node
+	replicaOf = cli.StringFlag{
+		Name: "replica-of",
+		Usage: "This flag, if set, will make the node start as a replica of the node running on the same host with the " +
+			"provided working directory. The replica opens the databases of that node read-only, follows the blocks it " +
+			"commits and serves the REST API without syncing nor processing blocks. The configs of the replica must " +
+			"match the ones of the followed node",
+		Value: "",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -348,8 +412,16 @@ func getFlags() []cli.Flag {
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		importDbReportFile,
+		importDbBisectStartNonce,
+		importDbBisectEndNonce,
+		exportStateSnapshots,
+		stateSnapshotFile,
+		trustedCheckpoint,
 		redundancyLevel,
 		fullArchive,
+		lightHistory,
+		replicaOf,
 	}
 }
 
@@ -401,6 +473,22 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	if ctx.IsSet(fullArchive.Name) {
 		cfgs.PreferencesConfig.Preferences.FullArchive = ctx.GlobalBool(fullArchive.Name)
 	}
+	if ctx.IsSet(lightHistory.Name) {
+		cfgs.PreferencesConfig.Preferences.LightHistory = ctx.GlobalBool(lightHistory.Name)
+	}
+	if ctx.IsSet(exportStateSnapshots.Name) {
+		cfgs.GeneralConfig.StateSnapshotFiles.ExportEnabled = ctx.GlobalBool(exportStateSnapshots.Name)
+	}
+	if ctx.IsSet(stateSnapshotFile.Name) {
+		cfgs.GeneralConfig.StateSnapshotFiles.ImportFilePath = ctx.GlobalString(stateSnapshotFile.Name)
+	}
+	if ctx.IsSet(trustedCheckpoint.Name) {
+		checkpoint, err := parseTrustedCheckpoint(ctx.GlobalString(trustedCheckpoint.Name))
+		if err != nil {
+			return err
+		}
+		cfgs.GeneralConfig.EpochStartConfig.TrustedCheckpoint = checkpoint
+	}
 
 	importDbDirectoryValue := ctx.GlobalString(importDbDirectory.Name)
 	importDBConfigs := &config.ImportDbConfig{
@@ -409,6 +497,14 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 		ImportDbNoSigCheckFlag:        ctx.GlobalBool(importDbNoSigCheck.Name),
 		ImportDbSaveTrieEpochRootHash: ctx.GlobalBool(importDbSaveEpochRootHash.Name),
 		ImportDBStartInEpoch:          uint32(ctx.GlobalUint64(importDbStartInEpoch.Name)),
+		ImportDBReportFilePath:        ctx.GlobalString(importDbReportFile.Name),
+		ImportDBBisectStartNonce:      ctx.GlobalUint64(importDbBisectStartNonce.Name),
+		ImportDBBisectEndNonce:        ctx.GlobalUint64(importDbBisectEndNonce.Name),
+	}
+	replicaOfValue := ctx.GlobalString(replicaOf.Name)
+	cfgs.ReplicaConfig = &config.ReplicaConfig{
+		IsReplicaMode:     len(replicaOfValue) > 0,
+		PrimaryWorkingDir: replicaOfValue,
 	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
@@ -427,6 +523,26 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 	return nil
 }
 
+func parseTrustedCheckpoint(value string) (config.TrustedCheckpointConfig, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return config.TrustedCheckpointConfig{}, fmt.Errorf("%w, expected epoch:hash, got %s",
+			epochStart.ErrInvalidTrustedCheckpoint, value)
+	}
+
+	epoch, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return config.TrustedCheckpointConfig{}, fmt.Errorf("%w, invalid epoch: %s",
+			epochStart.ErrInvalidTrustedCheckpoint, err.Error())
+	}
+
+	return config.TrustedCheckpointConfig{
+		Enabled:       true,
+		Epoch:         uint32(epoch),
+		MetaBlockHash: parts[1],
+	}, nil
+}
+
 func getWorkingDir(workingDir string, log logger.Logger) string {
 	var err error
 	if len(workingDir) == 0 {
@@ -446,15 +562,37 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 	importDbFlags.ImportDbNoSigCheckFlag = importDbFlags.ImportDbNoSigCheckFlag && importDbFlags.IsImportDBMode
 	importDbFlags.ImportDbSaveTrieEpochRootHash = importDbFlags.ImportDbSaveTrieEpochRootHash && importDbFlags.IsImportDBMode
 
+	if configs.ReplicaConfig.IsReplicaMode {
+		if importDbFlags.IsImportDBMode {
+			return fmt.Errorf("%w, replica mode can not be used with import-db", errors.ErrInvalidNodeOperationMode)
+		}
+		if configs.FlagsConfig.CleanupStorage {
+			return fmt.Errorf("%w, replica mode can not be used with storage cleanup", errors.ErrInvalidNodeOperationMode)
+		}
+
+		return processConfigReplicaMode(log, configs)
+	}
+
 	if importDbFlags.IsImportDBMode {
 		return processConfigImportDBMode(log, configs)
 	}
 
+	preferences := configs.PreferencesConfig.Preferences
+	if preferences.FullArchive && preferences.LightHistory {
+		return fmt.Errorf("%w, FullArchive and LightHistory can not be both enabled", errors.ErrInvalidNodeOperationMode)
+	}
+
 	// if FullArchive is enabled, we override the conflicting StoragePruning settings and StartInEpoch as well
-	if configs.PreferencesConfig.Preferences.FullArchive {
+	if preferences.FullArchive {
 		return processConfigFullArchiveMode(log, configs)
 	}
 
+	// if LightHistory is enabled, we override the StoragePruning settings as for the full archive mode, but the state
+	// tries are pruned and the db lookup extensions are forced on
+	if preferences.LightHistory {
+		return processConfigLightHistoryMode(log, configs)
+	}
+
 	return nil
 }
 
@@ -500,6 +638,9 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 		"no sig check", importDbFlags.ImportDbNoSigCheckFlag,
 		"import save trie epoch root hash", importDbFlags.ImportDbSaveTrieEpochRootHash,
 		"import DB start in epoch", importDbFlags.ImportDBStartInEpoch,
+		"import DB report file", importDbFlags.ImportDBReportFilePath,
+		"import DB bisect start nonce", importDbFlags.ImportDBBisectStartNonce,
+		"import DB bisect end nonce", importDbFlags.ImportDBBisectEndNonce,
 		"import DB shard ID", importDbFlags.ImportDBTargetShardID,
 		"kad dht discoverer", "off",
 		"health interval diagnose components deeply in seconds", generalConfigs.Health.IntervalDiagnoseComponentsDeeplyInSeconds,
@@ -507,6 +648,14 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 		"health interval verify memory in seconds", generalConfigs.Health.IntervalVerifyMemoryInSeconds,
 		"health memory usage threshold", core.ConvertBytes(uint64(generalConfigs.Health.MemoryUsageToCreateProfiles)),
 	)
+
+	isBisectEnabled := importDbFlags.ImportDBBisectEndNonce > 0
+	if isBisectEnabled && importDbFlags.ImportDBStartInEpoch == 0 {
+		log.Warn("import-db bisect mode without a start epoch: all the blocks before the bisect start nonce " +
+			"will be replayed, set the import-db-start-epoch flag to the epoch containing the bisect start nonce " +
+			"in order to skip them")
+	}
+
 	return nil
 }
 
@@ -530,6 +679,110 @@ func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) er
 	return nil
 }
 
+func processConfigLightHistoryMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+	generalConfigs.StoragePruning.Enabled = true
+	generalConfigs.StoragePruning.NumEpochsToKeep = math.MaxUint64
+	generalConfigs.DbLookupExtensions.Enabled = true
+	generalConfigs.StateTriesConfig.AccountsStatePruningEnabled = true
+	generalConfigs.StateTriesConfig.PeerStatePruningEnabled = true
+	generalConfigs.TrieStorageManagerConfig.KeepSnapshots = false
+
+	log.Warn("the node is in light history mode! Will auto-set some config values",
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"StoragePruning.Enabled", generalConfigs.StoragePruning.Enabled,
+		"StoragePruning.NumEpochsToKeep", generalConfigs.StoragePruning.NumEpochsToKeep,
+		"DbLookupExtensions.Enabled", generalConfigs.DbLookupExtensions.Enabled,
+		"StateTriesConfig.AccountsStatePruningEnabled", generalConfigs.StateTriesConfig.AccountsStatePruningEnabled,
+		"StateTriesConfig.PeerStatePruningEnabled", generalConfigs.StateTriesConfig.PeerStatePruningEnabled,
+		"TrieStorageManagerConfig.KeepSnapshots", generalConfigs.TrieStorageManagerConfig.KeepSnapshots,
+	)
+
+	return nil
+}
+
+func processConfigReplicaMode(log logger.Logger, configs *config.Configs) error {
+	generalConfigs := configs.GeneralConfig
+	p2pConfigs := configs.P2pConfig
+
+	// the replica uses the storage of the followed node as it is, it must not alter nor remove anything from it
+	generalConfigs.GeneralSettings.StartInEpochEnabled = false
+	generalConfigs.StoragePruning.ValidatorCleanOldEpochsData = false
+	generalConfigs.StoragePruning.ObserverCleanOldEpochsData = false
+	generalConfigs.StateTriesConfig.AccountsStatePruningEnabled = false
+	generalConfigs.StateTriesConfig.PeerStatePruningEnabled = false
+	generalConfigs.StateTriesConfig.CheckpointsEnabled = false
+	generalConfigs.TrieStorageManagerConfig.KeepSnapshots = true
+	// the replica does not take part in the network, it only follows the node owning its databases
+	p2pConfigs.Node.ThresholdMinConnectedPeers = 0
+	p2pConfigs.KadDhtPeerDiscovery.Enabled = false
+
+	alterStorageConfigsForReplica(generalConfigs)
+
+	log.Warn("the node is in replica mode! Will auto-set some config values, including storage config values",
+		"replica of", configs.ReplicaConfig.PrimaryWorkingDir,
+		"GeneralSettings.StartInEpochEnabled", generalConfigs.GeneralSettings.StartInEpochEnabled,
+		"StoragePruning.ValidatorCleanOldEpochsData", generalConfigs.StoragePruning.ValidatorCleanOldEpochsData,
+		"StoragePruning.ObserverCleanOldEpochsData", generalConfigs.StoragePruning.ObserverCleanOldEpochsData,
+		"StateTriesConfig.AccountsStatePruningEnabled", generalConfigs.StateTriesConfig.AccountsStatePruningEnabled,
+		"StateTriesConfig.PeerStatePruningEnabled", generalConfigs.StateTriesConfig.PeerStatePruningEnabled,
+		"StateTriesConfig.CheckpointsEnabled", generalConfigs.StateTriesConfig.CheckpointsEnabled,
+		"TrieStorageManagerConfig.KeepSnapshots", generalConfigs.TrieStorageManagerConfig.KeepSnapshots,
+		"p2p.ThresholdMinConnectedPeers", p2pConfigs.Node.ThresholdMinConnectedPeers,
+		"kad dht discoverer", "off",
+	)
+
+	return nil
+}
+
+// alterStorageConfigsForReplica opens read-only the databases written by the followed node and keeps in memory the
+// ones only written by the replica itself
+func alterStorageConfigsForReplica(generalConfig *config.Config) {
+	replicatedStorageConfigs := []*config.StorageConfig{
+		&generalConfig.MiniBlocksStorage,
+		&generalConfig.PeerBlockBodyStorage,
+		&generalConfig.BlockHeaderStorage,
+		&generalConfig.TxStorage,
+		&generalConfig.UnsignedTransactionStorage,
+		&generalConfig.RewardTxStorage,
+		&generalConfig.ShardHdrNonceHashStorage,
+		&generalConfig.MetaHdrNonceHashStorage,
+		&generalConfig.StatusMetricsStorage,
+		&generalConfig.ReceiptsStorage,
+		&generalConfig.ScheduledSCRsStorage,
+		&generalConfig.SmartContractsStorage,
+		&generalConfig.SmartContractsStorageForSCQuery,
+		&generalConfig.TrieEpochRootHashStorage,
+		&generalConfig.BootstrapStorage,
+		&generalConfig.MetaBlockStorage,
+		&generalConfig.AccountsTrieStorage,
+		&generalConfig.PeerAccountsTrieStorage,
+		&generalConfig.TxLogsStorage,
+		&generalConfig.DbLookupExtensions.MiniblocksMetadataStorageConfig,
+		&generalConfig.DbLookupExtensions.MiniblockHashByTxHashStorageConfig,
+		&generalConfig.DbLookupExtensions.EpochByHashStorageConfig,
+		&generalConfig.DbLookupExtensions.ResultsHashesByTxHashStorageConfig,
+		&generalConfig.DbLookupExtensions.EventsIndexStorageConfig,
+		&generalConfig.DbLookupExtensions.ESDTSuppliesStorageConfig,
+	}
+	for _, storageConfig := range replicatedStorageConfigs {
+		storageConfig.DB.Type = string(storageUnit.LvlDBReadOnly)
+		// the bloom filters only hold the keys written by the current process
+		storageConfig.Bloom = config.BloomFilterConfig{}
+	}
+	generalConfig.TrieSnapshotDB.Type = string(storageUnit.LvlDBReadOnly)
+
+	generalConfig.Heartbeat.HeartbeatStorage.DB.Type = string(storageUnit.MemoryDB)
+	generalConfig.EvictionWaitingList.DB.Type = string(storageUnit.MemoryDB)
+	generalConfig.TrieSyncStorage.DB.Type = string(storageUnit.MemoryDB)
+}
+
 func alterStorageConfigsForDBImport(config *config.Config) {
 	changeStorageConfigForDBImport(&config.MiniBlocksStorage)
 	changeStorageConfigForDBImport(&config.BlockHeaderStorage)
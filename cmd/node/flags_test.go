@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	errErd "github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConfigsForOperationMode(preferences config.PreferencesConfig) *config.Configs {
+	generalConfig := &config.Config{}
+	generalConfig.GeneralSettings.StartInEpochEnabled = true
+	generalConfig.StoragePruning.Enabled = true
+	generalConfig.StoragePruning.ValidatorCleanOldEpochsData = true
+	generalConfig.StoragePruning.ObserverCleanOldEpochsData = true
+	generalConfig.StoragePruning.NumEpochsToKeep = 4
+	generalConfig.TrieStorageManagerConfig.KeepSnapshots = true
+
+	return &config.Configs{
+		GeneralConfig:     generalConfig,
+		PreferencesConfig: &config.Preferences{Preferences: preferences},
+		ImportDbConfig:    &config.ImportDbConfig{},
+		ReplicaConfig:     &config.ReplicaConfig{},
+		FlagsConfig:       &config.ContextFlagsConfig{},
+		P2pConfig:         &config.P2PConfig{},
+	}
+}
+
+func TestApplyCompatibleConfigs_FullArchiveAndLightHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	configs := createConfigsForOperationMode(config.PreferencesConfig{FullArchive: true, LightHistory: true})
+	err := applyCompatibleConfigs(logger.GetOrCreate("test"), configs)
+	assert.True(t, errors.Is(err, errErd.ErrInvalidNodeOperationMode))
+}
+
+func TestProcessConfigLightHistoryMode(t *testing.T) {
+	t.Parallel()
+
+	configs := createConfigsForOperationMode(config.PreferencesConfig{LightHistory: true})
+	err := applyCompatibleConfigs(logger.GetOrCreate("test"), configs)
+	require.Nil(t, err)
+
+	generalConfig := configs.GeneralConfig
+	assert.False(t, generalConfig.GeneralSettings.StartInEpochEnabled)
+	assert.False(t, generalConfig.StoragePruning.ValidatorCleanOldEpochsData)
+	assert.False(t, generalConfig.StoragePruning.ObserverCleanOldEpochsData)
+	assert.True(t, generalConfig.StoragePruning.Enabled)
+	assert.Equal(t, uint64(math.MaxUint64), generalConfig.StoragePruning.NumEpochsToKeep)
+	assert.True(t, generalConfig.DbLookupExtensions.Enabled)
+	assert.True(t, generalConfig.StateTriesConfig.AccountsStatePruningEnabled)
+	assert.True(t, generalConfig.StateTriesConfig.PeerStatePruningEnabled)
+	assert.False(t, generalConfig.TrieStorageManagerConfig.KeepSnapshots)
+}
+
+func TestProcessConfigReplicaModeShouldDisableThePeerDiscovery(t *testing.T) {
+	t.Parallel()
+
+	configs := createConfigsForOperationMode(config.PreferencesConfig{})
+	configs.P2pConfig.Node.ThresholdMinConnectedPeers = 3
+	configs.P2pConfig.KadDhtPeerDiscovery.Enabled = true
+	err := processConfigReplicaMode(logger.GetOrCreate("test"), configs)
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(0), configs.P2pConfig.Node.ThresholdMinConnectedPeers)
+	assert.False(t, configs.P2pConfig.KadDhtPeerDiscovery.Enabled)
+	assert.False(t, configs.GeneralConfig.GeneralSettings.StartInEpochEnabled)
+}
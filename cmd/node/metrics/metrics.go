@@ -97,6 +97,11 @@ func InitMetrics(
 	appStatusHandler.SetUInt64Value(core.MetricNumShardHeadersProcessed, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNumTimesInForkChoice, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricHighestFinalBlock, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricForkDetectorLastCheckpointNonce, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricForkDetectorNumHeaders, initUint)
+	appStatusHandler.SetUInt64Value(core.MetricNumSyncRollBacks, initUint)
+	appStatusHandler.SetStringValue(core.MetricLastSyncRollBackReason, initString)
+	appStatusHandler.SetUInt64Value(core.MetricNumHeaderSuppliers, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricCountConsensusAcceptedBlocks, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricRoundAtEpochStart, initUint)
 	appStatusHandler.SetUInt64Value(core.MetricNonceAtEpochStart, initUint)
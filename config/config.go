@@ -25,6 +25,7 @@ type DBConfig struct {
 	MaxBatchSize      int
 	MaxOpenFiles      int
 	UseTmpAsFilePath  bool
+	Compression       string
 }
 
 // BloomFilterConfig will map the bloom filter configuration
@@ -94,6 +95,14 @@ type EpochStartConfig struct {
 	MaxShuffledOutRestartThreshold    float64
 	MinNumConnectedPeersToStart       int
 	MinNumOfPeersToConsiderBlockValid int
+	TrustedCheckpoint                 TrustedCheckpointConfig
+}
+
+// TrustedCheckpointConfig will hold the epoch start metablock trusted by the node when it bootstraps from the network
+type TrustedCheckpointConfig struct {
+	Enabled       bool
+	Epoch         uint32
+	MetaBlockHash string
 }
 
 // BlockSizeThrottleConfig will hold the configuration for adaptive block size throttle
@@ -120,6 +129,7 @@ type Config struct {
 	MetaHdrNonceHashStorage         StorageConfig
 	StatusMetricsStorage            StorageConfig
 	ReceiptsStorage                 StorageConfig
+	ScheduledSCRsStorage            StorageConfig
 	SmartContractsStorage           StorageConfig
 	SmartContractsStorageForSCQuery StorageConfig
 	TrieEpochRootHashStorage        StorageConfig
@@ -132,6 +142,7 @@ type Config struct {
 	TrieSnapshotDB           DBConfig
 	EvictionWaitingList      EvictionWaitingListConfig
 	StateTriesConfig         StateTriesConfig
+	StateSnapshotFiles       StateSnapshotFilesConfig
 	TrieStorageManagerConfig TrieStorageManagerConfig
 	BadBlocksCache           CacheConfig
 
@@ -201,6 +212,10 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                uint64
 	NumActivePersisters            uint64
 	FullArchiveNumActivePersisters uint32
+	ColdStorageEnabled             bool
+	ColdStoragePath                string
+	ColdStorageAfterNumEpochs      uint32
+	ColdStorageNumOpenPersisters   uint32
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -260,6 +275,14 @@ type StateTriesConfig struct {
 	PeerStatePruningQueueSize   uint
 }
 
+// StateSnapshotFilesConfig will hold information about exporting and importing the state snapshot files
+type StateSnapshotFilesConfig struct {
+	ExportEnabled   bool
+	ExportDirectory string
+	NumNodesInChunk int
+	ImportFilePath  string
+}
+
 // TrieStorageManagerConfig will hold config information about trie storage manager
 type TrieStorageManagerConfig struct {
 	PruningBufferLen              uint32
@@ -387,6 +410,7 @@ type HardforkConfig struct {
 	EnableTriggerFromP2P         bool
 	MustImport                   bool
 	AfterHardFork                bool
+	ExportPortableFormat         bool
 }
 
 // DbLookupExtensionsConfig holds the configuration for the db lookup extensions
@@ -396,6 +420,10 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	EventsIndexEnabled                 bool
+	EventsIndexStorageConfig           StorageConfig
+	ESDTSuppliesEnabled                bool
+	ESDTSuppliesStorageConfig          StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -495,6 +523,7 @@ type Configs struct {
 	P2pConfig                *P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	ReplicaConfig            *ReplicaConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 }
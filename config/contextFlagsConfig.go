@@ -30,4 +30,13 @@ type ImportDbConfig struct {
 	ImportDBWorkingDir            string
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
+	ImportDBReportFilePath        string
+	ImportDBBisectStartNonce      uint64
+	ImportDBBisectEndNonce        uint64
+}
+
+// ReplicaConfig will hold the replica mode parameters
+type ReplicaConfig struct {
+	IsReplicaMode     bool
+	PrimaryWorkingDir string
 }
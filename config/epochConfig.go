@@ -45,6 +45,17 @@ type EnableEpochs struct {
 	ReDelegateBelowMinCheckEnableEpoch          uint32
 	WaitingListFixEnableEpoch                   uint32
 	IncrementSCRNonceInMultiTransferEnableEpoch uint32
+	RelayedTransactionsV3EnableEpoch            uint32
+	ScheduledMiniBlocksEnableEpoch              uint32
+	MetaESDTEnableEpoch                         uint32
+	LiquidStakingEnableEpoch                    uint32
+	UnDelegateQueueEnableEpoch                  uint32
+	GovernanceParamChangesEnableEpoch           uint32
+	GovernanceViewsEnableEpoch                  uint32
+	ValidatorKeyRotationEnableEpoch             uint32
+	DelegationAutoCompoundingEnableEpoch        uint32
+	ESDTAllowancesEnableEpoch                   uint32
+	CodeChangeLogsEnableEpoch                   uint32
 }
 
 // GasScheduleByEpochs represents a gas schedule toml entry that will be applied from the provided epoch
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -19,6 +20,7 @@ type ForkDetectorMock struct {
 	RestoreToGenesisCalled          func()
 	ResetProbableHighestNonceCalled func()
 	SetFinalToLastCheckpointCalled  func()
+	GetStatusCalled                 func() *api.ForkDetectorStatus
 }
 
 // RestoreToGenesis -
@@ -87,6 +89,15 @@ func (fdm *ForkDetectorMock) SetFinalToLastCheckpoint() {
 	}
 }
 
+// GetStatus -
+func (fdm *ForkDetectorMock) GetStatus() *api.ForkDetectorStatus {
+	if fdm.GetStatusCalled != nil {
+		return fdm.GetStatusCalled()
+	}
+
+	return &api.ForkDetectorStatus{}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fdm *ForkDetectorMock) IsInterfaceNil() bool {
 	return fdm == nil
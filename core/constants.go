@@ -177,6 +177,18 @@ const MetricNetworkSendBytesInCurrentEpochPerHost = "erd_network_sent_bytes_in_e
 // MetricNetworkSentPercent is the metric for monitoring network sent load [%]
 const MetricNetworkSentPercent = "erd_network_sent_percent"
 
+// MetricCompressionNumValues is the metric for monitoring the number of values written through the compressed storers
+const MetricCompressionNumValues = "erd_compression_num_values"
+
+// MetricCompressionNumCompressedValues is the metric for monitoring the number of values stored compressed
+const MetricCompressionNumCompressedValues = "erd_compression_num_compressed_values"
+
+// MetricCompressionUncompressedBytes is the metric for monitoring the size of the written values, before compression
+const MetricCompressionUncompressedBytes = "erd_compression_uncompressed_bytes"
+
+// MetricCompressionStoredBytes is the metric for monitoring the size of the written values, as stored
+const MetricCompressionStoredBytes = "erd_compression_stored_bytes"
+
 // MetricNetworkSentBps is the metric for monitoring network sent bytes per second
 const MetricNetworkSentBps = "erd_network_sent_bps"
 
@@ -237,6 +249,21 @@ const MetricNumTimesInForkChoice = "erd_fork_choice_count"
 // MetricHighestFinalBlock is the metric for the nonce of the highest final block
 const MetricHighestFinalBlock = "erd_highest_final_nonce"
 
+// MetricForkDetectorLastCheckpointNonce is the metric for the nonce of the last checkpoint of the fork detector
+const MetricForkDetectorLastCheckpointNonce = "erd_fork_detector_last_checkpoint_nonce"
+
+// MetricForkDetectorNumHeaders is the metric for the number of headers tracked by the fork detector
+const MetricForkDetectorNumHeaders = "erd_fork_detector_num_headers"
+
+// MetricNumSyncRollBacks is the metric that counts how many roll backs were done by the sync mechanism
+const MetricNumSyncRollBacks = "erd_num_sync_rollbacks"
+
+// MetricLastSyncRollBackReason is the metric for the reason of the last roll back done by the sync mechanism
+const MetricLastSyncRollBackReason = "erd_last_sync_rollback_reason"
+
+// MetricNumHeaderSuppliers is the metric for the number of peers which supplied headers to the node
+const MetricNumHeaderSuppliers = "erd_num_header_suppliers"
+
 // MetricLatestTagSoftwareVersion is the metric that stores the latest tag software version
 const MetricLatestTagSoftwareVersion = "erd_latest_tag_software_version"
 
@@ -482,6 +509,10 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// GovernanceParamChangesOrder defines the order in which the governance param changes are notified of a start of epoch event
+	GovernanceParamChangesOrder
+	// StateSnapshotExportOrder defines the order in which the state snapshot file exporter is notified of a start of epoch event
+	StateSnapshotExportOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -592,6 +623,16 @@ const BuiltInFunctionESDTNFTCreateRoleTransfer = "ESDTNFTCreateRoleTransfer"
 // BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
 const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
 
+// BuiltInFunctionESDTApprove is the key for the elrond standard digital token approve built-in function
+const BuiltInFunctionESDTApprove = "ESDTApprove"
+
+// BuiltInFunctionESDTTransferFrom is the key for the elrond standard digital token transfer from built-in function
+const BuiltInFunctionESDTTransferFrom = "ESDTTransferFrom"
+
+// BuiltInFunctionESDTSetTokenType is the key for the built-in function which saves the type of a token in the system
+// account of each shard
+const BuiltInFunctionESDTSetTokenType = "ESDTSetTokenType"
+
 // ESDTRoleLocalMint is the constant string for the local role of mint for ESDT tokens
 const ESDTRoleLocalMint = "ESDTRoleLocalMint"
 
@@ -626,6 +667,9 @@ const NonFungibleESDT = "NonFungibleESDT"
 // SemiFungibleESDT defines the string for the token type of semi fungible ESDT
 const SemiFungibleESDT = "SemiFungibleESDT"
 
+// MetaESDT defines the string for the token type of meta ESDT: fungible amounts, with decimals, carrying attributes per nonce
+const MetaESDT = "MetaESDT"
+
 // MaxRoyalty defines 100% as uint32
 const MaxRoyalty = uint32(10000)
 
@@ -635,6 +679,15 @@ const RelayedTransaction = "relayedTx"
 // RelayedTransactionV2 is the key for the optimized elrond meta/gassless/relayed transaction standard
 const RelayedTransactionV2 = "relayedTxV2"
 
+// GovernanceGasScheduleParam is the type of the governance parameter changes which modify a gas schedule entry
+const GovernanceGasScheduleParam = "gasSchedule"
+
+// GovernanceFeeSettingsParam is the type of the governance parameter changes which modify a fee setting
+const GovernanceFeeSettingsParam = "feeSettings"
+
+// GovernanceEnableEpochsParam is the type of the governance parameter changes which modify an enable epoch
+const GovernanceEnableEpochsParam = "enableEpochs"
+
 // SCDeployInitFunctionName is the key for the function which is called at smart contract deploy time
 const SCDeployInitFunctionName = "_init"
 
@@ -647,6 +700,12 @@ const WrongConfiguration = "wrongConfiguration"
 // ImportComplete signals that a node restart will be done because the import did complete
 const ImportComplete = "importComplete"
 
+// ReplicaEpochChanged signals that a replica node restart will be done because the followed node started a new epoch
+const ReplicaEpochChanged = "replicaEpochChanged"
+
+// EnableEpochsChanged signals that a node restart will be done because an enable epoch was changed through governance
+const EnableEpochsChanged = "enableEpochsChanged"
+
 // MaxRetriesToCreateDB represents the maximum number of times to try to create DB if it failed
 const MaxRetriesToCreateDB = 10
 
@@ -692,6 +751,18 @@ const ESDTRoleIdentifier = "role"
 // ESDTNFTLatestNonceIdentifier is the key prefix for esdt latest nonce identifier
 const ESDTNFTLatestNonceIdentifier = "nonce"
 
+// ESDTAllowanceIdentifier is the key prefix for the esdt allowances given by an account to spenders
+const ESDTAllowanceIdentifier = "allowance"
+
+// ESDTTokenTypeIdentifier is the key prefix for the token types saved in the system account
+const ESDTTokenTypeIdentifier = "tokentype"
+
+// SCDeployIdentifier is the identifier of the log event generated when a smart contract is deployed
+const SCDeployIdentifier = "SCDeploy"
+
+// SCUpgradeIdentifier is the identifier of the log event generated when a smart contract is upgraded
+const SCUpgradeIdentifier = "SCUpgrade"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -15,3 +15,22 @@ func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) er
 func newErrCannotSaveMiniblockMetadata(hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save miniblock metadata, hash [%s]: %w", hex.EncodeToString(hash), originalErr)
 }
+
+// ErrEventsIndexNotEnabled signals that the events index is not enabled
+var ErrEventsIndexNotEnabled = errors.New("events index is not enabled")
+
+// ErrEmptyEventsFilterAddress signals that the events filter does not contain an address
+var ErrEmptyEventsFilterAddress = errors.New("empty address in events filter")
+
+// ErrEventsFilterTopicWithoutIdentifier signals that the events filter contains a topic but no event identifier
+var ErrEventsFilterTopicWithoutIdentifier = errors.New("events filter topic requires an event identifier")
+
+// ErrInvalidEventsBlockRange signals that the block range of the events filter is invalid
+var ErrInvalidEventsBlockRange = errors.New("invalid block range for events filter")
+
+// ErrESDTSuppliesNotEnabled signals that the ESDT supplies tracking is not enabled
+var ErrESDTSuppliesNotEnabled = errors.New("ESDT supplies tracking is not enabled")
+
+// ErrESDTSuppliesNotTrackedFromGenesis signals that the node did not process all the blocks since genesis, so the
+// ESDT supplies it tracked are incomplete
+var ErrESDTSuppliesNotTrackedFromGenesis = errors.New("ESDT supplies were not tracked from genesis")
@@ -0,0 +1,271 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. esdtSupply.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+const (
+	keyPrefixSupply        = "supply"
+	keyPrefixSupplyBlock   = "supplyBlock"
+	keyFirstTrackedBlock   = "supplyFirstTrackedBlock"
+	firstNonceAfterGenesis = 1
+	tokenRandomSuffixSize  = 6
+	tokenSeparator         = "-"
+)
+
+type esdtSupplies struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newESDTSupplies(storer storage.Storer, marshalizer marshal.Marshalizer) *esdtSupplies {
+	return &esdtSupplies{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// processLogs updates the supplies of the tokens minted or burned in the block with the provided nonce. Each shard only
+// counts what happens in its own blocks, so the values kept here are per-shard deltas and the total supply of a token
+// is the sum of the deltas of all the shards
+func (es *esdtSupplies) processLogs(blockNonce uint64, logs map[string]data.LogHandler) error {
+	err := es.saveFirstTrackedBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	err = es.revertBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	deltas := es.computeDeltas(logs)
+	if len(deltas.Deltas) == 0 {
+		return nil
+	}
+
+	for _, delta := range deltas.Deltas {
+		err = es.applyDelta(delta.Token, delta.Minted, delta.Burned)
+		if err != nil {
+			return err
+		}
+	}
+
+	return es.putBlockDeltas(blockNonce, deltas)
+}
+
+func (es *esdtSupplies) saveFirstTrackedBlock(blockNonce uint64) error {
+	_, err := es.storer.Get([]byte(keyFirstTrackedBlock))
+	if err == nil {
+		return nil
+	}
+
+	return es.storer.Put([]byte(keyFirstTrackedBlock), big.NewInt(0).SetUint64(blockNonce).Bytes())
+}
+
+// checkTrackedFromGenesis returns an error if the supplies were not tracked since the first block after genesis, as is
+// the case of a node which started from a later epoch: its counters would only hold the quantities minted and burned
+// after it started
+func (es *esdtSupplies) checkTrackedFromGenesis() error {
+	firstNonceBytes, err := es.storer.Get([]byte(keyFirstTrackedBlock))
+	if err != nil {
+		// no block was processed yet
+		return nil
+	}
+
+	firstNonce := big.NewInt(0).SetBytes(firstNonceBytes).Uint64()
+	if firstNonce > firstNonceAfterGenesis {
+		return fmt.Errorf("%w, the first tracked block has nonce %d", ErrESDTSuppliesNotTrackedFromGenesis, firstNonce)
+	}
+
+	return nil
+}
+
+// revertBlock subtracts the supply changes previously recorded for the given nonce, as a block on a fork could have
+// been recorded before the one on the canonical chain
+func (es *esdtSupplies) revertBlock(blockNonce uint64) error {
+	deltasBytes, err := es.storer.Get(buildSupplyBlockKey(blockNonce))
+	if err != nil {
+		return nil
+	}
+
+	deltas := &SupplyDeltasByBlock{}
+	err = es.marshalizer.Unmarshal(deltas, deltasBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, delta := range deltas.Deltas {
+		err = es.applyDelta(delta.Token, big.NewInt(0).Neg(delta.Minted), big.NewInt(0).Neg(delta.Burned))
+		if err != nil {
+			return err
+		}
+	}
+
+	return es.putBlockDeltas(blockNonce, &SupplyDeltasByBlock{})
+}
+
+func (es *esdtSupplies) computeDeltas(logs map[string]data.LogHandler) *SupplyDeltasByBlock {
+	deltasByToken := make(map[string]*SupplyDelta)
+	getDelta := func(token []byte) *SupplyDelta {
+		delta, found := deltasByToken[string(token)]
+		if !found {
+			delta = &SupplyDelta{
+				Token:  token,
+				Minted: big.NewInt(0),
+				Burned: big.NewInt(0),
+			}
+			deltasByToken[string(token)] = delta
+		}
+		return delta
+	}
+
+	for _, logHandler := range logs {
+		if logHandler == nil || logHandler.IsInterfaceNil() {
+			continue
+		}
+
+		for _, event := range logHandler.GetLogEvents() {
+			if event == nil || event.IsInterfaceNil() {
+				continue
+			}
+
+			minted, burned, changesSupply := computeSupplyChange(event.GetAddress(), event.GetIdentifier(), event.GetTopics())
+			if !changesSupply {
+				continue
+			}
+
+			delta := getDelta(baseTokenIdentifier(event.GetTopics()[0]))
+			delta.Minted.Add(delta.Minted, minted)
+			delta.Burned.Add(delta.Burned, burned)
+		}
+	}
+
+	deltas := &SupplyDeltasByBlock{
+		Deltas: make([]*SupplyDelta, 0, len(deltasByToken)),
+	}
+	for _, delta := range deltasByToken {
+		deltas.Deltas = append(deltas.Deltas, delta)
+	}
+	sort.Slice(deltas.Deltas, func(i, j int) bool {
+		return bytes.Compare(deltas.Deltas[i].Token, deltas.Deltas[j].Token) < 0
+	})
+
+	return deltas
+}
+
+// computeSupplyChange returns the minted and the burned quantities of an event. The ESDT built-in functions log the
+// value as the second topic, while the NFT built-in functions have the quantity appended as the last topic.
+// The initial supply of an issued token, the mints of the esdt system smart contract and the refunds of the burns of
+// non burnable tokens are ESDTTransfer calls sent by the esdt system smart contract, logged in the destination shard
+// with the receiver as the third topic, and count as minted. An ESDTBurn removes the tokens in the shard of the caller,
+// before the metachain records it, and counts as burned
+func computeSupplyChange(address []byte, identifier []byte, topics [][]byte) (*big.Int, *big.Int, bool) {
+	zero := big.NewInt(0)
+	if len(topics) < 2 {
+		return zero, zero, false
+	}
+
+	switch string(identifier) {
+	case core.BuiltInFunctionESDTLocalMint:
+		return big.NewInt(0).SetBytes(topics[1]), zero, true
+	case core.BuiltInFunctionESDTTransfer:
+		if len(topics) > 2 && bytes.Equal(address, vm.ESDTSCAddress) {
+			return big.NewInt(0).SetBytes(topics[1]), zero, true
+		}
+	case core.BuiltInFunctionESDTLocalBurn, core.BuiltInFunctionESDTWipe, core.BuiltInFunctionESDTBurn:
+		return zero, big.NewInt(0).SetBytes(topics[1]), true
+	case core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity:
+		if len(topics) > 2 {
+			return big.NewInt(0).SetBytes(topics[len(topics)-1]), zero, true
+		}
+	case core.BuiltInFunctionESDTNFTBurn:
+		if len(topics) > 2 {
+			return zero, big.NewInt(0).SetBytes(topics[len(topics)-1]), true
+		}
+	}
+
+	return zero, zero, false
+}
+
+func (es *esdtSupplies) applyDelta(token []byte, minted *big.Int, burned *big.Int) error {
+	supply, err := es.getSupply(token)
+	if err != nil {
+		return err
+	}
+
+	supply.Minted.Add(supply.Minted, minted)
+	supply.Burned.Add(supply.Burned, burned)
+	supply.Supply.Sub(supply.Minted, supply.Burned)
+
+	supplyBytes, err := es.marshalizer.Marshal(supply)
+	if err != nil {
+		return err
+	}
+
+	return es.storer.Put(buildSupplyKey(token), supplyBytes)
+}
+
+// getSupply returns the supply of the provided token, or a zero supply if the token was not minted or burned yet
+func (es *esdtSupplies) getSupply(token []byte) (*SupplyESDT, error) {
+	supply := &SupplyESDT{
+		Supply: big.NewInt(0),
+		Minted: big.NewInt(0),
+		Burned: big.NewInt(0),
+	}
+
+	supplyBytes, err := es.storer.Get(buildSupplyKey(token))
+	if err != nil {
+		return supply, nil
+	}
+
+	err = es.marshalizer.Unmarshal(supply, supplyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return supply, nil
+}
+
+func (es *esdtSupplies) putBlockDeltas(blockNonce uint64, deltas *SupplyDeltasByBlock) error {
+	deltasBytes, err := es.marshalizer.Marshal(deltas)
+	if err != nil {
+		return err
+	}
+
+	return es.storer.Put(buildSupplyBlockKey(blockNonce), deltasBytes)
+}
+
+// baseTokenIdentifier strips the nonce which is appended to the identifier of a semi-fungible or non-fungible token
+func baseTokenIdentifier(tokenID []byte) []byte {
+	separatorIndex := bytes.Index(tokenID, []byte(tokenSeparator))
+	if separatorIndex < 0 {
+		return tokenID
+	}
+
+	baseLength := separatorIndex + len(tokenSeparator) + tokenRandomSuffixSize
+	if len(tokenID) <= baseLength {
+		return tokenID
+	}
+
+	return tokenID[:baseLength]
+}
+
+func buildSupplyKey(token []byte) []byte {
+	return []byte(fmt.Sprintf("%s_%s", keyPrefixSupply, token))
+}
+
+func buildSupplyBlockKey(blockNonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s_%d", keyPrefixSupplyBlock, blockNonce))
+}
@@ -0,0 +1,111 @@
+package dblookupext
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogsForESDTSupplies() map[string]data.LogHandler {
+	return map[string]data.LogHandler{
+		"txA": &transaction.Log{
+			Events: []*transaction.Event{
+				{Identifier: []byte(core.BuiltInFunctionESDTLocalMint), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(100).Bytes()}},
+				{Identifier: []byte(core.BuiltInFunctionESDTLocalBurn), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(30).Bytes()}},
+				{Identifier: []byte(core.BuiltInFunctionESDTBurn), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(5).Bytes()}},
+				{Identifier: []byte("transfer"), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(7).Bytes()}},
+				{Address: vm.ESDTSCAddress, Identifier: []byte(core.BuiltInFunctionESDTTransfer), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(1000).Bytes(), []byte("issuer")}},
+				{Address: vm.ESDTSCAddress, Identifier: []byte(core.BuiltInFunctionESDTTransfer), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(1000).Bytes()}},
+				{Address: []byte("sender"), Identifier: []byte(core.BuiltInFunctionESDTTransfer), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(8).Bytes(), []byte("receiver")}},
+			},
+		},
+		"txB": &transaction.Log{
+			Events: []*transaction.Event{
+				{Identifier: []byte(core.BuiltInFunctionESDTNFTCreate), Topics: [][]byte{[]byte("SFT-123456"), {1}, []byte("esdtData"), big.NewInt(10).Bytes()}},
+				{Identifier: []byte(core.BuiltInFunctionESDTNFTAddQuantity), Topics: [][]byte{[]byte("SFT-123456"), {1}, big.NewInt(5).Bytes()}},
+				{Identifier: []byte(core.BuiltInFunctionESDTNFTBurn), Topics: [][]byte{[]byte("SFT-123456"), {1}, big.NewInt(2).Bytes()}},
+				{Identifier: []byte(core.BuiltInFunctionESDTWipe), Topics: [][]byte{append([]byte("SFT-123456"), 1), big.NewInt(3).Bytes(), []byte("frozen")}},
+			},
+		},
+	}
+}
+
+func requireSupply(t *testing.T, supplies *esdtSupplies, token string, supply int64, minted int64, burned int64) {
+	tokenSupply, err := supplies.getSupply([]byte(token))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(supply), tokenSupply.Supply)
+	require.Equal(t, big.NewInt(minted), tokenSupply.Minted)
+	require.Equal(t, big.NewInt(burned), tokenSupply.Burned)
+}
+
+func TestESDTSupplies_ProcessLogs(t *testing.T) {
+	t.Parallel()
+
+	supplies := newESDTSupplies(genericMocks.NewStorerMock("ESDTSupplies", 0), &mock.MarshalizerMock{})
+
+	err := supplies.processLogs(7, createLogsForESDTSupplies())
+	require.Nil(t, err)
+	requireSupply(t, supplies, "TKN-abcdef", 1065, 1100, 35)
+	requireSupply(t, supplies, "SFT-123456", 10, 15, 5)
+	requireSupply(t, supplies, "NONE-000000", 0, 0, 0)
+
+	err = supplies.processLogs(8, createLogsForESDTSupplies())
+	require.Nil(t, err)
+	requireSupply(t, supplies, "TKN-abcdef", 2130, 2200, 70)
+}
+
+func TestESDTSupplies_ProcessLogsSameNonceShouldRevertPreviousBlock(t *testing.T) {
+	t.Parallel()
+
+	supplies := newESDTSupplies(genericMocks.NewStorerMock("ESDTSupplies", 0), &mock.MarshalizerMock{})
+
+	err := supplies.processLogs(7, createLogsForESDTSupplies())
+	require.Nil(t, err)
+
+	canonicalLogs := map[string]data.LogHandler{
+		"txC": &transaction.Log{
+			Events: []*transaction.Event{
+				{Identifier: []byte(core.BuiltInFunctionESDTLocalMint), Topics: [][]byte{[]byte("TKN-abcdef"), big.NewInt(40).Bytes()}},
+			},
+		},
+	}
+	err = supplies.processLogs(7, canonicalLogs)
+	require.Nil(t, err)
+	requireSupply(t, supplies, "TKN-abcdef", 40, 40, 0)
+	requireSupply(t, supplies, "SFT-123456", 0, 0, 0)
+
+	err = supplies.processLogs(7, nil)
+	require.Nil(t, err)
+	requireSupply(t, supplies, "TKN-abcdef", 0, 0, 0)
+}
+
+func TestESDTSupplies_CheckTrackedFromGenesis(t *testing.T) {
+	t.Parallel()
+
+	supplies := newESDTSupplies(genericMocks.NewStorerMock("ESDTSupplies", 0), &mock.MarshalizerMock{})
+	require.Nil(t, supplies.checkTrackedFromGenesis())
+
+	require.Nil(t, supplies.processLogs(1, nil))
+	require.Nil(t, supplies.processLogs(2, nil))
+	require.Nil(t, supplies.checkTrackedFromGenesis())
+
+	supplies = newESDTSupplies(genericMocks.NewStorerMock("ESDTSupplies", 0), &mock.MarshalizerMock{})
+	require.Nil(t, supplies.processLogs(1000, createLogsForESDTSupplies()))
+	require.True(t, errors.Is(supplies.checkTrackedFromGenesis(), ErrESDTSuppliesNotTrackedFromGenesis))
+}
+
+func TestBaseTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte("TKN-abcdef"), baseTokenIdentifier([]byte("TKN-abcdef")))
+	require.Equal(t, []byte("TKN-abcdef"), baseTokenIdentifier(append([]byte("TKN-abcdef"), 1, 2)))
+	require.Equal(t, []byte("EGLD"), baseTokenIdentifier([]byte("EGLD")))
+}
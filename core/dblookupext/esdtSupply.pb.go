@@ -0,0 +1,1137 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: esdtSupply.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SupplyESDT is used to store the minted and burned quantities of a token, as seen by the shard
+type SupplyESDT struct {
+	Supply *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"supply"`
+	Minted *math_big.Int `protobuf:"bytes,2,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"minted"`
+	Burned *math_big.Int `protobuf:"bytes,3,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"burned"`
+}
+
+func (m *SupplyESDT) Reset()      { *m = SupplyESDT{} }
+func (*SupplyESDT) ProtoMessage() {}
+func (*SupplyESDT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6075798bb2a83889, []int{0}
+}
+func (m *SupplyESDT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyESDT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplyESDT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyESDT.Merge(m, src)
+}
+func (m *SupplyESDT) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyESDT) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyESDT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyESDT proto.InternalMessageInfo
+
+func (m *SupplyESDT) GetSupply() *math_big.Int {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *SupplyESDT) GetMinted() *math_big.Int {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *SupplyESDT) GetBurned() *math_big.Int {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// SupplyDelta holds the quantities of a token minted and burned in one block
+type SupplyDelta struct {
+	Token  []byte        `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	Minted *math_big.Int `protobuf:"bytes,2,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Minted,omitempty"`
+	Burned *math_big.Int `protobuf:"bytes,3,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Burned,omitempty"`
+}
+
+func (m *SupplyDelta) Reset()      { *m = SupplyDelta{} }
+func (*SupplyDelta) ProtoMessage() {}
+func (*SupplyDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6075798bb2a83889, []int{1}
+}
+func (m *SupplyDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplyDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyDelta.Merge(m, src)
+}
+func (m *SupplyDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyDelta proto.InternalMessageInfo
+
+func (m *SupplyDelta) GetToken() []byte {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *SupplyDelta) GetMinted() *math_big.Int {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *SupplyDelta) GetBurned() *math_big.Int {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// SupplyDeltasByBlock is used to store the supply changes of a block, so they can be reverted when the block is re-recorded
+type SupplyDeltasByBlock struct {
+	Deltas []*SupplyDelta `protobuf:"bytes,1,rep,name=Deltas,proto3" json:"Deltas,omitempty"`
+}
+
+func (m *SupplyDeltasByBlock) Reset()      { *m = SupplyDeltasByBlock{} }
+func (*SupplyDeltasByBlock) ProtoMessage() {}
+func (*SupplyDeltasByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6075798bb2a83889, []int{2}
+}
+func (m *SupplyDeltasByBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyDeltasByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SupplyDeltasByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyDeltasByBlock.Merge(m, src)
+}
+func (m *SupplyDeltasByBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyDeltasByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyDeltasByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyDeltasByBlock proto.InternalMessageInfo
+
+func (m *SupplyDeltasByBlock) GetDeltas() []*SupplyDelta {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SupplyESDT)(nil), "proto.SupplyESDT")
+	proto.RegisterType((*SupplyDelta)(nil), "proto.SupplyDelta")
+	proto.RegisterType((*SupplyDeltasByBlock)(nil), "proto.SupplyDeltasByBlock")
+}
+
+func init() { proto.RegisterFile("esdtSupply.proto", fileDescriptor_6075798bb2a83889) }
+
+var fileDescriptor_6075798bb2a83889 = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x6e, 0xea, 0x30,
+	0x18, 0x85, 0x63, 0x10, 0x19, 0xcc, 0x1d, 0xae, 0x72, 0xef, 0x10, 0xdd, 0xe1, 0x07, 0x31, 0xa1,
+	0x2b, 0x91, 0x48, 0xed, 0xd8, 0x89, 0x94, 0x0c, 0x0c, 0x6d, 0x25, 0x60, 0xaa, 0xc4, 0x90, 0x10,
+	0xd7, 0x44, 0x09, 0x71, 0x94, 0x38, 0x6a, 0xd9, 0xfa, 0x08, 0x7d, 0x8c, 0xaa, 0x2f, 0xd2, 0x8e,
+	0x8c, 0x4c, 0x6d, 0x63, 0x96, 0xaa, 0x13, 0x8f, 0x50, 0x61, 0x23, 0x81, 0x98, 0x33, 0xd9, 0xe7,
+	0xe8, 0xf7, 0xf9, 0x74, 0xac, 0x1f, 0xff, 0x26, 0x79, 0xc0, 0xc7, 0x45, 0x9a, 0xc6, 0x4b, 0x2b,
+	0xcd, 0x18, 0x67, 0x46, 0x43, 0x1e, 0xff, 0x7a, 0x34, 0xe4, 0xf3, 0xc2, 0xb7, 0x66, 0x6c, 0x61,
+	0x53, 0x46, 0x99, 0x2d, 0x6d, 0xbf, 0xb8, 0x93, 0x4a, 0x0a, 0x79, 0x53, 0xaf, 0x3a, 0xaf, 0x35,
+	0x8c, 0x55, 0x8c, 0x3b, 0x1e, 0x4c, 0x0c, 0x8a, 0x75, 0xa5, 0x4c, 0xd4, 0x46, 0xdd, 0x5f, 0xce,
+	0xcd, 0xf7, 0x7b, 0x4b, 0xcf, 0xa5, 0xf3, 0xf2, 0xd1, 0xea, 0x2f, 0x3c, 0x3e, 0xb7, 0xfd, 0x90,
+	0x5a, 0xc3, 0x84, 0x5f, 0x1c, 0x81, 0xdc, 0x38, 0x63, 0x49, 0x70, 0x4d, 0xf8, 0x3d, 0xcb, 0x22,
+	0x9b, 0x48, 0xd5, 0xa3, 0xcc, 0x0e, 0x3c, 0xee, 0x59, 0x4e, 0x48, 0x87, 0x09, 0xbf, 0xf4, 0x72,
+	0x4e, 0xb2, 0xd1, 0x3e, 0x7e, 0x07, 0xba, 0x0a, 0x13, 0x4e, 0x02, 0xb3, 0x76, 0x00, 0x2d, 0xa4,
+	0x53, 0x11, 0x48, 0xc5, 0xef, 0x40, 0x4e, 0x91, 0x25, 0x24, 0x30, 0xeb, 0x07, 0x90, 0x2f, 0x9d,
+	0x8a, 0x40, 0x2a, 0xbe, 0x53, 0x22, 0xdc, 0x54, 0xe5, 0x06, 0x24, 0xe6, 0x9e, 0xf1, 0x17, 0x37,
+	0x26, 0x2c, 0x22, 0x89, 0xfa, 0xc9, 0x91, 0x12, 0xc6, 0xf4, 0xa4, 0xb7, 0x5b, 0x6d, 0xdb, 0xe9,
+	0x49, 0x5b, 0xb7, 0xda, 0x8e, 0x7d, 0xfc, 0xe7, 0xa8, 0x62, 0xee, 0x2c, 0x9d, 0x98, 0xcd, 0x22,
+	0xe3, 0x3f, 0xd6, 0x95, 0x61, 0xa2, 0x76, 0xbd, 0xdb, 0x3c, 0x33, 0xd4, 0x72, 0x59, 0x47, 0xb3,
+	0xa3, 0xfd, 0x84, 0xe3, 0xae, 0x4a, 0xd0, 0xd6, 0x25, 0x68, 0xdb, 0x12, 0xd0, 0xa3, 0x00, 0xf4,
+	0x2c, 0x00, 0xbd, 0x09, 0x40, 0x2b, 0x01, 0x68, 0x2d, 0x00, 0x7d, 0x0a, 0x40, 0x5f, 0x02, 0xb4,
+	0xad, 0x00, 0xf4, 0xb4, 0x01, 0x6d, 0xb5, 0x01, 0x6d, 0xbd, 0x01, 0xed, 0xb6, 0x19, 0xf8, 0x31,
+	0x63, 0x51, 0x91, 0x92, 0x07, 0xee, 0xeb, 0x92, 0x70, 0xfe, 0x33, 0x00, 0xc8, 0x09, 0x58, 0x57,
+	0x08, 0x03, 0x00, 0x00,
+}
+
+func (this *SupplyESDT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyESDT)
+	if !ok {
+		that2, ok := that.(SupplyESDT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Supply, that1.Supply) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Minted, that1.Minted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Burned, that1.Burned) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SupplyDelta) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyDelta)
+	if !ok {
+		that2, ok := that.(SupplyDelta)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Token, that1.Token) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Minted, that1.Minted) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Burned, that1.Burned) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SupplyDeltasByBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SupplyDeltasByBlock)
+	if !ok {
+		that2, ok := that.(SupplyDeltasByBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deltas) != len(that1.Deltas) {
+		return false
+	}
+	for i := range this.Deltas {
+		if !this.Deltas[i].Equal(that1.Deltas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SupplyESDT) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.SupplyESDT{")
+	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
+	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SupplyDelta) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.SupplyDelta{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SupplyDeltasByBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.SupplyDeltasByBlock{")
+	if this.Deltas != nil {
+		s = append(s, "Deltas: "+fmt.Sprintf("%#v", this.Deltas)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEsdtSupply(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SupplyESDT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyESDT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyESDT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Burned)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Burned, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Minted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Minted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Supply)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Supply, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Burned)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Burned, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Minted)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Minted, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintEsdtSupply(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyDeltasByBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyDeltasByBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyDeltasByBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deltas) > 0 {
+		for iNdEx := len(m.Deltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEsdtSupply(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEsdtSupply(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEsdtSupply(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupplyESDT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Supply)
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Minted)
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Burned)
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Minted)
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Burned)
+		n += 1 + l + sovEsdtSupply(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyDeltasByBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deltas) > 0 {
+		for _, e := range m.Deltas {
+			l = e.Size()
+			n += 1 + l + sovEsdtSupply(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEsdtSupply(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEsdtSupply(x uint64) (n int) {
+	return sovEsdtSupply(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SupplyESDT) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SupplyESDT{`,
+		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
+		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SupplyDelta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SupplyDelta{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SupplyDeltasByBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeltas := "[]*SupplyDelta{"
+	for _, f := range this.Deltas {
+		repeatedStringForDeltas += strings.Replace(f.String(), "SupplyDelta", "SupplyDelta", 1) + ","
+	}
+	repeatedStringForDeltas += "}"
+	s := strings.Join([]string{`&SupplyDeltasByBlock{`,
+		`Deltas:` + repeatedStringForDeltas + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEsdtSupply(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SupplyESDT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyESDT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyESDT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Supply = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Minted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Burned = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = append(m.Token[:0], dAtA[iNdEx:postIndex]...)
+			if m.Token == nil {
+				m.Token = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Minted = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Burned = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyDeltasByBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdtSupply
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyDeltasByBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyDeltasByBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deltas = append(m.Deltas, &SupplyDelta{})
+			if err := m.Deltas[len(m.Deltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdtSupply(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdtSupply
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEsdtSupply(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEsdtSupply
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEsdtSupply
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEsdtSupply
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEsdtSupply
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEsdtSupply
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEsdtSupply        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEsdtSupply          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEsdtSupply = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,314 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. eventsIndex.proto
+
+package dblookupext
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	keyPrefixAddress           = "a"
+	keyPrefixAddressIdentifier = "ai"
+	keyPrefixAddressIdentTopic = "ait"
+	keyPrefixBlockKeys         = "block"
+	keyPrefixContractHistory   = "history"
+)
+
+// EventsFilter holds the criteria used to select indexed events. Address is mandatory, Identifier and Topic are
+// optional, but Topic (compared against the first topic of the event) can be used only together with Identifier
+type EventsFilter struct {
+	Address        []byte
+	Identifier     []byte
+	Topic          []byte
+	FromBlockNonce uint64
+	ToBlockNonce   uint64
+}
+
+type eventsIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newEventsIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *eventsIndex {
+	return &eventsIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// saveEvents records the events of the given block under keys built from the filter criteria and the block nonce, so
+// that saving a block never rewrites the pointers saved for the previous blocks
+func (ei *eventsIndex) saveEvents(blockNonce uint64, epoch uint32, logs map[string]data.LogHandler) error {
+	err := ei.removeEventsOfBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	pointersByKey := ei.groupEventPointersByKey(blockNonce, epoch, logs)
+	if len(pointersByKey) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(pointersByKey))
+	for key := range pointersByKey {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	blockKeys := &EventKeysByBlock{
+		Keys: make([][]byte, 0, len(keys)),
+	}
+	for _, key := range keys {
+		bucket := &EventPointersBucket{}
+		if isContractHistoryKey([]byte(key)) {
+			existingBucket, errGet := ei.getBucket([]byte(key))
+			if errGet == nil {
+				bucket = existingBucket
+			}
+		}
+
+		bucket.Pointers = append(bucket.Pointers, pointersByKey[key]...)
+		errPut := ei.putBucket([]byte(key), bucket)
+		if errPut != nil {
+			log.Warn("eventsIndex.saveEvents() cannot save bucket", "error", errPut.Error())
+			continue
+		}
+
+		blockKeys.Keys = append(blockKeys.Keys, []byte(key))
+	}
+
+	return ei.putBlockKeys(blockNonce, blockKeys)
+}
+
+// removeEventsOfBlock drops the pointers previously recorded for the given nonce, as a block on a fork could have
+// been recorded before the one on the canonical chain
+func (ei *eventsIndex) removeEventsOfBlock(blockNonce uint64) error {
+	blockKeysBytes, err := ei.storer.Get(buildBlockKeysKey(blockNonce))
+	if err != nil {
+		return nil
+	}
+
+	blockKeys := &EventKeysByBlock{}
+	err = ei.marshalizer.Unmarshal(blockKeys, blockKeysBytes)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range blockKeys.Keys {
+		if !isContractHistoryKey(key) {
+			errRemove := ei.storer.Remove(key)
+			if errRemove != nil {
+				log.Warn("eventsIndex.removeEventsOfBlock() cannot remove bucket", "error", errRemove.Error())
+			}
+			continue
+		}
+
+		ei.removePointersOfBlock(key, blockNonce)
+	}
+
+	return ei.putBlockKeys(blockNonce, &EventKeysByBlock{})
+}
+
+func (ei *eventsIndex) removePointersOfBlock(key []byte, blockNonce uint64) {
+	bucket, err := ei.getBucket(key)
+	if err != nil {
+		return
+	}
+
+	remaining := make([]*EventPointer, 0, len(bucket.Pointers))
+	for _, pointer := range bucket.Pointers {
+		if pointer.BlockNonce != blockNonce {
+			remaining = append(remaining, pointer)
+		}
+	}
+	bucket.Pointers = remaining
+
+	err = ei.putBucket(key, bucket)
+	if err != nil {
+		log.Warn("eventsIndex.removePointersOfBlock() cannot save bucket", "error", err.Error())
+	}
+}
+
+func (ei *eventsIndex) groupEventPointersByKey(
+	blockNonce uint64,
+	epoch uint32,
+	logs map[string]data.LogHandler,
+) map[string][]*EventPointer {
+	txHashes := make([]string, 0, len(logs))
+	for txHash := range logs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	pointersByKey := make(map[string][]*EventPointer)
+	for _, txHash := range txHashes {
+		logHandler := logs[txHash]
+		if logHandler == nil || logHandler.IsInterfaceNil() {
+			continue
+		}
+
+		for index, event := range logHandler.GetLogEvents() {
+			if event == nil || event.IsInterfaceNil() {
+				continue
+			}
+
+			address := event.GetAddress()
+			if len(address) == 0 {
+				address = logHandler.GetAddress()
+			}
+
+			pointer := &EventPointer{
+				BlockNonce: blockNonce,
+				Epoch:      epoch,
+				TxHash:     []byte(txHash),
+				EventIndex: uint32(index),
+				Address:    address,
+				Identifier: event.GetIdentifier(),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+			}
+			for _, key := range buildEventKeys(address, event.GetIdentifier(), event.GetTopics(), blockNonce) {
+				pointersByKey[key] = append(pointersByKey[key], pointer)
+			}
+			if isCodeChangeEvent(event.GetIdentifier()) {
+				key := buildContractHistoryKey(address)
+				pointersByKey[key] = append(pointersByKey[key], pointer)
+			}
+		}
+	}
+
+	return pointersByKey
+}
+
+func (ei *eventsIndex) getEventPointers(filter EventsFilter) ([]*EventPointer, error) {
+	err := checkEventsFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pointers := make([]*EventPointer, 0)
+	for blockNonce := filter.FromBlockNonce; blockNonce <= filter.ToBlockNonce; blockNonce++ {
+		key := buildFilterKey(filter.Address, filter.Identifier, filter.Topic, blockNonce)
+		bucket, errGet := ei.getBucket([]byte(key))
+		if errGet == nil {
+			pointers = append(pointers, bucket.Pointers...)
+		}
+
+		if blockNonce == filter.ToBlockNonce {
+			break
+		}
+	}
+
+	return pointers, nil
+}
+
+func (ei *eventsIndex) getContractHistory(address []byte) ([]*EventPointer, error) {
+	if len(address) == 0 {
+		return nil, ErrEmptyEventsFilterAddress
+	}
+
+	bucket, err := ei.getBucket([]byte(buildContractHistoryKey(address)))
+	if err != nil {
+		return make([]*EventPointer, 0), nil
+	}
+
+	return bucket.Pointers, nil
+}
+
+func (ei *eventsIndex) getBucket(key []byte) (*EventPointersBucket, error) {
+	bucketBytes, err := ei.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := &EventPointersBucket{}
+	err = ei.marshalizer.Unmarshal(bucket, bucketBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+func (ei *eventsIndex) putBlockKeys(blockNonce uint64, blockKeys *EventKeysByBlock) error {
+	blockKeysBytes, err := ei.marshalizer.Marshal(blockKeys)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(buildBlockKeysKey(blockNonce), blockKeysBytes)
+}
+
+func (ei *eventsIndex) putBucket(key []byte, bucket *EventPointersBucket) error {
+	bucketBytes, err := ei.marshalizer.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+
+	return ei.storer.Put(key, bucketBytes)
+}
+
+func checkEventsFilter(filter EventsFilter) error {
+	if len(filter.Address) == 0 {
+		return ErrEmptyEventsFilterAddress
+	}
+	if len(filter.Topic) > 0 && len(filter.Identifier) == 0 {
+		return ErrEventsFilterTopicWithoutIdentifier
+	}
+	if filter.FromBlockNonce > filter.ToBlockNonce {
+		return ErrInvalidEventsBlockRange
+	}
+
+	return nil
+}
+
+func buildEventKeys(address []byte, identifier []byte, topics [][]byte, blockNonce uint64) []string {
+	keys := []string{buildFilterKey(address, nil, nil, blockNonce)}
+	if len(identifier) == 0 {
+		return keys
+	}
+
+	keys = append(keys, buildFilterKey(address, identifier, nil, blockNonce))
+	if len(topics) > 0 && len(topics[0]) > 0 {
+		keys = append(keys, buildFilterKey(address, identifier, topics[0], blockNonce))
+	}
+
+	return keys
+}
+
+func buildFilterKey(address []byte, identifier []byte, topic []byte, blockNonce uint64) string {
+	if len(topic) > 0 {
+		return fmt.Sprintf("%s_%s_%s_%s_%d", keyPrefixAddressIdentTopic, hex.EncodeToString(address),
+			hex.EncodeToString(identifier), hex.EncodeToString(topic), blockNonce)
+	}
+	if len(identifier) > 0 {
+		return fmt.Sprintf("%s_%s_%s_%d", keyPrefixAddressIdentifier, hex.EncodeToString(address),
+			hex.EncodeToString(identifier), blockNonce)
+	}
+
+	return fmt.Sprintf("%s_%s_%d", keyPrefixAddress, hex.EncodeToString(address), blockNonce)
+}
+
+func isCodeChangeEvent(identifier []byte) bool {
+	return string(identifier) == core.SCDeployIdentifier || string(identifier) == core.SCUpgradeIdentifier
+}
+
+func buildContractHistoryKey(address []byte) string {
+	return fmt.Sprintf("%s_%s", keyPrefixContractHistory, hex.EncodeToString(address))
+}
+
+func isContractHistoryKey(key []byte) bool {
+	return strings.HasPrefix(string(key), keyPrefixContractHistory+"_")
+}
+
+func buildBlockKeysKey(blockNonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s_%d", keyPrefixBlockKeys, blockNonce))
+}
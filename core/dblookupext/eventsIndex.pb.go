@@ -0,0 +1,1210 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: eventsIndex.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventPointer holds an indexed event together with its location in the transaction logs storage. The event payload
+// is kept in the index, so the indexed events can be served after the transaction logs were pruned
+type EventPointer struct {
+	BlockNonce uint64   `protobuf:"varint,1,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Epoch      uint32   `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	TxHash     []byte   `protobuf:"bytes,3,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	EventIndex uint32   `protobuf:"varint,4,opt,name=EventIndex,proto3" json:"EventIndex,omitempty"`
+	Address    []byte   `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	Identifier []byte   `protobuf:"bytes,6,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Topics     [][]byte `protobuf:"bytes,7,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data       []byte   `protobuf:"bytes,8,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *EventPointer) Reset()      { *m = EventPointer{} }
+func (*EventPointer) ProtoMessage() {}
+func (*EventPointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{0}
+}
+func (m *EventPointer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventPointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPointer.Merge(m, src)
+}
+func (m *EventPointer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPointer proto.InternalMessageInfo
+
+func (m *EventPointer) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *EventPointer) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EventPointer) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EventPointer) GetEventIndex() uint32 {
+	if m != nil {
+		return m.EventIndex
+	}
+	return 0
+}
+
+func (m *EventPointer) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *EventPointer) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *EventPointer) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *EventPointer) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// EventPointersBucket is used to store the pointers of the events matching one filter key in one block, or the pointers
+// to all the code change events of a contract
+type EventPointersBucket struct {
+	Pointers []*EventPointer `protobuf:"bytes,1,rep,name=Pointers,proto3" json:"Pointers,omitempty"`
+}
+
+func (m *EventPointersBucket) Reset()      { *m = EventPointersBucket{} }
+func (*EventPointersBucket) ProtoMessage() {}
+func (*EventPointersBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{1}
+}
+func (m *EventPointersBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPointersBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventPointersBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPointersBucket.Merge(m, src)
+}
+func (m *EventPointersBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPointersBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPointersBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPointersBucket proto.InternalMessageInfo
+
+func (m *EventPointersBucket) GetPointers() []*EventPointer {
+	if m != nil {
+		return m.Pointers
+	}
+	return nil
+}
+
+// EventKeysByBlock is used to store the filter keys touched by a block, so they can be cleaned when the block is re-recorded
+type EventKeysByBlock struct {
+	Keys [][]byte `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (m *EventKeysByBlock) Reset()      { *m = EventKeysByBlock{} }
+func (*EventKeysByBlock) ProtoMessage() {}
+func (*EventKeysByBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fcd5f81b5b003d0, []int{2}
+}
+func (m *EventKeysByBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventKeysByBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventKeysByBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventKeysByBlock.Merge(m, src)
+}
+func (m *EventKeysByBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventKeysByBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventKeysByBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventKeysByBlock proto.InternalMessageInfo
+
+func (m *EventKeysByBlock) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventPointer)(nil), "proto.EventPointer")
+	proto.RegisterType((*EventPointersBucket)(nil), "proto.EventPointersBucket")
+	proto.RegisterType((*EventKeysByBlock)(nil), "proto.EventKeysByBlock")
+}
+
+func init() { proto.RegisterFile("eventsIndex.proto", fileDescriptor_4fcd5f81b5b003d0) }
+
+var fileDescriptor_4fcd5f81b5b003d0 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4e, 0xf3, 0x40,
+	0x10, 0xc5, 0x3d, 0x5f, 0xfe, 0x6a, 0x93, 0x4f, 0x82, 0x0d, 0x42, 0x2b, 0x8a, 0x91, 0x95, 0x02,
+	0xb9, 0x21, 0x91, 0xe0, 0x04, 0x58, 0x04, 0x11, 0x21, 0x21, 0x64, 0x51, 0xd1, 0xc5, 0xf6, 0x26,
+	0xb1, 0x12, 0xbc, 0x96, 0xbd, 0x46, 0x49, 0xc7, 0x11, 0x38, 0x06, 0x47, 0xa1, 0x4c, 0x99, 0x92,
+	0x6c, 0x1a, 0xca, 0x1c, 0x01, 0x79, 0x42, 0x90, 0x2b, 0xcf, 0xef, 0xed, 0xbc, 0x37, 0xe3, 0x61,
+	0xc7, 0xf2, 0x55, 0xc6, 0x3a, 0x1b, 0xc6, 0xa1, 0x5c, 0xf4, 0x92, 0x54, 0x69, 0xc5, 0x6b, 0xf4,
+	0x39, 0xbb, 0x98, 0x44, 0x7a, 0x9a, 0xfb, 0xbd, 0x40, 0xbd, 0xf4, 0x27, 0x6a, 0xa2, 0xfa, 0x24,
+	0xfb, 0xf9, 0x98, 0x88, 0x80, 0xaa, 0xbd, 0xab, 0x6b, 0x80, 0xb5, 0x07, 0x45, 0xd6, 0xa3, 0x8a,
+	0x62, 0x2d, 0x53, 0x8e, 0x8c, 0xb9, 0x73, 0x15, 0xcc, 0x1e, 0x54, 0x1c, 0x48, 0x01, 0x36, 0x38,
+	0x55, 0xaf, 0xa4, 0xf0, 0x13, 0x56, 0x1b, 0x24, 0x2a, 0x98, 0x8a, 0x7f, 0x36, 0x38, 0xff, 0xbd,
+	0x3d, 0xf0, 0x53, 0x56, 0x7f, 0x5a, 0xdc, 0x8d, 0xb2, 0xa9, 0xa8, 0xd8, 0xe0, 0xb4, 0xbd, 0x5f,
+	0x2a, 0xd2, 0x28, 0x9d, 0x16, 0x15, 0x55, 0xb2, 0x94, 0x14, 0x2e, 0x58, 0xe3, 0x3a, 0x0c, 0x53,
+	0x99, 0x65, 0xa2, 0x46, 0xc6, 0x03, 0x16, 0xce, 0x61, 0x28, 0x63, 0x1d, 0x8d, 0x23, 0x99, 0x8a,
+	0x3a, 0x3d, 0x96, 0x14, 0x9a, 0xa8, 0x92, 0x28, 0xc8, 0x44, 0xc3, 0xae, 0xd0, 0x44, 0x22, 0xce,
+	0x59, 0xf5, 0x66, 0xa4, 0x47, 0xa2, 0x49, 0x0e, 0xaa, 0xbb, 0xb7, 0xac, 0x53, 0xfe, 0xc7, 0xcc,
+	0xcd, 0x83, 0x99, 0xd4, 0xbc, 0xcf, 0x9a, 0x07, 0x45, 0x80, 0x5d, 0x71, 0x5a, 0x97, 0x9d, 0xfd,
+	0x55, 0x7a, 0xe5, 0x6e, 0xef, 0xaf, 0xa9, 0x7b, 0xce, 0x8e, 0xe8, 0xe5, 0x5e, 0x2e, 0x33, 0x77,
+	0x49, 0x47, 0x29, 0xe6, 0x15, 0x48, 0x01, 0x6d, 0x8f, 0x6a, 0x77, 0xb0, 0xda, 0xa0, 0xb5, 0xde,
+	0xa0, 0xb5, 0xdb, 0x20, 0xbc, 0x19, 0x84, 0x0f, 0x83, 0xf0, 0x69, 0x10, 0x56, 0x06, 0x61, 0x6d,
+	0x10, 0xbe, 0x0c, 0xc2, 0xb7, 0x41, 0x6b, 0x67, 0x10, 0xde, 0xb7, 0x68, 0xad, 0xb6, 0x68, 0xad,
+	0xb7, 0x68, 0x3d, 0xb7, 0x42, 0x7f, 0xae, 0xd4, 0x2c, 0x4f, 0xe4, 0x42, 0xfb, 0x75, 0x5a, 0xe6,
+	0xea, 0x67, 0x00, 0x9b, 0x9b, 0xd6, 0xb5, 0xed, 0x01, 0x00, 0x00,
+}
+
+func (this *EventPointer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventPointer)
+	if !ok {
+		that2, ok := that.(EventPointer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.EventIndex != that1.EventIndex {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *EventPointersBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventPointersBucket)
+	if !ok {
+		that2, ok := that.(EventPointersBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Pointers) != len(that1.Pointers) {
+		return false
+	}
+	for i := range this.Pointers {
+		if !this.Pointers[i].Equal(that1.Pointers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EventKeysByBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EventKeysByBlock)
+	if !ok {
+		that2, ok := that.(EventKeysByBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EventPointer) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&dblookupext.EventPointer{")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "EventIndex: "+fmt.Sprintf("%#v", this.EventIndex)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventPointersBucket) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.EventPointersBucket{")
+	if this.Pointers != nil {
+		s = append(s, "Pointers: "+fmt.Sprintf("%#v", this.Pointers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EventKeysByBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.EventKeysByBlock{")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEventsIndex(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EventPointer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPointer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EventIndex != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.EventIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintEventsIndex(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPointersBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPointersBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPointersBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pointers) > 0 {
+		for iNdEx := len(m.Pointers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pointers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventsIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventKeysByBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventKeysByBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventKeysByBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintEventsIndex(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventsIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventsIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPointer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNonce != 0 {
+		n += 1 + sovEventsIndex(uint64(m.BlockNonce))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovEventsIndex(uint64(m.Epoch))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if m.EventIndex != 0 {
+		n += 1 + sovEventsIndex(uint64(m.EventIndex))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovEventsIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPointersBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pointers) > 0 {
+		for _, e := range m.Pointers {
+			l = e.Size()
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventKeysByBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovEventsIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEventsIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventsIndex(x uint64) (n int) {
+	return sovEventsIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EventPointer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventPointer{`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`EventIndex:` + fmt.Sprintf("%v", this.EventIndex) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventPointersBucket) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPointers := "[]*EventPointer{"
+	for _, f := range this.Pointers {
+		repeatedStringForPointers += strings.Replace(f.String(), "EventPointer", "EventPointer", 1) + ","
+	}
+	repeatedStringForPointers += "}"
+	s := strings.Join([]string{`&EventPointersBucket{`,
+		`Pointers:` + repeatedStringForPointers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventKeysByBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventKeysByBlock{`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEventsIndex(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EventPointer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPointer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPointer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+			}
+			m.EventIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPointersBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPointersBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPointersBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointers = append(m.Pointers, &EventPointer{})
+			if err := m.Pointers[len(m.Pointers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeysByBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeysByBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeysByBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventsIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEventsIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventsIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventsIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventsIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventsIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventsIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventsIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventsIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventsIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventsIndex = fmt.Errorf("proto: unexpected end of group")
+)
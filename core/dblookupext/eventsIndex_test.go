@@ -0,0 +1,212 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createLogsForEventsIndex() map[string]data.LogHandler {
+	return map[string]data.LogHandler{
+		"txA": &transaction.Log{
+			Address: []byte("contract"),
+			Events: []*transaction.Event{
+				{Address: []byte("contract"), Identifier: []byte("deposit"), Topics: [][]byte{[]byte("alice")}},
+				{Address: []byte("contract"), Identifier: []byte("withdraw"), Topics: [][]byte{[]byte("alice")}},
+			},
+		},
+		"txB": &transaction.Log{
+			Address: []byte("contract"),
+			Events: []*transaction.Event{
+				{Identifier: []byte("deposit"), Topics: [][]byte{[]byte("bob")}},
+				{Address: []byte("other"), Identifier: []byte("deposit"), Topics: [][]byte{[]byte("bob")}},
+			},
+		},
+	}
+}
+
+func TestEventsIndex_GetEventPointersInvalidFilterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsIndex(genericMocks.NewStorerMock("EventsIndex", 0), &mock.MarshalizerMock{})
+
+	pointers, err := index.getEventPointers(EventsFilter{})
+	require.Nil(t, pointers)
+	require.Equal(t, ErrEmptyEventsFilterAddress, err)
+
+	pointers, err = index.getEventPointers(EventsFilter{Address: []byte("contract"), Topic: []byte("alice")})
+	require.Nil(t, pointers)
+	require.Equal(t, ErrEventsFilterTopicWithoutIdentifier, err)
+
+	pointers, err = index.getEventPointers(EventsFilter{Address: []byte("contract"), FromBlockNonce: 2, ToBlockNonce: 1})
+	require.Nil(t, pointers)
+	require.Equal(t, ErrInvalidEventsBlockRange, err)
+}
+
+func TestEventsIndex_SaveAndGetEventPointers(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsIndex(genericMocks.NewStorerMock("EventsIndex", 0), &mock.MarshalizerMock{})
+
+	err := index.saveEvents(999, 3, createLogsForEventsIndex())
+	require.Nil(t, err)
+	err = index.saveEvents(1001, 3, createLogsForEventsIndex())
+	require.Nil(t, err)
+
+	filter := EventsFilter{Address: []byte("contract"), ToBlockNonce: 2000}
+	pointers, err := index.getEventPointers(filter)
+	require.Nil(t, err)
+	require.Equal(t, 6, len(pointers))
+	require.Equal(t, uint64(999), pointers[0].BlockNonce)
+	require.Equal(t, uint64(1001), pointers[5].BlockNonce)
+
+	filter.Identifier = []byte("deposit")
+	pointers, err = index.getEventPointers(filter)
+	require.Nil(t, err)
+	require.Equal(t, 4, len(pointers))
+
+	filter.Topic = []byte("bob")
+	filter.FromBlockNonce = 1000
+	pointers, err = index.getEventPointers(filter)
+	require.Nil(t, err)
+	require.Equal(t, []*EventPointer{
+		{
+			BlockNonce: 1001,
+			Epoch:      3,
+			TxHash:     []byte("txB"),
+			EventIndex: 0,
+			Address:    []byte("contract"),
+			Identifier: []byte("deposit"),
+			Topics:     [][]byte{[]byte("bob")},
+		},
+	}, pointers)
+
+	pointers, err = index.getEventPointers(EventsFilter{Address: []byte("other"), FromBlockNonce: 1000, ToBlockNonce: 1000})
+	require.Nil(t, err)
+	require.Equal(t, 0, len(pointers))
+}
+
+func TestEventsIndex_SaveEventsShouldReplaceEventsOfSameNonce(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsIndex(genericMocks.NewStorerMock("EventsIndex", 0), &mock.MarshalizerMock{})
+
+	err := index.saveEvents(7, 0, createLogsForEventsIndex())
+	require.Nil(t, err)
+
+	logsOnCanonicalChain := map[string]data.LogHandler{
+		"txC": &transaction.Log{
+			Address: []byte("contract"),
+			Events: []*transaction.Event{
+				{Address: []byte("contract"), Identifier: []byte("withdraw")},
+			},
+		},
+	}
+	err = index.saveEvents(7, 0, logsOnCanonicalChain)
+	require.Nil(t, err)
+
+	pointers, err := index.getEventPointers(EventsFilter{Address: []byte("contract"), FromBlockNonce: 7, ToBlockNonce: 7})
+	require.Nil(t, err)
+	require.Equal(t, []*EventPointer{
+		{
+			BlockNonce: 7,
+			TxHash:     []byte("txC"),
+			EventIndex: 0,
+			Address:    []byte("contract"),
+			Identifier: []byte("withdraw"),
+		},
+	}, pointers)
+
+	pointers, err = index.getEventPointers(EventsFilter{Address: []byte("contract"), Identifier: []byte("deposit"), ToBlockNonce: 7})
+	require.Nil(t, err)
+	require.Equal(t, 0, len(pointers))
+}
+
+func TestEventsIndex_GetContractHistory(t *testing.T) {
+	t.Parallel()
+
+	index := newEventsIndex(genericMocks.NewStorerMock("EventsIndex", 0), &mock.MarshalizerMock{})
+	contract := []byte("contract")
+
+	pointers, err := index.getContractHistory(nil)
+	require.Nil(t, pointers)
+	require.Equal(t, ErrEmptyEventsFilterAddress, err)
+
+	pointers, err = index.getContractHistory(contract)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(pointers))
+
+	deployLogs := map[string]data.LogHandler{
+		"deployTx": &transaction.Log{
+			Address: []byte("owner"),
+			Events: []*transaction.Event{
+				{Address: contract, Identifier: []byte(core.SCDeployIdentifier), Topics: [][]byte{contract, []byte("owner")}},
+			},
+		},
+	}
+	upgradeLogs := map[string]data.LogHandler{
+		"upgradeTx": &transaction.Log{
+			Address: contract,
+			Events: []*transaction.Event{
+				{Address: contract, Identifier: []byte("deposit")},
+				{Address: contract, Identifier: []byte(core.SCUpgradeIdentifier), Topics: [][]byte{contract, []byte("owner")}},
+			},
+		},
+	}
+	require.Nil(t, index.saveEvents(10, 1, deployLogs))
+	require.Nil(t, index.saveEvents(20, 2, upgradeLogs))
+
+	pointers, err = index.getContractHistory(contract)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(pointers))
+	require.Equal(t, uint64(10), pointers[0].BlockNonce)
+	require.Equal(t, []byte("deployTx"), pointers[0].TxHash)
+	require.Equal(t, []byte(core.SCDeployIdentifier), pointers[0].Identifier)
+	require.Equal(t, uint64(20), pointers[1].BlockNonce)
+	require.Equal(t, uint32(1), pointers[1].EventIndex)
+	require.Equal(t, []byte(core.SCUpgradeIdentifier), pointers[1].Identifier)
+
+	require.Nil(t, index.saveEvents(20, 2, nil))
+	pointers, err = index.getContractHistory(contract)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(pointers))
+	require.Equal(t, []byte("deployTx"), pointers[0].TxHash)
+}
+
+func TestEventsIndex_SaveEventsShouldNotRewriteTheEventsOfPreviousBlocks(t *testing.T) {
+	t.Parallel()
+
+	storer := genericMocks.NewStorerMock("EventsIndex", 0)
+	index := newEventsIndex(storer, &mock.MarshalizerMock{})
+
+	err := index.saveEvents(1, 0, createLogsForEventsIndex())
+	require.Nil(t, err)
+
+	firstBlockKey := []byte(buildFilterKey([]byte("contract"), nil, nil, 1))
+	firstBlockBucket, err := storer.Get(firstBlockKey)
+	require.Nil(t, err)
+
+	err = index.saveEvents(2, 0, createLogsForEventsIndex())
+	require.Nil(t, err)
+
+	firstBlockBucketAfterSave, err := storer.Get(firstBlockKey)
+	require.Nil(t, err)
+	require.Equal(t, firstBlockBucket, firstBlockBucketAfterSave)
+
+	bucket, err := index.getBucket(firstBlockKey)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(bucket.Pointers))
+	for _, pointer := range bucket.Pointers {
+		require.Equal(t, uint64(1), pointer.BlockNonce)
+	}
+
+	err = index.saveEvents(1, 0, nil)
+	require.Nil(t, err)
+	_, err = storer.Get(firstBlockKey)
+	require.NotNil(t, err)
+}
@@ -64,6 +64,13 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 	}
+	if hpf.dbLookupExtensionsConfig.EventsIndexEnabled {
+		historyRepArgs.EventsIndexStorer = hpf.store.GetStorer(dataRetriever.EventsIndexUnit)
+	}
+	if hpf.dbLookupExtensionsConfig.ESDTSuppliesEnabled {
+		historyRepArgs.ESDTSuppliesStorer = hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
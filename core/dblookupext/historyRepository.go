@@ -23,12 +23,16 @@ var log = logger.GetOrCreate("core/dblookupext")
 const sizeOfDeduplicationCache = 1000
 
 // HistoryRepositoryArguments is a structure that stores all components that are needed to a history processor
+// The EventsIndexStorer and the ESDTSuppliesStorer are optional: the events index, respectively the ESDT supplies
+// tracking, are disabled when they are not provided
 type HistoryRepositoryArguments struct {
 	SelfShardID                 uint32
 	MiniblocksMetadataStorer    storage.Storer
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	EventsIndexStorer           storage.Storer
+	ESDTSuppliesStorer          storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +43,8 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	eventsIndex                *eventsIndex
+	esdtSupplies               *esdtSupplies
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -87,6 +93,16 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	var eventsIndexer *eventsIndex
+	if !check.IfNil(arguments.EventsIndexStorer) {
+		eventsIndexer = newEventsIndex(arguments.EventsIndexStorer, arguments.Marshalizer)
+	}
+
+	var supplies *esdtSupplies
+	if !check.IfNil(arguments.ESDTSuppliesStorer) {
+		supplies = newESDTSupplies(arguments.ESDTSuppliesStorer, arguments.Marshalizer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -99,6 +115,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		eventsIndex:                                  eventsIndexer,
+		esdtSupplies:                                 supplies,
 	}, nil
 }
 
@@ -110,6 +128,7 @@ func (hr *historyRepository) RecordBlock(
 	blockBody data.BodyHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
+	logs map[string]data.LogHandler,
 ) error {
 	hr.recordBlockMutex.Lock()
 	defer hr.recordBlockMutex.Unlock()
@@ -144,6 +163,20 @@ func (hr *historyRepository) RecordBlock(
 		return err
 	}
 
+	if hr.eventsIndex != nil {
+		err = hr.eventsIndex.saveEvents(blockHeader.GetNonce(), epoch, logs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if hr.esdtSupplies != nil {
+		err = hr.esdtSupplies.processLogs(blockHeader.GetNonce(), logs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -264,6 +297,39 @@ func (hr *historyRepository) GetEpochByHash(hash []byte) (uint32, error) {
 	return hr.epochByHashIndex.getEpochByHash(hash)
 }
 
+// GetEventPointers returns the pointers to the events matching the provided filter, ordered by block nonce
+func (hr *historyRepository) GetEventPointers(filter EventsFilter) ([]*EventPointer, error) {
+	if hr.eventsIndex == nil {
+		return nil, ErrEventsIndexNotEnabled
+	}
+
+	return hr.eventsIndex.getEventPointers(filter)
+}
+
+// GetContractHistory returns the pointers to the deploy and upgrade events of the given contract, in the order they were recorded
+func (hr *historyRepository) GetContractHistory(address []byte) ([]*EventPointer, error) {
+	if hr.eventsIndex == nil {
+		return nil, ErrEventsIndexNotEnabled
+	}
+
+	return hr.eventsIndex.getContractHistory(address)
+}
+
+// GetESDTSupply returns the minted and burned quantities of the provided token, as seen by this shard. The supplies
+// are only returned by the nodes which processed all the blocks since genesis
+func (hr *historyRepository) GetESDTSupply(token string) (*SupplyESDT, error) {
+	if hr.esdtSupplies == nil {
+		return nil, ErrESDTSuppliesNotEnabled
+	}
+
+	err := hr.esdtSupplies.checkTrackedFromGenesis()
+	if err != nil {
+		return nil, err
+	}
+
+	return hr.esdtSupplies.getSupply([]byte(token))
+}
+
 // OnNotarizedBlocks notifies the history repository about notarized blocks
 func (hr *historyRepository) OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
 	for i, headerHandler := range headers {
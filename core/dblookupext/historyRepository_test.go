@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/assert"
@@ -100,7 +101,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -134,7 +135,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil,
+		nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -172,7 +173,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -230,7 +231,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -430,7 +431,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -438,7 +439,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -485,7 +486,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -542,7 +543,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -555,7 +556,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -669,7 +670,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil,
+				}, nil, nil, nil,
 			)
 		}
 
@@ -699,3 +700,119 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 	require.Equal(t, 4001, int(metadata.NotarizedAtDestinationInMetaNonce))
 	require.Equal(t, []byte("metablockFoo"), metadata.NotarizedAtDestinationInMetaHash)
 }
+
+func TestHistoryRepository_GetEventPointers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(42)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	filter := EventsFilter{Address: []byte("contract"), FromBlockNonce: 10, ToBlockNonce: 10}
+	pointers, err := repo.GetEventPointers(filter)
+	require.Nil(t, pointers)
+	require.Equal(t, ErrEventsIndexNotEnabled, err)
+
+	args.EventsIndexStorer = genericMocks.NewStorerMock("EventsIndex", 42)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	logs := map[string]data.LogHandler{
+		"txA": &transaction.Log{
+			Address: []byte("contract"),
+			Events:  []*transaction.Event{{Address: []byte("contract"), Identifier: []byte("deposit")}},
+		},
+	}
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 42, Nonce: 10}, &block.Body{}, nil, nil, logs)
+	require.Nil(t, err)
+
+	pointers, err = repo.GetEventPointers(filter)
+	require.Nil(t, err)
+	require.Equal(t, []*EventPointer{
+		{
+			BlockNonce: 10,
+			Epoch:      42,
+			TxHash:     []byte("txA"),
+			Address:    []byte("contract"),
+			Identifier: []byte("deposit"),
+		},
+	}, pointers)
+}
+
+func TestHistoryRepository_GetContractHistory(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(42)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	pointers, err := repo.GetContractHistory([]byte("contract"))
+	require.Nil(t, pointers)
+	require.Equal(t, ErrEventsIndexNotEnabled, err)
+
+	args.EventsIndexStorer = genericMocks.NewStorerMock("EventsIndex", 42)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	logs := map[string]data.LogHandler{
+		"deployTx": &transaction.Log{
+			Address: []byte("owner"),
+			Events:  []*transaction.Event{{Address: []byte("contract"), Identifier: []byte(core.SCDeployIdentifier)}},
+		},
+	}
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 42, Nonce: 10}, &block.Body{}, nil, nil, logs)
+	require.Nil(t, err)
+
+	pointers, err = repo.GetContractHistory([]byte("contract"))
+	require.Nil(t, err)
+	require.Equal(t, []*EventPointer{
+		{
+			BlockNonce: 10,
+			Epoch:      42,
+			TxHash:     []byte("deployTx"),
+			Address:    []byte("contract"),
+			Identifier: []byte(core.SCDeployIdentifier),
+		},
+	}, pointers)
+}
+
+func TestHistoryRepository_GetESDTSupply(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(42)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	supply, err := repo.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, ErrESDTSuppliesNotEnabled, err)
+
+	args.ESDTSuppliesStorer = genericMocks.NewStorerMock("ESDTSupplies", 42)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	logs := map[string]data.LogHandler{
+		"mintTx": &transaction.Log{
+			Events: []*transaction.Event{{Identifier: []byte(core.BuiltInFunctionESDTLocalMint), Topics: [][]byte{[]byte("TKN-abcdef"), {100}}}},
+		},
+	}
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 42, Nonce: 1}, &block.Body{}, nil, nil, logs)
+	require.Nil(t, err)
+
+	supply, err = repo.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, err)
+	require.Equal(t, "100", supply.Supply.String())
+	require.Equal(t, "100", supply.Minted.String())
+	require.Equal(t, "0", supply.Burned.String())
+
+	args.ESDTSuppliesStorer = genericMocks.NewStorerMock("ESDTSupplies", 42)
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 42, Nonce: 10}, &block.Body{}, nil, nil, logs)
+	require.Nil(t, err)
+
+	supply, err = repo.GetESDTSupply("TKN-abcdef")
+	require.Nil(t, supply)
+	require.True(t, errors.Is(err, ErrESDTSuppliesNotTrackedFromGenesis))
+}
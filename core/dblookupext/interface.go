@@ -17,12 +17,16 @@ type HistoryRepository interface {
 		blockBody data.BodyHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
+		logs map[string]data.LogHandler,
 	) error
 
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetEventPointers(filter EventsFilter) ([]*EventPointer, error)
+	GetContractHistory(address []byte) ([]*EventPointer, error)
+	GetESDTSupply(token string) (*SupplyESDT, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
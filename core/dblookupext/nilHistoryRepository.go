@@ -13,7 +13,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ map[string]data.LogHandler) error {
 	return nil
 }
 
@@ -41,6 +41,21 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetEventPointers returns the events index not enabled error
+func (nhr *nilHistoryRepository) GetEventPointers(_ EventsFilter) ([]*EventPointer, error) {
+	return nil, ErrEventsIndexNotEnabled
+}
+
+// GetContractHistory returns the events index not enabled error
+func (nhr *nilHistoryRepository) GetContractHistory(_ []byte) ([]*EventPointer, error) {
+	return nil, ErrEventsIndexNotEnabled
+}
+
+// GetESDTSupply returns the ESDT supplies not enabled error
+func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*SupplyESDT, error) {
+	return nil, ErrESDTSuppliesNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
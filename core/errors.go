@@ -92,6 +92,12 @@ var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
 // ErrInvalidGasScheduleConfig signals that invalid gas schedule config was provided
 var ErrInvalidGasScheduleConfig = errors.New("invalid gas schedule config")
 
+// ErrInvalidEnableEpochsConfig signals that an invalid enable epochs config was provided
+var ErrInvalidEnableEpochsConfig = errors.New("invalid enable epochs config")
+
+// ErrNilChanStopNodeProcess signals that a nil stop node process channel has been provided
+var ErrNilChanStopNodeProcess = errors.New("nil stop node process channel")
+
 // ErrAdditionOverflow signals that uint64 addition overflowed
 var ErrAdditionOverflow = errors.New("uint64 addition overflowed")
 
@@ -0,0 +1,169 @@
+package forking
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/random"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+)
+
+// ArgsEnableEpochsChanger defines the enable epochs changer arguments
+type ArgsEnableEpochsChanger struct {
+	EnableEpochs        *config.EnableEpochs
+	ChanStopNodeProcess chan endProcess.ArgEndProcess
+	EpochNotifier       core.EpochNotifier
+	StartEpoch          uint32
+	MaxRestartDelay     time.Duration
+}
+
+// enableEpochsChanger applies the enable epochs changes accepted through governance. The components copy their enable
+// epochs when they are created, so the node is restarted in order to recreate them with the changed configuration.
+// All the nodes apply a change at the same epoch start, so each node restarts after a random delay of at most
+// MaxRestartDelay, which should be shorter than an epoch: only a small part of the nodes restart at the same time and
+// the consensus continues. The delay is used only if the changed enable epochs are at least two epochs away, which is
+// always the case for the new value, as enforced by the governance contract
+type enableEpochsChanger struct {
+	mutEnableEpochs     sync.Mutex
+	enableEpochs        *config.EnableEpochs
+	currentEpoch        uint32
+	maxRestartDelay     time.Duration
+	restartTimer        *time.Timer
+	restartRequested    bool
+	randomizer          *random.ConcurrentSafeIntRandomizer
+	chanStopNodeProcess chan endProcess.ArgEndProcess
+}
+
+// NewEnableEpochsChanger creates a new instance of an enableEpochsChanger component
+func NewEnableEpochsChanger(args ArgsEnableEpochsChanger) (*enableEpochsChanger, error) {
+	if args.EnableEpochs == nil {
+		return nil, fmt.Errorf("%w, nil enable epochs", core.ErrInvalidEnableEpochsConfig)
+	}
+	if args.ChanStopNodeProcess == nil {
+		return nil, core.ErrNilChanStopNodeProcess
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, core.ErrNilEpochStartNotifier
+	}
+
+	eec := &enableEpochsChanger{
+		enableEpochs:        args.EnableEpochs,
+		currentEpoch:        args.StartEpoch,
+		maxRestartDelay:     args.MaxRestartDelay,
+		randomizer:          &random.ConcurrentSafeIntRandomizer{},
+		chanStopNodeProcess: args.ChanStopNodeProcess,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(eec)
+
+	return eec, nil
+}
+
+// IsEnableEpochName returns true if the provided name is the name of an enable epoch from the EnableEpochs config
+func IsEnableEpochName(name string) bool {
+	field, exists := reflect.TypeOf(config.EnableEpochs{}).FieldByName(name)
+
+	return exists && field.Type.Kind() == reflect.Uint32
+}
+
+// ApplyGovernanceParamChange sets the enable epoch, named as the EnableEpochs config field, to the value accepted
+// through governance. A changed value restarts the node, after a random delay if the changed enable epochs are far enough
+func (eec *enableEpochsChanger) ApplyGovernanceParamChange(name string, value string) error {
+	if !IsEnableEpochName(name) {
+		return fmt.Errorf("%w, unknown enable epoch %s", core.ErrInvalidEnableEpochsConfig, name)
+	}
+	epoch, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return fmt.Errorf("%w, invalid epoch %s for %s", core.ErrInvalidEnableEpochsConfig, value, name)
+	}
+
+	eec.mutEnableEpochs.Lock()
+	defer eec.mutEnableEpochs.Unlock()
+
+	field := reflect.ValueOf(eec.enableEpochs).Elem().FieldByName(name)
+	oldEpoch := field.Uint()
+	if oldEpoch == epoch {
+		return nil
+	}
+	field.SetUint(epoch)
+
+	restartDelay := eec.computeRestartDelay(oldEpoch, epoch)
+	log.Info("enableEpochsChanger: enable epoch changed through governance, restarting the node",
+		"name", name,
+		"epoch", epoch,
+		"restart delay", restartDelay,
+	)
+
+	argEndProcess := endProcess.ArgEndProcess{
+		Reason:      core.EnableEpochsChanged,
+		Description: fmt.Sprintf("%s changed to %d", name, epoch),
+	}
+	eec.scheduleRestart(restartDelay, argEndProcess)
+
+	return nil
+}
+
+func (eec *enableEpochsChanger) computeRestartDelay(oldEpoch uint64, newEpoch uint64) time.Duration {
+	firstChangedEpoch := oldEpoch
+	if newEpoch < firstChangedEpoch {
+		firstChangedEpoch = newEpoch
+	}
+	if firstChangedEpoch <= uint64(eec.currentEpoch)+1 || eec.maxRestartDelay <= 0 {
+		return 0
+	}
+
+	return time.Duration(eec.randomizer.Intn(int(eec.maxRestartDelay)))
+}
+
+// scheduleRestart keeps a single restart timer, so the node restarts only once, at the earliest of the required moments
+func (eec *enableEpochsChanger) scheduleRestart(delay time.Duration, argEndProcess endProcess.ArgEndProcess) {
+	if eec.restartRequested {
+		return
+	}
+	if eec.restartTimer != nil {
+		if delay > 0 {
+			return
+		}
+		if !eec.restartTimer.Stop() {
+			// the delayed restart was already requested
+			return
+		}
+	}
+
+	if delay > 0 {
+		eec.restartTimer = time.AfterFunc(delay, func() {
+			eec.stopNode(argEndProcess)
+		})
+		return
+	}
+
+	eec.restartRequested = true
+	eec.stopNode(argEndProcess)
+}
+
+func (eec *enableEpochsChanger) stopNode(argEndProcess endProcess.ArgEndProcess) {
+	select {
+	case eec.chanStopNodeProcess <- argEndProcess:
+	default:
+		log.Debug("enableEpochsChanger: could not write on the stop node channel")
+	}
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (eec *enableEpochsChanger) EpochConfirmed(epoch uint32, _ uint64) {
+	eec.mutEnableEpochs.Lock()
+	if epoch > eec.currentEpoch {
+		eec.currentEpoch = epoch
+	}
+	eec.mutEnableEpochs.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eec *enableEpochsChanger) IsInterfaceNil() bool {
+	return eec == nil
+}
@@ -0,0 +1,136 @@
+package forking
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEnableEpochsChanger_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eec, err := NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+		EpochNotifier:       NewGenericEpochNotifier(),
+	})
+	assert.Nil(t, eec)
+	assert.True(t, errors.Is(err, core.ErrInvalidEnableEpochsConfig))
+
+	eec, err = NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:  &config.EnableEpochs{},
+		EpochNotifier: NewGenericEpochNotifier(),
+	})
+	assert.Nil(t, eec)
+	assert.Equal(t, core.ErrNilChanStopNodeProcess, err)
+
+	eec, err = NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:        &config.EnableEpochs{},
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+	})
+	assert.Nil(t, eec)
+	assert.Equal(t, core.ErrNilEpochStartNotifier, err)
+}
+
+func TestIsEnableEpochName(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsEnableEpochName("ESDTEnableEpoch"))
+	assert.False(t, IsEnableEpochName("MaxNodesChangeEnableEpoch"))
+	assert.False(t, IsEnableEpochName("UnknownEnableEpoch"))
+}
+
+func TestEnableEpochsChanger_ApplyGovernanceParamChangeInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eec, _ := NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:        &config.EnableEpochs{},
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+		EpochNotifier:       NewGenericEpochNotifier(),
+	})
+
+	err := eec.ApplyGovernanceParamChange("UnknownEnableEpoch", "10")
+	assert.True(t, errors.Is(err, core.ErrInvalidEnableEpochsConfig))
+
+	err = eec.ApplyGovernanceParamChange("ESDTEnableEpoch", "-1")
+	assert.True(t, errors.Is(err, core.ErrInvalidEnableEpochsConfig))
+}
+
+func TestEnableEpochsChanger_ApplyGovernanceParamChangeShouldRestartOnlyOnChange(t *testing.T) {
+	t.Parallel()
+
+	enableEpochs := &config.EnableEpochs{ESDTEnableEpoch: 100}
+	chanStopNodeProcess := make(chan endProcess.ArgEndProcess, 1)
+	eec, _ := NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:        enableEpochs,
+		ChanStopNodeProcess: chanStopNodeProcess,
+		EpochNotifier:       NewGenericEpochNotifier(),
+	})
+
+	err := eec.ApplyGovernanceParamChange("ESDTEnableEpoch", "100")
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(chanStopNodeProcess))
+
+	err = eec.ApplyGovernanceParamChange("ESDTEnableEpoch", "20")
+	require.Nil(t, err)
+	assert.Equal(t, uint32(20), enableEpochs.ESDTEnableEpoch)
+	require.Equal(t, 1, len(chanStopNodeProcess))
+	assert.Equal(t, core.EnableEpochsChanged, (<-chanStopNodeProcess).Reason)
+}
+
+func TestEnableEpochsChanger_ApplyGovernanceParamChangeShouldDelayTheRestartForFarEpochs(t *testing.T) {
+	t.Parallel()
+
+	enableEpochs := &config.EnableEpochs{ESDTEnableEpoch: 100, GovernanceEnableEpoch: 100}
+	chanStopNodeProcess := make(chan endProcess.ArgEndProcess, 2)
+	epochNotifier := NewGenericEpochNotifier()
+	eec, _ := NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:        enableEpochs,
+		ChanStopNodeProcess: chanStopNodeProcess,
+		EpochNotifier:       epochNotifier,
+		StartEpoch:          5,
+		MaxRestartDelay:     time.Millisecond * 100,
+	})
+
+	err := eec.ApplyGovernanceParamChange("ESDTEnableEpoch", "7")
+	require.Nil(t, err)
+	err = eec.ApplyGovernanceParamChange("GovernanceEnableEpoch", "8")
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(chanStopNodeProcess))
+
+	time.Sleep(time.Millisecond * 200)
+	require.Equal(t, 1, len(chanStopNodeProcess))
+	assert.Equal(t, core.EnableEpochsChanged, (<-chanStopNodeProcess).Reason)
+}
+
+func TestEnableEpochsChanger_ApplyGovernanceParamChangeShouldRestartImmediatelyForTheNextEpoch(t *testing.T) {
+	t.Parallel()
+
+	enableEpochs := &config.EnableEpochs{ESDTEnableEpoch: 100, GovernanceEnableEpoch: 100}
+	chanStopNodeProcess := make(chan endProcess.ArgEndProcess, 2)
+	epochNotifier := NewGenericEpochNotifier()
+	eec, _ := NewEnableEpochsChanger(ArgsEnableEpochsChanger{
+		EnableEpochs:        enableEpochs,
+		ChanStopNodeProcess: chanStopNodeProcess,
+		EpochNotifier:       epochNotifier,
+		MaxRestartDelay:     time.Millisecond * 100,
+	})
+
+	err := eec.ApplyGovernanceParamChange("ESDTEnableEpoch", "7")
+	require.Nil(t, err)
+
+	epochNotifier.CheckEpoch(&testscommon.HeaderHandlerStub{EpochField: 6})
+	err = eec.ApplyGovernanceParamChange("GovernanceEnableEpoch", "7")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(chanStopNodeProcess))
+	assert.Equal(t, core.EnableEpochsChanged, (<-chanStopNodeProcess).Reason)
+
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, 0, len(chanStopNodeProcess))
+}
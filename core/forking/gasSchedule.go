@@ -1,8 +1,11 @@
 package forking
 
 import (
+	"fmt"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -20,6 +23,7 @@ type gasScheduleNotifier struct {
 	gasScheduleConfig config.GasScheduleConfig
 	currentEpoch      uint32
 	lastGasSchedule   GasScheduleMap
+	governanceChanges GasScheduleMap
 	handlers          []core.GasScheduleSubscribeHandler
 }
 
@@ -41,6 +45,7 @@ func NewGasScheduleNotifier(args ArgsNewGasScheduleNotifier) (*gasScheduleNotifi
 
 	g := &gasScheduleNotifier{
 		gasScheduleConfig: args.GasScheduleConfig,
+		governanceChanges: make(GasScheduleMap),
 		handlers:          make([]core.GasScheduleSubscribeHandler, 0),
 		configDir:         args.ConfigDir,
 	}
@@ -128,11 +133,75 @@ func (g *gasScheduleNotifier) EpochConfirmed(epoch uint32, _ uint64) {
 	)
 
 	g.lastGasSchedule = newGasSchedule
+	g.applyGovernanceChanges()
 	for _, handler := range g.handlers {
 		handler.GasScheduleChange(g.lastGasSchedule)
 	}
 }
 
+// ApplyGovernanceParamChange changes a gas schedule entry, named as Section.Entry, to the value accepted through
+// governance. The change is kept over the gas schedule versions loaded in the following epochs
+func (g *gasScheduleNotifier) ApplyGovernanceParamChange(name string, value string) error {
+	nameParts := strings.Split(name, ".")
+	if len(nameParts) != 2 {
+		return fmt.Errorf("%w, invalid gas schedule entry %s", core.ErrInvalidGasScheduleConfig, name)
+	}
+	gasCost, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w, invalid gas cost %s for %s", core.ErrInvalidGasScheduleConfig, value, name)
+	}
+
+	g.mutNotifier.Lock()
+	defer g.mutNotifier.Unlock()
+
+	section, entry := nameParts[0], nameParts[1]
+	if _, exists := g.lastGasSchedule[section][entry]; !exists {
+		return fmt.Errorf("%w, unknown gas schedule entry %s", core.ErrInvalidGasScheduleConfig, name)
+	}
+
+	if g.governanceChanges[section] == nil {
+		g.governanceChanges[section] = make(map[string]uint64)
+	}
+	g.governanceChanges[section][entry] = gasCost
+	g.lastGasSchedule = copyGasSchedule(g.lastGasSchedule)
+	g.applyGovernanceChanges()
+
+	log.Debug("gasScheduleNotifier: gas schedule entry changed through governance",
+		"name", name,
+		"value", gasCost,
+		"num handlers", len(g.handlers),
+	)
+
+	for _, handler := range g.handlers {
+		handler.GasScheduleChange(g.lastGasSchedule)
+	}
+
+	return nil
+}
+
+func (g *gasScheduleNotifier) applyGovernanceChanges() {
+	for section, entries := range g.governanceChanges {
+		for entry, gasCost := range entries {
+			if _, exists := g.lastGasSchedule[section][entry]; exists {
+				g.lastGasSchedule[section][entry] = gasCost
+			}
+		}
+	}
+}
+
+func copyGasSchedule(gasSchedule GasScheduleMap) GasScheduleMap {
+	newGasSchedule := make(GasScheduleMap, len(gasSchedule))
+	for section, entries := range gasSchedule {
+		newEntries := make(map[string]uint64, len(entries))
+		for entry, gasCost := range entries {
+			newEntries[entry] = gasCost
+		}
+		newGasSchedule[section] = newEntries
+	}
+
+	return newGasSchedule
+}
+
 // LatestGasSchedule returns the latest gas schedule
 func (g *gasScheduleNotifier) LatestGasSchedule() map[string]map[string]uint64 {
 	g.mutNotifier.RLock()
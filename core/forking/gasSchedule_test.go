@@ -1,6 +1,7 @@
 package forking
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -180,3 +181,45 @@ func TestGasScheduleNotifier_CheckEpochInSyncShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
 	assert.True(t, end.Sub(start) >= handlerWait)
 }
+
+func TestGasScheduleNotifier_ApplyGovernanceParamChangeInvalidEntryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createGasScheduleNotifierArgs()
+	g, _ := NewGasScheduleNotifier(args)
+
+	err := g.ApplyGovernanceParamChange("ClaimDeveloperRewards", "100")
+	assert.True(t, errors.Is(err, core.ErrInvalidGasScheduleConfig))
+
+	err = g.ApplyGovernanceParamChange("BuiltInCost.ClaimDeveloperRewards", "-100")
+	assert.True(t, errors.Is(err, core.ErrInvalidGasScheduleConfig))
+
+	err = g.ApplyGovernanceParamChange("BuiltInCost.UnknownEntry", "100")
+	assert.True(t, errors.Is(err, core.ErrInvalidGasScheduleConfig))
+}
+
+func TestGasScheduleNotifier_ApplyGovernanceParamChangeShouldBeKeptOverNewVersions(t *testing.T) {
+	t.Parallel()
+
+	args := createGasScheduleNotifierArgs()
+	g, _ := NewGasScheduleNotifier(args)
+	numCalled := uint32(0)
+	g.RegisterNotifyHandler(&mock.GasScheduleSubscribeHandlerStub{
+		GasScheduleChangeCalled: func(gasMap map[string]map[string]uint64) {
+			if atomic.AddUint32(&numCalled, 1) > 1 {
+				assert.Equal(t, uint64(100), gasMap["BuiltInCost"]["ClaimDeveloperRewards"])
+			}
+		},
+	})
+	initialGasSchedule := g.LatestGasSchedule()
+
+	err := g.ApplyGovernanceParamChange("BuiltInCost.ClaimDeveloperRewards", "100")
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(5000000), initialGasSchedule["BuiltInCost"]["ClaimDeveloperRewards"])
+
+	g.EpochConfirmed(2, 0)
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalled))
+	assert.Equal(t, uint64(100), g.LatestGasSchedule()["BuiltInCost"]["ClaimDeveloperRewards"])
+	assert.Equal(t, uint64(300), g.LatestGasSchedule()["BaseOperationCost"]["AoTPreparePerByte"])
+}
@@ -103,6 +103,12 @@ type GasScheduleNotifier interface {
 	IsInterfaceNil() bool
 }
 
+// GovernanceParamChangeHandler defines a component which applies the network parameter changes accepted through governance
+type GovernanceParamChangeHandler interface {
+	ApplyGovernanceParamChange(name string, value string) error
+	IsInterfaceNil() bool
+}
+
 // Queue is an interface for queue implementations that evict the first element when the queue is full
 type Queue interface {
 	Add(hash []byte) []byte
@@ -70,6 +70,17 @@ type DirectStakedValue struct {
 	Total      string `json:"total"`
 }
 
+// StakingQueueEntry holds a BLS key waiting in the staking queue. The expected activation epoch is the epoch in which
+// the key should be staked from the queue, considering only the configured increases of the maximum number of nodes
+type StakingQueueEntry struct {
+	BLSKey                  string `json:"blsKey"`
+	Owner                   string `json:"owner"`
+	RewardAddress           string `json:"rewardAddress"`
+	Index                   uint32 `json:"index"`
+	RegisterNonce           uint64 `json:"registerNonce"`
+	ExpectedActivationEpoch uint32 `json:"expectedActivationEpoch,omitempty"`
+}
+
 // DelegatedValue holds the value and the delegation system SC address
 type DelegatedValue struct {
 	DelegationScAddress string `json:"delegationScAddress"`
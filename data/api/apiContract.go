@@ -0,0 +1,44 @@
+package api
+
+import "github.com/ElrondNetwork/elrond-go/data/transaction"
+
+// ContractCodeMetadata holds the flags set on the code of a smart contract
+type ContractCodeMetadata struct {
+	Upgradeable bool `json:"upgradeable"`
+	Readable    bool `json:"readable"`
+	Payable     bool `json:"payable"`
+}
+
+// ContractHistoryEntry represents a deployment or an upgrade of a smart contract
+type ContractHistoryEntry struct {
+	TxHash     string `json:"txHash"`
+	BlockNonce uint64 `json:"blockNonce"`
+	Epoch      uint32 `json:"epoch"`
+	Action     string `json:"action"`
+}
+
+// ContractInfo represents the structure for a smart contract code inspection that is returned by api routes
+type ContractInfo struct {
+	Address      string                  `json:"address"`
+	Code         string                  `json:"code"`
+	CodeHash     string                  `json:"codeHash"`
+	CodeMetadata ContractCodeMetadata    `json:"codeMetadata"`
+	Owner        string                  `json:"owner"`
+	History      []*ContractHistoryEntry `json:"history,omitempty"`
+}
+
+// UpgradeCheckRequest holds the hex encoded code, code metadata and arguments of a contract upgrade dry-run
+type UpgradeCheckRequest struct {
+	Code         string   `json:"code"`
+	CodeMetadata string   `json:"codeMetadata"`
+	Arguments    []string `json:"arguments"`
+	GasLimit     uint64   `json:"gasLimit"`
+}
+
+// UpgradeCheckResult holds the outcome of a contract upgrade dry-run and the differences between the exported functions
+type UpgradeCheckResult struct {
+	Simulation       *transaction.SimulationResults `json:"simulation"`
+	AddedFunctions   []string                       `json:"addedFunctions"`
+	RemovedFunctions []string                       `json:"removedFunctions"`
+	ChangedFunctions []string                       `json:"changedFunctions"`
+}
@@ -0,0 +1,8 @@
+package api
+
+// ESDTAllowance represents the value of a token which a spender is allowed to transfer from the balance of an owner
+type ESDTAllowance struct {
+	Spender   string `json:"spender"`
+	Token     string `json:"token"`
+	Allowance string `json:"allowance"`
+}
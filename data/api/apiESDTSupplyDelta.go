@@ -0,0 +1,11 @@
+package api
+
+// ESDTSupplyDelta represents the quantities of a token minted and burned in the shard which answered the request. The
+// total supply of a token is the sum of the deltas of all the shards
+type ESDTSupplyDelta struct {
+	Token   string `json:"token"`
+	ShardID uint32 `json:"shardID"`
+	Delta   string `json:"delta"`
+	Minted  string `json:"minted"`
+	Burned  string `json:"burned"`
+}
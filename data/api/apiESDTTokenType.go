@@ -0,0 +1,6 @@
+package api
+
+// ESDTTokenType represents the type of a token, as registered by the esdt system smart contract
+type ESDTTokenType struct {
+	Type string `json:"type"`
+}
@@ -0,0 +1,9 @@
+package api
+
+// EpochStartCheckpoint represents the epoch start metablock of the current epoch, which can be used as a trusted
+// checkpoint by the nodes bootstrapping from the network
+type EpochStartCheckpoint struct {
+	Epoch         uint32 `json:"epoch"`
+	MetaBlockHash string `json:"metaBlockHash"`
+	FlagValue     string `json:"flagValue"`
+}
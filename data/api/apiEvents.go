@@ -0,0 +1,30 @@
+package api
+
+// EventsQuery holds the criteria used to query the indexed smart contract events
+type EventsQuery struct {
+	Address    string
+	Identifier string
+	Topic      string
+	FromBlock  uint64
+	ToBlock    uint64
+	Offset     uint32
+	Limit      uint32
+}
+
+// IndexedEvent represents a smart contract event, together with its location, as returned by the api routes
+type IndexedEvent struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	Epoch      uint32   `json:"epoch"`
+	EventIndex uint32   `json:"eventIndex"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// EventsPage holds a page of the events matching a query and the total number of matching events
+type EventsPage struct {
+	Events []*IndexedEvent `json:"events"`
+	Total  uint32          `json:"total"`
+}
@@ -0,0 +1,40 @@
+package api
+
+// GovernanceProposal holds the general information of a governance proposal
+type GovernanceProposal struct {
+	CommitHash     string `json:"commitHash"`
+	Issuer         string `json:"issuer"`
+	StartVoteNonce uint64 `json:"startVoteNonce"`
+	EndVoteNonce   uint64 `json:"endVoteNonce"`
+	Status         string `json:"status"`
+	Passed         bool   `json:"passed"`
+	Yes            string `json:"yes"`
+	No             string `json:"no"`
+	Veto           string `json:"veto"`
+	NumVoters      uint64 `json:"numVoters"`
+}
+
+// GovernanceVotingPower holds the voting power cast for each of the vote options
+type GovernanceVotingPower struct {
+	Yes  string `json:"yes"`
+	No   string `json:"no"`
+	Veto string `json:"veto"`
+}
+
+// GovernanceProposalTally holds a governance proposal and its voting power split between the validators and the
+// funds votes
+type GovernanceProposalTally struct {
+	Proposal   *GovernanceProposal    `json:"proposal"`
+	Validators *GovernanceVotingPower `json:"validators"`
+	Funds      *GovernanceVotingPower `json:"funds"`
+}
+
+// GovernanceVote holds the votes cast by an address on a governance proposal
+type GovernanceVote struct {
+	CommitHash  string `json:"commitHash"`
+	UsedPower   string `json:"usedPower"`
+	UsedBalance string `json:"usedBalance"`
+	Yes         string `json:"yes"`
+	No          string `json:"no"`
+	Veto        string `json:"veto"`
+}
@@ -0,0 +1,38 @@
+package api
+
+// StorageUsage represents the size on disk of the databases of the node, per storage unit and per epoch
+type StorageUsage struct {
+	DatabasePath string                   `json:"databasePath"`
+	TotalSize    uint64                   `json:"totalSize"`
+	Units        []*StorageUnitUsage      `json:"units"`
+	Epochs       []*StorageEpochUsage     `json:"epochs"`
+	Timestamp    int64                    `json:"timestamp"`
+	Compaction   *StorageCompactionStatus `json:"compaction"`
+}
+
+// StorageUnitUsage represents the size on disk of a storage unit. The number of epochs is 0 for the static units
+type StorageUnitUsage struct {
+	Shard      string `json:"shard"`
+	Identifier string `json:"identifier"`
+	Size       uint64 `json:"size"`
+	NumEpochs  uint32 `json:"numEpochs"`
+}
+
+// StorageEpochUsage represents the size on disk of the storage units of an epoch or of the static storage units
+type StorageEpochUsage struct {
+	Epoch    uint32              `json:"epoch"`
+	IsStatic bool                `json:"isStatic"`
+	Size     uint64              `json:"size"`
+	Units    []*StorageUnitUsage `json:"units"`
+}
+
+// StorageCompactionStatus represents the status of the last online compaction of the storage units
+type StorageCompactionStatus struct {
+	InProgress       bool              `json:"inProgress"`
+	Units            []string          `json:"units"`
+	CompactedUnits   []string          `json:"compactedUnits"`
+	FailedUnits      map[string]string `json:"failedUnits"`
+	CompactableUnits []string          `json:"compactableUnits"`
+	StartTimestamp   int64             `json:"startTimestamp"`
+	EndTimestamp     int64             `json:"endTimestamp"`
+}
@@ -0,0 +1,78 @@
+package api
+
+// SyncStatus holds the information gathered from the sync mechanism of the node
+type SyncStatus struct {
+	State           *SyncState            `json:"state"`
+	ForkDetector    *ForkDetectorStatus   `json:"forkDetector"`
+	RollBacks       []*SyncRollBack       `json:"rollBacks"`
+	HeaderSuppliers []*SyncHeaderSupplier `json:"headerSuppliers"`
+}
+
+// SyncState represents the last sync state computed by the bootstrapper
+type SyncState struct {
+	IsSynchronized          bool   `json:"isSynchronized"`
+	HasLastBlock            bool   `json:"hasLastBlock"`
+	IsConnectedToTheNetwork bool   `json:"isConnectedToTheNetwork"`
+	IsForkDetected          bool   `json:"isForkDetected"`
+	ForkNonce               uint64 `json:"forkNonce"`
+	ForkRound               uint64 `json:"forkRound"`
+	ForkHash                string `json:"forkHash"`
+	CurrentNonce            uint64 `json:"currentNonce"`
+	CurrentHash             string `json:"currentHash"`
+	ProbableHighestNonce    uint64 `json:"probableHighestNonce"`
+	HighestFinalNonce       uint64 `json:"highestFinalNonce"`
+	Round                   int64  `json:"round"`
+	Timestamp               int64  `json:"timestamp"`
+}
+
+// ForkDetectorStatus represents the internal state of the fork detector
+type ForkDetectorStatus struct {
+	Headers                 []*ForkDetectorHeader     `json:"headers"`
+	Checkpoints             []*ForkDetectorCheckpoint `json:"checkpoints"`
+	FinalCheckpoint         *ForkDetectorCheckpoint   `json:"finalCheckpoint"`
+	ProbableHighestNonce    uint64                    `json:"probableHighestNonce"`
+	HighestNonceReceived    uint64                    `json:"highestNonceReceived"`
+	RollBackNonce           uint64                    `json:"rollBackNonce"`
+	LastRoundWithForcedFork int64                     `json:"lastRoundWithForcedFork"`
+}
+
+// ForkDetectorHeader represents a header known by the fork detector
+type ForkDetectorHeader struct {
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Epoch uint32 `json:"epoch"`
+	Hash  string `json:"hash"`
+	State string `json:"state"`
+}
+
+// ForkDetectorCheckpoint represents a checkpoint of the fork detector
+type ForkDetectorCheckpoint struct {
+	Nonce uint64 `json:"nonce"`
+	Round uint64 `json:"round"`
+	Hash  string `json:"hash"`
+}
+
+// SyncRollBack represents a roll back done by the sync mechanism, together with the reason which triggered it
+type SyncRollBack struct {
+	Reason    string `json:"reason"`
+	FromNonce uint64 `json:"fromNonce"`
+	FromRound uint64 `json:"fromRound"`
+	FromHash  string `json:"fromHash"`
+	ToNonce   uint64 `json:"toNonce"`
+	ToHash    string `json:"toHash"`
+	ForkNonce uint64 `json:"forkNonce,omitempty"`
+	ForkRound uint64 `json:"forkRound,omitempty"`
+	ForkHash  string `json:"forkHash,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// SyncHeaderSupplier represents a peer which supplied headers to the node
+type SyncHeaderSupplier struct {
+	Pid          string `json:"pid"`
+	NumHeaders   uint64 `json:"numHeaders"`
+	LastNonce    uint64 `json:"lastNonce"`
+	LastRound    uint64 `json:"lastRound"`
+	LastShardID  uint32 `json:"lastShardID"`
+	LastReceived int64  `json:"lastReceived"`
+}
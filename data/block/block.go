@@ -11,6 +11,29 @@ import (
 //  necessarily representing a full block body
 type MiniBlockSlice []*MiniBlock
 
+// ProcessingType defines the way the transactions from a miniblock are processed
+type ProcessingType uint8
+
+const (
+	// Normal means that the miniblock transactions are executed while the block is processed
+	Normal ProcessingType = 0
+	// Scheduled means that the miniblock transactions are only verified while the block is processed and executed
+	// after the block is committed, their results being reflected in the next block
+	Scheduled ProcessingType = 1
+)
+
+// String returns the string representation of the processing type
+func (pt ProcessingType) String() string {
+	switch pt {
+	case Normal:
+		return "Normal"
+	case Scheduled:
+		return "Scheduled"
+	default:
+		return "Unknown"
+	}
+}
+
 // MiniblockAndHash holds the info related to a miniblock and its hash
 type MiniblockAndHash struct {
 	Miniblock *MiniBlock
@@ -210,6 +233,37 @@ func (mb *MiniBlock) Clone() *MiniBlock {
 	}
 	newMb.TxHashes = make([][]byte, len(mb.TxHashes))
 	copy(newMb.TxHashes, mb.TxHashes)
+	if len(mb.Reserved) > 0 {
+		newMb.Reserved = make([]byte, len(mb.Reserved))
+		copy(newMb.Reserved, mb.Reserved)
+	}
 
 	return newMb
 }
+
+// GetProcessingType returns the processing type of the miniblock, stored in the first reserved byte
+func (mb *MiniBlock) GetProcessingType() ProcessingType {
+	if len(mb.Reserved) == 0 {
+		return Normal
+	}
+
+	return ProcessingType(mb.Reserved[0])
+}
+
+// SetProcessingType sets the processing type of the miniblock in the first reserved byte
+func (mb *MiniBlock) SetProcessingType(processingType ProcessingType) {
+	if processingType == Normal && len(mb.Reserved) <= 1 {
+		mb.Reserved = nil
+		return
+	}
+	if len(mb.Reserved) == 0 {
+		mb.Reserved = make([]byte, 1)
+	}
+
+	mb.Reserved[0] = byte(processingType)
+}
+
+// IsScheduledMiniBlock returns true if the miniblock transactions are executed after the block is committed
+func (mb *MiniBlock) IsScheduledMiniBlock() bool {
+	return mb.GetProcessingType() == Scheduled
+}
@@ -379,3 +379,36 @@ func TestMiniBlock_Clone(t *testing.T) {
 
 	assert.True(t, reflect.DeepEqual(miniBlock, clonedMB))
 }
+
+func TestMiniBlock_CloneShouldCopyReserved(t *testing.T) {
+	t.Parallel()
+
+	miniBlock := &block.MiniBlock{
+		TxHashes: [][]byte{[]byte("something")},
+	}
+	miniBlock.SetProcessingType(block.Scheduled)
+
+	clonedMB := miniBlock.Clone()
+	assert.True(t, reflect.DeepEqual(miniBlock, clonedMB))
+
+	clonedMB.SetProcessingType(block.Normal)
+	assert.True(t, miniBlock.IsScheduledMiniBlock())
+	assert.False(t, clonedMB.IsScheduledMiniBlock())
+}
+
+func TestMiniBlock_ProcessingType(t *testing.T) {
+	t.Parallel()
+
+	miniBlock := &block.MiniBlock{}
+	assert.Equal(t, block.Normal, miniBlock.GetProcessingType())
+	assert.False(t, miniBlock.IsScheduledMiniBlock())
+
+	miniBlock.SetProcessingType(block.Scheduled)
+	assert.Equal(t, block.Scheduled, miniBlock.GetProcessingType())
+	assert.True(t, miniBlock.IsScheduledMiniBlock())
+	assert.Equal(t, []byte{byte(block.Scheduled)}, miniBlock.Reserved)
+
+	miniBlock.SetProcessingType(block.Normal)
+	assert.Equal(t, block.Normal, miniBlock.GetProcessingType())
+	assert.Nil(t, miniBlock.Reserved)
+}
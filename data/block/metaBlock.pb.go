@@ -70,8 +70,8 @@ func (PeerAction) EnumDescriptor() ([]byte, []int) {
 }
 
 // PeerData holds information about actions taken by a peer:
-//  - a peer can register with an amount to become a validator
-//  - a peer can choose to deregister and get back the deposited value
+//   - a peer can register with an amount to become a validator
+//   - a peer can choose to deregister and get back the deposited value
 type PeerData struct {
 	Address     []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	PublicKey   []byte        `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
@@ -487,16 +487,89 @@ func (m *Economics) GetPrevEpochStartHash() []byte {
 	return nil
 }
 
+// GovernanceParamChange holds a network parameter change accepted through the governance system smart contract
+type GovernanceParamChange struct {
+	ProposalHash    []byte `protobuf:"bytes,1,opt,name=ProposalHash,proto3" json:"ProposalHash,omitempty"`
+	Type            string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Name            string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Value           string `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value,omitempty"`
+	ActivationEpoch uint32 `protobuf:"varint,5,opt,name=ActivationEpoch,proto3" json:"ActivationEpoch,omitempty"`
+}
+
+func (m *GovernanceParamChange) Reset()      { *m = GovernanceParamChange{} }
+func (*GovernanceParamChange) ProtoMessage() {}
+func (*GovernanceParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87b91ab531130b2b, []int{4}
+}
+func (m *GovernanceParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GovernanceParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceParamChange.Merge(m, src)
+}
+func (m *GovernanceParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceParamChange proto.InternalMessageInfo
+
+func (m *GovernanceParamChange) GetProposalHash() []byte {
+	if m != nil {
+		return m.ProposalHash
+	}
+	return nil
+}
+
+func (m *GovernanceParamChange) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GovernanceParamChange) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GovernanceParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GovernanceParamChange) GetActivationEpoch() uint32 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
 // EpochStart holds the block information for end-of-epoch
 type EpochStart struct {
-	LastFinalizedHeaders []EpochStartShardData `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
-	Economics            Economics             `protobuf:"bytes,2,opt,name=Economics,proto3" json:"Economics"`
+	LastFinalizedHeaders []EpochStartShardData   `protobuf:"bytes,1,rep,name=LastFinalizedHeaders,proto3" json:"LastFinalizedHeaders"`
+	Economics            Economics               `protobuf:"bytes,2,opt,name=Economics,proto3" json:"Economics"`
+	ParamChanges         []GovernanceParamChange `protobuf:"bytes,3,rep,name=ParamChanges,proto3" json:"ParamChanges"`
 }
 
 func (m *EpochStart) Reset()      { *m = EpochStart{} }
 func (*EpochStart) ProtoMessage() {}
 func (*EpochStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{4}
+	return fileDescriptor_87b91ab531130b2b, []int{5}
 }
 func (m *EpochStart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,6 +608,13 @@ func (m *EpochStart) GetEconomics() Economics {
 	return Economics{}
 }
 
+func (m *EpochStart) GetParamChanges() []GovernanceParamChange {
+	if m != nil {
+		return m.ParamChanges
+	}
+	return nil
+}
+
 // MetaBlock holds the data that will be saved to the metachain each round
 type MetaBlock struct {
 	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
@@ -567,7 +647,7 @@ type MetaBlock struct {
 func (m *MetaBlock) Reset()      { *m = MetaBlock{} }
 func (*MetaBlock) ProtoMessage() {}
 func (*MetaBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87b91ab531130b2b, []int{5}
+	return fileDescriptor_87b91ab531130b2b, []int{6}
 }
 func (m *MetaBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,6 +853,7 @@ func init() {
 	proto.RegisterType((*ShardData)(nil), "proto.ShardData")
 	proto.RegisterType((*EpochStartShardData)(nil), "proto.EpochStartShardData")
 	proto.RegisterType((*Economics)(nil), "proto.Economics")
+	proto.RegisterType((*GovernanceParamChange)(nil), "proto.GovernanceParamChange")
 	proto.RegisterType((*EpochStart)(nil), "proto.EpochStart")
 	proto.RegisterType((*MetaBlock)(nil), "proto.MetaBlock")
 }
@@ -780,86 +861,92 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xcb, 0xb6, 0x56, 0x92, 0x4d, 0x6f, 0x6c, 0x87, 0x35, 0x02, 0x46, 0x10, 0x7a,
+	0x50, 0x0b, 0xc4, 0x6e, 0xdd, 0xa0, 0x3d, 0xf4, 0x50, 0xf8, 0xb7, 0x51, 0x93, 0x18, 0x02, 0xe5,
+	0xfa, 0xd0, 0xdb, 0x8a, 0x9c, 0x48, 0x0b, 0x53, 0x5c, 0x95, 0x5c, 0xca, 0x75, 0x81, 0x02, 0x7d,
+	0x84, 0xf6, 0x19, 0xda, 0x43, 0xd0, 0xbe, 0x48, 0x8e, 0x39, 0xe6, 0xd4, 0x24, 0xf2, 0xa5, 0xc7,
+	0x14, 0xe8, 0x03, 0x14, 0xbb, 0x4b, 0x8a, 0x14, 0x45, 0x37, 0x39, 0x28, 0x27, 0x6b, 0x66, 0x76,
+	0x66, 0xbc, 0xb3, 0x33, 0xdf, 0x7c, 0x44, 0x6b, 0x03, 0xe0, 0xe4, 0xc0, 0x65, 0xf6, 0xc5, 0xce,
+	0xd0, 0x67, 0x9c, 0xe1, 0x92, 0xfc, 0xb3, 0x7d, 0xaf, 0x47, 0x79, 0x3f, 0xec, 0xee, 0xd8, 0x6c,
+	0xb0, 0xdb, 0x63, 0x3d, 0xb6, 0x2b, 0xd5, 0xdd, 0xf0, 0x89, 0x94, 0xa4, 0x20, 0x7f, 0x29, 0xaf,
+	0xed, 0x4a, 0x37, 0x09, 0xd1, 0xf8, 0x57, 0x43, 0x2b, 0x6d, 0x00, 0xff, 0x88, 0x70, 0x82, 0x0d,
+	0xb4, 0xbc, 0xef, 0x38, 0x3e, 0x04, 0x81, 0xa1, 0xd5, 0xb5, 0x66, 0xd5, 0x8a, 0x45, 0x7c, 0x07,
+	0x95, 0xdb, 0x61, 0xd7, 0xa5, 0xf6, 0x43, 0xb8, 0x32, 0x16, 0xa4, 0x2d, 0x51, 0xe0, 0x8f, 0xd0,
+	0xd2, 0xbe, 0xcd, 0x29, 0xf3, 0x8c, 0x62, 0x5d, 0x6b, 0xae, 0xee, 0xad, 0xab, 0xe0, 0x3b, 0x22,
+	0xb0, 0x32, 0x58, 0xd1, 0x01, 0x11, 0xe8, 0x8c, 0x0e, 0xa0, 0xc3, 0xc9, 0x60, 0x68, 0x2c, 0xd6,
+	0xb5, 0xe6, 0xa2, 0x95, 0x28, 0x70, 0x0f, 0x55, 0xce, 0x89, 0x1b, 0xc2, 0x61, 0x9f, 0x78, 0x3d,
+	0x30, 0x4a, 0x22, 0xd1, 0xc1, 0xf1, 0x1f, 0x2f, 0xef, 0xee, 0x0f, 0x08, 0xef, 0xef, 0x76, 0x69,
+	0x6f, 0xa7, 0xe5, 0xf1, 0x2f, 0x53, 0xf7, 0x3d, 0x76, 0x7d, 0xe6, 0x39, 0xa7, 0xc0, 0x2f, 0x99,
+	0x7f, 0xb1, 0x0b, 0x52, 0xba, 0xd7, 0x63, 0xbb, 0x0e, 0xe1, 0x64, 0xe7, 0x80, 0xf6, 0x5a, 0x1e,
+	0x3f, 0x24, 0x01, 0x07, 0xdf, 0x4a, 0x47, 0x6e, 0xfc, 0x59, 0x42, 0xe5, 0x4e, 0x9f, 0xf8, 0x8e,
+	0xbc, 0xb7, 0x89, 0xd0, 0x03, 0x20, 0x0e, 0xf8, 0x0f, 0x48, 0xd0, 0x8f, 0xae, 0x97, 0xd2, 0x60,
+	0x0b, 0x6d, 0xca, 0xc3, 0x8f, 0xa9, 0x47, 0x65, 0xfd, 0x95, 0x2d, 0x30, 0x8a, 0xf5, 0x62, 0xb3,
+	0xb2, 0xb7, 0x15, 0x5d, 0x37, 0x63, 0x3e, 0x58, 0x7c, 0xf6, 0xd7, 0xdd, 0x82, 0x95, 0xef, 0x8a,
+	0x1b, 0xa8, 0xda, 0xf6, 0x61, 0x64, 0x11, 0xcf, 0xe9, 0x00, 0x38, 0xb2, 0x16, 0x55, 0x6b, 0x4a,
+	0x87, 0x3f, 0x44, 0xb5, 0x76, 0xd8, 0x7d, 0x08, 0x57, 0xc1, 0x01, 0xe5, 0x03, 0x32, 0x54, 0x05,
+	0xb1, 0xa6, 0x95, 0xa2, 0xa4, 0x1d, 0xda, 0xf3, 0x08, 0x0f, 0x7d, 0x30, 0x96, 0xd4, 0xdb, 0x4c,
+	0x14, 0x78, 0x03, 0x95, 0x2c, 0x16, 0x7a, 0x8e, 0xb1, 0x22, 0x8b, 0xad, 0x04, 0xbc, 0x8d, 0x56,
+	0x44, 0x26, 0x79, 0xdf, 0xb2, 0x74, 0x99, 0xc8, 0xc2, 0xe3, 0x94, 0x79, 0x36, 0x18, 0x48, 0x79,
+	0x48, 0x01, 0x33, 0xb4, 0xb6, 0x6f, 0xdb, 0xe1, 0x20, 0x74, 0x09, 0x07, 0xe7, 0x04, 0x20, 0x30,
+	0xaa, 0xf3, 0x7c, 0x9e, 0x6c, 0x74, 0x7c, 0x81, 0x6a, 0x47, 0x30, 0x02, 0x97, 0x0d, 0xc1, 0x97,
+	0xe9, 0x56, 0xe7, 0x99, 0x6e, 0x3a, 0x36, 0xde, 0x43, 0x1b, 0xa7, 0xe1, 0xa0, 0x0d, 0x9e, 0x43,
+	0xbd, 0xde, 0xe4, 0xad, 0x02, 0xa3, 0x52, 0xd7, 0x9a, 0x35, 0x2b, 0xd7, 0x86, 0xef, 0xa3, 0xcd,
+	0x47, 0x24, 0xe0, 0x2d, 0xcf, 0x76, 0x43, 0x07, 0x9c, 0xc7, 0xc0, 0x89, 0xaa, 0x5b, 0x4d, 0xd6,
+	0x2d, 0xdf, 0x28, 0x66, 0x4c, 0x36, 0x44, 0xeb, 0x48, 0xce, 0x58, 0xcd, 0x8a, 0x45, 0x61, 0x39,
+	0xfb, 0xe1, 0x90, 0x85, 0x1e, 0x37, 0x96, 0x95, 0x25, 0x12, 0x1b, 0xff, 0x2c, 0xa0, 0x5b, 0xc7,
+	0x43, 0x66, 0xf7, 0x3b, 0x9c, 0xf8, 0x3c, 0xe9, 0xdb, 0x9b, 0x63, 0x6d, 0xa0, 0x92, 0x74, 0x90,
+	0x8f, 0x5b, 0xb3, 0x94, 0x90, 0xf4, 0xc2, 0x72, 0xba, 0x17, 0x26, 0xef, 0xbd, 0x92, 0x7e, 0xef,
+	0xb7, 0xcd, 0xc4, 0x36, 0x5a, 0xb1, 0x18, 0xe3, 0xd2, 0x5a, 0x54, 0x1d, 0x14, 0xcb, 0xa2, 0x32,
+	0x27, 0xd4, 0x0f, 0x78, 0x5c, 0xb3, 0x18, 0xb6, 0xa2, 0x26, 0xcf, 0x37, 0xc6, 0xf5, 0x3c, 0xa1,
+	0x1e, 0x0d, 0xfa, 0xe0, 0x4c, 0x0c, 0x51, 0xd7, 0xe7, 0x1b, 0xf1, 0x39, 0xba, 0x9d, 0x7d, 0x9a,
+	0x78, 0x3a, 0x97, 0xde, 0x61, 0x3a, 0x6f, 0x72, 0x6e, 0x3c, 0x5d, 0x42, 0xe5, 0x63, 0x9b, 0x79,
+	0x6c, 0x40, 0xed, 0x40, 0x00, 0xd3, 0x19, 0xe3, 0xc4, 0xed, 0x84, 0xc3, 0xa1, 0x7b, 0x65, 0x68,
+	0xf3, 0x6c, 0xc5, 0x74, 0x64, 0x1c, 0xa0, 0x75, 0x29, 0x9e, 0xb1, 0x23, 0x1a, 0x70, 0x9f, 0x76,
+	0x43, 0x0e, 0xc6, 0xc2, 0x3c, 0xd3, 0xcd, 0xc6, 0xc7, 0xdf, 0x23, 0x5d, 0x2a, 0x4f, 0xe1, 0xd2,
+	0xbd, 0x7a, 0x4c, 0x3d, 0x0e, 0x8e, 0x51, 0x9c, 0x67, 0xce, 0x99, 0xf0, 0x02, 0x4e, 0x2c, 0xb8,
+	0x24, 0xbe, 0x13, 0xb4, 0xc1, 0x4f, 0x35, 0xc7, 0xdc, 0xe0, 0x24, 0x13, 0x1d, 0xff, 0xaa, 0xa1,
+	0x7a, 0xa4, 0x3b, 0x61, 0x7e, 0x5b, 0xb4, 0x84, 0xcd, 0xdc, 0x4e, 0x18, 0x70, 0x42, 0x3d, 0xd2,
+	0xa5, 0x2e, 0xe5, 0x57, 0xf3, 0x5d, 0x38, 0x6f, 0x4d, 0x87, 0x6d, 0x54, 0x3e, 0x65, 0x0e, 0xb4,
+	0x7d, 0x6a, 0x47, 0xc8, 0x3d, 0xaf, 0xdc, 0x49, 0x5c, 0xfc, 0x09, 0xba, 0x25, 0xa0, 0x3d, 0xc1,
+	0x8f, 0x34, 0x04, 0xe4, 0x99, 0xf0, 0x0e, 0xc2, 0xd3, 0x6a, 0x39, 0xe4, 0x2b, 0x72, 0x0a, 0x73,
+	0x2c, 0x8d, 0xdf, 0x34, 0xb4, 0xf9, 0x35, 0x1b, 0x81, 0xef, 0x11, 0xcf, 0x86, 0x36, 0xf1, 0xc9,
+	0x40, 0xad, 0x59, 0xb5, 0xe4, 0xd8, 0x90, 0x05, 0xc4, 0x95, 0x31, 0xb4, 0x78, 0xc9, 0x25, 0x3a,
+	0x8c, 0xd1, 0xe2, 0xd9, 0xd5, 0x50, 0x35, 0x79, 0xd9, 0x92, 0xbf, 0x85, 0xee, 0x94, 0x0c, 0x40,
+	0x36, 0x61, 0xd9, 0x92, 0xbf, 0x05, 0x4c, 0xc9, 0x0d, 0x2e, 0xfb, 0xa4, 0x6c, 0x29, 0x01, 0x37,
+	0xc5, 0x5a, 0xe2, 0x74, 0x44, 0x04, 0xbb, 0x50, 0x90, 0x57, 0x92, 0x90, 0x97, 0x55, 0x37, 0x5e,
+	0x69, 0x08, 0x25, 0xff, 0x38, 0x3e, 0x43, 0x1b, 0x11, 0xa0, 0x10, 0x97, 0xfe, 0x08, 0x4e, 0x0c,
+	0x1a, 0x9a, 0x04, 0x8d, 0xed, 0x08, 0x34, 0x72, 0x50, 0x37, 0x02, 0x8e, 0x5c, 0x6f, 0x7c, 0x3f,
+	0x05, 0x1a, 0xf2, 0x46, 0x95, 0x3d, 0x3d, 0x0e, 0x15, 0xeb, 0xa3, 0x00, 0xc9, 0x41, 0x7c, 0x82,
+	0xaa, 0xa9, 0xaa, 0xc5, 0xb4, 0xe2, 0x4e, 0xe4, 0x98, 0x5b, 0xda, 0x28, 0xc8, 0x94, 0x5f, 0xe3,
+	0x65, 0x19, 0x95, 0x13, 0x64, 0x9c, 0xe0, 0xba, 0x96, 0xc6, 0xf5, 0xc9, 0x66, 0x58, 0xc8, 0xdd,
+	0x0c, 0xc5, 0xf4, 0x66, 0xf8, 0x7f, 0xb2, 0x76, 0x3f, 0xa2, 0x50, 0x2d, 0xef, 0x09, 0x33, 0x4a,
+	0xf5, 0x62, 0xea, 0xae, 0xd9, 0x62, 0x25, 0x07, 0xf1, 0xa7, 0x8a, 0x6f, 0x4a, 0x27, 0x05, 0xd0,
+	0x6b, 0x29, 0xb6, 0x98, 0xf2, 0x99, 0x1c, 0x9b, 0x26, 0x38, 0xcb, 0x59, 0x82, 0xd3, 0x44, 0x6b,
+	0x8f, 0x64, 0xf5, 0x93, 0x33, 0xaa, 0x55, 0xb3, 0xea, 0x59, 0x3a, 0x55, 0xce, 0xa3, 0x53, 0x69,
+	0x6a, 0x84, 0x32, 0xd4, 0x28, 0x4b, 0xda, 0x2a, 0x39, 0xa4, 0x4d, 0x2c, 0xc6, 0xd8, 0x5e, 0x8d,
+	0x16, 0x63, 0xda, 0x16, 0x2f, 0xcd, 0x5a, 0x66, 0x69, 0x7e, 0x8e, 0xb6, 0xce, 0x89, 0x4b, 0x1d,
+	0xc2, 0x99, 0xdf, 0xe1, 0x84, 0x07, 0x93, 0x93, 0x92, 0xf8, 0x58, 0x37, 0x58, 0xf1, 0x03, 0xa4,
+	0xcf, 0x6c, 0x3e, 0xfd, 0x1d, 0x36, 0x9f, 0x9e, 0x47, 0x49, 0x2d, 0xb0, 0x81, 0x0e, 0x79, 0x20,
+	0xf3, 0xae, 0xab, 0xdb, 0xa5, 0x75, 0xf8, 0x8b, 0xf4, 0x10, 0x19, 0x58, 0x76, 0xf8, 0xfa, 0xcc,
+	0xb0, 0x44, 0x29, 0xd2, 0xf3, 0x66, 0xa0, 0xe5, 0xc3, 0x3e, 0xa1, 0x5e, 0xeb, 0xc8, 0xb8, 0xa5,
+	0xbe, 0x2d, 0x22, 0x51, 0x3c, 0x60, 0x87, 0x3d, 0xe1, 0x97, 0xc4, 0x87, 0x73, 0xf0, 0x03, 0xf1,
+	0x19, 0xb1, 0xa1, 0x1e, 0x30, 0xa3, 0xce, 0xe3, 0xa0, 0x9b, 0xef, 0x95, 0x83, 0xfe, 0x84, 0xb6,
+	0x32, 0xaa, 0x56, 0x04, 0x32, 0x5b, 0xf3, 0xcc, 0x7b, 0x43, 0x92, 0x59, 0x0a, 0x7c, 0xfb, 0x3d,
+	0x52, 0xe0, 0x01, 0x5a, 0x3d, 0x82, 0x51, 0xfa, 0x8e, 0xc6, 0x3c, 0xb3, 0x65, 0x82, 0xa7, 0xd9,
+	0xee, 0x07, 0x53, 0x6c, 0x57, 0x0e, 0x09, 0x04, 0xe0, 0x8f, 0xc0, 0x31, 0xb6, 0xa3, 0x21, 0x89,
+	0xe4, 0x8f, 0x7f, 0xd7, 0x10, 0x4a, 0xbe, 0x2a, 0xf1, 0x3a, 0xaa, 0xb5, 0xbc, 0x91, 0x98, 0x0b,
+	0xa5, 0xd0, 0x0b, 0x78, 0x03, 0xe9, 0xe2, 0x80, 0x05, 0x3d, 0xc1, 0x6f, 0x24, 0xfe, 0xeb, 0x9a,
+	0x38, 0x28, 0xb4, 0xdf, 0x7a, 0x01, 0x27, 0x17, 0xd4, 0xeb, 0xe9, 0x0b, 0x78, 0x0b, 0x61, 0x89,
+	0x38, 0xe0, 0xa7, 0x8f, 0x16, 0xf1, 0xaa, 0xca, 0xf0, 0x0d, 0xa1, 0x2e, 0x38, 0xfa, 0x22, 0xd6,
+	0x51, 0x55, 0xb9, 0x46, 0x9a, 0x12, 0x5e, 0x43, 0x15, 0xa1, 0xe9, 0xb8, 0x44, 0x50, 0x51, 0x7d,
+	0x29, 0x56, 0x58, 0x02, 0x18, 0x2f, 0x40, 0x5f, 0x3e, 0xf8, 0xea, 0xf9, 0x6b, 0xb3, 0xf0, 0xe2,
+	0xb5, 0x59, 0x78, 0xf3, 0xda, 0xd4, 0x7e, 0x1e, 0x9b, 0xda, 0xd3, 0xb1, 0xa9, 0x3d, 0x1b, 0x9b,
+	0xda, 0xf3, 0xb1, 0xa9, 0xbd, 0x18, 0x9b, 0xda, 0xab, 0xb1, 0xa9, 0xfd, 0x3d, 0x36, 0x0b, 0x6f,
+	0xc6, 0xa6, 0xf6, 0xcb, 0xb5, 0x59, 0x78, 0x7e, 0x6d, 0x16, 0x5e, 0x5c, 0x9b, 0x85, 0xef, 0x4a,
+	0xf2, 0xe3, 0xbc, 0xbb, 0x24, 0x27, 0xea, 0xb3, 0xff, 0x06, 0x00, 0x0c, 0x3f, 0x43, 0x6c, 0xf3,
+	0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -1098,6 +1185,42 @@ func (this *Economics) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GovernanceParamChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GovernanceParamChange)
+	if !ok {
+		that2, ok := that.(GovernanceParamChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ProposalHash, that1.ProposalHash) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.ActivationEpoch != that1.ActivationEpoch {
+		return false
+	}
+	return true
+}
 func (this *EpochStart) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1128,6 +1251,14 @@ func (this *EpochStart) Equal(that interface{}) bool {
 	if !this.Economics.Equal(&that1.Economics) {
 		return false
 	}
+	if len(this.ParamChanges) != len(that1.ParamChanges) {
+		return false
+	}
+	for i := range this.ParamChanges {
+		if !this.ParamChanges[i].Equal(&that1.ParamChanges[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *MetaBlock) Equal(that interface{}) bool {
@@ -1337,11 +1468,25 @@ func (this *Economics) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *GovernanceParamChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&block.GovernanceParamChange{")
+	s = append(s, "ProposalHash: "+fmt.Sprintf("%#v", this.ProposalHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "ActivationEpoch: "+fmt.Sprintf("%#v", this.ActivationEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *EpochStart) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&block.EpochStart{")
 	if this.LastFinalizedHeaders != nil {
 		vs := make([]EpochStartShardData, len(this.LastFinalizedHeaders))
@@ -1351,6 +1496,13 @@ func (this *EpochStart) GoString() string {
 		s = append(s, "LastFinalizedHeaders: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
 	s = append(s, "Economics: "+strings.Replace(this.Economics.GoString(), `&`, ``, 1)+",\n")
+	if this.ParamChanges != nil {
+		vs := make([]GovernanceParamChange, len(this.ParamChanges))
+		for i := range vs {
+			vs[i] = this.ParamChanges[i]
+		}
+		s = append(s, "ParamChanges: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1782,6 +1934,62 @@ func (m *Economics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GovernanceParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintMetaBlock(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposalHash) > 0 {
+		i -= len(m.ProposalHash)
+		copy(dAtA[i:], m.ProposalHash)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.ProposalHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EpochStart) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1802,6 +2010,20 @@ func (m *EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParamChanges) > 0 {
+		for iNdEx := len(m.ParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMetaBlock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Economics.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2270,6 +2492,34 @@ func (m *Economics) Size() (n int) {
 	return n
 }
 
+func (m *GovernanceParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalHash)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovMetaBlock(uint64(m.ActivationEpoch))
+	}
+	return n
+}
+
 func (m *EpochStart) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2284,6 +2534,12 @@ func (m *EpochStart) Size() (n int) {
 	}
 	l = m.Economics.Size()
 	n += 1 + l + sovMetaBlock(uint64(l))
+	if len(m.ParamChanges) > 0 {
+		for _, e := range m.ParamChanges {
+			l = e.Size()
+			n += 1 + l + sovMetaBlock(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2487,6 +2743,20 @@ func (this *Economics) String() string {
 	}, "")
 	return s
 }
+func (this *GovernanceParamChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GovernanceParamChange{`,
+		`ProposalHash:` + fmt.Sprintf("%v", this.ProposalHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ActivationEpoch:` + fmt.Sprintf("%v", this.ActivationEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EpochStart) String() string {
 	if this == nil {
 		return "nil"
@@ -2496,9 +2766,15 @@ func (this *EpochStart) String() string {
 		repeatedStringForLastFinalizedHeaders += strings.Replace(strings.Replace(f.String(), "EpochStartShardData", "EpochStartShardData", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForLastFinalizedHeaders += "}"
+	repeatedStringForParamChanges := "[]GovernanceParamChange{"
+	for _, f := range this.ParamChanges {
+		repeatedStringForParamChanges += strings.Replace(strings.Replace(f.String(), "GovernanceParamChange", "GovernanceParamChange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParamChanges += "}"
 	s := strings.Join([]string{`&EpochStart{`,
 		`LastFinalizedHeaders:` + repeatedStringForLastFinalizedHeaders + `,`,
 		`Economics:` + strings.Replace(strings.Replace(this.Economics.String(), "Economics", "Economics", 1), `&`, ``, 1) + `,`,
+		`ParamChanges:` + repeatedStringForParamChanges + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3837,6 +4113,208 @@ func (m *Economics) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GovernanceParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetaBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalHash = append(m.ProposalHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalHash == nil {
+				m.ProposalHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetaBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EpochStart) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3933,6 +4411,40 @@ func (m *EpochStart) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamChanges = append(m.ParamChanges, GovernanceParamChange{})
+			if err := m.ParamChanges[len(m.ParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
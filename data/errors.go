@@ -46,6 +46,12 @@ var ErrNilValue = errors.New("nil value")
 // ErrNilSignature signals that a operation has been attempted with a nil signature
 var ErrNilSignature = errors.New("nil signature")
 
+// ErrNilRelayerSignature signals that a relayed transaction has been provided without the relayer's signature
+var ErrNilRelayerSignature = errors.New("nil relayer signature")
+
+// ErrNilRelayerAddress signals that a relayer signature has been provided without the relayer address
+var ErrNilRelayerAddress = errors.New("nil relayer address")
+
 // ErrNegativeValue signals that a negative value has been detected and it is not allowed
 var ErrNegativeValue = errors.New("negative value")
 
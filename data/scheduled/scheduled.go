@@ -0,0 +1,14 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. scheduled.proto
+package scheduled
+
+import (
+	"math/big"
+)
+
+// NewEmptyGasAndFees returns a gas and fees structure with all values set to zero
+func NewEmptyGasAndFees() GasAndFees {
+	return GasAndFees{
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+}
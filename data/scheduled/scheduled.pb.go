@@ -0,0 +1,1386 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduled.proto
+
+package scheduled
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasAndFees holds the gas and fees accounted while executing the scheduled transactions
+type GasAndFees struct {
+	AccumulatedFees *math_big.Int `protobuf:"bytes,1,opt,name=AccumulatedFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"accumulatedFees"`
+	DeveloperFees   *math_big.Int `protobuf:"bytes,2,opt,name=DeveloperFees,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"developerFees"`
+	GasProvided     uint64        `protobuf:"varint,3,opt,name=GasProvided,proto3" json:"gasProvided"`
+	GasRefunded     uint64        `protobuf:"varint,4,opt,name=GasRefunded,proto3" json:"gasRefunded"`
+}
+
+func (m *GasAndFees) Reset()      { *m = GasAndFees{} }
+func (*GasAndFees) ProtoMessage() {}
+func (*GasAndFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80076f37bd30c16, []int{0}
+}
+func (m *GasAndFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasAndFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GasAndFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasAndFees.Merge(m, src)
+}
+func (m *GasAndFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasAndFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasAndFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasAndFees proto.InternalMessageInfo
+
+func (m *GasAndFees) GetAccumulatedFees() *math_big.Int {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return nil
+}
+
+func (m *GasAndFees) GetDeveloperFees() *math_big.Int {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return nil
+}
+
+func (m *GasAndFees) GetGasProvided() uint64 {
+	if m != nil {
+		return m.GasProvided
+	}
+	return 0
+}
+
+func (m *GasAndFees) GetGasRefunded() uint64 {
+	if m != nil {
+		return m.GasRefunded
+	}
+	return 0
+}
+
+// ScheduledLog holds a marshalized log generated by a scheduled transaction
+type ScheduledLog struct {
+	TxHash []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"txHash"`
+	Log    []byte `protobuf:"bytes,2,opt,name=Log,proto3" json:"log"`
+}
+
+func (m *ScheduledLog) Reset()      { *m = ScheduledLog{} }
+func (*ScheduledLog) ProtoMessage() {}
+func (*ScheduledLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80076f37bd30c16, []int{1}
+}
+func (m *ScheduledLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledLog.Merge(m, src)
+}
+func (m *ScheduledLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledLog proto.InternalMessageInfo
+
+func (m *ScheduledLog) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ScheduledLog) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// ScheduledSCRs holds the results of the scheduled transactions executed after a block was committed
+type ScheduledSCRs struct {
+	RootHash   []byte          `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"rootHash"`
+	Scrs       [][]byte        `protobuf:"bytes,2,rep,name=Scrs,proto3" json:"scrs"`
+	GasAndFees GasAndFees      `protobuf:"bytes,3,opt,name=GasAndFees,proto3" json:"gasAndFees"`
+	Receipts   [][]byte        `protobuf:"bytes,4,rep,name=Receipts,proto3" json:"receipts"`
+	InvalidTxs [][]byte        `protobuf:"bytes,5,rep,name=InvalidTxs,proto3" json:"invalidTxs"`
+	Logs       []*ScheduledLog `protobuf:"bytes,6,rep,name=Logs,proto3" json:"logs"`
+}
+
+func (m *ScheduledSCRs) Reset()      { *m = ScheduledSCRs{} }
+func (*ScheduledSCRs) ProtoMessage() {}
+func (*ScheduledSCRs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80076f37bd30c16, []int{2}
+}
+func (m *ScheduledSCRs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledSCRs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledSCRs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledSCRs.Merge(m, src)
+}
+func (m *ScheduledSCRs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledSCRs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledSCRs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledSCRs proto.InternalMessageInfo
+
+func (m *ScheduledSCRs) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *ScheduledSCRs) GetScrs() [][]byte {
+	if m != nil {
+		return m.Scrs
+	}
+	return nil
+}
+
+func (m *ScheduledSCRs) GetGasAndFees() GasAndFees {
+	if m != nil {
+		return m.GasAndFees
+	}
+	return GasAndFees{}
+}
+
+func (m *ScheduledSCRs) GetReceipts() [][]byte {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *ScheduledSCRs) GetInvalidTxs() [][]byte {
+	if m != nil {
+		return m.InvalidTxs
+	}
+	return nil
+}
+
+func (m *ScheduledSCRs) GetLogs() []*ScheduledLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GasAndFees)(nil), "proto.GasAndFees")
+	proto.RegisterType((*ScheduledLog)(nil), "proto.ScheduledLog")
+	proto.RegisterType((*ScheduledSCRs)(nil), "proto.ScheduledSCRs")
+}
+
+func init() { proto.RegisterFile("scheduled.proto", fileDescriptor_f80076f37bd30c16) }
+
+var fileDescriptor_f80076f37bd30c16 = []byte{
+	// 521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8f, 0xd2, 0x4e,
+	0x18, 0xc7, 0x3b, 0xb4, 0xcb, 0x8f, 0xdf, 0x00, 0x12, 0xc7, 0x0b, 0x1a, 0x33, 0x25, 0x9c, 0xb8,
+	0x6c, 0xc9, 0xae, 0x47, 0x4f, 0x14, 0xd7, 0x95, 0x04, 0x8d, 0x19, 0x36, 0xc6, 0x78, 0x2b, 0xed,
+	0xec, 0xd0, 0x58, 0x3a, 0xa4, 0x33, 0xc5, 0x3d, 0x78, 0x30, 0xbe, 0x02, 0x5f, 0x86, 0xd1, 0x37,
+	0xb2, 0x47, 0x8e, 0x9c, 0xaa, 0x94, 0x8b, 0xe9, 0x69, 0x2f, 0xde, 0x0d, 0x43, 0xb7, 0x5b, 0x3c,
+	0x7b, 0x82, 0xe7, 0xf3, 0x7d, 0xfe, 0xf5, 0xdb, 0xa7, 0xb0, 0x25, 0xdc, 0x19, 0xf5, 0xe2, 0x80,
+	0x7a, 0xd6, 0x22, 0xe2, 0x92, 0xa3, 0x23, 0xf5, 0xf3, 0xe8, 0x98, 0xf9, 0x72, 0x16, 0x4f, 0x2d,
+	0x97, 0xcf, 0xfb, 0x8c, 0x33, 0xde, 0x57, 0x78, 0x1a, 0x5f, 0xaa, 0x48, 0x05, 0xea, 0xdf, 0xbe,
+	0xaa, 0xfb, 0xbb, 0x02, 0xe1, 0xb9, 0x23, 0x06, 0xa1, 0xf7, 0x9c, 0x52, 0x81, 0x3e, 0x03, 0xd8,
+	0x1a, 0xb8, 0x6e, 0x3c, 0x8f, 0x03, 0x47, 0x52, 0xc5, 0xda, 0xa0, 0x03, 0x7a, 0x0d, 0xfb, 0x6d,
+	0x96, 0x98, 0x2d, 0xe7, 0x50, 0xfa, 0xf6, 0xc3, 0x1c, 0xcc, 0x1d, 0x39, 0xeb, 0x4f, 0x7d, 0x66,
+	0x8d, 0x42, 0xf9, 0xb4, 0x34, 0xfb, 0x2c, 0x88, 0x78, 0xe8, 0xbd, 0xa2, 0xf2, 0x03, 0x8f, 0xde,
+	0xf7, 0xa9, 0x8a, 0x8e, 0x19, 0xef, 0x7b, 0x8e, 0x74, 0x2c, 0xdb, 0x67, 0xa3, 0x50, 0x0e, 0x1d,
+	0x21, 0x69, 0x44, 0xfe, 0x1e, 0x88, 0x3e, 0xc2, 0xe6, 0x33, 0xba, 0xa4, 0x01, 0x5f, 0xd0, 0x48,
+	0x6d, 0x50, 0x51, 0x1b, 0xbc, 0xc9, 0x12, 0xb3, 0xe9, 0x95, 0x85, 0x7f, 0x33, 0xff, 0x70, 0x18,
+	0x3a, 0x81, 0xf5, 0x73, 0x47, 0xbc, 0x8e, 0xf8, 0xd2, 0xf7, 0xa8, 0xd7, 0xd6, 0x3b, 0xa0, 0x67,
+	0xd8, 0xad, 0x2c, 0x31, 0xeb, 0xec, 0x0e, 0x93, 0x72, 0x4e, 0x5e, 0x42, 0xe8, 0x65, 0x1c, 0xee,
+	0x4a, 0x8c, 0x83, 0x92, 0x5b, 0x4c, 0xca, 0x39, 0xdd, 0x97, 0xb0, 0x31, 0xb9, 0x7d, 0x81, 0x63,
+	0xce, 0x50, 0x17, 0x56, 0x2f, 0xae, 0x5e, 0x38, 0x62, 0x96, 0xdb, 0x0d, 0xb3, 0xc4, 0xac, 0x4a,
+	0x45, 0x48, 0xae, 0xa0, 0x87, 0x50, 0x1f, 0x73, 0x96, 0xbb, 0xf1, 0x5f, 0x96, 0x98, 0x7a, 0xc0,
+	0x19, 0xd9, 0xb1, 0xee, 0xf7, 0x0a, 0x6c, 0x16, 0xfd, 0x26, 0x43, 0x22, 0x50, 0x0f, 0xd6, 0x08,
+	0xe7, 0xb2, 0xd4, 0xb2, 0x91, 0x25, 0x66, 0x2d, 0xca, 0x19, 0x29, 0x54, 0xf4, 0x18, 0x1a, 0x13,
+	0x37, 0xda, 0xb9, 0xac, 0xf7, 0x1a, 0x76, 0x2d, 0x4b, 0x4c, 0x43, 0xb8, 0x91, 0x20, 0x8a, 0xa2,
+	0xb3, 0xf2, 0x7d, 0x28, 0x37, 0xea, 0xa7, 0xf7, 0xf7, 0xc7, 0x63, 0xdd, 0x09, 0x36, 0xba, 0x4e,
+	0x4c, 0x2d, 0x4b, 0x4c, 0xc8, 0x0a, 0x46, 0xca, 0x87, 0xb5, 0x5b, 0x87, 0xba, 0xd4, 0x5f, 0x48,
+	0xd1, 0x36, 0x3a, 0x7a, 0xb1, 0x4e, 0xce, 0x48, 0xa1, 0x22, 0x0b, 0xc2, 0x51, 0xb8, 0x74, 0x02,
+	0xdf, 0xbb, 0xb8, 0x12, 0xed, 0x23, 0x95, 0x7b, 0x6f, 0xd7, 0xd9, 0x2f, 0x28, 0x29, 0x65, 0xa0,
+	0x13, 0x68, 0x8c, 0x39, 0x13, 0xed, 0x6a, 0x47, 0xef, 0xd5, 0x4f, 0x1f, 0xe4, 0xab, 0x95, 0xcd,
+	0xdd, 0x3f, 0x53, 0xc0, 0x99, 0x20, 0x2a, 0xd5, 0x1e, 0xae, 0x36, 0x58, 0x5b, 0x6f, 0xb0, 0x76,
+	0xb3, 0xc1, 0xe0, 0x53, 0x8a, 0xc1, 0xd7, 0x14, 0x83, 0xeb, 0x14, 0x83, 0x55, 0x8a, 0xc1, 0x3a,
+	0xc5, 0xe0, 0x67, 0x8a, 0xc1, 0xaf, 0x14, 0x6b, 0x37, 0x29, 0x06, 0x5f, 0xb6, 0x58, 0x5b, 0x6d,
+	0xb1, 0xb6, 0xde, 0x62, 0xed, 0xdd, 0xff, 0xc5, 0x57, 0x37, 0xad, 0xaa, 0x41, 0x4f, 0xfe, 0x0c,
+	0x00, 0x36, 0xf1, 0x57, 0x6f, 0x89, 0x03, 0x00, 0x00,
+}
+
+func (this *GasAndFees) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasAndFees)
+	if !ok {
+		that2, ok := that.(GasAndFees)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.AccumulatedFees, that1.AccumulatedFees) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.DeveloperFees, that1.DeveloperFees) {
+			return false
+		}
+	}
+	if this.GasProvided != that1.GasProvided {
+		return false
+	}
+	if this.GasRefunded != that1.GasRefunded {
+		return false
+	}
+	return true
+}
+func (this *ScheduledLog) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledLog)
+	if !ok {
+		that2, ok := that.(ScheduledLog)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.Log, that1.Log) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledSCRs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledSCRs)
+	if !ok {
+		that2, ok := that.(ScheduledSCRs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if len(this.Scrs) != len(that1.Scrs) {
+		return false
+	}
+	for i := range this.Scrs {
+		if !bytes.Equal(this.Scrs[i], that1.Scrs[i]) {
+			return false
+		}
+	}
+	if !this.GasAndFees.Equal(&that1.GasAndFees) {
+		return false
+	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !bytes.Equal(this.Receipts[i], that1.Receipts[i]) {
+			return false
+		}
+	}
+	if len(this.InvalidTxs) != len(that1.InvalidTxs) {
+		return false
+	}
+	for i := range this.InvalidTxs {
+		if !bytes.Equal(this.InvalidTxs[i], that1.InvalidTxs[i]) {
+			return false
+		}
+	}
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GasAndFees) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&scheduled.GasAndFees{")
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "GasProvided: "+fmt.Sprintf("%#v", this.GasProvided)+",\n")
+	s = append(s, "GasRefunded: "+fmt.Sprintf("%#v", this.GasRefunded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledLog) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&scheduled.ScheduledLog{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Log: "+fmt.Sprintf("%#v", this.Log)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledSCRs) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&scheduled.ScheduledSCRs{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "Scrs: "+fmt.Sprintf("%#v", this.Scrs)+",\n")
+	s = append(s, "GasAndFees: "+strings.Replace(this.GasAndFees.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Receipts: "+fmt.Sprintf("%#v", this.Receipts)+",\n")
+	s = append(s, "InvalidTxs: "+fmt.Sprintf("%#v", this.InvalidTxs)+",\n")
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduled(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *GasAndFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasAndFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasAndFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasRefunded != 0 {
+		i = encodeVarintScheduled(dAtA, i, uint64(m.GasRefunded))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasProvided != 0 {
+		i = encodeVarintScheduled(dAtA, i, uint64(m.GasProvided))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.DeveloperFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.DeveloperFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduled(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.AccumulatedFees)
+		i -= size
+		if _, err := __caster.MarshalTo(m.AccumulatedFees, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScheduled(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintScheduled(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintScheduled(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledSCRs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledSCRs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledSCRs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduled(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.InvalidTxs) > 0 {
+		for iNdEx := len(m.InvalidTxs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InvalidTxs[iNdEx])
+			copy(dAtA[i:], m.InvalidTxs[iNdEx])
+			i = encodeVarintScheduled(dAtA, i, uint64(len(m.InvalidTxs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receipts[iNdEx])
+			copy(dAtA[i:], m.Receipts[iNdEx])
+			i = encodeVarintScheduled(dAtA, i, uint64(len(m.Receipts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.GasAndFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScheduled(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Scrs) > 0 {
+		for iNdEx := len(m.Scrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scrs[iNdEx])
+			copy(dAtA[i:], m.Scrs[iNdEx])
+			i = encodeVarintScheduled(dAtA, i, uint64(len(m.Scrs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintScheduled(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduled(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduled(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasAndFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.AccumulatedFees)
+		n += 1 + l + sovScheduled(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.DeveloperFees)
+		n += 1 + l + sovScheduled(uint64(l))
+	}
+	if m.GasProvided != 0 {
+		n += 1 + sovScheduled(uint64(m.GasProvided))
+	}
+	if m.GasRefunded != 0 {
+		n += 1 + sovScheduled(uint64(m.GasRefunded))
+	}
+	return n
+}
+
+func (m *ScheduledLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovScheduled(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovScheduled(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledSCRs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovScheduled(uint64(l))
+	}
+	if len(m.Scrs) > 0 {
+		for _, b := range m.Scrs {
+			l = len(b)
+			n += 1 + l + sovScheduled(uint64(l))
+		}
+	}
+	l = m.GasAndFees.Size()
+	n += 1 + l + sovScheduled(uint64(l))
+	if len(m.Receipts) > 0 {
+		for _, b := range m.Receipts {
+			l = len(b)
+			n += 1 + l + sovScheduled(uint64(l))
+		}
+	}
+	if len(m.InvalidTxs) > 0 {
+		for _, b := range m.InvalidTxs {
+			l = len(b)
+			n += 1 + l + sovScheduled(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovScheduled(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovScheduled(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduled(x uint64) (n int) {
+	return sovScheduled(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GasAndFees) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GasAndFees{`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`GasProvided:` + fmt.Sprintf("%v", this.GasProvided) + `,`,
+		`GasRefunded:` + fmt.Sprintf("%v", this.GasRefunded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledLog) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledLog{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Log:` + fmt.Sprintf("%v", this.Log) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledSCRs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLogs := "[]*ScheduledLog{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(f.String(), "ScheduledLog", "ScheduledLog", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	s := strings.Join([]string{`&ScheduledSCRs{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`Scrs:` + fmt.Sprintf("%v", this.Scrs) + `,`,
+		`GasAndFees:` + strings.Replace(strings.Replace(this.GasAndFees.String(), "GasAndFees", "GasAndFees", 1), `&`, ``, 1) + `,`,
+		`Receipts:` + fmt.Sprintf("%v", this.Receipts) + `,`,
+		`InvalidTxs:` + fmt.Sprintf("%v", this.InvalidTxs) + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduled(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GasAndFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduled
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasAndFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasAndFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.AccumulatedFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.DeveloperFees = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProvided", wireType)
+			}
+			m.GasProvided = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasProvided |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefunded", wireType)
+			}
+			m.GasRefunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRefunded |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduled(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduled
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = append(m.Log[:0], dAtA[iNdEx:postIndex]...)
+			if m.Log == nil {
+				m.Log = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduled(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledSCRs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduled
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledSCRs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledSCRs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scrs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scrs = append(m.Scrs, make([]byte, postIndex-iNdEx))
+			copy(m.Scrs[len(m.Scrs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAndFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasAndFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, make([]byte, postIndex-iNdEx))
+			copy(m.Receipts[len(m.Receipts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidTxs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidTxs = append(m.InvalidTxs, make([]byte, postIndex-iNdEx))
+			copy(m.InvalidTxs[len(m.InvalidTxs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &ScheduledLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduled(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduled
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduled(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduled
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduled
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduled
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduled
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduled
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduled        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduled          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduled = fmt.Errorf("proto: unexpected end of group")
+)
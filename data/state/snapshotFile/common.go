@@ -0,0 +1,73 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+)
+
+const snapshotFileVersion = 1
+
+const (
+	recordTypeHeader byte = 1
+	recordTypeChunk  byte = 2
+	recordTypeFooter byte = 3
+)
+
+const lenRecordPrefix = 5
+
+// maxRecordSize limits the memory allocated when reading a record from an untrusted file
+const maxRecordSize = 256 * 1024 * 1024
+
+// writeRecord writes a record as: type (1 byte), payload length (4 bytes, big endian), payload and the payload hash
+func writeRecord(writer io.Writer, hasher hashing.Hasher, recordType byte, payload []byte) error {
+	prefix := make([]byte, lenRecordPrefix)
+	prefix[0] = recordType
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+
+	_, err := writer.Write(prefix)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(payload)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(hasher.Compute(string(payload)))
+
+	return err
+}
+
+// readRecord reads and verifies the next record. It returns io.EOF if there are no more records
+func readRecord(reader io.Reader, hasher hashing.Hasher) (byte, []byte, error) {
+	prefix := make([]byte, lenRecordPrefix)
+	_, err := io.ReadFull(reader, prefix)
+	if err == io.EOF {
+		return 0, nil, io.EOF
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrTruncatedSnapshotFile, err)
+	}
+
+	recordType := prefix[0]
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxRecordSize {
+		return 0, nil, fmt.Errorf("%w: %d", ErrInvalidRecordSize, size)
+	}
+
+	buff := make([]byte, int(size)+hasher.Size())
+	_, err = io.ReadFull(reader, buff)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrTruncatedSnapshotFile, err)
+	}
+
+	payload := buff[:size]
+	if !bytes.Equal(hasher.Compute(string(payload)), buff[size:]) {
+		return 0, nil, ErrCorruptedRecord
+	}
+
+	return recordType, payload, nil
+}
@@ -0,0 +1,216 @@
+package snapshotFile
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var log = logger.GetOrCreate("data/state/snapshotfile")
+
+const tempFileSuffix = ".tmp"
+
+// ArgsEpochStartExporter is the argument DTO used to create a new epoch start snapshot file exporter
+type ArgsEpochStartExporter struct {
+	Marshalizer        marshal.Marshalizer
+	Hasher             hashing.Hasher
+	ShardCoordinator   sharding.Coordinator
+	UserAccounts       state.AccountsAdapter
+	PeerAccounts       state.AccountsAdapter
+	EpochStartNotifier EpochStartNotifier
+	ExportDirectory    string
+	NumNodesInChunk    int
+}
+
+type epochStartExporter struct {
+	exporter          *exporter
+	shardCoordinator  sharding.Coordinator
+	userAccounts      state.AccountsAdapter
+	peerAccounts      state.AccountsAdapter
+	exportDirectory   string
+	exportInProgress  atomic.Flag
+	mutLastEpoch      sync.Mutex
+	lastExportedEpoch uint32
+	hasExported       bool
+}
+
+// NewEpochStartExporter creates a component that writes a snapshot file of the state tries at each epoch start
+func NewEpochStartExporter(args ArgsEpochStartExporter) (*epochStartExporter, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, state.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.UserAccounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if args.ShardCoordinator.SelfId() == core.MetachainShardId && check.IfNil(args.PeerAccounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.EpochStartNotifier) {
+		return nil, ErrNilEpochStartNotifier
+	}
+	if len(args.ExportDirectory) == 0 {
+		return nil, ErrEmptyExportDirectory
+	}
+
+	exp, err := NewExporter(ArgsExporter{
+		Marshalizer:     args.Marshalizer,
+		Hasher:          args.Hasher,
+		NumNodesInChunk: args.NumNodesInChunk,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(args.ExportDirectory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	ese := &epochStartExporter{
+		exporter:         exp,
+		shardCoordinator: args.ShardCoordinator,
+		userAccounts:     args.UserAccounts,
+		peerAccounts:     args.PeerAccounts,
+		exportDirectory:  args.ExportDirectory,
+	}
+
+	subscribeHandler := notifier.NewHandlerForEpochStart(
+		func(_ data.HeaderHandler) {},
+		ese.epochStartPrepare,
+		core.StateSnapshotExportOrder)
+	args.EpochStartNotifier.RegisterHandler(subscribeHandler)
+
+	return ese, nil
+}
+
+// SnapshotFileName returns the name of the snapshot file written for the provided shard and epoch
+func SnapshotFileName(shardID uint32, epoch uint32) string {
+	return fmt.Sprintf("stateSnapshot_shard_%s_epoch_%d.snap", core.GetShardIDString(shardID), epoch)
+}
+
+func (ese *epochStartExporter) epochStartPrepare(hdr data.HeaderHandler) {
+	metaBlock, ok := hdr.(*block.MetaBlock)
+	if !ok {
+		return
+	}
+
+	tries, err := ese.triesToExport(metaBlock)
+	if err != nil {
+		log.Warn("state snapshot file export skipped", "epoch", metaBlock.Epoch, "error", err)
+		return
+	}
+
+	if !ese.shouldExport(metaBlock.Epoch) {
+		return
+	}
+	if !ese.exportInProgress.Set() {
+		go ese.export(metaBlock.Epoch, tries)
+		return
+	}
+
+	log.Debug("state snapshot file export already in progress, skipping", "epoch", metaBlock.Epoch)
+}
+
+func (ese *epochStartExporter) shouldExport(epoch uint32) bool {
+	ese.mutLastEpoch.Lock()
+	defer ese.mutLastEpoch.Unlock()
+
+	return !ese.hasExported || epoch > ese.lastExportedEpoch
+}
+
+func (ese *epochStartExporter) triesToExport(metaBlock *block.MetaBlock) ([]*TrieToExport, error) {
+	selfID := ese.shardCoordinator.SelfId()
+	if selfID == core.MetachainShardId {
+		return []*TrieToExport{
+			{
+				Identifier: factory.UserAccountTrie,
+				RootHash:   metaBlock.RootHash,
+				Accounts:   ese.userAccounts,
+			},
+			{
+				Identifier: factory.PeerAccountTrie,
+				RootHash:   metaBlock.ValidatorStatsRootHash,
+				Accounts:   ese.peerAccounts,
+			},
+		}, nil
+	}
+
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID == selfID {
+			return []*TrieToExport{
+				{
+					Identifier: factory.UserAccountTrie,
+					RootHash:   shardData.RootHash,
+					Accounts:   ese.userAccounts,
+				},
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no last finalized header for shard %d in epoch start metablock", selfID)
+}
+
+func (ese *epochStartExporter) export(epoch uint32, tries []*TrieToExport) {
+	defer ese.exportInProgress.Unset()
+
+	selfID := ese.shardCoordinator.SelfId()
+	filePath := filepath.Join(ese.exportDirectory, SnapshotFileName(selfID, epoch))
+	log.Debug("exporting state snapshot file", "epoch", epoch, "file", filePath)
+
+	err := ese.exportToFile(filePath, epoch, selfID, tries)
+	if err != nil {
+		log.Warn("state snapshot file export failed", "epoch", epoch, "error", err)
+		return
+	}
+
+	ese.mutLastEpoch.Lock()
+	ese.lastExportedEpoch = epoch
+	ese.hasExported = true
+	ese.mutLastEpoch.Unlock()
+
+	log.Info("state snapshot file exported", "epoch", epoch, "file", filePath)
+}
+
+func (ese *epochStartExporter) exportToFile(filePath string, epoch uint32, shardID uint32, tries []*TrieToExport) error {
+	tempFilePath := filePath + tempFileSuffix
+	file, err := os.Create(tempFilePath)
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	err = ese.exporter.Export(writer, epoch, shardID, tries)
+	if err == nil {
+		err = writer.Flush()
+	}
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	return os.Rename(tempFilePath, filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ese *epochStartExporter) IsInterfaceNil() bool {
+	return ese == nil
+}
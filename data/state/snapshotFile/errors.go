@@ -0,0 +1,42 @@
+package snapshotFile
+
+import "errors"
+
+// ErrInvalidNumNodesInChunk signals that an invalid number of nodes in a chunk was provided
+var ErrInvalidNumNodesInChunk = errors.New("invalid number of nodes in chunk")
+
+// ErrEmptyExportDirectory signals that an empty export directory was provided
+var ErrEmptyExportDirectory = errors.New("empty export directory")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter was provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilEpochStartNotifier signals that a nil epoch start notifier was provided
+var ErrNilEpochStartNotifier = errors.New("nil epoch start notifier")
+
+// ErrNilDatabase signals that a nil database was provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrInvalidRecordType signals that a record of an unexpected type was read from the snapshot file
+var ErrInvalidRecordType = errors.New("invalid record type")
+
+// ErrInvalidRecordSize signals that a record with an invalid size was read from the snapshot file
+var ErrInvalidRecordSize = errors.New("invalid record size")
+
+// ErrCorruptedRecord signals that the checksum of a record does not match its content
+var ErrCorruptedRecord = errors.New("corrupted record")
+
+// ErrUnsupportedVersion signals that the snapshot file was written with an unsupported version
+var ErrUnsupportedVersion = errors.New("unsupported snapshot file version")
+
+// ErrTrieNotInSnapshot signals that the requested trie was not exported in the snapshot file
+var ErrTrieNotInSnapshot = errors.New("trie not found in snapshot file")
+
+// ErrRootHashMismatch signals that the root hash of the exported trie is not the expected one
+var ErrRootHashMismatch = errors.New("root hash mismatch")
+
+// ErrTruncatedSnapshotFile signals that the snapshot file ended before its footer
+var ErrTruncatedSnapshotFile = errors.New("truncated snapshot file")
+
+// ErrMissingRootNode signals that the root node of the trie was not found in the snapshot file
+var ErrMissingRootNode = errors.New("missing root node")
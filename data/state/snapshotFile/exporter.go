@@ -0,0 +1,199 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// TrieToExport defines a trie, identified by its root hash, that will be written in a snapshot file
+type TrieToExport struct {
+	Identifier string
+	RootHash   []byte
+	Accounts   state.AccountsAdapter
+}
+
+// ArgsExporter is the argument DTO used to create a new snapshot file exporter
+type ArgsExporter struct {
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	NumNodesInChunk int
+}
+
+type exporter struct {
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	numNodesInChunk int
+}
+
+// NewExporter creates a new instance able to write the state tries in a snapshot file
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+	if args.NumNodesInChunk < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumNodesInChunk, args.NumNodesInChunk)
+	}
+
+	return &exporter{
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		numNodesInChunk: args.NumNodesInChunk,
+	}, nil
+}
+
+// Export writes all the nodes of the provided tries, including the data tries of the user accounts, in the writer
+func (e *exporter) Export(writer io.Writer, epoch uint32, shardID uint32, tries []*TrieToExport) error {
+	header := &SnapshotHeader{
+		Version: snapshotFileVersion,
+		ShardID: shardID,
+		Epoch:   epoch,
+		Tries:   make([]*SnapshotTrie, 0, len(tries)),
+	}
+	for _, trieToExport := range tries {
+		if check.IfNil(trieToExport.Accounts) {
+			return fmt.Errorf("%w for trie %s", ErrNilAccountsAdapter, trieToExport.Identifier)
+		}
+
+		header.Tries = append(header.Tries, &SnapshotTrie{
+			Identifier: trieToExport.Identifier,
+			RootHash:   trieToExport.RootHash,
+		})
+	}
+
+	err := e.writeMarshalizedRecord(writer, recordTypeHeader, header)
+	if err != nil {
+		return err
+	}
+
+	footer := &SnapshotFooter{}
+	for index, trieToExport := range tries {
+		err = e.exportTrie(writer, uint32(index), trieToExport, footer)
+		if err != nil {
+			return fmt.Errorf("%w while exporting trie %s", err, trieToExport.Identifier)
+		}
+	}
+
+	return e.writeMarshalizedRecord(writer, recordTypeFooter, footer)
+}
+
+func (e *exporter) exportTrie(writer io.Writer, trieIndex uint32, trieToExport *TrieToExport, footer *SnapshotFooter) error {
+	mainTrie, err := trieToExport.Accounts.GetTrie(trieToExport.RootHash)
+	if err != nil {
+		return err
+	}
+
+	storageManager := mainTrie.GetStorageManager()
+	storageManager.EnterPruningBufferingMode()
+	defer storageManager.ExitPruningBufferingMode()
+
+	allTries, err := trieToExport.Accounts.RecreateAllTries(trieToExport.RootHash)
+	if err != nil {
+		return err
+	}
+
+	// the main trie is exported first so the importer can check early that the root node is present
+	rootHashes := make([]string, 0, len(allTries))
+	for rootHash := range allTries {
+		if rootHash != string(trieToExport.RootHash) {
+			rootHashes = append(rootHashes, rootHash)
+		}
+	}
+	sort.Strings(rootHashes)
+	rootHashes = append([]string{string(trieToExport.RootHash)}, rootHashes...)
+
+	chunk := &SnapshotChunk{
+		TrieIndex: trieIndex,
+		Nodes:     make([][]byte, 0, e.numNodesInChunk),
+	}
+	for _, rootHash := range rootHashes {
+		tr, ok := allTries[rootHash]
+		if !ok || len(rootHash) == 0 || bytes.Equal([]byte(rootHash), trie.EmptyTrieHash) {
+			continue
+		}
+
+		chunk, err = e.exportNodes(writer, tr, chunk, footer)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(chunk.Nodes) == 0 {
+		return nil
+	}
+
+	return e.writeChunk(writer, chunk, footer)
+}
+
+func (e *exporter) exportNodes(writer io.Writer, tr data.Trie, chunk *SnapshotChunk, footer *SnapshotFooter) (*SnapshotChunk, error) {
+	it, err := trie.NewIterator(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		encodedNode, errNode := it.MarshalizedNode()
+		if errNode != nil {
+			return nil, errNode
+		}
+
+		chunk.Nodes = append(chunk.Nodes, encodedNode)
+		if len(chunk.Nodes) >= e.numNodesInChunk {
+			errNode = e.writeChunk(writer, chunk, footer)
+			if errNode != nil {
+				return nil, errNode
+			}
+
+			chunk = &SnapshotChunk{
+				TrieIndex: chunk.TrieIndex,
+				Nodes:     make([][]byte, 0, e.numNodesInChunk),
+			}
+		}
+
+		if !it.HasNext() {
+			return chunk, nil
+		}
+
+		errNode = it.Next()
+		if errNode != nil {
+			return nil, errNode
+		}
+	}
+}
+
+func (e *exporter) writeChunk(writer io.Writer, chunk *SnapshotChunk, footer *SnapshotFooter) error {
+	err := e.writeMarshalizedRecord(writer, recordTypeChunk, chunk)
+	if err != nil {
+		return err
+	}
+
+	footer.NumChunks++
+	footer.NumNodes += uint64(len(chunk.Nodes))
+
+	return nil
+}
+
+func (e *exporter) writeMarshalizedRecord(writer io.Writer, recordType byte, obj interface{}) error {
+	payload, err := e.marshalizer.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	return writeRecord(writer, e.hasher, recordType, payload)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
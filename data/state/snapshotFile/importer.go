@@ -0,0 +1,170 @@
+package snapshotFile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// ArgsImporter is the argument DTO used to create a new snapshot file importer
+type ArgsImporter struct {
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type importer struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewImporter creates a new instance able to load the trie nodes from a snapshot file
+func NewImporter(args ArgsImporter) (*importer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, state.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, state.ErrNilHasher
+	}
+
+	return &importer{
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// ImportTrieFromFile opens the snapshot file found at the provided path and calls ImportTrie
+func (i *importer) ImportTrieFromFile(filePath string, identifier string, expectedRootHash []byte, db data.DBWriteCacher) (uint64, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return i.ImportTrie(bufio.NewReader(file), identifier, expectedRootHash, db)
+}
+
+// ImportTrie verifies the whole snapshot and writes in the provided database the nodes of the trie with the given
+// identifier. The root hash written in the snapshot header has to match the expected one, which the caller obtains
+// from a trusted source (the epoch start metablock). It returns the number of imported nodes
+func (i *importer) ImportTrie(reader io.Reader, identifier string, expectedRootHash []byte, db data.DBWriteCacher) (uint64, error) {
+	if check.IfNil(db) {
+		return 0, ErrNilDatabase
+	}
+
+	header := &SnapshotHeader{}
+	err := i.readMarshalizedRecord(reader, recordTypeHeader, header)
+	if err != nil {
+		return 0, err
+	}
+	if header.Version != snapshotFileVersion {
+		return 0, fmt.Errorf("%w: %d", ErrUnsupportedVersion, header.Version)
+	}
+
+	trieIndex, err := findTrieIndex(header, identifier, expectedRootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	rootFound := len(expectedRootHash) == 0 || bytes.Equal(expectedRootHash, trie.EmptyTrieHash)
+	numImported := uint64(0)
+	readFooter := &SnapshotFooter{}
+	for {
+		recordType, payload, errRead := readRecord(reader, i.hasher)
+		if errRead == io.EOF {
+			return 0, ErrTruncatedSnapshotFile
+		}
+		if errRead != nil {
+			return 0, errRead
+		}
+
+		switch recordType {
+		case recordTypeChunk:
+			chunk := &SnapshotChunk{}
+			errRead = i.marshalizer.Unmarshal(chunk, payload)
+			if errRead != nil {
+				return 0, errRead
+			}
+
+			readFooter.NumChunks++
+			readFooter.NumNodes += uint64(len(chunk.Nodes))
+			if chunk.TrieIndex != trieIndex {
+				continue
+			}
+
+			for _, encodedNode := range chunk.Nodes {
+				hash := i.hasher.Compute(string(encodedNode))
+				errRead = db.Put(hash, encodedNode)
+				if errRead != nil {
+					return 0, errRead
+				}
+
+				rootFound = rootFound || bytes.Equal(hash, expectedRootHash)
+				numImported++
+			}
+		case recordTypeFooter:
+			footer := &SnapshotFooter{}
+			errRead = i.marshalizer.Unmarshal(footer, payload)
+			if errRead != nil {
+				return 0, errRead
+			}
+			if footer.NumChunks != readFooter.NumChunks || footer.NumNodes != readFooter.NumNodes {
+				return 0, fmt.Errorf("%w: footer has %d chunks and %d nodes, read %d chunks and %d nodes",
+					ErrTruncatedSnapshotFile, footer.NumChunks, footer.NumNodes, readFooter.NumChunks, readFooter.NumNodes)
+			}
+			if !rootFound {
+				return 0, ErrMissingRootNode
+			}
+
+			return numImported, nil
+		default:
+			return 0, fmt.Errorf("%w: %d", ErrInvalidRecordType, recordType)
+		}
+	}
+}
+
+func findTrieIndex(header *SnapshotHeader, identifier string, expectedRootHash []byte) (uint32, error) {
+	for index, snapshotTrie := range header.Tries {
+		if snapshotTrie.Identifier != identifier {
+			continue
+		}
+		if !bytes.Equal(snapshotTrie.RootHash, expectedRootHash) {
+			return 0, fmt.Errorf("%w for trie %s: snapshot has %x, expected %x",
+				ErrRootHashMismatch, identifier, snapshotTrie.RootHash, expectedRootHash)
+		}
+
+		return uint32(index), nil
+	}
+
+	return 0, fmt.Errorf("%w: %s", ErrTrieNotInSnapshot, identifier)
+}
+
+func (i *importer) readMarshalizedRecord(reader io.Reader, expectedType byte, obj interface{}) error {
+	recordType, payload, err := readRecord(reader, i.hasher)
+	if err == io.EOF {
+		return ErrTruncatedSnapshotFile
+	}
+	if err != nil {
+		return err
+	}
+	if recordType != expectedType {
+		return fmt.Errorf("%w: expected %d, got %d", ErrInvalidRecordType, expectedType, recordType)
+	}
+
+	return i.marshalizer.Unmarshal(obj, payload)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
@@ -0,0 +1,9 @@
+package snapshotFile
+
+import "github.com/ElrondNetwork/elrond-go/epochStart"
+
+// EpochStartNotifier defines which actions should be done for handling new epoch's events
+type EpochStartNotifier interface {
+	RegisterHandler(handler epochStart.ActionHandler)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,1302 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: snapshotFile.proto
+
+package snapshotFile
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SnapshotTrie identifies one of the tries exported in a snapshot file
+type SnapshotTrie struct {
+	Identifier string `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	RootHash   []byte `protobuf:"bytes,2,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+}
+
+func (m *SnapshotTrie) Reset()      { *m = SnapshotTrie{} }
+func (*SnapshotTrie) ProtoMessage() {}
+func (*SnapshotTrie) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b30689315d1fb65, []int{0}
+}
+func (m *SnapshotTrie) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotTrie) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SnapshotTrie) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotTrie.Merge(m, src)
+}
+func (m *SnapshotTrie) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotTrie) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotTrie.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotTrie proto.InternalMessageInfo
+
+func (m *SnapshotTrie) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *SnapshotTrie) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+// SnapshotHeader is the first record of a snapshot file and describes the exported tries
+type SnapshotHeader struct {
+	Version uint32          `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	ShardID uint32          `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Epoch   uint32          `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Tries   []*SnapshotTrie `protobuf:"bytes,4,rep,name=Tries,proto3" json:"Tries,omitempty"`
+}
+
+func (m *SnapshotHeader) Reset()      { *m = SnapshotHeader{} }
+func (*SnapshotHeader) ProtoMessage() {}
+func (*SnapshotHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b30689315d1fb65, []int{1}
+}
+func (m *SnapshotHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SnapshotHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotHeader.Merge(m, src)
+}
+func (m *SnapshotHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotHeader proto.InternalMessageInfo
+
+func (m *SnapshotHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetTries() []*SnapshotTrie {
+	if m != nil {
+		return m.Tries
+	}
+	return nil
+}
+
+// SnapshotChunk holds a batch of encoded trie nodes belonging to one of the exported tries
+type SnapshotChunk struct {
+	TrieIndex uint32   `protobuf:"varint,1,opt,name=TrieIndex,proto3" json:"TrieIndex,omitempty"`
+	Nodes     [][]byte `protobuf:"bytes,2,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+}
+
+func (m *SnapshotChunk) Reset()      { *m = SnapshotChunk{} }
+func (*SnapshotChunk) ProtoMessage() {}
+func (*SnapshotChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b30689315d1fb65, []int{2}
+}
+func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SnapshotChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotChunk.Merge(m, src)
+}
+func (m *SnapshotChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotChunk proto.InternalMessageInfo
+
+func (m *SnapshotChunk) GetTrieIndex() uint32 {
+	if m != nil {
+		return m.TrieIndex
+	}
+	return 0
+}
+
+func (m *SnapshotChunk) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// SnapshotFooter is the last record of a snapshot file and allows the detection of truncated files
+type SnapshotFooter struct {
+	NumChunks uint32 `protobuf:"varint,1,opt,name=NumChunks,proto3" json:"NumChunks,omitempty"`
+	NumNodes  uint64 `protobuf:"varint,2,opt,name=NumNodes,proto3" json:"NumNodes,omitempty"`
+}
+
+func (m *SnapshotFooter) Reset()      { *m = SnapshotFooter{} }
+func (*SnapshotFooter) ProtoMessage() {}
+func (*SnapshotFooter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b30689315d1fb65, []int{3}
+}
+func (m *SnapshotFooter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotFooter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SnapshotFooter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotFooter.Merge(m, src)
+}
+func (m *SnapshotFooter) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotFooter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotFooter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotFooter proto.InternalMessageInfo
+
+func (m *SnapshotFooter) GetNumChunks() uint32 {
+	if m != nil {
+		return m.NumChunks
+	}
+	return 0
+}
+
+func (m *SnapshotFooter) GetNumNodes() uint64 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SnapshotTrie)(nil), "proto.SnapshotTrie")
+	proto.RegisterType((*SnapshotHeader)(nil), "proto.SnapshotHeader")
+	proto.RegisterType((*SnapshotChunk)(nil), "proto.SnapshotChunk")
+	proto.RegisterType((*SnapshotFooter)(nil), "proto.SnapshotFooter")
+}
+
+func init() { proto.RegisterFile("snapshotFile.proto", fileDescriptor_9b30689315d1fb65) }
+
+var fileDescriptor_9b30689315d1fb65 = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbd, 0x6e, 0xe2, 0x40,
+	0x14, 0x85, 0x3d, 0xfc, 0xec, 0x2e, 0xb3, 0x66, 0x8b, 0xd9, 0x14, 0x16, 0x8a, 0xae, 0x2c, 0x57,
+	0x4e, 0x11, 0x90, 0x92, 0x37, 0x08, 0x09, 0x02, 0x0a, 0x8a, 0x21, 0x4a, 0x91, 0xce, 0xe0, 0x01,
+	0x5b, 0x09, 0xbe, 0x68, 0xc6, 0x96, 0x52, 0xa6, 0x49, 0x9f, 0xc7, 0xc8, 0xa3, 0xa4, 0xa4, 0xa4,
+	0x0c, 0x43, 0x93, 0x92, 0x47, 0x88, 0x3c, 0xc6, 0x40, 0x65, 0x7f, 0xe7, 0xda, 0x9f, 0xcf, 0xf5,
+	0x50, 0xa6, 0x92, 0x60, 0xa9, 0x22, 0x4c, 0x7b, 0xf1, 0xb3, 0x68, 0x2f, 0x25, 0xa6, 0xc8, 0xea,
+	0xe6, 0xd2, 0xba, 0x9c, 0xc7, 0x69, 0x94, 0x4d, 0xda, 0x53, 0x5c, 0x74, 0xe6, 0x38, 0xc7, 0x8e,
+	0x89, 0x27, 0xd9, 0xcc, 0x90, 0x01, 0x73, 0x57, 0xbc, 0xe5, 0x0d, 0xa9, 0x3d, 0xde, 0xbb, 0xee,
+	0x65, 0x2c, 0x18, 0x50, 0x3a, 0x08, 0x45, 0x92, 0xc6, 0xb3, 0x58, 0x48, 0x87, 0xb8, 0xc4, 0x6f,
+	0xf0, 0x93, 0x84, 0xb5, 0xe8, 0x1f, 0x8e, 0x98, 0xf6, 0x03, 0x15, 0x39, 0x15, 0x97, 0xf8, 0x36,
+	0x3f, 0xb0, 0xf7, 0x46, 0xe8, 0xbf, 0x52, 0xd6, 0x17, 0x41, 0x28, 0x24, 0x73, 0xe8, 0xef, 0x07,
+	0x21, 0x55, 0x8c, 0x89, 0x71, 0x35, 0x79, 0x89, 0xf9, 0x64, 0x1c, 0x05, 0x32, 0x1c, 0xdc, 0x1a,
+	0x4f, 0x93, 0x97, 0xc8, 0xce, 0x68, 0xfd, 0x6e, 0x89, 0xd3, 0xc8, 0xa9, 0x9a, 0xbc, 0x00, 0x76,
+	0x41, 0xeb, 0x79, 0x41, 0xe5, 0xd4, 0xdc, 0xaa, 0xff, 0xf7, 0xea, 0x7f, 0xd1, 0xbf, 0x7d, 0x5a,
+	0x9e, 0x17, 0x4f, 0x78, 0x5d, 0xda, 0x2c, 0xe3, 0x6e, 0x94, 0x25, 0x4f, 0xec, 0x9c, 0x36, 0xf2,
+	0xc9, 0x20, 0x09, 0xc5, 0xcb, 0xbe, 0xc7, 0x31, 0xc8, 0xbf, 0x37, 0xc2, 0x50, 0x28, 0xa7, 0xe2,
+	0x56, 0x7d, 0x9b, 0x17, 0xe0, 0x0d, 0x8f, 0xbb, 0xf4, 0x10, 0x53, 0x21, 0x73, 0xcb, 0x28, 0x5b,
+	0x18, 0xa3, 0x2a, 0x2d, 0x87, 0x20, 0xff, 0x31, 0xa3, 0x6c, 0x51, 0x8a, 0x88, 0x5f, 0xe3, 0x07,
+	0xbe, 0xe9, 0xad, 0x36, 0x60, 0xad, 0x37, 0x60, 0xed, 0x36, 0x40, 0x5e, 0x35, 0x90, 0x0f, 0x0d,
+	0xe4, 0x53, 0x03, 0x59, 0x69, 0x20, 0x6b, 0x0d, 0xe4, 0x4b, 0x03, 0xf9, 0xd6, 0x60, 0xed, 0x34,
+	0x90, 0xf7, 0x2d, 0x58, 0xab, 0x2d, 0x58, 0xeb, 0x2d, 0x58, 0x8f, 0xf6, 0xe9, 0x41, 0x4f, 0x7e,
+	0x99, 0x9d, 0xaf, 0x7f, 0x06, 0x00, 0x91, 0x70, 0xa0, 0x11, 0xff, 0x01, 0x00, 0x00,
+}
+
+func (this *SnapshotTrie) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotTrie)
+	if !ok {
+		that2, ok := that.(SnapshotTrie)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Identifier != that1.Identifier {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	return true
+}
+func (this *SnapshotHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotHeader)
+	if !ok {
+		that2, ok := that.(SnapshotHeader)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if len(this.Tries) != len(that1.Tries) {
+		return false
+	}
+	for i := range this.Tries {
+		if !this.Tries[i].Equal(that1.Tries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SnapshotChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotChunk)
+	if !ok {
+		that2, ok := that.(SnapshotChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TrieIndex != that1.TrieIndex {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !bytes.Equal(this.Nodes[i], that1.Nodes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SnapshotFooter) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SnapshotFooter)
+	if !ok {
+		that2, ok := that.(SnapshotFooter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumChunks != that1.NumChunks {
+		return false
+	}
+	if this.NumNodes != that1.NumNodes {
+		return false
+	}
+	return true
+}
+func (this *SnapshotTrie) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&snapshotFile.SnapshotTrie{")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SnapshotHeader) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&snapshotFile.SnapshotHeader{")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	if this.Tries != nil {
+		s = append(s, "Tries: "+fmt.Sprintf("%#v", this.Tries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SnapshotChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&snapshotFile.SnapshotChunk{")
+	s = append(s, "TrieIndex: "+fmt.Sprintf("%#v", this.TrieIndex)+",\n")
+	s = append(s, "Nodes: "+fmt.Sprintf("%#v", this.Nodes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SnapshotFooter) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&snapshotFile.SnapshotFooter{")
+	s = append(s, "NumChunks: "+fmt.Sprintf("%#v", this.NumChunks)+",\n")
+	s = append(s, "NumNodes: "+fmt.Sprintf("%#v", this.NumNodes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSnapshotFile(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SnapshotTrie) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotTrie) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotTrie) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tries) > 0 {
+		for iNdEx := len(m.Tries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshotFile(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Nodes[iNdEx])
+			copy(dAtA[i:], m.Nodes[iNdEx])
+			i = encodeVarintSnapshotFile(dAtA, i, uint64(len(m.Nodes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TrieIndex != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.TrieIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SnapshotFooter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotFooter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotFooter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumNodes != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.NumNodes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumChunks != 0 {
+		i = encodeVarintSnapshotFile(dAtA, i, uint64(m.NumChunks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSnapshotFile(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSnapshotFile(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SnapshotTrie) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovSnapshotFile(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovSnapshotFile(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.Version))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.ShardID))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.Epoch))
+	}
+	if len(m.Tries) > 0 {
+		for _, e := range m.Tries {
+			l = e.Size()
+			n += 1 + l + sovSnapshotFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrieIndex != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.TrieIndex))
+	}
+	if len(m.Nodes) > 0 {
+		for _, b := range m.Nodes {
+			l = len(b)
+			n += 1 + l + sovSnapshotFile(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotFooter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumChunks != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.NumChunks))
+	}
+	if m.NumNodes != 0 {
+		n += 1 + sovSnapshotFile(uint64(m.NumNodes))
+	}
+	return n
+}
+
+func sovSnapshotFile(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSnapshotFile(x uint64) (n int) {
+	return sovSnapshotFile(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SnapshotTrie) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotTrie{`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTries := "[]*SnapshotTrie{"
+	for _, f := range this.Tries {
+		repeatedStringForTries += strings.Replace(f.String(), "SnapshotTrie", "SnapshotTrie", 1) + ","
+	}
+	repeatedStringForTries += "}"
+	s := strings.Join([]string{`&SnapshotHeader{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Tries:` + repeatedStringForTries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotChunk{`,
+		`TrieIndex:` + fmt.Sprintf("%v", this.TrieIndex) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SnapshotFooter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SnapshotFooter{`,
+		`NumChunks:` + fmt.Sprintf("%v", this.NumChunks) + `,`,
+		`NumNodes:` + fmt.Sprintf("%v", this.NumNodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSnapshotFile(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SnapshotTrie) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshotFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotTrie: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotTrie: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshotFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshotFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tries = append(m.Tries, &SnapshotTrie{})
+			if err := m.Tries[len(m.Tries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshotFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshotFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrieIndex", wireType)
+			}
+			m.TrieIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrieIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshotFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotFooter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshotFile
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotFooter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotFooter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumChunks", wireType)
+			}
+			m.NumChunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumChunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodes", wireType)
+			}
+			m.NumNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshotFile(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSnapshotFile
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSnapshotFile(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSnapshotFile
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSnapshotFile
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSnapshotFile
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSnapshotFile
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSnapshotFile
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSnapshotFile        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSnapshotFile          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSnapshotFile = fmt.Errorf("proto: unexpected end of group")
+)
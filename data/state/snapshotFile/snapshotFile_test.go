@@ -0,0 +1,327 @@
+package snapshotFile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = sha256.NewSha256()
+
+func createAccountsDB(t *testing.T, db data.DBWriteCacher, accountFactory state.AccountFactory) *state.AccountsDB {
+	tsm, err := trie.NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(tsm, testMarshalizer, testHasher, 5)
+	require.Nil(t, err)
+	adb, err := state.NewAccountsDB(tr, testHasher, testMarshalizer, accountFactory, disabled.NewDisabledStoragePruningManager())
+	require.Nil(t, err)
+
+	return adb
+}
+
+func createUserAccounts(t *testing.T, numAccounts int) (*state.AccountsDB, []byte) {
+	adb := createAccountsDB(t, memorydb.New(), stateFactory.NewAccountCreator())
+	for i := 0; i < numAccounts; i++ {
+		acc, err := adb.LoadAccount([]byte(fmt.Sprintf("address%025d", i)))
+		require.Nil(t, err)
+
+		userAccount := acc.(state.UserAccountHandler)
+		userAccount.IncreaseNonce(uint64(i))
+		if i%3 == 0 {
+			for j := 0; j < 5; j++ {
+				err = userAccount.DataTrieTracker().SaveKeyValue([]byte(fmt.Sprintf("key%d", j)), []byte(fmt.Sprintf("value%d_%d", i, j)))
+				require.Nil(t, err)
+			}
+		}
+		require.Nil(t, adb.SaveAccount(userAccount))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return adb, rootHash
+}
+
+func createPeerAccounts(t *testing.T, numAccounts int) (*state.AccountsDB, []byte) {
+	adb := createAccountsDB(t, memorydb.New(), stateFactory.NewPeerAccountCreator())
+	for i := 0; i < numAccounts; i++ {
+		acc, err := adb.LoadAccount([]byte(fmt.Sprintf("validator%025d", i)))
+		require.Nil(t, err)
+
+		peerAccount := acc.(state.PeerAccountHandler)
+		peerAccount.SetTempRating(uint32(i))
+		require.Nil(t, adb.SaveAccount(peerAccount))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return adb, rootHash
+}
+
+func exportTestSnapshot(t *testing.T) ([]byte, []byte, []byte) {
+	userAccounts, userRootHash := createUserAccounts(t, 30)
+	peerAccounts, peerRootHash := createPeerAccounts(t, 10)
+
+	exp, _ := NewExporter(ArgsExporter{
+		Marshalizer:     testMarshalizer,
+		Hasher:          testHasher,
+		NumNodesInChunk: 7,
+	})
+
+	buff := bytes.NewBuffer(nil)
+	err := exp.Export(buff, 2, core.MetachainShardId, []*TrieToExport{
+		{Identifier: factory.UserAccountTrie, RootHash: userRootHash, Accounts: userAccounts},
+		{Identifier: factory.PeerAccountTrie, RootHash: peerRootHash, Accounts: peerAccounts},
+	})
+	require.Nil(t, err)
+
+	return buff.Bytes(), userRootHash, peerRootHash
+}
+
+func createTestImporter() *importer {
+	imp, _ := NewImporter(ArgsImporter{
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+	})
+
+	return imp
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExporter(ArgsExporter{Hasher: testHasher, NumNodesInChunk: 1})
+	assert.Nil(t, exp)
+	assert.Equal(t, state.ErrNilMarshalizer, err)
+
+	exp, err = NewExporter(ArgsExporter{Marshalizer: testMarshalizer, NumNodesInChunk: 1})
+	assert.Nil(t, exp)
+	assert.Equal(t, state.ErrNilHasher, err)
+
+	exp, err = NewExporter(ArgsExporter{Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.Nil(t, exp)
+	assert.True(t, errors.Is(err, ErrInvalidNumNodesInChunk))
+
+	exp, err = NewExporter(ArgsExporter{Marshalizer: testMarshalizer, Hasher: testHasher, NumNodesInChunk: 1})
+	assert.False(t, exp.IsInterfaceNil())
+	assert.Nil(t, err)
+}
+
+func TestNewImporter(t *testing.T) {
+	t.Parallel()
+
+	imp, err := NewImporter(ArgsImporter{Hasher: testHasher})
+	assert.Nil(t, imp)
+	assert.Equal(t, state.ErrNilMarshalizer, err)
+
+	imp, err = NewImporter(ArgsImporter{Marshalizer: testMarshalizer})
+	assert.Nil(t, imp)
+	assert.Equal(t, state.ErrNilHasher, err)
+
+	imp, err = NewImporter(ArgsImporter{Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.False(t, imp.IsInterfaceNil())
+	assert.Nil(t, err)
+}
+
+func TestExportImport_ShouldRecreateTheTries(t *testing.T) {
+	t.Parallel()
+
+	snapshot, userRootHash, peerRootHash := exportTestSnapshot(t)
+	imp := createTestImporter()
+
+	userDb := memorydb.New()
+	numNodes, err := imp.ImportTrie(bytes.NewReader(snapshot), factory.UserAccountTrie, userRootHash, userDb)
+	require.Nil(t, err)
+	assert.True(t, numNodes > 30)
+
+	userAccounts := createAccountsDB(t, userDb, stateFactory.NewAccountCreator())
+	allTries, err := userAccounts.RecreateAllTries(userRootHash)
+	require.Nil(t, err)
+	assert.Equal(t, 11, len(allTries))
+
+	require.Nil(t, userAccounts.RecreateTrie(userRootHash))
+	acc, err := userAccounts.GetExistingAccount([]byte(fmt.Sprintf("address%025d", 3)))
+	require.Nil(t, err)
+	userAccount := acc.(state.UserAccountHandler)
+	assert.Equal(t, uint64(3), userAccount.GetNonce())
+	value, err := userAccount.DataTrieTracker().RetrieveValue([]byte("key4"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value3_4"), value)
+
+	peerDb := memorydb.New()
+	_, err = imp.ImportTrie(bytes.NewReader(snapshot), factory.PeerAccountTrie, peerRootHash, peerDb)
+	require.Nil(t, err)
+
+	peerAccounts := createAccountsDB(t, peerDb, stateFactory.NewPeerAccountCreator())
+	require.Nil(t, peerAccounts.RecreateTrie(peerRootHash))
+	acc, err = peerAccounts.GetExistingAccount([]byte(fmt.Sprintf("validator%025d", 7)))
+	require.Nil(t, err)
+	assert.Equal(t, uint32(7), acc.(state.PeerAccountHandler).GetTempRating())
+}
+
+func TestImportTrie_RootHashMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshot, userRootHash, _ := exportTestSnapshot(t)
+	imp := createTestImporter()
+
+	wrongRootHash := append([]byte{}, userRootHash...)
+	wrongRootHash[0]++
+	db := memorydb.New()
+	numNodes, err := imp.ImportTrie(bytes.NewReader(snapshot), factory.UserAccountTrie, wrongRootHash, db)
+	assert.True(t, errors.Is(err, ErrRootHashMismatch))
+	assert.Equal(t, uint64(0), numNodes)
+	_, errGet := db.Get(userRootHash)
+	assert.NotNil(t, errGet)
+}
+
+func TestImportTrie_UnknownTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshot, userRootHash, _ := exportTestSnapshot(t)
+	imp := createTestImporter()
+
+	_, err := imp.ImportTrie(bytes.NewReader(snapshot), "unknown", userRootHash, memorydb.New())
+	assert.True(t, errors.Is(err, ErrTrieNotInSnapshot))
+}
+
+func TestImportTrie_CorruptedRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshot, userRootHash, _ := exportTestSnapshot(t)
+	imp := createTestImporter()
+
+	corrupted := append([]byte{}, snapshot...)
+	corrupted[len(corrupted)/2]++
+	_, err := imp.ImportTrie(bytes.NewReader(corrupted), factory.UserAccountTrie, userRootHash, memorydb.New())
+	assert.NotNil(t, err)
+
+	corrupted = append([]byte{}, snapshot...)
+	corrupted[lenRecordPrefix+1]++
+	_, err = imp.ImportTrie(bytes.NewReader(corrupted), factory.UserAccountTrie, userRootHash, memorydb.New())
+	assert.Equal(t, ErrCorruptedRecord, err)
+}
+
+func TestImportTrie_TruncatedFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	snapshot, userRootHash, _ := exportTestSnapshot(t)
+	imp := createTestImporter()
+
+	_, err := imp.ImportTrie(bytes.NewReader(snapshot[:len(snapshot)-10]), factory.UserAccountTrie, userRootHash, memorydb.New())
+	assert.True(t, errors.Is(err, ErrTruncatedSnapshotFile))
+
+	_, err = imp.ImportTrie(bytes.NewReader(nil), factory.UserAccountTrie, userRootHash, memorydb.New())
+	assert.True(t, errors.Is(err, ErrTruncatedSnapshotFile))
+}
+
+func TestImportTrie_NilDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	imp := createTestImporter()
+	_, err := imp.ImportTrie(bytes.NewReader(nil), factory.UserAccountTrie, nil, nil)
+	assert.Equal(t, ErrNilDatabase, err)
+}
+
+func TestEpochStartExporter_ShouldWriteTheSnapshotFileAtEpochStart(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "snapshotFile")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	userAccounts, userRootHash := createUserAccounts(t, 10)
+	shardCoordinator, _ := sharding.NewMultiShardCoordinator(2, 1)
+	epochStartNotifier := notifier.NewEpochStartSubscriptionHandler()
+	ese, err := NewEpochStartExporter(ArgsEpochStartExporter{
+		Marshalizer:        testMarshalizer,
+		Hasher:             testHasher,
+		ShardCoordinator:   shardCoordinator,
+		UserAccounts:       userAccounts,
+		EpochStartNotifier: epochStartNotifier,
+		ExportDirectory:    dir,
+		NumNodesInChunk:    10,
+	})
+	require.Nil(t, err)
+	require.False(t, ese.IsInterfaceNil())
+
+	metaBlock := &block.MetaBlock{
+		Epoch: 3,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{
+				{ShardID: 0, RootHash: []byte("other shard root hash")},
+				{ShardID: 1, RootHash: userRootHash},
+			},
+		},
+	}
+	epochStartNotifier.NotifyAllPrepare(metaBlock, &block.Body{})
+
+	filePath := filepath.Join(dir, SnapshotFileName(1, 3))
+	require.Eventually(t, func() bool {
+		_, errStat := os.Stat(filePath)
+		return errStat == nil && !ese.exportInProgress.IsSet()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	db := memorydb.New()
+	_, err = createTestImporter().ImportTrieFromFile(filePath, factory.UserAccountTrie, userRootHash, db)
+	require.Nil(t, err)
+	restored := createAccountsDB(t, db, stateFactory.NewAccountCreator())
+	assert.Nil(t, restored.RecreateTrie(userRootHash))
+}
+
+func TestNewEpochStartExporter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	userAccounts, _ := createUserAccounts(t, 1)
+	metaCoordinator, _ := sharding.NewMultiShardCoordinator(2, core.MetachainShardId)
+	args := ArgsEpochStartExporter{
+		Marshalizer:        testMarshalizer,
+		Hasher:             testHasher,
+		ShardCoordinator:   metaCoordinator,
+		UserAccounts:       userAccounts,
+		EpochStartNotifier: notifier.NewEpochStartSubscriptionHandler(),
+		ExportDirectory:    "dir",
+		NumNodesInChunk:    10,
+	}
+
+	ese, err := NewEpochStartExporter(args)
+	assert.Nil(t, ese)
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	args.PeerAccounts = userAccounts
+	args.EpochStartNotifier = nil
+	ese, err = NewEpochStartExporter(args)
+	assert.Nil(t, ese)
+	assert.Equal(t, ErrNilEpochStartNotifier, err)
+
+	args.EpochStartNotifier = notifier.NewEpochStartSubscriptionHandler()
+	args.ExportDirectory = ""
+	ese, err = NewEpochStartExporter(args)
+	assert.Nil(t, ese)
+	assert.Equal(t, ErrEmptyExportDirectory, err)
+}
@@ -30,6 +30,8 @@ type ApiTransactionResult struct {
 	ReturnMessage                     string                    `json:"returnMessage,omitempty"`
 	OriginalSender                    string                    `json:"originalSender,omitempty"`
 	Signature                         string                    `json:"signature,omitempty"`
+	RelayerAddress                    string                    `json:"relayer,omitempty"`
+	RelayerSignature                  string                    `json:"relayerSignature,omitempty"`
 	SourceShard                       uint32                    `json:"sourceShard"`
 	DestinationShard                  uint32                    `json:"destinationShard"`
 	BlockNonce                        uint64                    `json:"blockNonce,omitempty"`
@@ -54,7 +56,10 @@ type SimulationResults struct {
 	ScResults  map[string]*ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                             `json:"hash,omitempty"`
+	GasPayer   string                             `json:"gasPayer,omitempty"`
+	ValuePayer string                             `json:"valuePayer,omitempty"`
 	VMOutput   *vmcommon.VMOutput                 `json:"-"`
+	ScrsByHash map[string]data.TransactionHandler `json:"-"`
 }
 
 // ApiSmartContractResult represents a smart contract result with changed fields' types in order to make it friendly for API's json
@@ -106,4 +111,26 @@ type CostResponse struct {
 	GasUnits             uint64                             `json:"txGasUnits"`
 	ReturnMessage        string                             `json:"returnMessage"`
 	SmartContractResults map[string]*ApiSmartContractResult `json:"smartContractResults"`
+	GasBreakdown         *GasBreakdown                      `json:"gasBreakdown,omitempty"`
+}
+
+// GasBreakdown holds the gas units consumed by each step of a transaction's execution flow
+type GasBreakdown struct {
+	Relayer               uint64           `json:"relayer"`
+	MoveBalance           uint64           `json:"moveBalance"`
+	Processing            uint64           `json:"processing"`
+	IsProcessingEstimated bool             `json:"isProcessingEstimated"`
+	ScrHops               []*ScrHopGasCost `json:"scrHops,omitempty"`
+	Callback              uint64           `json:"callback"`
+	IsCallbackEstimated   bool             `json:"isCallbackEstimated"`
+	Refund                uint64           `json:"refund"`
+}
+
+// ScrHopGasCost holds the gas units consumed when a smart contract result is executed
+type ScrHopGasCost struct {
+	Hash          string `json:"hash"`
+	SenderShard   uint32 `json:"senderShard"`
+	ReceiverShard uint32 `json:"receiverShard"`
+	GasUnits      uint64 `json:"gasUnits"`
+	IsEstimated   bool   `json:"isEstimated"`
 }
@@ -15,4 +15,7 @@ type FrontendTransaction struct {
 	ChainID          string `json:"chainID"`
 	Version          uint32 `json:"version"`
 	Options          uint32 `json:"options,omitempty"`
+	Relayer          string `json:"relayer,omitempty"`
+	RelayerSignature string `json:"relayerSignature,omitempty"`
+	RelayerNonce     uint64 `json:"relayerNonce,omitempty"`
 }
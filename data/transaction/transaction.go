@@ -79,10 +79,19 @@ func (tx *Transaction) GetDataForSigning(encoder Encoder, marshalizer Marshalize
 		Version:          tx.Version,
 		Options:          tx.Options,
 	}
+	if len(tx.RelayerAddr) > 0 {
+		ftx.Relayer = encoder.Encode(tx.RelayerAddr)
+		ftx.RelayerNonce = tx.RelayerNonce
+	}
 
 	return marshalizer.Marshal(ftx)
 }
 
+// IsRelayedV3 returns true if the transaction carries the relayer fields of a relayed transaction v3
+func (tx *Transaction) IsRelayedV3() bool {
+	return len(tx.RelayerAddr) > 0
+}
+
 // CheckIntegrity checks for not nil fields and negative value
 func (tx *Transaction) CheckIntegrity() error {
 	if tx.Signature == nil {
@@ -100,6 +109,12 @@ func (tx *Transaction) CheckIntegrity() error {
 	if len(tx.SndUserName) > core.MaxUserNameLength {
 		return data.ErrInvalidUserNameLength
 	}
+	if len(tx.RelayerAddr) > 0 && len(tx.RelayerSignature) == 0 {
+		return data.ErrNilRelayerSignature
+	}
+	if len(tx.RelayerAddr) == 0 && len(tx.RelayerSignature) > 0 {
+		return data.ErrNilRelayerAddress
+	}
 
 	return nil
 }
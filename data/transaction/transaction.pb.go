@@ -30,19 +30,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Transaction holds all the data needed for a value transfer or SC call
 type Transaction struct {
-	Nonce       uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
-	Value       *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	RcvAddr     []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
-	RcvUserName []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
-	SndAddr     []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
-	SndUserName []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
-	GasPrice    uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
-	GasLimit    uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
-	Data        []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
-	ChainID     []byte        `protobuf:"bytes,10,opt,name=ChainID,proto3" json:"chainID"`
-	Version     uint32        `protobuf:"varint,11,opt,name=Version,proto3" json:"version"`
-	Signature   []byte        `protobuf:"bytes,12,opt,name=Signature,proto3" json:"signature,omitempty"`
-	Options     uint32        `protobuf:"varint,13,opt,name=Options,proto3" json:"options,omitempty"`
+	Nonce            uint64        `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
+	Value            *math_big.Int `protobuf:"bytes,2,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	RcvAddr          []byte        `protobuf:"bytes,3,opt,name=RcvAddr,proto3" json:"receiver"`
+	RcvUserName      []byte        `protobuf:"bytes,4,opt,name=RcvUserName,proto3" json:"rcvUserName,omitempty"`
+	SndAddr          []byte        `protobuf:"bytes,5,opt,name=SndAddr,proto3" json:"sender"`
+	SndUserName      []byte        `protobuf:"bytes,6,opt,name=SndUserName,proto3" json:"sndUserName,omitempty"`
+	GasPrice         uint64        `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"gasPrice,omitempty"`
+	GasLimit         uint64        `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"gasLimit,omitempty"`
+	Data             []byte        `protobuf:"bytes,9,opt,name=Data,proto3" json:"data,omitempty"`
+	ChainID          []byte        `protobuf:"bytes,10,opt,name=ChainID,proto3" json:"chainID"`
+	Version          uint32        `protobuf:"varint,11,opt,name=Version,proto3" json:"version"`
+	Signature        []byte        `protobuf:"bytes,12,opt,name=Signature,proto3" json:"signature,omitempty"`
+	Options          uint32        `protobuf:"varint,13,opt,name=Options,proto3" json:"options,omitempty"`
+	RelayerAddr      []byte        `protobuf:"bytes,14,opt,name=RelayerAddr,proto3" json:"relayer,omitempty"`
+	RelayerSignature []byte        `protobuf:"bytes,15,opt,name=RelayerSignature,proto3" json:"relayerSignature,omitempty"`
+	RelayerNonce     uint64        `protobuf:"varint,16,opt,name=RelayerNonce,proto3" json:"relayerNonce,omitempty"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -164,6 +167,27 @@ func (m *Transaction) GetOptions() uint32 {
 	return 0
 }
 
+func (m *Transaction) GetRelayerAddr() []byte {
+	if m != nil {
+		return m.RelayerAddr
+	}
+	return nil
+}
+
+func (m *Transaction) GetRelayerSignature() []byte {
+	if m != nil {
+		return m.RelayerSignature
+	}
+	return nil
+}
+
+func (m *Transaction) GetRelayerNonce() uint64 {
+	if m != nil {
+		return m.RelayerNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Transaction)(nil), "proto.Transaction")
 }
@@ -171,40 +195,44 @@ func init() {
 func init() { proto.RegisterFile("transaction.proto", fileDescriptor_2cc4e03d2c28c490) }
 
 var fileDescriptor_2cc4e03d2c28c490 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x58, 0x96, 0xd5, 0xed, 0x90, 0x66, 0x34, 0x30, 0x1c, 0xec, 0x0a, 0xc1, 0xd4,
-	0x03, 0x6b, 0x24, 0x10, 0xa7, 0x9d, 0xd6, 0x6d, 0x42, 0x95, 0x50, 0x41, 0x29, 0xec, 0xc0, 0xcd,
-	0x4d, 0x4c, 0x6a, 0xb1, 0xd8, 0x95, 0xe3, 0x16, 0x71, 0xe3, 0x11, 0x78, 0x06, 0x4e, 0x88, 0x27,
-	0xe1, 0xd8, 0x63, 0x4f, 0x81, 0xa6, 0x17, 0x94, 0xd3, 0x1e, 0x01, 0xc5, 0x69, 0xd7, 0xc0, 0xa9,
-	0xfd, 0xff, 0xbe, 0xef, 0xfb, 0x7f, 0x96, 0xe5, 0xc0, 0x03, 0xa3, 0x99, 0x4c, 0x59, 0x68, 0x84,
-	0x92, 0xdd, 0x89, 0x56, 0x46, 0x21, 0xd7, 0xfe, 0x3c, 0x3c, 0x8e, 0x85, 0x19, 0x4f, 0x47, 0xdd,
-	0x50, 0x25, 0x7e, 0xac, 0x62, 0xe5, 0x5b, 0x3c, 0x9a, 0x7e, 0xb0, 0x93, 0x1d, 0xec, 0xbf, 0x2a,
-	0xf5, 0xe8, 0x9b, 0x0b, 0x9b, 0x6f, 0xb7, 0xbb, 0x10, 0x85, 0xee, 0x40, 0xc9, 0x90, 0x63, 0xd0,
-	0x06, 0x9d, 0x9d, 0x5e, 0xa3, 0xc8, 0xa8, 0x2b, 0x4b, 0x10, 0x54, 0x1c, 0x45, 0xd0, 0xbd, 0x64,
-	0x57, 0x53, 0x8e, 0x6f, 0xb5, 0x41, 0xa7, 0xd5, 0x1b, 0x94, 0x86, 0x59, 0x09, 0x7e, 0xfc, 0xa2,
-	0xa7, 0x09, 0x33, 0x63, 0x7f, 0x24, 0xe2, 0x6e, 0x5f, 0x9a, 0x93, 0xda, 0x41, 0x2e, 0xae, 0xb4,
-	0x92, 0xd1, 0x80, 0x9b, 0x4f, 0x4a, 0x7f, 0xf4, 0xb9, 0x9d, 0x8e, 0x63, 0xe5, 0x47, 0xcc, 0xb0,
-	0x6e, 0x4f, 0xc4, 0x7d, 0x69, 0xce, 0x58, 0x6a, 0xb8, 0x0e, 0xaa, 0xe5, 0xe8, 0x08, 0x7a, 0x41,
-	0x38, 0x3b, 0x8d, 0x22, 0x8d, 0x6f, 0xdb, 0x9e, 0x56, 0x91, 0xd1, 0x3d, 0xcd, 0x43, 0x2e, 0x66,
-	0x5c, 0x07, 0x1b, 0x11, 0x9d, 0xc0, 0x66, 0x10, 0xce, 0xde, 0xa5, 0x5c, 0x0f, 0x58, 0xc2, 0xf1,
-	0x8e, 0xf5, 0x3e, 0x28, 0x32, 0x7a, 0xa8, 0xb7, 0xf8, 0xa9, 0x4a, 0x84, 0xe1, 0xc9, 0xc4, 0x7c,
-	0x0e, 0xea, 0x6e, 0xf4, 0x18, 0x7a, 0x43, 0x19, 0xd9, 0x12, 0xd7, 0x06, 0x61, 0x91, 0xd1, 0xdd,
-	0x94, 0xcb, 0xa8, 0xac, 0x58, 0x4b, 0x65, 0xc5, 0x50, 0x46, 0x37, 0x15, 0xbb, 0xdb, 0x8a, 0x74,
-	0x8b, 0xeb, 0x15, 0x35, 0x37, 0x7a, 0x06, 0xf7, 0x5e, 0xb2, 0xf4, 0x8d, 0x16, 0x21, 0xc7, 0x9e,
-	0xbd, 0xd1, 0x7b, 0x45, 0x46, 0x51, 0xbc, 0x66, 0xb5, 0xd8, 0x8d, 0x6f, 0x9d, 0x79, 0x25, 0x12,
-	0x61, 0xf0, 0xde, 0x3f, 0x19, 0xcb, 0xfe, 0xcb, 0x58, 0x86, 0x8e, 0xe0, 0xce, 0x39, 0x33, 0x0c,
-	0x37, 0xec, 0xe9, 0x50, 0x91, 0xd1, 0x3b, 0xe5, 0xdd, 0xd6, 0xbc, 0x56, 0x47, 0x4f, 0xa0, 0x77,
-	0x36, 0x66, 0x42, 0xf6, 0xcf, 0x31, 0xb4, 0xd6, 0x66, 0x91, 0x51, 0x2f, 0xac, 0x50, 0xb0, 0xd1,
-	0x4a, 0xdb, 0x25, 0xd7, 0xa9, 0x50, 0x12, 0x37, 0xdb, 0xa0, 0xb3, 0x5f, 0xd9, 0x66, 0x15, 0x0a,
-	0x36, 0x1a, 0x7a, 0x01, 0x1b, 0x43, 0x11, 0x4b, 0x66, 0xa6, 0x9a, 0xe3, 0x96, 0xdd, 0x77, 0xbf,
-	0xc8, 0xe8, 0xdd, 0x74, 0x03, 0x6b, 0xfd, 0x5b, 0x27, 0xf2, 0xa1, 0xf7, 0x7a, 0x52, 0xbe, 0xb6,
-	0x14, 0xef, 0xdb, 0xed, 0x87, 0x45, 0x46, 0x0f, 0x54, 0x85, 0x6a, 0x91, 0x8d, 0xab, 0x77, 0x31,
-	0x5f, 0x12, 0x67, 0xb1, 0x24, 0xce, 0xf5, 0x92, 0x80, 0x2f, 0x39, 0x01, 0xdf, 0x73, 0x02, 0x7e,
-	0xe6, 0x04, 0xcc, 0x73, 0x02, 0x16, 0x39, 0x01, 0xbf, 0x73, 0x02, 0xfe, 0xe4, 0xc4, 0xb9, 0xce,
-	0x09, 0xf8, 0xba, 0x22, 0xce, 0x7c, 0x45, 0x9c, 0xc5, 0x8a, 0x38, 0xef, 0x9b, 0xb5, 0xef, 0x64,
-	0xb4, 0x6b, 0x9f, 0xfc, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0xda, 0x4b, 0xdc, 0x3d,
-	0x03, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x94, 0xdd, 0x85, 0xe9, 0x82, 0x30, 0x06, 0xac, 0x1c, 0x66, 0x88, 0x51, 0xc2,
+	0x41, 0xb6, 0x89, 0xc6, 0x78, 0x20, 0x31, 0x61, 0x81, 0x18, 0x8c, 0x59, 0x4d, 0x51, 0x0e, 0xde,
+	0x66, 0xdb, 0xb1, 0x4c, 0xa4, 0x33, 0x64, 0x3a, 0xbb, 0x86, 0x9b, 0x1f, 0xc1, 0x8f, 0x61, 0xfc,
+	0x24, 0x1e, 0x39, 0x72, 0xaa, 0x52, 0x0e, 0x9a, 0x9e, 0xf8, 0x08, 0xa6, 0xaf, 0xbb, 0xb4, 0x8b,
+	0x9e, 0x76, 0xdf, 0xef, 0xfd, 0xff, 0xef, 0x3f, 0x99, 0xe9, 0xc3, 0x4b, 0xd6, 0x70, 0x95, 0xf0,
+	0xc0, 0x4a, 0xad, 0xba, 0x27, 0x46, 0x5b, 0x4d, 0x9a, 0xf0, 0xb3, 0xba, 0x19, 0x49, 0x7b, 0x34,
+	0x1c, 0x74, 0x03, 0x1d, 0x7b, 0x91, 0x8e, 0xb4, 0x07, 0x78, 0x30, 0xfc, 0x08, 0x15, 0x14, 0xf0,
+	0xaf, 0x74, 0x3d, 0xf8, 0xdd, 0xc2, 0xce, 0xbb, 0x6a, 0x16, 0x61, 0xb8, 0xd9, 0xd7, 0x2a, 0x10,
+	0x2e, 0x5a, 0x43, 0x1b, 0x33, 0xbd, 0xb9, 0x3c, 0x65, 0x4d, 0x55, 0x00, 0xbf, 0xe4, 0x24, 0xc4,
+	0xcd, 0x43, 0x7e, 0x3c, 0x14, 0xee, 0xad, 0x35, 0xb4, 0xd1, 0xe9, 0xf5, 0x0b, 0xc1, 0xa8, 0x00,
+	0xdf, 0x7f, 0xb2, 0xed, 0x98, 0xdb, 0x23, 0x6f, 0x20, 0xa3, 0xee, 0xbe, 0xb2, 0x5b, 0xb5, 0x83,
+	0xec, 0x1d, 0x1b, 0xad, 0xc2, 0xbe, 0xb0, 0x9f, 0xb5, 0xf9, 0xe4, 0x09, 0xa8, 0x36, 0x23, 0xed,
+	0x85, 0xdc, 0xf2, 0x6e, 0x4f, 0x46, 0xfb, 0xca, 0xee, 0xf0, 0xc4, 0x0a, 0xe3, 0x97, 0xc3, 0xc9,
+	0x3a, 0x6e, 0xfb, 0xc1, 0x68, 0x3b, 0x0c, 0x8d, 0x7b, 0x1b, 0x72, 0x3a, 0x79, 0xca, 0x66, 0x8d,
+	0x08, 0x84, 0x1c, 0x09, 0xe3, 0x4f, 0x9a, 0x64, 0x0b, 0x3b, 0x7e, 0x30, 0x7a, 0x9f, 0x08, 0xd3,
+	0xe7, 0xb1, 0x70, 0x67, 0x40, 0x7b, 0x3f, 0x4f, 0xd9, 0xb2, 0xa9, 0xf0, 0x63, 0x1d, 0x4b, 0x2b,
+	0xe2, 0x13, 0x7b, 0xea, 0xd7, 0xd5, 0xe4, 0x21, 0x6e, 0x1f, 0xa8, 0x10, 0x42, 0x9a, 0x60, 0xc4,
+	0x79, 0xca, 0x5a, 0x89, 0x50, 0x61, 0x11, 0x31, 0x6e, 0x15, 0x11, 0x07, 0x2a, 0xbc, 0x8e, 0x68,
+	0x55, 0x11, 0x89, 0x0a, 0xff, 0x17, 0x51, 0x53, 0x93, 0x27, 0x78, 0xf6, 0x25, 0x4f, 0xde, 0x1a,
+	0x19, 0x08, 0xb7, 0x0d, 0x37, 0xba, 0x92, 0xa7, 0x8c, 0x44, 0x63, 0x56, 0xb3, 0x5d, 0xeb, 0xc6,
+	0x9e, 0xd7, 0x32, 0x96, 0xd6, 0x9d, 0x9d, 0xf2, 0x00, 0xbb, 0xe1, 0x01, 0x46, 0xd6, 0xf1, 0xcc,
+	0x2e, 0xb7, 0xdc, 0x9d, 0x83, 0xd3, 0x91, 0x3c, 0x65, 0x0b, 0xc5, 0xdd, 0xd6, 0xb4, 0xd0, 0x27,
+	0x8f, 0x70, 0x7b, 0xe7, 0x88, 0x4b, 0xb5, 0xbf, 0xeb, 0x62, 0x90, 0x3a, 0x79, 0xca, 0xda, 0x41,
+	0x89, 0xfc, 0x49, 0xaf, 0x90, 0x1d, 0x0a, 0x93, 0x48, 0xad, 0x5c, 0x67, 0x0d, 0x6d, 0xcc, 0x97,
+	0xb2, 0x51, 0x89, 0xfc, 0x49, 0x8f, 0x3c, 0xc3, 0x73, 0x07, 0x32, 0x52, 0xdc, 0x0e, 0x8d, 0x70,
+	0x3b, 0x30, 0xef, 0x5e, 0x9e, 0xb2, 0xbb, 0xc9, 0x04, 0xd6, 0xf2, 0x2b, 0x25, 0xf1, 0x70, 0xfb,
+	0xcd, 0x49, 0xf1, 0xb5, 0x25, 0xee, 0x3c, 0x4c, 0x5f, 0xce, 0x53, 0xb6, 0xa4, 0x4b, 0x54, 0xb3,
+	0x4c, 0x54, 0xe4, 0x39, 0x76, 0x7c, 0x71, 0xcc, 0x4f, 0x85, 0x81, 0xc7, 0x5a, 0x80, 0x24, 0x30,
+	0x99, 0x12, 0x4f, 0xbd, 0x70, 0xa5, 0x24, 0xaf, 0xf0, 0xe2, 0xb8, 0xac, 0xce, 0x79, 0x07, 0xdc,
+	0x34, 0x4f, 0xd9, 0xaa, 0xb9, 0xd1, 0xab, 0x8d, 0xf9, 0xc7, 0x47, 0x5e, 0xe0, 0xce, 0x98, 0x95,
+	0x0b, 0xb2, 0x08, 0x4f, 0xb3, 0x9a, 0xa7, 0x6c, 0xc5, 0xd4, 0x78, 0x6d, 0xc6, 0x94, 0xbe, 0xb7,
+	0x77, 0x76, 0x41, 0x1b, 0xe7, 0x17, 0xb4, 0x71, 0x75, 0x41, 0xd1, 0x97, 0x8c, 0xa2, 0x6f, 0x19,
+	0x45, 0x3f, 0x32, 0x8a, 0xce, 0x32, 0x8a, 0xce, 0x33, 0x8a, 0x7e, 0x65, 0x14, 0xfd, 0xc9, 0x68,
+	0xe3, 0x2a, 0xa3, 0xe8, 0xeb, 0x25, 0x6d, 0x9c, 0x5d, 0xd2, 0xc6, 0xf9, 0x25, 0x6d, 0x7c, 0x70,
+	0x6a, 0xcb, 0x3e, 0x68, 0xc1, 0xde, 0x3e, 0xfd, 0x3b, 0x00, 0xa6, 0xd2, 0xf3, 0xdf, 0x02, 0x04,
+	0x00, 0x00,
 }
 
 func (this *Transaction) Equal(that interface{}) bool {
@@ -268,13 +296,22 @@ func (this *Transaction) Equal(that interface{}) bool {
 	if this.Options != that1.Options {
 		return false
 	}
+	if !bytes.Equal(this.RelayerAddr, that1.RelayerAddr) {
+		return false
+	}
+	if !bytes.Equal(this.RelayerSignature, that1.RelayerSignature) {
+		return false
+	}
+	if this.RelayerNonce != that1.RelayerNonce {
+		return false
+	}
 	return true
 }
 func (this *Transaction) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 20)
 	s = append(s, "&transaction.Transaction{")
 	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
@@ -289,6 +326,9 @@ func (this *Transaction) GoString() string {
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
 	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	s = append(s, "RelayerAddr: "+fmt.Sprintf("%#v", this.RelayerAddr)+",\n")
+	s = append(s, "RelayerSignature: "+fmt.Sprintf("%#v", this.RelayerSignature)+",\n")
+	s = append(s, "RelayerNonce: "+fmt.Sprintf("%#v", this.RelayerNonce)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -320,6 +360,27 @@ func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RelayerNonce != 0 {
+		i = encodeVarintTransaction(dAtA, i, uint64(m.RelayerNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RelayerSignature) > 0 {
+		i -= len(m.RelayerSignature)
+		copy(dAtA[i:], m.RelayerSignature)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.RelayerSignature)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.RelayerAddr) > 0 {
+		i -= len(m.RelayerAddr)
+		copy(dAtA[i:], m.RelayerAddr)
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.RelayerAddr)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Options != 0 {
 		i = encodeVarintTransaction(dAtA, i, uint64(m.Options))
 		i--
@@ -473,6 +534,17 @@ func (m *Transaction) Size() (n int) {
 	if m.Options != 0 {
 		n += 1 + sovTransaction(uint64(m.Options))
 	}
+	l = len(m.RelayerAddr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.RelayerSignature)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.RelayerNonce != 0 {
+		n += 2 + sovTransaction(uint64(m.RelayerNonce))
+	}
 	return n
 }
 
@@ -500,6 +572,9 @@ func (this *Transaction) String() string {
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
 		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`RelayerAddr:` + fmt.Sprintf("%v", this.RelayerAddr) + `,`,
+		`RelayerSignature:` + fmt.Sprintf("%v", this.RelayerSignature) + `,`,
+		`RelayerNonce:` + fmt.Sprintf("%v", this.RelayerNonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -912,6 +987,93 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddr = append(m.RelayerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.RelayerAddr == nil {
+				m.RelayerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerSignature = append(m.RelayerSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.RelayerSignature == nil {
+				m.RelayerSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerNonce", wireType)
+			}
+			m.RelayerNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -261,3 +261,29 @@ func TestTransaction_CheckIntegrityShouldErr(t *testing.T) {
 	err = tx.CheckIntegrity()
 	assert.Equal(t, data.ErrInvalidUserNameLength, err)
 }
+
+func TestTransaction_CheckIntegrityRelayedV3ShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:       1,
+		Value:       big.NewInt(10),
+		GasPrice:    1,
+		GasLimit:    10,
+		Signature:   []byte("signature"),
+		RelayerAddr: []byte("relayer"),
+	}
+
+	err := tx.CheckIntegrity()
+	assert.Equal(t, data.ErrNilRelayerSignature, err)
+
+	tx.RelayerAddr = nil
+	tx.RelayerSignature = []byte("relayer signature")
+	err = tx.CheckIntegrity()
+	assert.Equal(t, data.ErrNilRelayerAddress, err)
+
+	tx.RelayerAddr = []byte("relayer")
+	err = tx.CheckIntegrity()
+	assert.Nil(t, err)
+	assert.True(t, tx.IsRelayedV3())
+}
@@ -39,10 +39,26 @@ func (ut UnitType) String() string {
 		return "BootstrapUnit"
 	case StatusMetricsUnit:
 		return "StatusMetricsUnit"
+	case TxLogsUnit:
+		return "TxLogsUnit"
+	case MiniblocksMetadataUnit:
+		return "MiniblocksMetadataUnit"
+	case EpochByHashUnit:
+		return "EpochByHashUnit"
+	case MiniblockHashByTxHashUnit:
+		return "MiniblockHashByTxHashUnit"
 	case ReceiptsUnit:
 		return "ReceiptsUnit"
+	case ResultsHashesByTxHashUnit:
+		return "ResultsHashesByTxHashUnit"
 	case TrieEpochRootHashUnit:
 		return "TrieEpochRootHashUnit"
+	case ScheduledSCRsUnit:
+		return "ScheduledSCRsUnit"
+	case EventsIndexUnit:
+		return "EventsIndexUnit"
+	case ESDTSuppliesUnit:
+		return "ESDTSuppliesUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -89,6 +105,12 @@ const (
 	ResultsHashesByTxHashUnit UnitType = 16
 	// TrieEpochRootHashUnit is the trie epoch <-> root hash storage unit identifier
 	TrieEpochRootHashUnit UnitType = 17
+	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
+	ScheduledSCRsUnit UnitType = 18
+	// EventsIndexUnit is the smart contract events index storage unit identifier
+	EventsIndexUnit UnitType = 19
+	// ESDTSuppliesUnit is the ESDT supplies storage unit identifier
+	ESDTSuppliesUnit UnitType = 20
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -13,6 +13,7 @@ import (
 	disabledGenesis "github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
+	syncIntrospectionDisabled "github.com/ElrondNetwork/elrond-go/process/sync/introspection/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -37,6 +38,7 @@ type ArgsEpochStartInterceptorContainer struct {
 	ArgumentsParser           process.ArgumentsParser
 	HeaderIntegrityVerifier   process.HeaderIntegrityVerifier
 	EnableSignTxWithHashEpoch uint32
+	EnableRelayedTxV3Epoch    uint32
 	EpochNotifier             process.EpochNotifier
 	RequestHandler            process.RequestHandler
 }
@@ -93,8 +95,10 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		AntifloodHandler:          antiFloodHandler,
 		ArgumentsParser:           args.ArgumentsParser,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    args.EnableRelayedTxV3Epoch,
 		PreferredPeersHolder:      disabled.NewPreferredPeersHolder(),
 		RequestHandler:            args.RequestHandler,
+		SyncIntrospector:          syncIntrospectionDisabled.NewSyncIntrospector(),
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
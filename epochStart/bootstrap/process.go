@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/snapshotFile"
 	"github.com/ElrondNetwork/elrond-go/data/syncer"
 	"github.com/ElrondNetwork/elrond-go/data/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters/uint64ByteSlice"
@@ -94,6 +95,7 @@ type epochStartBootstrap struct {
 	statusHandler              core.AppStatusHandler
 	headerIntegrityVerifier    process.HeaderIntegrityVerifier
 	enableSignTxWithHashEpoch  uint32
+	enableRelayedTxV3Epoch     uint32
 	epochNotifier              process.EpochNotifier
 	numConcurrentTrieSyncers   int
 	maxHardCapForMissingNodes  int
@@ -185,6 +187,7 @@ func NewEpochStartBootstrap(args ArgsEpochStartBootstrap) (*epochStartBootstrap,
 		argumentsParser:            args.ArgumentsParser,
 		headerIntegrityVerifier:    args.HeaderIntegrityVerifier,
 		enableSignTxWithHashEpoch:  args.EpochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
+		enableRelayedTxV3Epoch:     args.EpochConfig.EnableEpochs.RelayedTransactionsV3EnableEpoch,
 		epochNotifier:              args.CoreComponentsHolder.EpochNotifier(),
 		numConcurrentTrieSyncers:   args.GeneralConfig.TrieSync.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes:  args.GeneralConfig.TrieSync.MaxHardCapForMissingNodes,
@@ -464,15 +467,7 @@ func (e *epochStartBootstrap) prepareComponentsToSyncFromNetwork() error {
 	}
 
 	epochStartConfig := e.generalConfig.EpochStartConfig
-	metablockProcessor, err := NewEpochStartMetaBlockProcessor(
-		e.messenger,
-		e.requestHandler,
-		e.coreComponentsHolder.InternalMarshalizer(),
-		e.coreComponentsHolder.Hasher(),
-		thresholdForConsideringMetaBlockCorrect,
-		epochStartConfig.MinNumConnectedPeersToStart,
-		epochStartConfig.MinNumOfPeersToConsiderBlockValid,
-	)
+	metablockProcessor, err := e.createEpochStartMetaBlockProcessor(epochStartConfig)
 	if err != nil {
 		return err
 	}
@@ -497,6 +492,28 @@ func (e *epochStartBootstrap) prepareComponentsToSyncFromNetwork() error {
 	return nil
 }
 
+func (e *epochStartBootstrap) createEpochStartMetaBlockProcessor(
+	epochStartConfig config.EpochStartConfig,
+) (EpochStartMetaBlockInterceptorProcessor, error) {
+	if epochStartConfig.TrustedCheckpoint.Enabled {
+		return NewTrustedEpochStartMetaBlockProcessor(
+			e.messenger,
+			e.requestHandler,
+			epochStartConfig.TrustedCheckpoint,
+		)
+	}
+
+	return NewEpochStartMetaBlockProcessor(
+		e.messenger,
+		e.requestHandler,
+		e.coreComponentsHolder.InternalMarshalizer(),
+		e.coreComponentsHolder.Hasher(),
+		thresholdForConsideringMetaBlockCorrect,
+		epochStartConfig.MinNumConnectedPeersToStart,
+		epochStartConfig.MinNumOfPeersToConsiderBlockValid,
+	)
+}
+
 func (e *epochStartBootstrap) createSyncers() error {
 	var err error
 
@@ -512,6 +529,7 @@ func (e *epochStartBootstrap) createSyncers() error {
 		ArgumentsParser:           e.argumentsParser,
 		HeaderIntegrityVerifier:   e.headerIntegrityVerifier,
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    e.enableRelayedTxV3Epoch,
 		EpochNotifier:             e.epochNotifier,
 		RequestHandler:            e.requestHandler,
 	}
@@ -683,7 +701,7 @@ func (e *epochStartBootstrap) processNodesConfig(pubKey []byte) error {
 		WaitingListFixEnableEpoch: e.waitingListFixEnableEpoch,
 		ChanNodeStop:              e.coreComponentsHolder.ChanStopNodeProcess(),
 		NodeTypeProvider:          e.coreComponentsHolder.NodeTypeProvider(),
-		IsFullArchive:             e.prefsConfig.FullArchive,
+		IsFullArchive:             storageFactory.KeepsAllEpochs(e.prefsConfig),
 	}
 
 	e.nodesConfigHandler, err = NewSyncValidatorStatus(argsNewValidatorStatusSyncers)
@@ -804,23 +822,6 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		return epochStart.ErrWrongTypeAssertion
 	}
 
-	log.Debug("start in epoch bootstrap: started syncUserAccountsState")
-	err = e.syncUserAccountsState(ownShardHdr.RootHash)
-	if err != nil {
-		return err
-	}
-	log.Debug("start in epoch bootstrap: syncUserAccountsState")
-
-	components := &ComponentsNeededForBootstrap{
-		EpochStartMetaBlock: e.epochStartMeta,
-		PreviousEpochStart:  e.prevEpochStartMeta,
-		ShardHeader:         ownShardHdr,
-		NodesConfig:         e.nodesConfig,
-		Headers:             e.syncedHeaders,
-		ShardCoordinator:    e.shardCoordinator,
-		PendingMiniBlocks:   pendingMiniBlocks,
-	}
-
 	storageHandlerComponent, err := NewShardStorageHandler(
 		e.generalConfig,
 		e.prefsConfig,
@@ -836,6 +837,28 @@ func (e *epochStartBootstrap) requestAndProcessForShard() error {
 		return err
 	}
 
+	// the state of the shard header includes the execution of its scheduled transactions, the same as when the
+	// state is reverted to a block, if their results are available
+	rootHash := storageHandlerComponent.getRootHashAfterScheduledExecution(epochStartData.HeaderHash, ownShardHdr.RootHash)
+
+	log.Debug("start in epoch bootstrap: started syncUserAccountsState", "root hash", rootHash)
+	err = e.syncUserAccountsState(rootHash)
+	if err != nil {
+		storageHandlerComponent.closeStorageService()
+		return err
+	}
+	log.Debug("start in epoch bootstrap: syncUserAccountsState")
+
+	components := &ComponentsNeededForBootstrap{
+		EpochStartMetaBlock: e.epochStartMeta,
+		PreviousEpochStart:  e.prevEpochStartMeta,
+		ShardHeader:         ownShardHdr,
+		NodesConfig:         e.nodesConfig,
+		Headers:             e.syncedHeaders,
+		ShardCoordinator:    e.shardCoordinator,
+		PendingMiniBlocks:   pendingMiniBlocks,
+	}
+
 	errSavingToStorage := storageHandlerComponent.SaveDataToStorage(components)
 	if errSavingToStorage != nil {
 		return errSavingToStorage
@@ -854,6 +877,8 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
 	e.mutTrieStorageManagers.RUnlock()
 
+	e.importStateSnapshotFile(factory.UserAccountTrie, rootHash, trieStorageManager)
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
@@ -882,6 +907,35 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+// importStateSnapshotFile loads the trie nodes from the configured snapshot file, if any, so the accounts syncer finds
+// them locally. The root hash comes from the epoch start metablock, so a wrong or incomplete file is never trusted: the
+// import fails, or the missing nodes are requested from the network by the syncer
+func (e *epochStartBootstrap) importStateSnapshotFile(trieIdentifier string, rootHash []byte, storageManager data.StorageManager) {
+	filePath := e.generalConfig.StateSnapshotFiles.ImportFilePath
+	if len(filePath) == 0 || check.IfNil(storageManager) {
+		return
+	}
+
+	importer, err := snapshotFile.NewImporter(snapshotFile.ArgsImporter{
+		Marshalizer: e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:      e.coreComponentsHolder.Hasher(),
+	})
+	if err != nil {
+		log.Warn("start in epoch bootstrap: can not create the state snapshot file importer", "error", err)
+		return
+	}
+
+	numNodes, err := importer.ImportTrieFromFile(filePath, trieIdentifier, rootHash, storageManager.Database())
+	if err != nil {
+		log.Warn("start in epoch bootstrap: state snapshot file import failed, syncing the trie from the network",
+			"trie", trieIdentifier, "file", filePath, "error", err)
+		return
+	}
+
+	log.Info("start in epoch bootstrap: imported trie from state snapshot file",
+		"trie", trieIdentifier, "root hash", rootHash, "num nodes", numNodes)
+}
+
 func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) error {
 	e.tryCloseExisting(factory.UserAccountTrie)
 	e.tryCloseExisting(factory.PeerAccountTrie)
@@ -952,6 +1006,8 @@ func (e *epochStartBootstrap) syncValidatorAccountsState(rootHash []byte) error
 	peerTrieStorageManager := e.trieStorageManagers[factory.PeerAccountTrie]
 	e.mutTrieStorageManagers.RUnlock()
 
+	e.importStateSnapshotFile(factory.PeerAccountTrie, rootHash, peerTrieStorageManager)
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.coreComponentsHolder.Hasher(),
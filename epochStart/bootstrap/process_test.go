@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 	"errors"
+	"os"
 	"testing"
 	"time"
 
@@ -481,8 +482,13 @@ func TestSyncUserAccountsState(t *testing.T) {
 }
 
 func TestRequestAndProcessForShard(t *testing.T) {
+	defer func() {
+		_ = os.RemoveAll("./Epoch_0")
+	}()
+
 	coreComp, cryptoComp := createComponentsForEpochStart()
 	args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+	args.GeneralConfig = testscommon.GetGeneralConfig()
 
 	hdrHash1 := []byte("hdrHash1")
 	header1 := &block.Header{}
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
@@ -71,14 +72,34 @@ func NewShardStorageHandler(
 	return &shardStorageHandler{baseStorageHandler: base}, nil
 }
 
+// getRootHashAfterScheduledExecution returns the root hash resulted after the execution of the scheduled transactions
+// included in the shard header with the given hash, if the scheduled results are available in the storage, or the
+// given root hash otherwise
+func (ssh *shardStorageHandler) getRootHashAfterScheduledExecution(headerHash []byte, rootHash []byte) []byte {
+	buff, err := ssh.storageService.Get(dataRetriever.ScheduledSCRsUnit, headerHash)
+	if err != nil {
+		return rootHash
+	}
+
+	scheduledResults := &scheduled.ScheduledSCRs{}
+	err = ssh.marshalizer.Unmarshal(scheduledResults, buff)
+	if err != nil || len(scheduledResults.RootHash) == 0 {
+		return rootHash
+	}
+
+	return scheduledResults.RootHash
+}
+
+func (ssh *shardStorageHandler) closeStorageService() {
+	err := ssh.storageService.CloseAll()
+	if err != nil {
+		log.Warn("error while closing storers", "error", err)
+	}
+}
+
 // SaveDataToStorage will save the fetched data to storage so it will be used by the storage bootstrap component
 func (ssh *shardStorageHandler) SaveDataToStorage(components *ComponentsNeededForBootstrap) error {
-	defer func() {
-		err := ssh.storageService.CloseAll()
-		if err != nil {
-			log.Warn("error while closing storers", "error", err)
-		}
-	}()
+	defer ssh.closeStorageService()
 
 	bootStorer := ssh.storageService.GetStorer(dataRetriever.BootstrapUnit)
 
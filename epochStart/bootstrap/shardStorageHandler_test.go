@@ -8,6 +8,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -137,6 +139,32 @@ func TestShardStorageHandler_SaveDataToStorage(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestShardStorageHandler_GetRootHashAfterScheduledExecution(t *testing.T) {
+	defer func() {
+		_ = os.RemoveAll("./Epoch_0")
+	}()
+
+	gCfg := testscommon.GetGeneralConfig()
+	prefsConfig := config.PreferencesConfig{}
+	coordinator := &mock.ShardCoordinatorStub{}
+	pathManager := &testscommon.PathManagerStub{}
+	marshalizer := &mock.MarshalizerMock{}
+	hasher := &mock.HasherMock{}
+	uit64Cvt := &mock.Uint64ByteSliceConverterMock{}
+	nodeTypeProvider := &nodeTypeProviderMock.NodeTypeProviderStub{}
+
+	shardStrHandler, _ := NewShardStorageHandler(gCfg, prefsConfig, coordinator, pathManager, marshalizer, hasher, 1, uit64Cvt, nodeTypeProvider)
+	defer shardStrHandler.closeStorageService()
+
+	rootHash := []byte("root hash")
+	scheduledHeaderHash := []byte("scheduled header hash")
+	buff, _ := marshalizer.Marshal(&scheduled.ScheduledSCRs{RootHash: []byte("scheduled root hash")})
+	_ = shardStrHandler.storageService.Put(dataRetriever.ScheduledSCRsUnit, scheduledHeaderHash, buff)
+
+	assert.Equal(t, rootHash, shardStrHandler.getRootHashAfterScheduledExecution([]byte("header hash"), rootHash))
+	assert.Equal(t, []byte("scheduled root hash"), shardStrHandler.getRootHashAfterScheduledExecution(scheduledHeaderHash, rootHash))
+}
+
 func TestGetAllMiniBlocksWithDst(t *testing.T) {
 	t.Parallel()
 
@@ -407,7 +407,7 @@ func (sesb *storageEpochStartBootstrap) processNodesConfig(pubKey []byte) error
 		ShardIdAsObserver:  shardId,
 		ChanNodeStop:       sesb.coreComponentsHolder.ChanStopNodeProcess(),
 		NodeTypeProvider:   sesb.coreComponentsHolder.NodeTypeProvider(),
-		IsFullArchive:      sesb.prefsConfig.FullArchive,
+		IsFullArchive:      storageFactory.KeepsAllEpochs(sesb.prefsConfig),
 	}
 	sesb.nodesConfigHandler, err = NewSyncValidatorStatus(argsNewValidatorStatusSyncers)
 	if err != nil {
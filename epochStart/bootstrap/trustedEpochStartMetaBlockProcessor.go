@@ -0,0 +1,186 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+)
+
+var _ process.InterceptorProcessor = (*trustedEpochStartMetaBlockProcessor)(nil)
+
+// trustedEpochStartMetaBlockProcessor accepts the first received epoch start metablock matching the trusted
+// checkpoint, without waiting for a majority of the connected peers to agree on it
+type trustedEpochStartMetaBlockProcessor struct {
+	messenger      Messenger
+	requestHandler RequestHandler
+	epoch          uint32
+	hash           []byte
+	mutMetaBlock   sync.RWMutex
+	metaBlock      *block.MetaBlock
+	chanReceived   chan struct{}
+}
+
+// NewTrustedEpochStartMetaBlockProcessor will return an interceptor processor for the epoch start meta block
+// described by the provided trusted checkpoint
+func NewTrustedEpochStartMetaBlockProcessor(
+	messenger Messenger,
+	handler RequestHandler,
+	checkpoint config.TrustedCheckpointConfig,
+) (*trustedEpochStartMetaBlockProcessor, error) {
+	if check.IfNil(messenger) {
+		return nil, epochStart.ErrNilMessenger
+	}
+	if check.IfNil(handler) {
+		return nil, epochStart.ErrNilRequestHandler
+	}
+	hash, err := hex.DecodeString(checkpoint.MetaBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", epochStart.ErrInvalidTrustedCheckpoint, err.Error())
+	}
+	if len(hash) == 0 {
+		return nil, fmt.Errorf("%w: empty metablock hash", epochStart.ErrInvalidTrustedCheckpoint)
+	}
+
+	processor := &trustedEpochStartMetaBlockProcessor{
+		messenger:      messenger,
+		requestHandler: handler,
+		epoch:          checkpoint.Epoch,
+		hash:           hash,
+		chanReceived:   make(chan struct{}, 1),
+	}
+
+	log.Info("epoch bootstrapper: using trusted checkpoint", "epoch", checkpoint.Epoch, "metablock hash", hash)
+
+	return processor, nil
+}
+
+// Validate will return nil as there is no need for validation
+func (t *trustedEpochStartMetaBlockProcessor) Validate(_ process.InterceptedData, _ core.PeerID) error {
+	return nil
+}
+
+// Save will keep the received metablock if it matches the trusted checkpoint
+// All errors are just logged because if this function returns an error, the processing is finished. This way, we ignore
+// wrong received data and wait for relevant intercepted data
+func (t *trustedEpochStartMetaBlockProcessor) Save(data process.InterceptedData, fromConnectedPeer core.PeerID, _ string) error {
+	if check.IfNil(data) {
+		log.Debug("epoch bootstrapper: nil intercepted data")
+		return nil
+	}
+
+	interceptedHdr, ok := data.(process.HdrValidatorHandler)
+	if !ok {
+		log.Warn("saving trusted epoch start meta block error", "error", epochStart.ErrWrongTypeAssertion)
+		return nil
+	}
+
+	metaBlock, ok := interceptedHdr.HeaderHandler().(*block.MetaBlock)
+	if !ok {
+		log.Warn("saving trusted epoch start meta block error", "error", epochStart.ErrWrongTypeAssertion,
+			"header", interceptedHdr.HeaderHandler())
+		return nil
+	}
+
+	if !bytes.Equal(interceptedHdr.Hash(), t.hash) {
+		log.Debug("received metablock does not match the trusted checkpoint",
+			"epoch", metaBlock.GetEpoch(), "hash", interceptedHdr.Hash(), "from peer", fromConnectedPeer.Pretty())
+		return nil
+	}
+	if !metaBlock.IsStartOfEpochBlock() || metaBlock.GetEpoch() != t.epoch {
+		log.Warn("received metablock with the trusted hash is not the expected epoch start block",
+			"expected epoch", t.epoch, "epoch", metaBlock.GetEpoch(), "error", epochStart.ErrNotEpochStartBlock)
+		return nil
+	}
+
+	log.Debug("received trusted epoch start meta", "epoch", metaBlock.GetEpoch(), "from peer", fromConnectedPeer.Pretty())
+
+	t.mutMetaBlock.Lock()
+	defer t.mutMetaBlock.Unlock()
+
+	if t.metaBlock != nil {
+		return nil
+	}
+	t.metaBlock = metaBlock
+	t.chanReceived <- struct{}{}
+
+	return nil
+}
+
+// GetEpochStartMetaBlock will return the trusted metablock after it was received or an error if the context is done
+// This is a blocking method which will end after the trusted metablock is received or the context is done
+func (t *trustedEpochStartMetaBlockProcessor) GetEpochStartMetaBlock(ctx context.Context) (*block.MetaBlock, error) {
+	originalIntra, originalCross, err := t.requestHandler.GetNumPeersToQuery(factory.MetachainBlocksTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		err = t.requestHandler.SetNumPeersToQuery(factory.MetachainBlocksTopic, originalIntra, originalCross)
+		if err != nil {
+			log.Warn("epoch bootstrapper: error setting num of peers intra/cross for resolver",
+				"resolver", factory.MetachainBlocksTopic,
+				"error", err)
+		}
+	}()
+
+	err = t.requestMetaBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	chanRequests := time.After(durationBetweenReRequests)
+	for {
+		select {
+		case <-t.chanReceived:
+			t.mutMetaBlock.RLock()
+			metaBlock := t.metaBlock
+			t.mutMetaBlock.RUnlock()
+
+			return metaBlock, nil
+		case <-ctx.Done():
+			return nil, epochStart.ErrTimeoutWaitingForMetaBlock
+		case <-chanRequests:
+			err = t.requestMetaBlock()
+			if err != nil {
+				return nil, err
+			}
+			chanRequests = time.After(durationBetweenReRequests)
+		}
+	}
+}
+
+func (t *trustedEpochStartMetaBlockProcessor) requestMetaBlock() error {
+	numConnectedPeers := len(t.messenger.ConnectedPeers())
+	if numConnectedPeers < minNumConnectedPeers {
+		log.Debug("epoch bootstrapper: no connected peers to request the trusted epoch start meta block from")
+		return nil
+	}
+
+	err := t.requestHandler.SetNumPeersToQuery(factory.MetachainBlocksTopic, numConnectedPeers, numConnectedPeers)
+	if err != nil {
+		return err
+	}
+
+	t.requestHandler.RequestStartOfEpochMetaBlock(t.epoch)
+	return nil
+}
+
+// RegisterHandler registers a callback function to be notified of incoming epoch start metablocks
+func (t *trustedEpochStartMetaBlockProcessor) RegisterHandler(_ func(topic string, hash []byte, data interface{})) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *trustedEpochStartMetaBlockProcessor) IsInterfaceNil() bool {
+	return t == nil
+}
@@ -0,0 +1,114 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTrustedCheckpoint() config.TrustedCheckpointConfig {
+	return config.TrustedCheckpointConfig{
+		Enabled:       true,
+		Epoch:         5,
+		MetaBlockHash: hex.EncodeToString([]byte("trusted hash")),
+	}
+}
+
+func createConnectedMessengerStub() *mock.MessengerStub {
+	return &mock.MessengerStub{
+		ConnectedPeersCalled: func() []core.PeerID {
+			return []core.PeerID{"peer_0"}
+		},
+	}
+}
+
+func TestNewTrustedEpochStartMetaBlockProcessor(t *testing.T) {
+	t.Parallel()
+
+	tesmbp, err := NewTrustedEpochStartMetaBlockProcessor(nil, &testscommon.RequestHandlerStub{}, createTrustedCheckpoint())
+	assert.Equal(t, epochStart.ErrNilMessenger, err)
+	assert.True(t, check.IfNil(tesmbp))
+
+	tesmbp, err = NewTrustedEpochStartMetaBlockProcessor(&mock.MessengerStub{}, nil, createTrustedCheckpoint())
+	assert.Equal(t, epochStart.ErrNilRequestHandler, err)
+	assert.True(t, check.IfNil(tesmbp))
+
+	checkpoint := createTrustedCheckpoint()
+	checkpoint.MetaBlockHash = "not hex"
+	tesmbp, err = NewTrustedEpochStartMetaBlockProcessor(&mock.MessengerStub{}, &testscommon.RequestHandlerStub{}, checkpoint)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidTrustedCheckpoint))
+	assert.True(t, check.IfNil(tesmbp))
+
+	checkpoint.MetaBlockHash = ""
+	tesmbp, err = NewTrustedEpochStartMetaBlockProcessor(&mock.MessengerStub{}, &testscommon.RequestHandlerStub{}, checkpoint)
+	assert.True(t, errors.Is(err, epochStart.ErrInvalidTrustedCheckpoint))
+	assert.True(t, check.IfNil(tesmbp))
+
+	tesmbp, err = NewTrustedEpochStartMetaBlockProcessor(&mock.MessengerStub{}, &testscommon.RequestHandlerStub{}, createTrustedCheckpoint())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(tesmbp))
+}
+
+func TestTrustedEpochStartMetaBlockProcessor_GetEpochStartMetaBlockShouldIgnoreUntrustedMetaBlocks(t *testing.T) {
+	t.Parallel()
+
+	requestedEpoch := uint32(0)
+	tesmbp, _ := NewTrustedEpochStartMetaBlockProcessor(
+		createConnectedMessengerStub(),
+		&testscommon.RequestHandlerStub{
+			RequestStartOfEpochMetaBlockCalled: func(epoch uint32) {
+				requestedEpoch = epoch
+			},
+		},
+		createTrustedCheckpoint(),
+	)
+
+	epochStartData := block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{Round: 1}}}
+	otherHashMetaBlock := &block.MetaBlock{Nonce: 10, Epoch: 5, EpochStart: epochStartData}
+	_ = tesmbp.Save(mock.NewInterceptedMetaBlockMock(otherHashMetaBlock, []byte("other hash")), "peer_0", "")
+	otherEpochMetaBlock := &block.MetaBlock{Nonce: 10, Epoch: 6, EpochStart: epochStartData}
+	_ = tesmbp.Save(mock.NewInterceptedMetaBlockMock(otherEpochMetaBlock, []byte("trusted hash")), "peer_0", "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	mb, err := tesmbp.GetEpochStartMetaBlock(ctx)
+	cancel()
+	assert.Nil(t, mb)
+	assert.Equal(t, epochStart.ErrTimeoutWaitingForMetaBlock, err)
+	assert.Equal(t, uint32(5), requestedEpoch)
+}
+
+func TestTrustedEpochStartMetaBlockProcessor_GetEpochStartMetaBlockShouldWorkWithOnePeer(t *testing.T) {
+	t.Parallel()
+
+	tesmbp, _ := NewTrustedEpochStartMetaBlockProcessor(
+		createConnectedMessengerStub(),
+		&testscommon.RequestHandlerStub{},
+		createTrustedCheckpoint(),
+	)
+
+	expectedMetaBlock := &block.MetaBlock{
+		Nonce:      10,
+		Epoch:      5,
+		EpochStart: block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{Round: 1}}},
+	}
+	intData := mock.NewInterceptedMetaBlockMock(expectedMetaBlock, []byte("trusted hash"))
+	_ = tesmbp.Save(intData, "peer_0", "")
+	_ = tesmbp.Save(intData, "peer_0", "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	mb, err := tesmbp.GetEpochStartMetaBlock(ctx)
+	cancel()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedMetaBlock, mb)
+}
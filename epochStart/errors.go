@@ -316,3 +316,21 @@ var ErrEmptyESDTOwnerAddress = errors.New("empty ESDT owner address")
 
 // ErrNilCurrentNetworkEpochSetter signals that a nil current network epoch setter has been provided
 var ErrNilCurrentNetworkEpochSetter = errors.New("nil current network epoch setter")
+
+// ErrNilGovernanceParamChangeHandler signals that a nil governance param change handler has been provided
+var ErrNilGovernanceParamChangeHandler = errors.New("nil governance param change handler")
+
+// ErrUnknownGovernanceParamType signals that no handler was registered for the type of a governance param change
+var ErrUnknownGovernanceParamType = errors.New("unknown governance param type")
+
+// ErrNilChanStopNodeProcess signals that a nil stop node process channel has been provided
+var ErrNilChanStopNodeProcess = errors.New("nil stop node process channel")
+
+// ErrNilValidatorKeyRotator signals that a nil validator key rotator has been provided
+var ErrNilValidatorKeyRotator = errors.New("nil validator key rotator")
+
+// ErrInvalidKeyRotationOutput signals that the key rotations returned by the validator system SC are malformed
+var ErrInvalidKeyRotationOutput = errors.New("invalid key rotation output")
+
+// ErrInvalidTrustedCheckpoint signals that an invalid trusted checkpoint has been provided
+var ErrInvalidTrustedCheckpoint = errors.New("invalid trusted checkpoint")
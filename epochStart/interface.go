@@ -97,6 +97,12 @@ type ValidatorInfoCreator interface {
 	IsInterfaceNil() bool
 }
 
+// ValidatorKeyRotator defines the methods to move the validator statistics from a rotated BLS key to its replacement
+type ValidatorKeyRotator interface {
+	RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error)
+	IsInterfaceNil() bool
+}
+
 // HeadersByHashSyncer defines the methods to sync all missing headers by hash
 type HeadersByHashSyncer interface {
 	SyncMissingHeadersByHash(shardIDs []uint32, headersHashes [][]byte, ctx context.Context) error
@@ -94,6 +94,7 @@ func (e *epochStartData) VerifyEpochStartDataForMetablock(metaBlock *block.MetaB
 
 	epochStartDataWithoutEconomics := metaBlock.EpochStart
 	epochStartDataWithoutEconomics.Economics = block.Economics{}
+	epochStartDataWithoutEconomics.ParamChanges = nil
 	receivedEpochStartHash, err := core.CalculateHash(e.marshalizer, e.hasher, &epochStartDataWithoutEconomics)
 	if err != nil {
 		return err
@@ -33,6 +33,7 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 	Marshalizer          marshal.Marshalizer
 	StartRating          uint32
 	ValidatorInfoCreator epochStart.ValidatorInfoCreator
+	ValidatorKeyRotator  epochStart.ValidatorKeyRotator
 	ChanceComputer       sharding.ChanceComputer
 	ShardCoordinator     sharding.Coordinator
 	EpochConfig          config.EpochConfig
@@ -57,6 +58,7 @@ type systemSCProcessor struct {
 	shardCoordinator               sharding.Coordinator
 	startRating                    uint32
 	validatorInfoCreator           epochStart.ValidatorInfoCreator
+	validatorKeyRotator            epochStart.ValidatorKeyRotator
 	genesisNodesConfig             sharding.GenesisNodesSetupHandler
 	nodesConfigProvider            epochStart.NodesConfigProvider
 	stakingDataProvider            epochStart.StakingDataProvider
@@ -70,6 +72,9 @@ type systemSCProcessor struct {
 	esdtEnableEpoch                uint32
 	saveJailedAlwaysEnableEpoch    uint32
 	governanceEnableEpoch          uint32
+	paramChangesEnableEpoch        uint32
+	keyRotationEnableEpoch         uint32
+	autoCompoundingEnableEpoch     uint32
 	maxNodesEnableConfig           []config.MaxNodesChangeConfig
 	maxNodes                       uint32
 	flagSwitchJailedWaiting        atomic.Flag
@@ -83,6 +88,9 @@ type systemSCProcessor struct {
 	flagESDTEnabled                atomic.Flag
 	flagSaveJailedAlwaysEnabled    atomic.Flag
 	flagGovernanceEnabled          atomic.Flag
+	flagParamChangesEnabled        atomic.Flag
+	flagKeyRotationEnabled         atomic.Flag
+	flagAutoCompoundingEnabled     atomic.Flag
 	esdtOwnerAddressBytes          []byte
 	mapNumSwitchedPerShard         map[uint32]uint32
 	mapNumSwitchablePerShard       map[uint32]uint32
@@ -125,6 +133,9 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 	if check.IfNil(args.ValidatorInfoCreator) {
 		return nil, epochStart.ErrNilValidatorInfoProcessor
 	}
+	if check.IfNil(args.ValidatorKeyRotator) {
+		return nil, epochStart.ErrNilValidatorKeyRotator
+	}
 	if len(args.EndOfEpochCallerAddress) == 0 {
 		return nil, epochStart.ErrNilEndOfEpochCallerAddress
 	}
@@ -160,6 +171,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		marshalizer:                 args.Marshalizer,
 		startRating:                 args.StartRating,
 		validatorInfoCreator:        args.ValidatorInfoCreator,
+		validatorKeyRotator:         args.ValidatorKeyRotator,
 		genesisNodesConfig:          args.GenesisNodesConfig,
 		endOfEpochCallerAddress:     args.EndOfEpochCallerAddress,
 		stakingSCAddress:            args.StakingSCAddress,
@@ -178,6 +190,9 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		esdtOwnerAddressBytes:       args.ESDTOwnerAddressBytes,
 		saveJailedAlwaysEnableEpoch: args.EpochConfig.EnableEpochs.SaveJailedAlwaysEnableEpoch,
 		governanceEnableEpoch:       args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		paramChangesEnableEpoch:     args.EpochConfig.EnableEpochs.GovernanceParamChangesEnableEpoch,
+		keyRotationEnableEpoch:      args.EpochConfig.EnableEpochs.ValidatorKeyRotationEnableEpoch,
+		autoCompoundingEnableEpoch:  args.EpochConfig.EnableEpochs.DelegationAutoCompoundingEnableEpoch,
 	}
 
 	log.Debug("systemSC: enable epoch for switch jail waiting", "epoch", s.switchEnableEpoch)
@@ -188,6 +203,9 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 	log.Debug("systemSC: enable epoch for correct last unjailed", "epoch", s.correctLastUnJailEpoch)
 	log.Debug("systemSC: enable epoch for save jailed always", "epoch", s.saveJailedAlwaysEnableEpoch)
 	log.Debug("systemSC: enable epoch for governanceV2 init", "epoch", s.governanceEnableEpoch)
+	log.Debug("systemSC: enable epoch for governance param changes", "epoch", s.paramChangesEnableEpoch)
+	log.Debug("systemSC: enable epoch for validator key rotation", "epoch", s.keyRotationEnableEpoch)
+	log.Debug("systemSC: enable epoch for delegation auto-compounding", "epoch", s.autoCompoundingEnableEpoch)
 
 	s.maxNodesEnableConfig = make([]config.MaxNodesChangeConfig, len(args.MaxNodesEnableConfig))
 	copy(s.maxNodesEnableConfig, args.MaxNodesEnableConfig)
@@ -247,6 +265,13 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(
 		}
 	}
 
+	if s.flagKeyRotationEnabled.IsSet() {
+		err := s.executeKeyRotations(validatorInfos)
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.flagSwitchJailedWaiting.IsSet() {
 		err := s.computeNumWaitingPerShard(validatorInfos)
 		if err != nil {
@@ -608,6 +633,10 @@ func (s *systemSCProcessor) executeRewardTx(rwdTx data.TransactionHandler) error
 		RecipientAddr: rwdTx.GetRcvAddr(),
 		Function:      "updateRewards",
 	}
+	if s.flagAutoCompoundingEnabled.IsSet() {
+		// the compounded rewards are staked through the validator system SC, which needs gas
+		vmInput.GasProvided = math.MaxUint64
+	}
 
 	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
 	if err != nil {
@@ -1100,6 +1129,131 @@ func (s *systemSCProcessor) updateToGovernanceV2() error {
 	return nil
 }
 
+// ProcessGovernanceParamChanges collects from the governance system SC all the accepted network parameter changes
+// activated up to the provided epoch. The returned changes are added in the epoch start block, so all the shards apply
+// the new ones and a node starting in the epoch finds all of them in the epoch start block
+func (s *systemSCProcessor) ProcessGovernanceParamChanges(epoch uint32) ([]block.GovernanceParamChange, error) {
+	if !s.flagParamChangesEnabled.IsSet() {
+		return nil, nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{big.NewInt(int64(epoch)).Bytes()},
+		},
+		RecipientAddr: vm.GovernanceSCAddress,
+		Function:      "applyParamChanges",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return nil, fmt.Errorf("%w when applying governance param changes", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("got return code %s when applying governance param changes", vmOutput.ReturnCode)
+	}
+
+	err := s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	paramChanges := make([]block.GovernanceParamChange, 0, len(vmOutput.ReturnData))
+	for _, returnData := range vmOutput.ReturnData {
+		paramChange := &systemSmartContracts.ParamChangeProposal{}
+		err = s.marshalizer.Unmarshal(paramChange, returnData)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debug("systemSCProcessor: governance param change",
+			"type", string(paramChange.Type),
+			"name", string(paramChange.Name),
+			"value", string(paramChange.Value),
+			"activation epoch", paramChange.ActivationEpoch,
+		)
+		paramChanges = append(paramChanges, block.GovernanceParamChange{
+			ProposalHash:    paramChange.CommitHash,
+			Type:            string(paramChange.Type),
+			Name:            string(paramChange.Name),
+			Value:           string(paramChange.Value),
+			ActivationEpoch: paramChange.ActivationEpoch,
+		})
+	}
+
+	return paramChanges, nil
+}
+
+// executeKeyRotations replaces the BLS keys rotated during the epoch. The peer accounts are moved under the new keys
+// and the validator infos are updated, so the new keys take the place of the old ones in the nodes configuration
+// computed for the next epoch
+func (s *systemSCProcessor) executeKeyRotations(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  make([][]byte, 0),
+		},
+		RecipientAddr: vm.ValidatorSCAddress,
+		Function:      "executeKeyRotations",
+	}
+	vmOutput, errRun := s.systemVM.RunSmartContractCall(vmInput)
+	if errRun != nil {
+		return fmt.Errorf("%w when executing key rotations", errRun)
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("got return code %s when executing key rotations", vmOutput.ReturnCode)
+	}
+	if len(vmOutput.ReturnData)%2 != 0 {
+		return epochStart.ErrInvalidKeyRotationOutput
+	}
+
+	err := s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(vmOutput.ReturnData); i += 2 {
+		oldKey := vmOutput.ReturnData[i]
+		newKey := vmOutput.ReturnData[i+1]
+
+		account, errRotate := s.validatorKeyRotator.RotatePeerAccountKey(oldKey, newKey)
+		if errRotate != nil {
+			return errRotate
+		}
+
+		for shardID := range validatorInfos {
+			deleteNewValidatorIfExistsFromMap(validatorInfos, newKey, shardID)
+		}
+		replaceValidatorKeyInMap(validatorInfos, oldKey, newKey)
+
+		log.Debug("systemSCProcessor: rotated validator key",
+			"old key", oldKey,
+			"new key", newKey,
+			"shard", account.GetShardId(),
+			"list", account.GetList(),
+		)
+	}
+
+	return nil
+}
+
+func replaceValidatorKeyInMap(
+	validatorInfos map[uint32][]*state.ValidatorInfo,
+	oldKey []byte,
+	newKey []byte,
+) {
+	for _, validatorsInShard := range validatorInfos {
+		for _, validatorInfo := range validatorsInShard {
+			if bytes.Equal(validatorInfo.PublicKey, oldKey) {
+				validatorInfo.PublicKey = newKey
+				return
+			}
+		}
+	}
+}
+
 func (s *systemSCProcessor) getValidatorSystemAccount() (state.UserAccountHandler, error) {
 	validatorAccount, err := s.userAccountsDB.LoadAccount(vm.ValidatorSCAddress)
 	if err != nil {
@@ -1493,4 +1647,13 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 
 	s.flagGovernanceEnabled.Toggle(epoch == s.governanceEnableEpoch)
 	log.Debug("systemProcessor: governanceV2", "enabled", s.flagGovernanceEnabled.IsSet())
+
+	s.flagParamChangesEnabled.Toggle(epoch >= s.paramChangesEnableEpoch)
+	log.Debug("systemProcessor: governance param changes", "enabled", s.flagParamChangesEnabled.IsSet())
+
+	s.flagKeyRotationEnabled.Toggle(epoch >= s.keyRotationEnableEpoch)
+	log.Debug("systemProcessor: validator key rotation", "enabled", s.flagKeyRotationEnabled.IsSet())
+
+	s.flagAutoCompoundingEnabled.Toggle(epoch >= s.autoCompoundingEnableEpoch)
+	log.Debug("systemProcessor: delegation auto-compounding", "enabled", s.flagAutoCompoundingEnabled.IsSet())
 }
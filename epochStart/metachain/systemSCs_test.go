@@ -120,6 +120,10 @@ func TestNewSystemSCProcessor(t *testing.T) {
 	args.ValidatorInfoCreator = nil
 	checkConstructorWithNilArg(t, args, epochStart.ErrNilValidatorInfoProcessor)
 
+	args, _ = createFullArgumentsForSystemSCProcessing(100, createMemUnit())
+	args.ValidatorKeyRotator = nil
+	checkConstructorWithNilArg(t, args, epochStart.ErrNilValidatorKeyRotator)
+
 	args, _ = createFullArgumentsForSystemSCProcessing(100, createMemUnit())
 	args.ChanceComputer = nil
 	checkConstructorWithNilArg(t, args, epochStart.ErrNilChanceComputer)
@@ -992,6 +996,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		Marshalizer:             marshalizer,
 		StartRating:             5,
 		ValidatorInfoCreator:    vCreator,
+		ValidatorKeyRotator:     vCreator,
 		EndOfEpochCallerAddress: vm.EndOfEpochAddress,
 		StakingSCAddress:        vm.StakingSCAddress,
 		ChanceComputer:          &mock.ChanceComputerStub{},
@@ -1147,6 +1152,42 @@ func TestSystemSCProcessor_ProcessDelegationRewardsErrors(t *testing.T) {
 	assert.Equal(t, err, epochStart.ErrSystemDelegationCall)
 }
 
+func TestSystemSCProcessor_ProcessDelegationRewardsProvidesGasOnlyWithAutoCompounding(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(1000, createMemUnit())
+	args.EpochConfig.EnableEpochs.DelegationAutoCompoundingEnableEpoch = 5
+	var gasProvided []uint64
+	args.SystemVM = &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			gasProvided = append(gasProvided, input.GasProvided)
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+		},
+	}
+	s, _ := NewSystemSCProcessor(args)
+
+	localCache, _ := dataPool.NewCurrentBlockPool()
+	localCache.AddTx([]byte("txHash"), &rewardTx.RewardTx{Value: big.NewInt(100), RcvAddr: vm.FirstDelegationSCAddress})
+	miniBlocks := []*block.MiniBlock{
+		{
+			SenderShardID:   core.MetachainShardId,
+			ReceiverShardID: core.MetachainShardId,
+			TxHashes:        [][]byte{[]byte("txHash")},
+			Type:            block.RewardsBlock,
+		},
+	}
+
+	s.EpochConfirmed(4, 0)
+	err := s.ProcessDelegationRewards(miniBlocks, localCache)
+	require.Nil(t, err)
+
+	s.EpochConfirmed(5, 0)
+	err = s.ProcessDelegationRewards(miniBlocks, localCache)
+	require.Nil(t, err)
+
+	assert.Equal(t, []uint64{0, math.MaxUint64}, gasProvided)
+}
+
 func TestSystemSCProcessor_ProcessDelegationRewards(t *testing.T) {
 	t.Parallel()
 
@@ -1870,3 +1911,134 @@ func TestSystemSCProcessor_ProcessSystemSmartContractJailAndUnStake(t *testing.T
 		assert.Equal(t, peerAcc.GetList(), string(core.LeavingList))
 	}
 }
+
+func TestSystemSCProcessor_ProcessGovernanceParamChanges(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(0, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+
+	commitHash := bytes.Repeat([]byte("a"), 40)
+	governanceAcc := loadSCAccount(args.UserAccountsDB, vm.GovernanceSCAddress)
+	marshaledData, _ := args.Marshalizer.Marshal(&systemSmartContracts.ParamChangeProposal{
+		CommitHash:      commitHash,
+		Type:            []byte(core.GovernanceFeeSettingsParam),
+		Name:            []byte("MinGasPrice"),
+		Value:           []byte("1000"),
+		ActivationEpoch: 4,
+	})
+	_ = governanceAcc.DataTrieTracker().SaveKeyValue(append([]byte("paramChange_"), commitHash...), marshaledData)
+	marshaledData, _ = args.Marshalizer.Marshal(&systemSmartContracts.GeneralProposal{
+		CommitHash: commitHash,
+		Yes:        big.NewInt(0),
+		No:         big.NewInt(0),
+		Veto:       big.NewInt(0),
+		Closed:     true,
+		Passed:     true,
+	})
+	_ = governanceAcc.DataTrieTracker().SaveKeyValue(append([]byte("proposal_"), commitHash...), marshaledData)
+	marshaledData, _ = args.Marshalizer.Marshal(&systemSmartContracts.ParamChangesList{CommitHashes: [][]byte{commitHash}})
+	_ = governanceAcc.DataTrieTracker().SaveKeyValue([]byte("paramChangesList"), marshaledData)
+	_ = args.UserAccountsDB.SaveAccount(governanceAcc)
+
+	paramChanges, err := s.ProcessGovernanceParamChanges(3)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(paramChanges))
+
+	paramChanges, err = s.ProcessGovernanceParamChanges(4)
+	require.Nil(t, err)
+	expectedParamChanges := []block.GovernanceParamChange{
+		{
+			ProposalHash:    commitHash,
+			Type:            core.GovernanceFeeSettingsParam,
+			Name:            "MinGasPrice",
+			Value:           "1000",
+			ActivationEpoch: 4,
+		},
+	}
+	assert.Equal(t, expectedParamChanges, paramChanges)
+
+	paramChanges, err = s.ProcessGovernanceParamChanges(5)
+	require.Nil(t, err)
+	assert.Equal(t, expectedParamChanges, paramChanges)
+
+	s.flagParamChangesEnabled.Unset()
+	paramChanges, err = s.ProcessGovernanceParamChanges(5)
+	require.Nil(t, err)
+	assert.Nil(t, paramChanges)
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldExecuteKeyRotations(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createFullArgumentsForSystemSCProcessing(0, createMemUnit())
+	s, _ := NewSystemSCProcessor(args)
+
+	owner := []byte("owner")
+	oldKey := []byte("oldBLSKey")
+	otherKey := []byte("otherBLSKey")
+	newKey := []byte("newBLSKey")
+	doStake(t, s.systemVM, s.userAccountsDB, owner, big.NewInt(1000), oldKey, otherKey)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			Arguments:   [][]byte{oldKey, newKey, []byte("sig")},
+			CallValue:   big.NewInt(0),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: vm.ValidatorSCAddress,
+		Function:      "rotateValidatorKey",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	saveOutputAccounts(t, s.userAccountsDB, vmOutput)
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	for _, blsKey := range [][]byte{oldKey, otherKey} {
+		peerAcc, _ := s.getPeerAccount(blsKey)
+		_ = peerAcc.SetBLSPublicKey(blsKey)
+		_ = peerAcc.SetRewardAddress(owner)
+		peerAcc.SetListAndIndex(1, string(core.EligibleList), 3)
+		peerAcc.SetRating(70)
+		peerAcc.SetTempRating(60)
+		_ = s.peerAccountsDB.SaveAccount(peerAcc)
+
+		validatorInfos[1] = append(validatorInfos[1], &state.ValidatorInfo{
+			PublicKey:       blsKey,
+			ShardId:         1,
+			List:            string(core.EligibleList),
+			Index:           3,
+			Rating:          70,
+			TempRating:      60,
+			RewardAddress:   owner,
+			AccumulatedFees: big.NewInt(0),
+		})
+	}
+
+	err = s.executeKeyRotations(validatorInfos)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, len(validatorInfos[1]))
+	assert.Equal(t, newKey, validatorInfos[1][0].PublicKey)
+	assert.Equal(t, string(core.EligibleList), validatorInfos[1][0].List)
+	assert.Equal(t, uint32(60), validatorInfos[1][0].TempRating)
+	assert.Equal(t, otherKey, validatorInfos[1][1].PublicKey)
+
+	_, err = s.peerAccountsDB.GetExistingAccount(oldKey)
+	assert.NotNil(t, err)
+	peerAcc, err := s.getPeerAccount(newKey)
+	require.Nil(t, err)
+	assert.Equal(t, newKey, peerAcc.GetBLSPublicKey())
+	assert.Equal(t, uint32(1), peerAcc.GetShardId())
+	assert.Equal(t, string(core.EligibleList), peerAcc.GetList())
+	assert.Equal(t, uint32(70), peerAcc.GetRating())
+	assert.Equal(t, uint32(60), peerAcc.GetTempRating())
+
+	checkOwnerOfBlsKey(t, s.systemVM, newKey, owner)
+
+	err = s.executeKeyRotations(validatorInfos)
+	require.Nil(t, err)
+	assert.Equal(t, newKey, validatorInfos[1][0].PublicKey)
+}
@@ -0,0 +1,19 @@
+package mock
+
+// GovernanceParamChangeHandlerStub -
+type GovernanceParamChangeHandlerStub struct {
+	ApplyGovernanceParamChangeCalled func(name string, value string) error
+}
+
+// ApplyGovernanceParamChange -
+func (g *GovernanceParamChangeHandlerStub) ApplyGovernanceParamChange(name string, value string) error {
+	if g.ApplyGovernanceParamChangeCalled != nil {
+		return g.ApplyGovernanceParamChangeCalled(name, value)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (g *GovernanceParamChangeHandlerStub) IsInterfaceNil() bool {
+	return g == nil
+}
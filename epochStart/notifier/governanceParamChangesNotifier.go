@@ -0,0 +1,200 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ epochStart.ActionHandler = (*governanceParamChangesNotifier)(nil)
+
+// ArgsGovernanceParamChangesNotifier holds the arguments needed to create a governance param changes notifier
+type ArgsGovernanceParamChangesNotifier struct {
+	MetaBlockStorer     storage.Storer
+	Marshalizer         marshal.Marshalizer
+	ChanStopNodeProcess chan endProcess.ArgEndProcess
+}
+
+// governanceParamChangesNotifier applies the network parameter changes accepted through governance. Each epoch start
+// meta block carries all the changes activated up to its epoch, so the new changes are applied when the node's own
+// chain enters the new epoch, while a starting node applies all of them from the epoch start meta block of its epoch
+type governanceParamChangesNotifier struct {
+	mutHandlers         sync.RWMutex
+	handlers            map[string]core.GovernanceParamChangeHandler
+	mutPending          sync.Mutex
+	pendingChanges      map[uint32][]block.GovernanceParamChange
+	appliedChanges      map[string]struct{}
+	metaBlockStorer     storage.Storer
+	marshalizer         marshal.Marshalizer
+	chanStopNodeProcess chan endProcess.ArgEndProcess
+}
+
+// NewGovernanceParamChangesNotifier creates a new instance of a governance param changes notifier
+func NewGovernanceParamChangesNotifier(args ArgsGovernanceParamChangesNotifier) (*governanceParamChangesNotifier, error) {
+	if check.IfNil(args.MetaBlockStorer) {
+		return nil, epochStart.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, epochStart.ErrNilMarshalizer
+	}
+	if args.ChanStopNodeProcess == nil {
+		return nil, epochStart.ErrNilChanStopNodeProcess
+	}
+
+	return &governanceParamChangesNotifier{
+		handlers:            make(map[string]core.GovernanceParamChangeHandler),
+		pendingChanges:      make(map[uint32][]block.GovernanceParamChange),
+		appliedChanges:      make(map[string]struct{}),
+		metaBlockStorer:     args.MetaBlockStorer,
+		marshalizer:         args.Marshalizer,
+		chanStopNodeProcess: args.ChanStopNodeProcess,
+	}, nil
+}
+
+// RegisterParamChangeHandler registers the handler which applies the parameter changes of the provided type
+func (gpcn *governanceParamChangesNotifier) RegisterParamChangeHandler(paramType string, handler core.GovernanceParamChangeHandler) error {
+	if check.IfNil(handler) {
+		return epochStart.ErrNilGovernanceParamChangeHandler
+	}
+
+	gpcn.mutHandlers.Lock()
+	gpcn.handlers[paramType] = handler
+	gpcn.mutHandlers.Unlock()
+
+	return nil
+}
+
+// EpochStartPrepare records the parameter changes carried by the epoch start meta block
+func (gpcn *governanceParamChangesNotifier) EpochStartPrepare(metaHdr data.HeaderHandler, _ data.BodyHandler) {
+	metaBlock, ok := metaHdr.(*block.MetaBlock)
+	if !ok || !metaBlock.IsStartOfEpochBlock() || len(metaBlock.EpochStart.ParamChanges) == 0 {
+		return
+	}
+
+	gpcn.mutPending.Lock()
+	gpcn.pendingChanges[metaBlock.Epoch] = metaBlock.EpochStart.ParamChanges
+	gpcn.mutPending.Unlock()
+}
+
+// ApplyEpochStartParamChanges applies, when the node starts in the provided epoch, the parameter changes carried by the
+// epoch start meta block of that epoch. It must be called after all the handlers were registered
+func (gpcn *governanceParamChangesNotifier) ApplyEpochStartParamChanges(epoch uint32) error {
+	marshaledData, err := gpcn.metaBlockStorer.SearchFirst([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		if epoch == 0 {
+			log.Debug("no epoch start meta block for the genesis epoch", "error", err)
+			return nil
+		}
+		return fmt.Errorf("%w while reading the epoch start meta block of epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = gpcn.marshalizer.Unmarshal(metaBlock, marshaledData)
+	if err != nil {
+		return err
+	}
+
+	gpcn.mutPending.Lock()
+	defer gpcn.mutPending.Unlock()
+
+	return gpcn.applyNewParamChanges(metaBlock.EpochStart.ParamChanges, epoch)
+}
+
+// EpochStartAction applies the parameter changes of the epoch which starts. A change which can not be applied stops
+// the node, as it would otherwise process the following blocks with different parameters than the rest of the network
+func (gpcn *governanceParamChangesNotifier) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	gpcn.mutPending.Lock()
+	defer gpcn.mutPending.Unlock()
+
+	paramChanges := gpcn.pendingChanges[hdr.GetEpoch()]
+	for epoch := range gpcn.pendingChanges {
+		if epoch <= hdr.GetEpoch() {
+			delete(gpcn.pendingChanges, epoch)
+		}
+	}
+
+	err := gpcn.applyNewParamChanges(paramChanges, hdr.GetEpoch())
+	if err != nil {
+		gpcn.stopNode(err)
+	}
+}
+
+// applyNewParamChanges applies, in order, the changes activated up to the provided epoch which were not applied yet
+func (gpcn *governanceParamChangesNotifier) applyNewParamChanges(paramChanges []block.GovernanceParamChange, epoch uint32) error {
+	for _, paramChange := range paramChanges {
+		_, isApplied := gpcn.appliedChanges[string(paramChange.ProposalHash)]
+		if isApplied || paramChange.ActivationEpoch > epoch {
+			continue
+		}
+
+		err := gpcn.applyParamChange(paramChange)
+		if err != nil {
+			return err
+		}
+		gpcn.appliedChanges[string(paramChange.ProposalHash)] = struct{}{}
+
+		log.Info("applied governance param change",
+			"epoch", epoch,
+			"type", paramChange.Type,
+			"name", paramChange.Name,
+			"value", paramChange.Value,
+		)
+	}
+
+	return nil
+}
+
+func (gpcn *governanceParamChangesNotifier) applyParamChange(paramChange block.GovernanceParamChange) error {
+	gpcn.mutHandlers.RLock()
+	handler, exists := gpcn.handlers[paramChange.Type]
+	gpcn.mutHandlers.RUnlock()
+	if !exists {
+		return fmt.Errorf("%w %s, name %s, proposal %s", epochStart.ErrUnknownGovernanceParamType,
+			paramChange.Type, paramChange.Name, hex.EncodeToString(paramChange.ProposalHash))
+	}
+
+	err := handler.ApplyGovernanceParamChange(paramChange.Name, paramChange.Value)
+	if err != nil {
+		return fmt.Errorf("%w when applying the governance param change of type %s, name %s, value %s",
+			err, paramChange.Type, paramChange.Name, paramChange.Value)
+	}
+
+	return nil
+}
+
+func (gpcn *governanceParamChangesNotifier) stopNode(err error) {
+	log.Error("could not apply governance param changes, stopping the node", "error", err)
+
+	argEndProcess := endProcess.ArgEndProcess{
+		Reason:      core.WrongConfiguration,
+		Description: err.Error(),
+	}
+	select {
+	case gpcn.chanStopNodeProcess <- argEndProcess:
+	default:
+		log.Debug("governanceParamChangesNotifier: could not write on the stop node channel")
+	}
+}
+
+// NotifyOrder returns the notification order for a start of epoch event
+func (gpcn *governanceParamChangesNotifier) NotifyOrder() uint32 {
+	return core.GovernanceParamChangesOrder
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gpcn *governanceParamChangesNotifier) IsInterfaceNil() bool {
+	return gpcn == nil
+}
@@ -0,0 +1,181 @@
+package notifier_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createEpochStartMetaBlockWithParamChanges(epoch uint32, paramChanges []block.GovernanceParamChange) *block.MetaBlock {
+	return &block.MetaBlock{
+		Epoch: epoch,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0}},
+			ParamChanges:         paramChanges,
+		},
+	}
+}
+
+func createGovernanceParamChangesNotifierArgs() notifier.ArgsGovernanceParamChangesNotifier {
+	return notifier.ArgsGovernanceParamChangesNotifier{
+		MetaBlockStorer:     genericMocks.NewStorerMock("MetaBlock", 0),
+		Marshalizer:         &mock.MarshalizerMock{},
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+	}
+}
+
+func TestNewGovernanceParamChangesNotifier_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createGovernanceParamChangesNotifierArgs()
+	args.MetaBlockStorer = nil
+	gpcn, err := notifier.NewGovernanceParamChangesNotifier(args)
+	assert.True(t, check.IfNil(gpcn))
+	assert.Equal(t, epochStart.ErrNilStorage, err)
+
+	args = createGovernanceParamChangesNotifierArgs()
+	args.Marshalizer = nil
+	gpcn, err = notifier.NewGovernanceParamChangesNotifier(args)
+	assert.True(t, check.IfNil(gpcn))
+	assert.Equal(t, epochStart.ErrNilMarshalizer, err)
+
+	args = createGovernanceParamChangesNotifierArgs()
+	args.ChanStopNodeProcess = nil
+	gpcn, err = notifier.NewGovernanceParamChangesNotifier(args)
+	assert.True(t, check.IfNil(gpcn))
+	assert.Equal(t, epochStart.ErrNilChanStopNodeProcess, err)
+}
+
+func TestGovernanceParamChangesNotifier_RegisterNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gpcn, _ := notifier.NewGovernanceParamChangesNotifier(createGovernanceParamChangesNotifierArgs())
+	assert.False(t, gpcn.IsInterfaceNil())
+
+	err := gpcn.RegisterParamChangeHandler(core.GovernanceGasScheduleParam, nil)
+	assert.Equal(t, epochStart.ErrNilGovernanceParamChangeHandler, err)
+}
+
+func createRecordingParamChangeHandler(appliedChanges map[string]string) *mock.GovernanceParamChangeHandlerStub {
+	return &mock.GovernanceParamChangeHandlerStub{
+		ApplyGovernanceParamChangeCalled: func(name string, value string) error {
+			appliedChanges[name] = value
+			return nil
+		},
+	}
+}
+
+func TestGovernanceParamChangesNotifier_ShouldApplyChangesWhenTheEpochStarts(t *testing.T) {
+	t.Parallel()
+
+	args := createGovernanceParamChangesNotifierArgs()
+	gpcn, _ := notifier.NewGovernanceParamChangesNotifier(args)
+	appliedChanges := make(map[string]string)
+	_ = gpcn.RegisterParamChangeHandler(core.GovernanceFeeSettingsParam, createRecordingParamChangeHandler(appliedChanges))
+	_ = gpcn.RegisterParamChangeHandler(core.GovernanceGasScheduleParam, createRecordingParamChangeHandler(appliedChanges))
+
+	metaBlock := createEpochStartMetaBlockWithParamChanges(5, []block.GovernanceParamChange{
+		{ProposalHash: []byte("a"), Type: core.GovernanceFeeSettingsParam, Name: "MinGasPrice", Value: "1000", ActivationEpoch: 5},
+		{ProposalHash: []byte("b"), Type: core.GovernanceGasScheduleParam, Name: "BuiltInCost.ClaimDeveloperRewards", Value: "100", ActivationEpoch: 5},
+	})
+
+	gpcn.EpochStartPrepare(metaBlock, &block.Body{})
+	assert.Equal(t, 0, len(appliedChanges))
+
+	gpcn.EpochStartAction(&block.Header{Epoch: 4})
+	assert.Equal(t, 0, len(appliedChanges))
+
+	gpcn.EpochStartAction(&block.Header{Epoch: 5})
+	assert.Equal(t, map[string]string{"MinGasPrice": "1000", "BuiltInCost.ClaimDeveloperRewards": "100"}, appliedChanges)
+
+	for name := range appliedChanges {
+		delete(appliedChanges, name)
+	}
+	gpcn.EpochStartAction(&block.Header{Epoch: 5})
+	assert.Equal(t, 0, len(appliedChanges))
+
+	// the next epoch start block carries again the changes already applied
+	metaBlock = createEpochStartMetaBlockWithParamChanges(6, append(metaBlock.EpochStart.ParamChanges,
+		block.GovernanceParamChange{ProposalHash: []byte("c"), Type: core.GovernanceFeeSettingsParam, Name: "MinGasLimit", Value: "70000", ActivationEpoch: 6},
+	))
+	gpcn.EpochStartPrepare(metaBlock, &block.Body{})
+	gpcn.EpochStartAction(&block.Header{Epoch: 6})
+	assert.Equal(t, map[string]string{"MinGasLimit": "70000"}, appliedChanges)
+	assert.Equal(t, 0, len(args.ChanStopNodeProcess))
+	assert.Equal(t, uint32(core.GovernanceParamChangesOrder), gpcn.NotifyOrder())
+}
+
+func TestGovernanceParamChangesNotifier_ChangeWhichCanNotBeAppliedShouldStopTheNode(t *testing.T) {
+	t.Parallel()
+
+	args := createGovernanceParamChangesNotifierArgs()
+	gpcn, _ := notifier.NewGovernanceParamChangesNotifier(args)
+	_ = gpcn.RegisterParamChangeHandler(core.GovernanceFeeSettingsParam, &mock.GovernanceParamChangeHandlerStub{
+		ApplyGovernanceParamChangeCalled: func(name string, value string) error {
+			return errors.New("invalid value")
+		},
+	})
+
+	gpcn.EpochStartPrepare(createEpochStartMetaBlockWithParamChanges(5, []block.GovernanceParamChange{
+		{Type: core.GovernanceFeeSettingsParam, Name: "MinGasPrice", Value: "-1", ActivationEpoch: 5},
+	}), &block.Body{})
+	gpcn.EpochStartAction(&block.Header{Epoch: 5})
+	require.Equal(t, 1, len(args.ChanStopNodeProcess))
+	assert.Equal(t, core.WrongConfiguration, (<-args.ChanStopNodeProcess).Reason)
+
+	gpcn.EpochStartPrepare(createEpochStartMetaBlockWithParamChanges(6, []block.GovernanceParamChange{
+		{Type: core.GovernanceGasScheduleParam, Name: "BuiltInCost.ClaimDeveloperRewards", Value: "100", ActivationEpoch: 6},
+	}), &block.Body{})
+	gpcn.EpochStartAction(&block.Header{Epoch: 6})
+	require.Equal(t, 1, len(args.ChanStopNodeProcess))
+	stopArgs := <-args.ChanStopNodeProcess
+	assert.Equal(t, core.WrongConfiguration, stopArgs.Reason)
+	assert.True(t, strings.Contains(stopArgs.Description, epochStart.ErrUnknownGovernanceParamType.Error()))
+}
+
+func TestGovernanceParamChangesNotifier_ApplyEpochStartParamChangesShouldApplyTheChangesOfTheEpochStartBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createGovernanceParamChangesNotifierArgs()
+	metaBlock := createEpochStartMetaBlockWithParamChanges(7, []block.GovernanceParamChange{
+		{ProposalHash: []byte("a"), Type: core.GovernanceFeeSettingsParam, Name: "MinGasPrice", Value: "1000", ActivationEpoch: 5},
+		{ProposalHash: []byte("b"), Type: core.GovernanceFeeSettingsParam, Name: "MinGasLimit", Value: "70000", ActivationEpoch: 7},
+	})
+	marshaledMetaBlock, _ := args.Marshalizer.Marshal(metaBlock)
+	_ = args.MetaBlockStorer.Put([]byte(core.EpochStartIdentifier(7)), marshaledMetaBlock)
+
+	gpcn, _ := notifier.NewGovernanceParamChangesNotifier(args)
+	appliedChanges := make(map[string]string)
+	_ = gpcn.RegisterParamChangeHandler(core.GovernanceFeeSettingsParam, createRecordingParamChangeHandler(appliedChanges))
+
+	err := gpcn.ApplyEpochStartParamChanges(0)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(appliedChanges))
+
+	err = gpcn.ApplyEpochStartParamChanges(6)
+	require.NotNil(t, err)
+	assert.Equal(t, 0, len(appliedChanges))
+
+	err = gpcn.ApplyEpochStartParamChanges(7)
+	require.Nil(t, err)
+	assert.Equal(t, map[string]string{"MinGasPrice": "1000", "MinGasLimit": "70000"}, appliedChanges)
+
+	// the changes applied at start are not applied again when the next epoch starts
+	for name := range appliedChanges {
+		delete(appliedChanges, name)
+	}
+	gpcn.EpochStartPrepare(createEpochStartMetaBlockWithParamChanges(8, metaBlock.EpochStart.ParamChanges), &block.Body{})
+	gpcn.EpochStartAction(&block.Header{Epoch: 8})
+	assert.Equal(t, 0, len(appliedChanges))
+}
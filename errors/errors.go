@@ -457,3 +457,9 @@ var ErrNilLocker = errors.New("nil locker")
 
 // ErrNilCurrentEpochProvider signals that a nil current epoch provider was provided
 var ErrNilCurrentEpochProvider = errors.New("nil current epoch provider")
+
+// ErrNilSyncIntrospector signals that a nil sync introspector was provided
+var ErrNilSyncIntrospector = errors.New("nil sync introspector")
+
+// ErrInvalidNodeOperationMode signals that an invalid combination of node operation modes has been provided
+var ErrInvalidNodeOperationMode = errors.New("invalid node operation mode")
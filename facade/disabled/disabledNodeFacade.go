@@ -101,6 +101,16 @@ func (nf *disabledNodeFacade) GetAllESDTTokens(_ string) (map[string]*esdt.ESDig
 	return nil, errNodeStarting
 }
 
+// GetESDTAllowances returns nil and error
+func (nf *disabledNodeFacade) GetESDTAllowances(_ string) ([]*api.ESDTAllowance, error) {
+	return nil, errNodeStarting
+}
+
+// GetESDTTokenTypes returns nil and error
+func (nf *disabledNodeFacade) GetESDTTokenTypes(_ []string) (map[string]*api.ESDTTokenType, error) {
+	return nil, errNodeStarting
+}
+
 // GetNFTTokenIDsRegisteredByAddress returns nil and error
 func (nf *disabledNodeFacade) GetNFTTokenIDsRegisteredByAddress(_ string) ([]string, error) {
 	return nil, errNodeStarting
@@ -125,7 +135,10 @@ func (nf *disabledNodeFacade) CreateTransaction(
 	_ string,
 	_ string,
 	_ uint32,
-	_ uint32) (*transaction.Transaction, []byte, error) {
+	_ uint32,
+	_ string,
+	_ uint64,
+	_ string) (*transaction.Transaction, []byte, error) {
 	return nil, nil, errNodeStarting
 }
 
@@ -234,6 +247,26 @@ func (nf *disabledNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetSyncStatus returns nil and error
+func (nf *disabledNodeFacade) GetSyncStatus() (*api.SyncStatus, error) {
+	return nil, errNodeStarting
+}
+
+// GetEpochStartCheckpoint returns nil and error
+func (nf *disabledNodeFacade) GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error) {
+	return nil, errNodeStarting
+}
+
+// GetStorageUsage returns nil and error
+func (nf *disabledNodeFacade) GetStorageUsage() (*api.StorageUsage, error) {
+	return nil, errNodeStarting
+}
+
+// CompactStorageUnits returns error
+func (nf *disabledNodeFacade) CompactStorageUnits(_ []string) error {
+	return errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (nf *disabledNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -249,6 +282,21 @@ func (nf *disabledNodeFacade) GetBlockByNonce(_ uint64, _ bool) (*api.Block, err
 	return nil, errNodeStarting
 }
 
+// GetEvents returns nil and error
+func (nf *disabledNodeFacade) GetEvents(_ api.EventsQuery) (*api.EventsPage, error) {
+	return nil, errNodeStarting
+}
+
+// GetContractInfo returns nil and error
+func (nf *disabledNodeFacade) GetContractInfo(_ string) (*api.ContractInfo, error) {
+	return nil, errNodeStarting
+}
+
+// CheckContractUpgrade returns nil and error
+func (nf *disabledNodeFacade) CheckContractUpgrade(_ string, _ api.UpgradeCheckRequest) (*api.UpgradeCheckResult, error) {
+	return nil, errNodeStarting
+}
+
 // Close returns error
 func (nf *disabledNodeFacade) Close() error {
 	return errNodeStarting
@@ -279,6 +327,26 @@ func (nf *disabledNodeFacade) GetDelegatorsList() ([]*api.Delegator, error) {
 	return make([]*api.Delegator, 0), nil
 }
 
+// GetGovernanceProposals returns empty slice
+func (nf *disabledNodeFacade) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return make([]*api.GovernanceProposal, 0), nil
+}
+
+// GetGovernanceProposal returns nil and error
+func (nf *disabledNodeFacade) GetGovernanceProposal(_ string) (*api.GovernanceProposalTally, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotes returns empty slice
+func (nf *disabledNodeFacade) GetGovernanceVotes(_ string) ([]*api.GovernanceVote, error) {
+	return make([]*api.GovernanceVote, 0), nil
+}
+
+// GetStakingQueue returns empty slice
+func (nf *disabledNodeFacade) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	return make([]*api.StakingQueueEntry, 0), nil
+}
+
 // GetESDTData returns nil and error
 func (nf *disabledNodeFacade) GetESDTData(_ string, _ string, _ uint64) (*esdt.ESDigitalToken, error) {
 	return nil, errNodeStarting
@@ -289,6 +357,11 @@ func (nf *disabledNodeFacade) GetAllIssuedESDTs(_ string) ([]string, error) {
 	return nil, errNodeStarting
 }
 
+// GetTokenSupplyDelta returns nil and error
+func (nf *disabledNodeFacade) GetTokenSupplyDelta(_ string) (*api.ESDTSupplyDelta, error) {
+	return nil, errNodeStarting
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *disabledNodeFacade) IsInterfaceNil() bool {
 	return nf == nil
@@ -49,7 +49,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, errNodeStarting, err)
 
 	n1, n2, err := dnf.CreateTransaction(uint64(0), "", "", []byte{0}, "",
-		[]byte{0}, uint64(0), uint64(0), []byte{0}, "", "", uint32(0), uint32(0))
+		[]byte{0}, uint64(0), uint64(0), []byte{0}, "", "", uint32(0), uint32(0), "", uint64(0), "")
 	assert.Nil(t, n1)
 	assert.Nil(t, n2)
 	assert.Equal(t, errNodeStarting, err)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -32,6 +33,9 @@ type NodeHandler interface {
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 
+	// GetTokenSupplyDelta returns the quantities of the provided token minted and burned in the shard of the node
+	GetTokenSupplyDelta(token string) (*api.ESDTSupplyDelta, error)
+
 	// GetESDTData returns the esdt data from a given account, given key and given nonce
 	GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error)
 
@@ -44,9 +48,15 @@ type NodeHandler interface {
 	// GetAllESDTTokens returns the value of a key from a given account
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
 
+	// GetESDTAllowances returns the allowances given by the provided address
+	GetESDTAllowances(address string) ([]*api.ESDTAllowance, error)
+
+	// GetESDTTokenTypes returns the types of the provided tokens
+	GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error)
+
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 
 	// ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
@@ -81,14 +91,24 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetSyncStatus() (*api.SyncStatus, error)
+	GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error)
+	GetStorageUsage() (*api.StorageUsage, error)
+	CompactStorageUnits(units []string) error
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+
+	GetEvents(query api.EventsQuery) (*api.EventsPage, error)
+
+	GetContractInfo(address string) (*api.ContractInfo, error)
+	CreateContractUpgradeTransaction(contract *api.ContractInfo, request api.UpgradeCheckRequest) (*transaction.Transaction, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessSCR(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -100,6 +120,10 @@ type ApiResolver interface {
 	GetTotalStakedValue() (*api.StakeValues, error)
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotes(address string) ([]*api.GovernanceVote, error)
+	GetStakingQueue() ([]*api.StakingQueueEntry, error)
 	Close() error
 	IsInterfaceNil() bool
 }
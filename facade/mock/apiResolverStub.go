@@ -16,6 +16,10 @@ type ApiResolverStub struct {
 	GetTotalStakedValueHandler        func() (*api.StakeValues, error)
 	GetDirectStakedListHandler        func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler          func() ([]*api.Delegator, error)
+	GetGovernanceProposalsHandler     func() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposalHandler      func(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotesHandler         func(address string) ([]*api.GovernanceVote, error)
+	GetStakingQueueHandler            func() ([]*api.StakingQueueEntry, error)
 }
 
 // ExecuteSCQuery -
@@ -56,6 +60,42 @@ func (ars *ApiResolverStub) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	if ars.GetGovernanceProposalsHandler != nil {
+		return ars.GetGovernanceProposalsHandler()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error) {
+	if ars.GetGovernanceProposalHandler != nil {
+		return ars.GetGovernanceProposalHandler(commitHash)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (ars *ApiResolverStub) GetGovernanceVotes(address string) ([]*api.GovernanceVote, error) {
+	if ars.GetGovernanceVotesHandler != nil {
+		return ars.GetGovernanceVotesHandler(address)
+	}
+
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (ars *ApiResolverStub) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	if ars.GetStakingQueueHandler != nil {
+		return ars.GetStakingQueueHandler()
+	}
+
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
@@ -20,7 +20,7 @@ type NodeStub struct {
 	GetBalanceHandler          func(address string) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
@@ -37,8 +37,15 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetSyncStatusCalled                            func() (*api.SyncStatus, error)
+	GetEpochStartCheckpointCalled                  func() (*api.EpochStartCheckpoint, error)
+	GetStorageUsageCalled                          func() (*api.StorageUsage, error)
+	CompactStorageUnitsCalled                      func(units []string) error
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetEventsCalled                                func(query api.EventsQuery) (*api.EventsPage, error)
+	GetContractInfoCalled                          func(address string) (*api.ContractInfo, error)
+	CreateContractUpgradeTransactionCalled         func(contract *api.ContractInfo, request api.UpgradeCheckRequest) (*transaction.Transaction, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                         func(address string) (map[string]*esdt.ESDigitalToken, error)
@@ -46,6 +53,9 @@ type NodeStub struct {
 	GetESDTsWithRoleCalled                         func(address string, role string) ([]string, error)
 	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
+	GetTokenSupplyDeltaCalled                           func(token string) (*api.ESDTSupplyDelta, error)
+	GetESDTAllowancesCalled                        func(address string) ([]*api.ESDTAllowance, error)
+	GetESDTTokenTypesCalled                        func(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error)
 }
 
 // GetUsername -
@@ -90,6 +100,30 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetEvents -
+func (ns *NodeStub) GetEvents(query api.EventsQuery) (*api.EventsPage, error) {
+	if ns.GetEventsCalled != nil {
+		return ns.GetEventsCalled(query)
+	}
+	return nil, nil
+}
+
+// GetContractInfo -
+func (ns *NodeStub) GetContractInfo(address string) (*api.ContractInfo, error) {
+	if ns.GetContractInfoCalled != nil {
+		return ns.GetContractInfoCalled(address)
+	}
+	return nil, nil
+}
+
+// CreateContractUpgradeTransaction -
+func (ns *NodeStub) CreateContractUpgradeTransaction(contract *api.ContractInfo, request api.UpgradeCheckRequest) (*transaction.Transaction, error) {
+	if ns.CreateContractUpgradeTransactionCalled != nil {
+		return ns.CreateContractUpgradeTransactionCalled(contract, request)
+	}
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -102,9 +136,9 @@ func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
 
 // CreateTransaction -
 func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-	gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error) {
+	gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error) {
 
-	return ns.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
+	return ns.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options, relayer, relayerNonce, relayerSignatureHex)
 }
 
 //ValidateTransaction -
@@ -179,6 +213,42 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetSyncStatus -
+func (ns *NodeStub) GetSyncStatus() (*api.SyncStatus, error) {
+	if ns.GetSyncStatusCalled != nil {
+		return ns.GetSyncStatusCalled()
+	}
+
+	return &api.SyncStatus{}, nil
+}
+
+// GetEpochStartCheckpoint -
+func (ns *NodeStub) GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error) {
+	if ns.GetEpochStartCheckpointCalled != nil {
+		return ns.GetEpochStartCheckpointCalled()
+	}
+
+	return &api.EpochStartCheckpoint{}, nil
+}
+
+// GetStorageUsage -
+func (ns *NodeStub) GetStorageUsage() (*api.StorageUsage, error) {
+	if ns.GetStorageUsageCalled != nil {
+		return ns.GetStorageUsageCalled()
+	}
+
+	return &api.StorageUsage{}, nil
+}
+
+// CompactStorageUnits -
+func (ns *NodeStub) CompactStorageUnits(units []string) error {
+	if ns.CompactStorageUnitsCalled != nil {
+		return ns.CompactStorageUnitsCalled(units)
+	}
+
+	return nil
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
@@ -214,6 +284,30 @@ func (ns *NodeStub) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return make([]string, 0), nil
 }
 
+// GetTokenSupplyDelta -
+func (ns *NodeStub) GetTokenSupplyDelta(token string) (*api.ESDTSupplyDelta, error) {
+	if ns.GetTokenSupplyDeltaCalled != nil {
+		return ns.GetTokenSupplyDeltaCalled(token)
+	}
+	return nil, nil
+}
+
+// GetESDTAllowances -
+func (ns *NodeStub) GetESDTAllowances(address string) ([]*api.ESDTAllowance, error) {
+	if ns.GetESDTAllowancesCalled != nil {
+		return ns.GetESDTAllowancesCalled(address)
+	}
+	return make([]*api.ESDTAllowance, 0), nil
+}
+
+// GetESDTTokenTypes -
+func (ns *NodeStub) GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error) {
+	if ns.GetESDTTokenTypesCalled != nil {
+		return ns.GetESDTTokenTypesCalled(tokenIdentifiers)
+	}
+	return make(map[string]*api.ESDTTokenType), nil
+}
+
 // GetNFTTokenIDsRegisteredByAddress -
 func (ns *NodeStub) GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error) {
 	if ns.GetNFTTokenIDsRegisteredByAddressCalled != nil {
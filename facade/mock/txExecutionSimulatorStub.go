@@ -1,12 +1,14 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTxCalled  func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessSCRCalled func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -18,6 +20,15 @@ func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tran
 	return &transaction.SimulationResults{}, nil
 }
 
+// ProcessSCR -
+func (t *TxExecutionSimulatorStub) ProcessSCR(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+	if t.ProcessSCRCalled != nil {
+		return t.ProcessSCRCalled(scr)
+	}
+
+	return &transaction.SimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
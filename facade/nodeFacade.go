@@ -29,6 +29,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/codeInspector"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
 )
@@ -224,11 +225,26 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigit
 	return nf.node.GetAllESDTTokens(address)
 }
 
+// GetESDTAllowances returns the esdt allowances given by the provided address
+func (nf *nodeFacade) GetESDTAllowances(address string) ([]*apiData.ESDTAllowance, error) {
+	return nf.node.GetESDTAllowances(address)
+}
+
+// GetESDTTokenTypes returns the types of the provided tokens
+func (nf *nodeFacade) GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*apiData.ESDTTokenType, error) {
+	return nf.node.GetESDTTokenTypes(tokenIdentifiers)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	return nf.node.GetAllIssuedESDTs(tokenType)
 }
 
+// GetTokenSupplyDelta returns the quantities of the provided token minted and burned in the shard of the node
+func (nf *nodeFacade) GetTokenSupplyDelta(token string) (*apiData.ESDTSupplyDelta, error) {
+	return nf.node.GetTokenSupplyDelta(token)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -244,9 +260,12 @@ func (nf *nodeFacade) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	relayer string,
+	relayerNonce uint64,
+	relayerSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
 
-	return nf.node.CreateTransaction(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, txData, signatureHex, chainID, version, options)
+	return nf.node.CreateTransaction(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, txData, signatureHex, chainID, version, options, relayer, relayerNonce, relayerSignatureHex)
 }
 
 // ValidateTransaction will validate a transaction
@@ -327,6 +346,26 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will output the proposals of the governance system SC
+func (nf *nodeFacade) GetGovernanceProposals() ([]*apiData.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal will output a governance proposal together with its vote tallies
+func (nf *nodeFacade) GetGovernanceProposal(commitHash string) (*apiData.GovernanceProposalTally, error) {
+	return nf.apiResolver.GetGovernanceProposal(commitHash)
+}
+
+// GetGovernanceVotes will output the governance votes cast by the provided address
+func (nf *nodeFacade) GetGovernanceVotes(address string) ([]*apiData.GovernanceVote, error) {
+	return nf.apiResolver.GetGovernanceVotes(address)
+}
+
+// GetStakingQueue will output the BLS keys waiting in the staking queue
+func (nf *nodeFacade) GetStakingQueue() ([]*apiData.StakingQueueEntry, error) {
+	return nf.apiResolver.GetStakingQueue()
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -372,6 +411,26 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetSyncStatus returns the information gathered from the fork detector and the sync mechanism of the node
+func (nf *nodeFacade) GetSyncStatus() (*apiData.SyncStatus, error) {
+	return nf.node.GetSyncStatus()
+}
+
+// GetEpochStartCheckpoint returns the epoch start metablock of the current epoch, usable as a trusted checkpoint
+func (nf *nodeFacade) GetEpochStartCheckpoint() (*apiData.EpochStartCheckpoint, error) {
+	return nf.node.GetEpochStartCheckpoint()
+}
+
+// GetStorageUsage returns the size on disk of the storage units, per unit and per epoch
+func (nf *nodeFacade) GetStorageUsage() (*apiData.StorageUsage, error) {
+	return nf.node.GetStorageUsage()
+}
+
+// CompactStorageUnits starts the online compaction of the provided storage units
+func (nf *nodeFacade) CompactStorageUnits(units []string) error {
+	return nf.node.CompactStorageUnits(units)
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -390,6 +449,54 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetEvents returns a page of the indexed smart contract events matching the provided query
+func (nf *nodeFacade) GetEvents(query apiData.EventsQuery) (*apiData.EventsPage, error) {
+	return nf.node.GetEvents(query)
+}
+
+// GetContractInfo returns the code, the code metadata, the owner and the history of the provided smart contract
+func (nf *nodeFacade) GetContractInfo(address string) (*apiData.ContractInfo, error) {
+	return nf.node.GetContractInfo(address)
+}
+
+// CheckContractUpgrade simulates the upgrade of the provided smart contract, as if it was sent by its owner,
+// and returns the simulation results together with the differences between the exported functions
+func (nf *nodeFacade) CheckContractUpgrade(address string, request apiData.UpgradeCheckRequest) (*apiData.UpgradeCheckResult, error) {
+	contract, err := nf.node.GetContractInfo(address)
+	if err != nil {
+		return nil, err
+	}
+
+	currentCode, err := hex.DecodeString(contract.Code)
+	if err != nil {
+		return nil, err
+	}
+	newCode, err := hex.DecodeString(request.Code)
+	if err != nil {
+		return nil, err
+	}
+	differences, err := codeInspector.CompareExportedFunctions(currentCode, newCode)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := nf.node.CreateContractUpgradeTransaction(contract, request)
+	if err != nil {
+		return nil, err
+	}
+	simulation, err := nf.txSimulatorProc.ProcessTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiData.UpgradeCheckResult{
+		Simulation:       simulation,
+		AddedFunctions:   differences.Added,
+		RemovedFunctions: differences.Removed,
+		ChangedFunctions: differences.ChangedSignature,
+	}, nil
+}
+
 // Close will cleanup started go routines
 func (nf *nodeFacade) Close() error {
 	log.LogIfError(nf.apiResolver.Close())
@@ -496,7 +496,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 
 	nodeCreateTxWasCalled := false
 	node := &mock.NodeStub{
-		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32) (*transaction.Transaction, []byte, error) {
+		CreateTransactionHandler: func(_ uint64, _ string, _ string, _ []byte, _ string, _ []byte, _ uint64, _ uint64, _ []byte, _ string, _ string, _, _ uint32, _ string, _ uint64, _ string) (*transaction.Transaction, []byte, error) {
 			nodeCreateTxWasCalled = true
 			return nil, nil, nil
 		},
@@ -505,7 +505,7 @@ func TestNodeFacade_CreateTransaction(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _, _ = nf.CreateTransaction(0, "0", "0", nil, "0", nil, 0, 0, []byte("0"), "0", "chainID", 1, 0)
+	_, _, _ = nf.CreateTransaction(0, "0", "0", nil, "0", nil, 0, 0, []byte("0"), "0", "chainID", 1, 0, "", 0, "")
 
 	assert.True(t, nodeCreateTxWasCalled)
 }
@@ -1111,3 +1111,46 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	require.Equal(t, expectedBalance, outputAccount.Balance)
 	require.Equal(t, hex.EncodeToString(expectedAddress), outputAccount.Address)
 }
+
+func TestNodeFacade_CheckContractUpgrade(t *testing.T) {
+	t.Parallel()
+
+	emptyModule := "0061736d01000000"
+	contract := &api.ContractInfo{Address: "contract", Code: emptyModule, Owner: "owner"}
+	request := api.UpgradeCheckRequest{Code: emptyModule}
+	expectedTx := &transaction.Transaction{Nonce: 37}
+	expectedSimulation := &transaction.SimulationResults{Status: transaction.TxStatusSuccess}
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetContractInfoCalled: func(address string) (*api.ContractInfo, error) {
+			require.Equal(t, contract.Address, address)
+			return contract, nil
+		},
+		CreateContractUpgradeTransactionCalled: func(c *api.ContractInfo, r api.UpgradeCheckRequest) (*transaction.Transaction, error) {
+			require.Equal(t, contract, c)
+			require.Equal(t, request, r)
+			return expectedTx, nil
+		},
+	}
+	arg.TxSimulatorProcessor = &mock.TxExecutionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			require.Equal(t, expectedTx, tx)
+			return expectedSimulation, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	result, err := nf.CheckContractUpgrade(contract.Address, request)
+	require.Nil(t, err)
+	require.Equal(t, &api.UpgradeCheckResult{
+		Simulation:       expectedSimulation,
+		AddedFunctions:   make([]string, 0),
+		RemovedFunctions: make([]string, 0),
+		ChangedFunctions: make([]string, 0),
+	}, result)
+
+	result, err = nf.CheckContractUpgrade(contract.Address, api.UpgradeCheckRequest{Code: "aabb"})
+	require.Nil(t, result)
+	require.NotNil(t, err)
+}
@@ -96,6 +96,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		args.CoreComponents.InternalMarshalizer(),
 		args.StateComponents.AccountsAdapter(),
 		args.BootstrapComponents.ShardCoordinator(),
+		args.CoreComponents.EpochNotifier(),
+		args.Configs.EpochConfig.EnableEpochs,
 	)
 	if err != nil {
 		return nil, err
@@ -120,6 +122,9 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		args.ProcessComponents.TransactionSimulatorProcessor(),
 		args.StateComponents.AccountsAdapter(),
 		args.ProcessComponents.ShardCoordinator(),
+		args.CoreComponents.TxMarshalizer(),
+		// the state of the other shards is not available on a regular node, so their execution steps are estimated
+		nil,
 	)
 	if err != nil {
 		return nil, err
@@ -131,11 +136,12 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 	}
 
 	argsProcessors := trieIterators.ArgTrieIteratorProcessor{
-		ShardID:            args.BootstrapComponents.ShardCoordinator().SelfId(),
-		Accounts:           accountsWrapper,
-		PublicKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
-		BlockChain:         args.DataComponents.Blockchain(),
-		QueryService:       scQueryService,
+		ShardID:              args.BootstrapComponents.ShardCoordinator().SelfId(),
+		Accounts:             accountsWrapper,
+		PublicKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		BlockChain:           args.DataComponents.Blockchain(),
+		QueryService:         scQueryService,
+		MaxNodesChangeConfig: args.Configs.EpochConfig.EnableEpochs.MaxNodesChangeEnableEpoch,
 	}
 	totalStakedValueHandler, err := trieIteratorsFactory.CreateTotalStakedValueHandler(argsProcessors)
 	if err != nil {
@@ -152,6 +158,16 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
+	stakingQueueHandler, err := trieIteratorsFactory.CreateStakingQueueHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:          scQueryService,
 		StatusMetricsHandler:    args.CoreComponents.StatusHandlerUtils().Metrics(),
@@ -159,6 +175,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TotalStakedValueHandler: totalStakedValueHandler,
 		DirectStakedListHandler: directStakedListHandler,
 		DelegatedListHandler:    delegatedListHandler,
+		GovernanceHandler:       governanceHandler,
+		StakingQueueHandler:     stakingQueueHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -219,6 +237,8 @@ func createScQueryElement(
 		args.coreComponents.InternalMarshalizer(),
 		args.stateComponents.AccountsAdapter(),
 		args.processComponents.ShardCoordinator(),
+		args.coreComponents.EpochNotifier(),
+		args.epochConfig.EnableEpochs,
 	)
 	if err != nil {
 		return nil, err
@@ -319,6 +339,8 @@ func createBuiltinFuncs(
 	marshalizer marshal.Marshalizer,
 	accnts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
+	epochNotifier process.EpochNotifier,
+	enableEpochs config.EnableEpochs,
 ) (vmcommon.BuiltInFunctionContainer, error) {
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
 		GasSchedule:      gasScheduleNotifier,
@@ -326,6 +348,10 @@ func createBuiltinFuncs(
 		Marshalizer:      marshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    epochNotifier,
+
+		ESDTAllowancesEnableEpoch: enableEpochs.ESDTAllowancesEnableEpoch,
+		MetaESDTEnableEpoch:       enableEpochs.MetaESDTEnableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
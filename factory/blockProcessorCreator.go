@@ -15,6 +15,8 @@ import (
 	processDisabled "github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/importReport"
+	importReportDisabled "github.com/ElrondNetwork/elrond-go/process/block/importReport/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
@@ -102,6 +104,11 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		Marshalizer:      pcf.coreData.InternalMarshalizer(),
 		Accounts:         pcf.state.AccountsAdapter(),
 		ShardCoordinator: pcf.bootstrapComponents.ShardCoordinator(),
+		EpochNotifier:    pcf.coreData.EpochNotifier(),
+
+		EnableESDTSupplyLogs:      pcf.config.DbLookupExtensions.Enabled && pcf.config.DbLookupExtensions.ESDTSuppliesEnabled,
+		ESDTAllowancesEnableEpoch: pcf.epochConfig.EnableEpochs.ESDTAllowancesEnableEpoch,
+		MetaESDTEnableEpoch:       pcf.epochConfig.EnableEpochs.MetaESDTEnableEpoch,
 	}
 
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
@@ -248,6 +255,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		ArwenChangeLocker:                   arwenChangeLocker,
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
+		CodeChangeLogsEnableEpoch:                   enableEpochs.CodeChangeLogsEnableEpoch,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
@@ -283,6 +291,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		MetaProtectionEnableEpoch:      enableEpochs.MetaProtectionEnableEpoch,
 		EpochNotifier:                  pcf.epochNotifier,
 		RelayedTxV2EnableEpoch:         enableEpochs.RelayedTransactionsV2EnableEpoch,
+		RelayedTxV3EnableEpoch:         enableEpochs.RelayedTransactionsV3EnableEpoch,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -334,6 +343,8 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		blockTracker,
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		pcf.epochNotifier,
+		enableEpochs.ScheduledMiniBlocksEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -344,6 +355,21 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		return nil, err
 	}
 
+	argsScheduledTxsExecution := preprocess.ArgsScheduledTxsExecution{
+		TxProcessor:       transactionProcessor,
+		InterimProcessors: interimProcContainer,
+		TxFeeHandler:      txFeeHandler,
+		GasHandler:        gasHandler,
+		Accounts:          pcf.state.AccountsAdapter(),
+		TxLogsProcessor:   pcf.txLogsProcessor,
+		Storer:            pcf.data.StorageService().GetStorer(dataRetriever.ScheduledSCRsUnit),
+		Marshalizer:       pcf.coreData.InternalMarshalizer(),
+	}
+	scheduledTxsExecutionHandler, err := preprocess.NewScheduledTxsExecution(argsScheduledTxsExecution)
+	if err != nil {
+		return nil, err
+	}
+
 	argsTransactionCoordinator := coordinator.ArgTransactionCoordinator{
 		Hasher:                            pcf.coreData.Hasher(),
 		Marshalizer:                       pcf.coreData.InternalMarshalizer(),
@@ -361,12 +387,18 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		TxTypeHandler:                     txTypeHandler,
 		BlockGasAndFeesReCheckEnableEpoch: pcf.epochConfig.EnableEpochs.BlockGasAndFeesReCheckEnableEpoch,
 		TransactionsLogProcessor:          pcf.txLogsProcessor,
+		ScheduledTxsExecutionHandler:      scheduledTxsExecutionHandler,
 	}
 	txCoordinator, err := coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	if err != nil {
 		return nil, err
 	}
 
+	processingReporter, err := pcf.createBlockProcessingReporter(txCoordinator, gasHandler, txFeeHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	accountsDb := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
 	accountsDb[state.UserAccountsState] = pcf.state.AccountsAdapter()
 
@@ -393,9 +425,11 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		EpochNotifier:       pcf.epochNotifier,
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
+		ProcessingReporter:  processingReporter,
 	}
 	arguments := block.ArgShardProcessor{
-		ArgBaseProcessor: argumentsBaseProcessor,
+		ArgBaseProcessor:             argumentsBaseProcessor,
+		ScheduledTxsExecutionHandler: scheduledTxsExecutionHandler,
 	}
 
 	blockProcessor, err := block.NewShardProcessor(arguments)
@@ -425,6 +459,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		Marshalizer:      pcf.coreData.InternalMarshalizer(),
 		Accounts:         pcf.state.AccountsAdapter(),
 		ShardCoordinator: pcf.bootstrapComponents.ShardCoordinator(),
+		EpochNotifier:    pcf.coreData.EpochNotifier(),
+
+		EnableESDTSupplyLogs:      pcf.config.DbLookupExtensions.Enabled && pcf.config.DbLookupExtensions.ESDTSuppliesEnabled,
+		ESDTAllowancesEnableEpoch: pcf.epochConfig.EnableEpochs.ESDTAllowancesEnableEpoch,
+		MetaESDTEnableEpoch:       pcf.epochConfig.EnableEpochs.MetaESDTEnableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -561,6 +600,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		ArwenChangeLocker:                   arwenChangeLocker,
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
+		CodeChangeLogsEnableEpoch:                   enableEpochs.CodeChangeLogsEnableEpoch,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
@@ -625,6 +665,8 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		pcf.coreData.AddressPubKeyConverter(),
 		blockSizeComputationHandler,
 		balanceComputationHandler,
+		pcf.epochNotifier,
+		enableEpochs.ScheduledMiniBlocksEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -652,6 +694,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		TxTypeHandler:                     txTypeHandler,
 		BlockGasAndFeesReCheckEnableEpoch: enableEpochs.BlockGasAndFeesReCheckEnableEpoch,
 		TransactionsLogProcessor:          pcf.txLogsProcessor,
+		ScheduledTxsExecutionHandler:      &processDisabled.ScheduledTxsExecutionHandler{},
 	}
 	txCoordinator, err := coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	if err != nil {
@@ -761,6 +804,11 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		return nil, err
 	}
 
+	processingReporter, err := pcf.createBlockProcessingReporter(txCoordinator, gasHandler, txFeeHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	accountsDb := make(map[state.AccountsDbIdentifier]state.AccountsAdapter)
 	accountsDb[state.UserAccountsState] = pcf.state.AccountsAdapter()
 	accountsDb[state.PeerAccountsState] = pcf.state.PeerAccounts()
@@ -788,6 +836,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		EpochNotifier:       pcf.epochNotifier,
 		VMContainersFactory: vmFactory,
 		VmContainer:         vmContainer,
+		ProcessingReporter:  processingReporter,
 	}
 
 	esdtOwnerAddress, err := pcf.coreData.AddressPubKeyConverter().Decode(pcf.systemSCConfig.ESDTSystemSCConfig.OwnerAddress)
@@ -803,6 +852,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		Marshalizer:             pcf.coreData.InternalMarshalizer(),
 		StartRating:             pcf.coreData.RatingsData().StartRating(),
 		ValidatorInfoCreator:    validatorStatisticsProcessor,
+		ValidatorKeyRotator:     validatorStatisticsProcessor,
 		EndOfEpochCallerAddress: vm.EndOfEpochAddress,
 		StakingSCAddress:        vm.StakingSCAddress,
 		ChanceComputer:          pcf.coreData.Rater(),
@@ -898,6 +948,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return err
 	}
 	txProcArgs.ScProcessor = scProcessor
+	txSimulatorProcessorArgs.SCRProcessor = scProcessor
 
 	txProcArgs.Accounts = readOnlyAccountsDB
 
@@ -954,6 +1005,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	if err != nil {
 		return err
 	}
+	txSimulatorProcessorArgs.SCRProcessor = scProcessor
 
 	accountsWrapper, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapter())
 	if err != nil {
@@ -982,3 +1034,28 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	return nil
 }
+
+func (pcf *processComponentsFactory) createBlockProcessingReporter(
+	txCoordinator process.TransactionCoordinator,
+	gasHandler process.GasHandler,
+	feeHandler process.TransactionFeeHandler,
+) (process.BlockProcessingReporter, error) {
+	if !pcf.importDBConfig.IsImportDBMode || len(pcf.importDBConfig.ImportDBReportFilePath) == 0 {
+		return importReportDisabled.NewProcessingReporter(), nil
+	}
+
+	argsReporter := importReport.ArgsReporter{
+		ReportFilePath:      pcf.importDBConfig.ImportDBReportFilePath,
+		BisectStartNonce:    pcf.importDBConfig.ImportDBBisectStartNonce,
+		BisectEndNonce:      pcf.importDBConfig.ImportDBBisectEndNonce,
+		Accounts:            pcf.state.AccountsAdapter(),
+		PeerAccounts:        pcf.state.PeerAccounts(),
+		TxCoordinator:       txCoordinator,
+		GasHandler:          gasHandler,
+		FeeHandler:          feeHandler,
+		PubkeyConverter:     pcf.coreData.AddressPubKeyConverter(),
+		ChanStopNodeProcess: pcf.coreData.ChanStopNodeProcess(),
+	}
+
+	return importReport.NewReporter(argsReporter)
+}
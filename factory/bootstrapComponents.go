@@ -125,7 +125,7 @@ func (bcf *bootstrapComponentsFactory) Create() (*bootstrapComponents, error) {
 		parentDir,
 		core.DefaultEpochString,
 		core.DefaultShardString,
-		bcf.prefConfig.Preferences.FullArchive,
+		storageFactory.KeepsAllEpochs(bcf.prefConfig.Preferences),
 	)
 	if err != nil {
 		return nil, err
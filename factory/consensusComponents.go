@@ -19,13 +19,17 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/sync/replica"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
 const timeoutGettingTrieNode = time.Minute
 
+const replicaPollInterval = time.Second
+
 // ConsensusComponentsFactoryArgs holds the arguments needed to create a consensus components factory
 type ConsensusComponentsFactoryArgs struct {
 	Config              config.Config
@@ -39,6 +43,7 @@ type ConsensusComponentsFactoryArgs struct {
 	StateComponents     StateComponentsHolder
 	StatusComponents    StatusComponentsHolder
 	IsInImportMode      bool
+	IsInReplicaMode     bool
 }
 
 type consensusComponentsFactory struct {
@@ -53,6 +58,7 @@ type consensusComponentsFactory struct {
 	stateComponents     StateComponentsHolder
 	statusComponents    StatusComponentsHolder
 	isInImportMode      bool
+	isInReplicaMode     bool
 }
 
 type consensusComponents struct {
@@ -63,6 +69,7 @@ type consensusComponents struct {
 	hardforkTrigger    HardforkTrigger
 	consensusTopic     string
 	consensusGroupSize int
+	chainFollower      ChainFollower
 }
 
 // NewConsensusComponentsFactory creates an instance of consensusComponentsFactory
@@ -104,6 +111,7 @@ func NewConsensusComponentsFactory(args ConsensusComponentsFactoryArgs) (*consen
 		stateComponents:     args.StateComponents,
 		statusComponents:    args.StatusComponents,
 		isInImportMode:      args.IsInImportMode,
+		isInReplicaMode:     args.IsInReplicaMode,
 	}, nil
 }
 
@@ -142,7 +150,17 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
-	cc.bootstrapper.StartSyncingBlocks()
+	// a replica node does not sync nor process blocks, it follows the blocks committed by the node owning its databases
+	if ccf.isInReplicaMode {
+		cc.chainFollower, err = ccf.createChainFollower()
+		if err != nil {
+			return nil, err
+		}
+
+		cc.chainFollower.StartFollowing()
+	} else {
+		cc.bootstrapper.StartSyncingBlocks()
+	}
 
 	epoch := ccf.getEpoch()
 	consensusState, err := ccf.createConsensusState(epoch, cc.consensusGroupSize)
@@ -269,16 +287,44 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
-	cc.chronology.StartRounds()
+	if !ccf.isInReplicaMode {
+		cc.chronology.StartRounds()
+	}
 
 	err = ccf.addCloserInstances(cc.chronology, cc.bootstrapper, cc.worker, ccf.coreComponents.SyncTimer())
 	if err != nil {
 		return nil, err
 	}
 
+	if ccf.isInReplicaMode {
+		err = ccf.disableNetworkTopics()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cc, nil
 }
 
+// disableNetworkTopics stops the interceptors, the resolvers and the consensus from receiving messages and
+// leaves all the joined topics, as a replica node must not take part in the network
+func (ccf *consensusComponentsFactory) disableNetworkTopics() error {
+	messenger := ccf.networkComponents.NetworkMessenger()
+	err := messenger.UnregisterAllMessageProcessors()
+	if err != nil {
+		return err
+	}
+
+	err = messenger.UnjoinAllTopics()
+	if err != nil {
+		return err
+	}
+
+	log.Info("node is running in replica mode, the p2p topics, interceptors and resolvers were disabled")
+
+	return nil
+}
+
 // Close will close all the inner components
 func (cc *consensusComponents) Close() error {
 	err := cc.chronology.Close()
@@ -294,6 +340,12 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	if !check.IfNil(cc.chainFollower) {
+		err = cc.chainFollower.Close()
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -394,6 +446,34 @@ func (ccf *consensusComponentsFactory) createBootstrapper() (process.Bootstrappe
 	return nil, sharding.ErrShardIdOutOfRange
 }
 
+func (ccf *consensusComponentsFactory) createChainFollower() (ChainFollower, error) {
+	bootstrapDataProvider, err := storageFactory.NewBootstrapDataProvider(ccf.coreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	argsChainFollower := replica.ArgsChainFollower{
+		ShardCoordinator:        ccf.processComponents.ShardCoordinator(),
+		BlockChain:              ccf.dataComponents.Blockchain(),
+		StorageService:          ccf.dataComponents.StorageService(),
+		Accounts:                ccf.stateComponents.AccountsAdapter(),
+		PeerAccounts:            ccf.stateComponents.PeerAccounts(),
+		AccountsAPI:             ccf.stateComponents.AccountsAdapterAPI(),
+		TrieStorageManagers:     ccf.stateComponents.TrieStorageManagers(),
+		Marshalizer:             ccf.coreComponents.InternalMarshalizer(),
+		PathManager:             ccf.coreComponents.PathHandler(),
+		PersisterFactory:        storageFactory.NewPersisterFactory(ccf.config.BootstrapStorage.DB),
+		BootstrapDataProvider:   bootstrapDataProvider,
+		BootstrapUnitIdentifier: ccf.config.BootstrapStorage.DB.FilePath,
+		AppStatusHandler:        ccf.coreComponents.StatusHandler(),
+		Epoch:                   ccf.processComponents.EpochStartTrigger().Epoch(),
+		ChanStopNodeProcess:     ccf.coreComponents.ChanStopNodeProcess(),
+		PollInterval:            replicaPollInterval,
+	}
+
+	return replica.NewChainFollower(argsChainFollower)
+}
+
 func (ccf *consensusComponentsFactory) createShardBootstrapper() (process.Bootstrapper, error) {
 	argsBaseStorageBootstrapper := storageBootstrap.ArgsBaseStorageBootstrapper{
 		BootStorer:          ccf.processComponents.BootStorer(),
@@ -449,6 +529,7 @@ func (ccf *consensusComponentsFactory) createShardBootstrapper() (process.Bootst
 		Indexer:              ccf.statusComponents.ElasticIndexer(),
 		AccountsDBSyncer:     accountsDBSyncer,
 		CurrentEpochProvider: ccf.processComponents.CurrentEpochProvider(),
+		SyncIntrospector:     ccf.processComponents.SyncIntrospector(),
 		IsInImportMode:       ccf.isInImportMode,
 	}
 
@@ -570,6 +651,7 @@ func (ccf *consensusComponentsFactory) createMetaChainBootstrapper() (process.Bo
 		Indexer:              ccf.statusComponents.ElasticIndexer(),
 		AccountsDBSyncer:     accountsDBSyncer,
 		CurrentEpochProvider: ccf.processComponents.CurrentEpochProvider(),
+		SyncIntrospector:     ccf.processComponents.SyncIntrospector(),
 		IsInImportMode:       ccf.isInImportMode,
 	}
 
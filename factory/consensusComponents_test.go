@@ -150,6 +150,23 @@ func TestConsensusComponentsFactory_CreateForShard(t *testing.T) {
 	require.NotNil(t, cc)
 }
 
+func TestConsensusComponentsFactory_CreateInReplicaMode(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	args := getConsensusArgs(shardCoordinator)
+	args.IsInReplicaMode = true
+	ccf, _ := factory.NewConsensusComponentsFactory(args)
+	require.NotNil(t, ccf)
+
+	cc, err := ccf.Create()
+	require.NoError(t, err)
+	require.NotNil(t, cc)
+
+	err = cc.Close()
+	require.NoError(t, err)
+}
+
 type wrappedProcessComponents struct {
 	factory.ProcessComponentsHolder
 }
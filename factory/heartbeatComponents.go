@@ -32,6 +32,7 @@ type HeartbeatComponentsFactoryArgs struct {
 	NetworkComponents NetworkComponentsHolder
 	CryptoComponents  CryptoComponentsHolder
 	ProcessComponents ProcessComponentsHolder
+	IsInReplicaMode   bool
 }
 
 type heartbeatComponentsFactory struct {
@@ -46,6 +47,7 @@ type heartbeatComponentsFactory struct {
 	networkComponents NetworkComponentsHolder
 	cryptoComponents  CryptoComponentsHolder
 	processComponents ProcessComponentsHolder
+	isInReplicaMode   bool
 }
 
 type heartbeatComponents struct {
@@ -93,6 +95,7 @@ func NewHeartbeatComponentsFactory(args HeartbeatComponentsFactoryArgs) (*heartb
 		networkComponents: args.NetworkComponents,
 		cryptoComponents:  args.CryptoComponents,
 		processComponents: args.ProcessComponents,
+		isInReplicaMode:   args.IsInReplicaMode,
 	}, nil
 }
 
@@ -113,7 +116,8 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 	if check.IfNil(hcf.networkComponents.NetworkMessenger()) {
 		return nil, errors.ErrNilMessenger
 	}
-	if !hcf.networkComponents.NetworkMessenger().HasTopic(core.HeartbeatTopic) {
+	// a replica node neither sends nor receives heartbeats
+	if !hcf.isInReplicaMode && !hcf.networkComponents.NetworkMessenger().HasTopic(core.HeartbeatTopic) {
 		err = hcf.networkComponents.NetworkMessenger().CreateTopic(core.HeartbeatTopic, true)
 		if err != nil {
 			return nil, err
@@ -130,6 +134,7 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 	}
 
 	peerSubType := core.RegularPeer
+	// the light history nodes prune the state tries, so only the full archive nodes are advertised as full history observers
 	if hcf.prefs.Preferences.FullArchive {
 		peerSubType = core.FullHistoryObserver
 	}
@@ -213,6 +218,11 @@ func (hcf *heartbeatComponentsFactory) Create() (*heartbeatComponents, error) {
 
 	log.Debug("heartbeat's monitor component has been instantiated")
 
+	if hcf.isInReplicaMode {
+		log.Debug("node is running in replica mode, the heartbeat messages will not be sent nor received")
+		return hbc, nil
+	}
+
 	err = hcf.networkComponents.NetworkMessenger().RegisterMessageProcessor(
 		core.HeartbeatTopic,
 		core.DefaultInterceptorsIdentifier,
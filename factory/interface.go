@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -216,6 +217,7 @@ type NetworkComponentsHandler interface {
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
 	ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessSCR(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -252,6 +254,7 @@ type ProcessComponentsHolder interface {
 	NodeRedundancyHandler() consensus.NodeRedundancyHandler
 	ArwenChangeLocker() process.Locker
 	CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler
+	SyncIntrospector() process.SyncIntrospectionHandler
 	IsInterfaceNil() bool
 }
 
@@ -333,6 +336,13 @@ type HeartbeatComponentsHandler interface {
 	HeartbeatComponentsHolder
 }
 
+// ChainFollower defines the component that makes a replica node follow the blocks committed by another node
+type ChainFollower interface {
+	StartFollowing()
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ConsensusWorker is the consensus worker handle for the exported functionality
 type ConsensusWorker interface {
 	Close() error
@@ -44,6 +44,7 @@ type ProcessComponentsMock struct {
 	NodeRedundancyHandlerInternal  consensus.NodeRedundancyHandler
 	ArwenChangeLockerInternal      process.Locker
 	CurrentEpochProviderInternal   process.CurrentNetworkEpochProviderHandler
+	SyncIntrospectorInternal       process.SyncIntrospectionHandler
 }
 
 // Create -
@@ -216,6 +217,11 @@ func (pcm *ProcessComponentsMock) CurrentEpochProvider() process.CurrentNetworkE
 	return pcm.CurrentEpochProviderInternal
 }
 
+// SyncIntrospector -
+func (pcm *ProcessComponentsMock) SyncIntrospector() process.SyncIntrospectionHandler {
+	return pcm.SyncIntrospectorInternal
+}
+
 // IsInterfaceNil -
 func (pcm *ProcessComponentsMock) IsInterfaceNil() bool {
 	return pcm == nil
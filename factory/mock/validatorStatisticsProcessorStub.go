@@ -18,6 +18,7 @@ type ValidatorStatisticsProcessorStub struct {
 	CommitCalled                             func() ([]byte, error)
 	PeerAccountToValidatorInfoCalled         func(peerAccount state.PeerAccountHandler) *state.ValidatorInfo
 	SaveNodesCoordinatorUpdatesCalled        func(epoch uint32) (bool, error)
+	RotatePeerAccountKeyCalled               func(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error)
 }
 
 // PeerAccountToValidatorInfo -
@@ -116,6 +117,14 @@ func (vsp *ValidatorStatisticsProcessorStub) GetPeerAccount(address []byte) (sta
 func (vsp *ValidatorStatisticsProcessorStub) DisplayRatings(_ uint32) {
 }
 
+// RotatePeerAccountKey -
+func (vsp *ValidatorStatisticsProcessorStub) RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error) {
+	if vsp.RotatePeerAccountKeyCalled != nil {
+		return vsp.RotatePeerAccountKeyCalled(oldKey, newKey)
+	}
+	return nil, nil
+}
+
 // SaveNodesCoordinatorUpdates -
 func (vsp *ValidatorStatisticsProcessorStub) SaveNodesCoordinatorUpdates(epoch uint32) (bool, error){
 	if vsp.SaveNodesCoordinatorUpdatesCalled !=nil{
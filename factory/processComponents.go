@@ -44,6 +44,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/sync/introspection"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
@@ -62,6 +63,12 @@ var log = logger.GetOrCreate("factory")
 // timeSpanForBadHeaders is the expiry time for an added block header hash
 var timeSpanForBadHeaders = time.Minute * 2
 
+// maxNumSyncRollBacks is the number of the most recent sync roll backs exposed through the API
+const maxNumSyncRollBacks = 50
+
+// maxNumHeaderSuppliers is the maximum number of peers supplying headers exposed through the API
+const maxNumHeaderSuppliers = 100
+
 // processComponents struct holds the process components
 type processComponents struct {
 	nodesCoordinator            sharding.NodesCoordinator
@@ -98,6 +105,7 @@ type processComponents struct {
 	nodeRedundancyHandler       consensus.NodeRedundancyHandler
 	currentEpochProvider        dataRetriever.CurrentNetworkEpochProviderHandler
 	arwenChangeLocker           process.Locker
+	syncIntrospector            process.SyncIntrospectionHandler
 }
 
 // ProcessComponentsFactoryArgs holds the arguments needed to create a process components factory
@@ -119,6 +127,7 @@ type ProcessComponentsFactoryArgs struct {
 	ImportStartHandler     update.ImportStartHandler
 	WorkingDir             string
 	HistoryRepo            dblookupext.HistoryRepository
+	EnableEpochsChanger    core.GovernanceParamChangeHandler
 
 	Data                DataComponentsHolder
 	CoreData            CoreComponentsHolder
@@ -148,6 +157,7 @@ type processComponentsFactory struct {
 	importStartHandler     update.ImportStartHandler
 	workingDir             string
 	historyRepo            dblookupext.HistoryRepository
+	enableEpochsChanger    core.GovernanceParamChangeHandler
 	epochNotifier          process.EpochNotifier
 	importHandler          update.ImportHandler
 
@@ -192,6 +202,7 @@ func NewProcessComponentsFactory(args ProcessComponentsFactoryArgs) (*processCom
 		importStartHandler:     args.ImportStartHandler,
 		workingDir:             args.WorkingDir,
 		historyRepo:            args.HistoryRepo,
+		enableEpochsChanger:    args.EnableEpochsChanger,
 		epochNotifier:          args.CoreData.EpochNotifier(),
 	}, nil
 }
@@ -204,7 +215,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		pcf.config,
 		pcf.coreData.GenesisNodesSetup().GetRoundDuration(),
 		pcf.coreData.GenesisTime().Unix(),
-		pcf.prefConfigs.FullArchive,
+		storageFactory.KeepsAllEpochs(pcf.prefConfigs),
 	)
 	if err != nil {
 		return nil, err
@@ -316,6 +327,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	err = pcf.registerGovernanceParamChangesNotifier()
+	if err != nil {
+		return nil, err
+	}
+
 	epochStartTrigger, err := pcf.newEpochStartTrigger(requestHandler)
 	if err != nil {
 		return nil, err
@@ -402,12 +418,22 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	syncIntrospector, err := introspection.NewSyncIntrospector(introspection.ArgsSyncIntrospector{
+		MaxNumRollBacks:       maxNumSyncRollBacks,
+		MaxNumHeaderSuppliers: maxNumHeaderSuppliers,
+		StatusHandler:         pcf.coreData.StatusHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, blackListHandler, err := pcf.newInterceptorContainerFactory(
 		headerSigVerifier,
 		pcf.bootstrapComponents.HeaderIntegrityVerifier(),
 		blockTracker,
 		epochStartTrigger,
 		requestHandler,
+		syncIntrospector,
 	)
 	if err != nil {
 		return nil, err
@@ -543,6 +569,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		nodeRedundancyHandler:       nodeRedundancyHandler,
 		currentEpochProvider:        currentEpochProvider,
 		arwenChangeLocker:           arwenChangeLocker,
+		syncIntrospector:            syncIntrospector,
 	}, nil
 }
 
@@ -588,6 +615,52 @@ func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.
 	return validatorStatisticsProcessor, nil
 }
 
+// registerGovernanceParamChangesNotifier subscribes the components which apply the network parameter changes accepted
+// through governance to the start of epoch events, after applying the changes carried by the epoch start meta block of
+// the epoch in which the node starts
+func (pcf *processComponentsFactory) registerGovernanceParamChangesNotifier() error {
+	paramChangesNotifier, err := notifier.NewGovernanceParamChangesNotifier(notifier.ArgsGovernanceParamChangesNotifier{
+		MetaBlockStorer:     pcf.data.StorageService().GetStorer(dataRetriever.MetaBlockUnit),
+		Marshalizer:         pcf.coreData.InternalMarshalizer(),
+		ChanStopNodeProcess: pcf.coreData.ChanStopNodeProcess(),
+	})
+	if err != nil {
+		return err
+	}
+
+	gasScheduleHandler, ok := pcf.gasSchedule.(core.GovernanceParamChangeHandler)
+	if ok {
+		err = paramChangesNotifier.RegisterParamChangeHandler(core.GovernanceGasScheduleParam, gasScheduleHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	feeSettingsHandler, ok := pcf.coreData.EconomicsData().(core.GovernanceParamChangeHandler)
+	if ok {
+		err = paramChangesNotifier.RegisterParamChangeHandler(core.GovernanceFeeSettingsParam, feeSettingsHandler)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !check.IfNil(pcf.enableEpochsChanger) {
+		err = paramChangesNotifier.RegisterParamChangeHandler(core.GovernanceEnableEpochsParam, pcf.enableEpochsChanger)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = paramChangesNotifier.ApplyEpochStartParamChanges(pcf.bootstrapComponents.EpochBootstrapParams().Epoch())
+	if err != nil {
+		return err
+	}
+
+	pcf.coreData.EpochStartNotifierWithConfirm().RegisterHandler(paramChangesNotifier)
+
+	return nil
+}
+
 func (pcf *processComponentsFactory) newEpochStartTrigger(requestHandler process.RequestHandler) (epochStart.TriggerHandler, error) {
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() < pcf.bootstrapComponents.ShardCoordinator().NumberOfShards() {
 		argsHeaderValidator := block.ArgsHeaderValidator{
@@ -836,7 +909,7 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(genesisBlocks map[uint32
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -930,7 +1003,7 @@ func (pcf *processComponentsFactory) newShardResolverContainerFactory(
 		InputAntifloodHandler:       pcf.network.InputAntiFloodHandler(),
 		OutputAntifloodHandler:      pcf.network.OutputAntiFloodHandler(),
 		NumConcurrentResolvingJobs:  pcf.config.Antiflood.NumConcurrentResolverJobs,
-		IsFullHistoryNode:           pcf.prefConfigs.FullArchive,
+		IsFullHistoryNode:           storageFactory.KeepsAllEpochs(pcf.prefConfigs),
 		CurrentNetworkEpochProvider: currentEpochProvider,
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
@@ -965,7 +1038,7 @@ func (pcf *processComponentsFactory) newMetaResolverContainerFactory(
 		InputAntifloodHandler:       pcf.network.InputAntiFloodHandler(),
 		OutputAntifloodHandler:      pcf.network.OutputAntiFloodHandler(),
 		NumConcurrentResolvingJobs:  pcf.config.Antiflood.NumConcurrentResolverJobs,
-		IsFullHistoryNode:           pcf.prefConfigs.FullArchive,
+		IsFullHistoryNode:           storageFactory.KeepsAllEpochs(pcf.prefConfigs),
 		CurrentNetworkEpochProvider: currentEpochProvider,
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
@@ -983,6 +1056,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	syncIntrospector process.SyncIntrospectionHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() < pcf.bootstrapComponents.ShardCoordinator().NumberOfShards() {
 		return pcf.newShardInterceptorContainerFactory(
@@ -991,6 +1065,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 			validityAttester,
 			epochStartTrigger,
 			requestHandler,
+			syncIntrospector,
 		)
 	}
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
@@ -1000,6 +1075,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 			validityAttester,
 			epochStartTrigger,
 			requestHandler,
+			syncIntrospector,
 		)
 	}
 
@@ -1132,6 +1208,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	syncIntrospector process.SyncIntrospectionHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	shardInterceptorsContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
@@ -1156,8 +1233,10 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 		ArgumentsParser:           smartContract.NewArgumentParser(),
 		SizeCheckDelta:            pcf.config.Marshalizer.SizeCheckDelta,
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
+		EnableRelayedTxV3Epoch:    pcf.epochConfig.EnableEpochs.RelayedTransactionsV3EnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		SyncIntrospector:          syncIntrospector,
 	}
 	log.Debug("shardInterceptor: enable epoch for transaction signed with tx hash", "epoch", shardInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -1175,6 +1254,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	syncIntrospector process.SyncIntrospectionHandler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	metaInterceptorsContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
@@ -1199,8 +1279,10 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 		ArgumentsParser:           smartContract.NewArgumentParser(),
 		SizeCheckDelta:            pcf.config.Marshalizer.SizeCheckDelta,
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
+		EnableRelayedTxV3Epoch:    pcf.epochConfig.EnableEpochs.RelayedTransactionsV3EnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		SyncIntrospector:          syncIntrospector,
 	}
 	log.Debug("metaInterceptor: enable epoch for transaction signed with tx hash", "epoch", metaInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -151,6 +151,9 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.currentEpochProvider) {
 		return errors.ErrNilCurrentEpochProvider
 	}
+	if check.IfNil(m.processComponents.syncIntrospector) {
+		return errors.ErrNilSyncIntrospector
+	}
 
 	return nil
 }
@@ -527,6 +530,18 @@ func (m *managedProcessComponents) CurrentEpochProvider() process.CurrentNetwork
 	return m.processComponents.currentEpochProvider
 }
 
+// SyncIntrospector returns the component which gathers the information about the sync process
+func (m *managedProcessComponents) SyncIntrospector() process.SyncIntrospectionHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.syncIntrospector
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (m *managedProcessComponents) IsInterfaceNil() bool {
 	return m == nil
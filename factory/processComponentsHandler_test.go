@@ -82,6 +82,7 @@ func TestManagedProcessComponents_Create_ShouldWork(t *testing.T) {
 	require.True(t, check.IfNil(managedProcessComponents.HeaderIntegrityVerifier()))
 	require.True(t, check.IfNilReflect(managedProcessComponents.ArwenChangeLocker()))
 	require.True(t, check.IfNil(managedProcessComponents.CurrentEpochProvider()))
+	require.True(t, check.IfNil(managedProcessComponents.SyncIntrospector()))
 	require.True(t, check.IfNil(managedProcessComponents.NodeRedundancyHandler()))
 	require.True(t, check.IfNil(managedProcessComponents.WhiteListHandler()))
 	require.True(t, check.IfNil(managedProcessComponents.WhiteListerVerifiedTxs()))
@@ -116,6 +117,7 @@ func TestManagedProcessComponents_Create_ShouldWork(t *testing.T) {
 	require.False(t, check.IfNil(managedProcessComponents.HeaderIntegrityVerifier()))
 	require.False(t, check.IfNilReflect(managedProcessComponents.ArwenChangeLocker()))
 	require.False(t, check.IfNil(managedProcessComponents.CurrentEpochProvider()))
+	require.False(t, check.IfNil(managedProcessComponents.SyncIntrospector()))
 	require.False(t, check.IfNil(managedProcessComponents.NodeRedundancyHandler()))
 	require.False(t, check.IfNil(managedProcessComponents.WhiteListHandler()))
 	require.False(t, check.IfNil(managedProcessComponents.WhiteListerVerifiedTxs()))
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
 
@@ -191,7 +192,7 @@ func CreateNodesCoordinator(
 		WaitingListFixEnabledEpoch: waitingListFixEnabledEpoch,
 		ChanStopNode:               chanNodeStop,
 		NodeTypeProvider:           nodeTypeProvider,
-		IsFullArchive:              prefsConfig.FullArchive,
+		IsFullArchive:              storageFactory.KeepsAllEpochs(prefsConfig),
 	}
 
 	baseNodesCoordinator, err := sharding.NewIndexHashedNodesCoordinator(argumentsNodesCoordinator)
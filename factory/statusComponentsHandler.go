@@ -20,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 )
 
 var _ ComponentHandler = (*managedStatusComponents)(nil)
@@ -201,6 +202,11 @@ func (msc *managedStatusComponents) startStatusPolling(ctx context.Context) erro
 		return err
 	}
 
+	err = registerCompressionStatistics(appStatusPollingHandler)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll(ctx)
 
 	return nil
@@ -320,6 +326,7 @@ func registerPollProbableHighestNonce(
 	probableHighestNonceHandlerFunc := func(appStatusHandler core.AppStatusHandler) {
 		probableHigherNonce := forkDetector.ProbableHighestNonce()
 		appStatusHandler.SetUInt64Value(core.MetricProbableHighestNonce, probableHigherNonce)
+		computeForkDetectorMetrics(appStatusHandler, forkDetector)
 	}
 
 	err := appStatusPollingHandler.RegisterPollingFunc(probableHighestNonceHandlerFunc)
@@ -330,6 +337,19 @@ func registerPollProbableHighestNonce(
 	return nil
 }
 
+func computeForkDetectorMetrics(appStatusHandler core.AppStatusHandler, forkDetector process.ForkDetector) {
+	status := forkDetector.GetStatus()
+	if status == nil {
+		return
+	}
+
+	appStatusHandler.SetUInt64Value(core.MetricForkDetectorNumHeaders, uint64(len(status.Headers)))
+	if len(status.Checkpoints) > 0 {
+		lastCheckpoint := status.Checkpoints[len(status.Checkpoints)-1]
+		appStatusHandler.SetUInt64Value(core.MetricForkDetectorLastCheckpointNonce, lastCheckpoint.Nonce)
+	}
+}
+
 func (msc *managedStatusComponents) startMachineStatisticsPolling(ctx context.Context) error {
 	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(msc.statusComponentsFactory.coreComponents.StatusHandler(), time.Second)
 	if err != nil {
@@ -356,6 +376,17 @@ func (msc *managedStatusComponents) startMachineStatisticsPolling(ctx context.Co
 	return nil
 }
 
+func registerCompressionStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
+	statistics := compression.NodeStatistics()
+
+	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		appStatusHandler.SetUInt64Value(core.MetricCompressionNumValues, statistics.NumValues())
+		appStatusHandler.SetUInt64Value(core.MetricCompressionNumCompressedValues, statistics.NumCompressedValues())
+		appStatusHandler.SetUInt64Value(core.MetricCompressionUncompressedBytes, statistics.NumUncompressedBytes())
+		appStatusHandler.SetUInt64Value(core.MetricCompressionStoredBytes, statistics.NumStoredBytes())
+	})
+}
+
 func registerMemStatistics(_ context.Context, appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
 	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
 		mem := machine.AcquireMemStatistics()
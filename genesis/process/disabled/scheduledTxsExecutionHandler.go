@@ -0,0 +1,59 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+)
+
+// ScheduledTxsExecutionHandler implements ScheduledTxsExecutionHandler interface but does nothing as it is a disabled component
+type ScheduledTxsExecutionHandler struct {
+}
+
+// Init does nothing as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) Init() {
+}
+
+// AddScheduledTx returns true as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) AddScheduledTx(_ []byte, _ data.TransactionHandler) bool {
+	return true
+}
+
+// Execute does nothing as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) Execute(_ []byte) error {
+	return nil
+}
+
+// ApplyScheduledResults does nothing as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) ApplyScheduledResults(_ []byte) error {
+	return nil
+}
+
+// GetScheduledSCRs returns an empty slice as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) GetScheduledSCRs() []data.TransactionHandler {
+	return make([]data.TransactionHandler, 0)
+}
+
+// GetScheduledGasAndFees returns zero values as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) GetScheduledGasAndFees() scheduled.GasAndFees {
+	return scheduled.NewEmptyGasAndFees()
+}
+
+// GetScheduledRootHash returns nil as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) GetScheduledRootHash() []byte {
+	return nil
+}
+
+// GetScheduledRootHashForHeader returns nil as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) GetScheduledRootHashForHeader(_ []byte) ([]byte, error) {
+	return nil, nil
+}
+
+// RollBackToBlock does nothing as it is a disabled component
+func (steh *ScheduledTxsExecutionHandler) RollBackToBlock(_ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (steh *ScheduledTxsExecutionHandler) IsInterfaceNil() bool {
+	return steh == nil
+}
@@ -58,8 +58,11 @@ func createGenesisConfig() config.EnableEpochs {
 		SwitchJailWaitingEnableEpoch:           unreachableEpoch,
 		BlockGasAndFeesReCheckEnableEpoch:      unreachableEpoch,
 		RelayedTransactionsV2EnableEpoch:       unreachableEpoch,
+		RelayedTransactionsV3EnableEpoch:       unreachableEpoch,
+		ScheduledMiniBlocksEnableEpoch:         unreachableEpoch,
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: unreachableEpoch,
+		CodeChangeLogsEnableEpoch:                   unreachableEpoch,
 	}
 }
 
@@ -275,6 +278,10 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		Marshalizer:          arg.Core.InternalMarshalizer(),
 		Accounts:             arg.Accounts,
 		ShardCoordinator:     arg.ShardCoordinator,
+		EpochNotifier:        epochNotifier,
+
+		ESDTAllowancesEnableEpoch: enableEpochs.ESDTAllowancesEnableEpoch,
+		MetaESDTEnableEpoch:       enableEpochs.MetaESDTEnableEpoch,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -412,6 +419,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		ArwenChangeLocker:                   genesisArwenLocker,
 
 		IncrementSCRNonceInMultiTransferEnableEpoch: enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
+		CodeChangeLogsEnableEpoch:                   enableEpochs.CodeChangeLogsEnableEpoch,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewScProcessor)
 	if err != nil {
@@ -447,6 +455,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		PenalizedTooMuchGasEnableEpoch: enableEpochs.PenalizedTooMuchGasEnableEpoch,
 		MetaProtectionEnableEpoch:      enableEpochs.MetaProtectionEnableEpoch,
 		RelayedTxV2EnableEpoch:         enableEpochs.RelayedTransactionsV2EnableEpoch,
+		RelayedTxV3EnableEpoch:         enableEpochs.RelayedTransactionsV3EnableEpoch,
 	}
 	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
@@ -476,6 +485,8 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		disabledBlockTracker,
 		disabledBlockSizeComputationHandler,
 		disabledBalanceComputationHandler,
+		epochNotifier,
+		enableEpochs.ScheduledMiniBlocksEnableEpoch,
 	)
 	if err != nil {
 		return nil, err
@@ -503,6 +514,7 @@ func createProcessorsForShardGenesisBlock(arg ArgsGenesisBlockCreator, enableEpo
 		TxTypeHandler:                     txTypeHandler,
 		BlockGasAndFeesReCheckEnableEpoch: enableEpochs.BlockGasAndFeesReCheckEnableEpoch,
 		TransactionsLogProcessor:          arg.TxLogsProcessor,
+		ScheduledTxsExecutionHandler:      &disabled.ScheduledTxsExecutionHandler{},
 	}
 	txCoordinator, err := coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	if err != nil {
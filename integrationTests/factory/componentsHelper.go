@@ -68,6 +68,7 @@ func CreateDefaultConfig() *config.Configs {
 	}
 	configs.ConfigurationPathsHolder = configPathsHolder
 	configs.ImportDbConfig = &config.ImportDbConfig{}
+	configs.ReplicaConfig = &config.ReplicaConfig{}
 
 	return configs
 }
@@ -60,8 +60,14 @@ type Facade interface {
 	GetNFTTokenIDsRegisteredByAddress(address string) ([]string, error)
 	GetESDTsWithRole(address string, role string) ([]string, error)
 	GetAllESDTTokens(address string) (map[string]*esdt.ESDigitalToken, error)
+	GetESDTAllowances(address string) ([]*dataApi.ESDTAllowance, error)
+	GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*dataApi.ESDTTokenType, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
+	GetEvents(query dataApi.EventsQuery) (*dataApi.EventsPage, error)
+	GetContractInfo(address string) (*dataApi.ContractInfo, error)
+	CheckContractUpgrade(address string, request dataApi.UpgradeCheckRequest) (*dataApi.UpgradeCheckResult, error)
+	GetTokenSupplyDelta(token string) (*dataApi.ESDTSupplyDelta, error)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*dataApi.StakeValues, error)
@@ -70,10 +76,14 @@ type Facade interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetSyncStatus() (*dataApi.SyncStatus, error)
+	GetEpochStartCheckpoint() (*dataApi.EpochStartCheckpoint, error)
+	GetStorageUsage() (*dataApi.StorageUsage, error)
+	CompactStorageUnits(units []string) error
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
-		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32, relayer string, relayerNonce uint64, relayerSignatureHex string) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
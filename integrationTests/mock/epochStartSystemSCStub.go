@@ -8,9 +8,10 @@ import (
 
 // EpochStartSystemSCStub -
 type EpochStartSystemSCStub struct {
-	ProcessSystemSmartContractCalled func(validatorInfos map[uint32][]*state.ValidatorInfo, nonce uint64, epoch uint32) error
-	ProcessDelegationRewardsCalled   func(miniBlocks block.MiniBlockSlice, txCache epochStart.TransactionCacher) error
-	ToggleUnStakeUnBondCalled        func(value bool) error
+	ProcessSystemSmartContractCalled    func(validatorInfos map[uint32][]*state.ValidatorInfo, nonce uint64, epoch uint32) error
+	ProcessDelegationRewardsCalled      func(miniBlocks block.MiniBlockSlice, txCache epochStart.TransactionCacher) error
+	ToggleUnStakeUnBondCalled           func(value bool) error
+	ProcessGovernanceParamChangesCalled func(epoch uint32) ([]block.GovernanceParamChange, error)
 }
 
 // ToggleUnStakeUnBond -
@@ -40,6 +41,14 @@ func (e *EpochStartSystemSCStub) ProcessDelegationRewards(
 	return nil
 }
 
+// ProcessGovernanceParamChanges -
+func (e *EpochStartSystemSCStub) ProcessGovernanceParamChanges(epoch uint32) ([]block.GovernanceParamChange, error) {
+	if e.ProcessGovernanceParamChangesCalled != nil {
+		return e.ProcessGovernanceParamChangesCalled(epoch)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (e *EpochStartSystemSCStub) IsInterfaceNil() bool {
 	return e == nil
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -19,6 +20,7 @@ type ForkDetectorStub struct {
 	SetRollBackNonceCalled          func(nonce uint64)
 	ResetProbableHighestNonceCalled func()
 	SetFinalToLastCheckpointCalled  func()
+	GetStatusCalled                 func() *api.ForkDetectorStatus
 }
 
 // RestoreToGenesis -
@@ -114,6 +116,15 @@ func (fdm *ForkDetectorStub) SetFinalToLastCheckpoint() {
 	}
 }
 
+// GetStatus -
+func (fdm *ForkDetectorStub) GetStatus() *api.ForkDetectorStatus {
+	if fdm.GetStatusCalled != nil {
+		return fdm.GetStatusCalled()
+	}
+
+	return &api.ForkDetectorStatus{}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (fdm *ForkDetectorStub) IsInterfaceNil() bool {
 	return fdm == nil
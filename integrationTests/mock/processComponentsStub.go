@@ -44,6 +44,7 @@ type ProcessComponentsStub struct {
 	NodeRedundancyHandlerInternal  consensus.NodeRedundancyHandler
 	ArwenChangeLockerInternal      process.Locker
 	CurrentEpochProviderInternal   process.CurrentNetworkEpochProviderHandler
+	SyncIntrospectorInternal       process.SyncIntrospectionHandler
 }
 
 // Create -
@@ -216,6 +217,11 @@ func (pcs *ProcessComponentsStub) CurrentEpochProvider() process.CurrentNetworkE
 	return pcs.CurrentEpochProviderInternal
 }
 
+// SyncIntrospector -
+func (pcs *ProcessComponentsStub) SyncIntrospector() process.SyncIntrospectionHandler {
+	return pcs.SyncIntrospectorInternal
+}
+
 // IsInterfaceNil -
 func (pcs *ProcessComponentsStub) IsInterfaceNil() bool {
 	return pcs == nil
@@ -1,12 +1,14 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 )
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessTxCalled  func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
+	ProcessSCRCalled func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error)
 }
 
 // ProcessTx -
@@ -18,6 +20,15 @@ func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*tr
 	return nil, nil
 }
 
+// ProcessSCR -
+func (tss *TransactionSimulatorStub) ProcessSCR(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+	if tss.ProcessSCRCalled != nil {
+		return tss.ProcessSCRCalled(scr)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (tss *TransactionSimulatorStub) IsInterfaceNil() bool {
 	return tss == nil
@@ -94,7 +94,7 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
-		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
+		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier", "/:address/contract", "/:address/upgrade-check"},
 		"hardfork":    {"/trigger"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
@@ -102,6 +102,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
 		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash"},
+		"events":      {"/query"},
 	}
 
 	routesConfig := config.ApiRoutesConfig{
@@ -134,6 +135,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:      TestMarshalizer,
 		Accounts:         tpn.AccntState,
 		ShardCoordinator: tpn.ShardCoordinator,
+		EpochNotifier:    tpn.EpochNotifier,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	log.LogIfError(err)
@@ -158,6 +160,8 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		},
 		tpn.AccntState,
 		tpn.ShardCoordinator,
+		TestTxSignMarshalizer,
+		nil,
 	)
 	log.LogIfError(err)
 
@@ -182,6 +186,12 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	governanceHandler, err := factory.CreateGovernanceHandler(args)
+	log.LogIfError(err)
+
+	stakingQueueHandler, err := factory.CreateStakingQueueHandler(args)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:          tpn.SCQueryService,
 		StatusMetricsHandler:    &mock.StatusMetricsStub{},
@@ -189,6 +199,8 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		TotalStakedValueHandler: totalStakedValueHandler,
 		DirectStakedListHandler: directStakedListHandler,
 		DelegatedListHandler:    delegatedListHandler,
+		GovernanceHandler:       governanceHandler,
+		StakingQueueHandler:     stakingQueueHandler,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
@@ -196,6 +208,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 
 	argSimulator := txsimulator.ArgsTxSimulator{
 		TransactionProcessor:      tpn.TxProcessor,
+		SCRProcessor:              tpn.ScProcessor,
 		IntermediateProcContainer: tpn.InterimProcContainer,
 		AddressPubKeyConverter:    TestAddressPubkeyConverter,
 		ShardCoordinator:          tpn.ShardCoordinator,
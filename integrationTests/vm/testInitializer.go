@@ -539,6 +539,7 @@ func CreateVMAndBlockchainHookAndDataPool(
 		Marshalizer:      testMarshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -607,6 +608,7 @@ func CreateVMAndBlockchainHookMeta(
 		Marshalizer:      testMarshalizer,
 		Accounts:         accnts,
 		ShardCoordinator: shardCoordinator,
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -900,6 +902,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, nil, nil, nil, nil, err
 	}
 	argsNewTxProcessor.ScProcessor = scProcessorTxSim
+	txSimulatorProcessorArgs.SCRProcessor = scProcessorTxSim
 
 	argsNewTxProcessor.Accounts = readOnlyAccountsDB
 
@@ -921,6 +924,8 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		txSimulator,
 		argsNewTxProcessor.Accounts,
 		shardCoordinator,
+		testMarshalizer,
+		nil,
 	)
 	if err != nil {
 		return nil, nil, nil, nil, nil, err
@@ -52,6 +52,9 @@ var ErrInvalidValue = errors.New("invalid value")
 // ErrInvalidSignatureLength signals that an invalid signature length has been provided
 var ErrInvalidSignatureLength = errors.New("invalid signature length")
 
+// ErrInvalidRelayerSignatureLength signals that an invalid relayer signature length has been provided
+var ErrInvalidRelayerSignatureLength = errors.New("invalid relayer signature length")
+
 // ErrInvalidAddressLength signals that an invalid address length has been provided
 var ErrInvalidAddressLength = errors.New("invalid address length")
 
@@ -147,3 +150,39 @@ var ErrInvalidESDTRole = errors.New("invalid ESDT role")
 
 // ErrMetachainOnlyEndpoint signals that an endpoint was called, but it is only available for metachain nodes
 var ErrMetachainOnlyEndpoint = errors.New("the endpoint is only available on metachain nodes")
+
+// ErrEventsBlockRangeTooLarge signals that the block range of an events query is too large
+var ErrEventsBlockRangeTooLarge = errors.New("block range of the events query is too large")
+
+// ErrEventsQueryLimitTooLarge signals that the page size of an events query is too large
+var ErrEventsQueryLimitTooLarge = errors.New("limit of the events query is too large")
+
+// ErrAccountIsNotASmartContract signals that the provided account is not a smart contract
+var ErrAccountIsNotASmartContract = errors.New("account is not a smart contract")
+
+// ErrCannotCastAccountHandlerToUserAccountHandler signals that the account handler cannot be cast to a user account handler
+var ErrCannotCastAccountHandlerToUserAccountHandler = errors.New("cannot cast AccountHandler to UserAccountHandler")
+
+// ErrNilContractInfo signals that a nil contract info has been provided
+var ErrNilContractInfo = errors.New("nil contract info")
+
+// ErrInvalidUpgradeCheckRequest signals that an invalid contract upgrade check request has been provided
+var ErrInvalidUpgradeCheckRequest = errors.New("invalid upgrade check request")
+
+// ErrESDTSupplyDeltaNotTrackedOnMetachain signals that the ESDT supply deltas were requested from a metachain node
+var ErrESDTSupplyDeltaNotTrackedOnMetachain = errors.New("esdt supply deltas are tracked only by the shard nodes")
+
+// ErrNilForkDetector signals that a nil fork detector has been provided
+var ErrNilForkDetector = errors.New("nil fork detector")
+
+// ErrNilSyncIntrospector signals that a nil sync introspector has been provided
+var ErrNilSyncIntrospector = errors.New("nil sync introspector")
+
+// ErrNilEpochStartTrigger signals that a nil epoch start trigger has been provided
+var ErrNilEpochStartTrigger = errors.New("nil epoch start trigger")
+
+// ErrEpochStartCheckpointNotAvailable signals that the node does not know yet an epoch start metablock
+var ErrEpochStartCheckpointNotAvailable = errors.New("epoch start checkpoint not available")
+
+// ErrNilStorageMaintainer signals that a nil storage maintainer has been provided
+var ErrNilStorageMaintainer = errors.New("nil storage maintainer")
@@ -20,6 +20,12 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilStakingQueueHandler signals that a nil staking queue handler has been provided
+var ErrNilStakingQueueHandler = errors.New("nil staking queue handler")
+
 // ErrNilVmContainer signals that a nil vm container has been provided
 var ErrNilVmContainer = errors.New("nil vm container")
 
@@ -50,3 +50,17 @@ type DelegatedListHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	IsInterfaceNil() bool
 }
+
+// GovernanceHandler defines the behavior of a component able to return the governance proposals and votes
+type GovernanceHandler interface {
+	GetGovernanceProposals() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotes(address string) ([]*api.GovernanceVote, error)
+	IsInterfaceNil() bool
+}
+
+// StakingQueueHandler defines the behavior of a component able to return the staking queue
+type StakingQueueHandler interface {
+	GetStakingQueue() ([]*api.StakingQueueEntry, error)
+	IsInterfaceNil() bool
+}
@@ -16,6 +16,8 @@ type ArgNodeApiResolver struct {
 	TotalStakedValueHandler TotalStakedValueHandler
 	DirectStakedListHandler DirectStakedListHandler
 	DelegatedListHandler    DelegatedListHandler
+	GovernanceHandler       GovernanceHandler
+	StakingQueueHandler     StakingQueueHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -26,6 +28,8 @@ type nodeApiResolver struct {
 	totalStakedValueHandler TotalStakedValueHandler
 	directStakedListHandler DirectStakedListHandler
 	delegatedListHandler    DelegatedListHandler
+	governanceHandler       GovernanceHandler
+	stakingQueueHandler     StakingQueueHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -48,6 +52,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.StakingQueueHandler) {
+		return nil, ErrNilStakingQueueHandler
+	}
 
 	return &nodeApiResolver{
 		scQueryService:          arg.SCQueryService,
@@ -56,6 +66,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		totalStakedValueHandler: arg.TotalStakedValueHandler,
 		directStakedListHandler: arg.DirectStakedListHandler,
 		delegatedListHandler:    arg.DelegatedListHandler,
+		governanceHandler:       arg.GovernanceHandler,
+		stakingQueueHandler:     arg.StakingQueueHandler,
 	}, nil
 }
 
@@ -94,6 +106,26 @@ func (nar *nodeApiResolver) GetDelegatorsList() ([]*api.Delegator, error) {
 	return nar.delegatedListHandler.GetDelegatorsList()
 }
 
+// GetGovernanceProposals will return the governance proposals
+func (nar *nodeApiResolver) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return nar.governanceHandler.GetGovernanceProposals()
+}
+
+// GetGovernanceProposal will return a governance proposal and its vote tallies
+func (nar *nodeApiResolver) GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error) {
+	return nar.governanceHandler.GetGovernanceProposal(commitHash)
+}
+
+// GetGovernanceVotes will return the governance votes of an address
+func (nar *nodeApiResolver) GetGovernanceVotes(address string) ([]*api.GovernanceVote, error) {
+	return nar.governanceHandler.GetGovernanceVotes(address)
+}
+
+// GetStakingQueue will return the BLS keys waiting in the staking queue
+func (nar *nodeApiResolver) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	return nar.stakingQueueHandler.GetStakingQueue()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *nodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -20,6 +20,8 @@ func createMockAgrs() external.ArgNodeApiResolver {
 		TotalStakedValueHandler: &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler: &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:    &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:       &mock.GovernanceProcessorStub{},
+		StakingQueueHandler:     &mock.StakingQueueProcessorStub{},
 	}
 }
 
@@ -89,6 +91,28 @@ func TestNewNodeApiResolver_NilDelegatedListHandler(t *testing.T) {
 	assert.Equal(t, external.ErrNilDelegatedListHandler, err)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilStakingQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	arg.StakingQueueHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStakingQueueHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -230,6 +254,61 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetGovernanceData(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockAgrs()
+	proposals := make([]*api.GovernanceProposal, 1)
+	tally := &api.GovernanceProposalTally{}
+	votes := make([]*api.GovernanceVote, 2)
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetGovernanceProposalsCalled: func() ([]*api.GovernanceProposal, error) {
+			return proposals, nil
+		},
+		GetGovernanceProposalCalled: func(commitHash string) (*api.GovernanceProposalTally, error) {
+			assert.Equal(t, "commit", commitHash)
+			return tally, nil
+		},
+		GetGovernanceVotesCalled: func(address string) ([]*api.GovernanceVote, error) {
+			assert.Equal(t, "address", address)
+			return votes, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredProposals, err := nar.GetGovernanceProposals()
+	assert.Nil(t, err)
+	assert.Equal(t, proposals, recoveredProposals)
+
+	recoveredTally, err := nar.GetGovernanceProposal("commit")
+	assert.Nil(t, err)
+	assert.True(t, tally == recoveredTally)
+
+	recoveredVotes, err := nar.GetGovernanceVotes("address")
+	assert.Nil(t, err)
+	assert.Equal(t, votes, recoveredVotes)
+}
+
+func TestNodeApiResolver_GetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockAgrs()
+	queue := make([]*api.StakingQueueEntry, 1)
+	arg.StakingQueueHandler = &mock.StakingQueueProcessorStub{
+		GetStakingQueueCalled: func() ([]*api.StakingQueueEntry, error) {
+			wasCalled = true
+			return queue, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredQueue, err := nar.GetStakingQueue()
+	assert.Nil(t, err)
+	assert.Equal(t, queue, recoveredQueue)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
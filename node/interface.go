@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -74,3 +75,12 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// StorageMaintainer defines the behavior of a component able to report the disk usage of the storage units and to
+// compact them online
+type StorageMaintainer interface {
+	DiskUsage() (*api.StorageUsage, error)
+	StartCompaction(units []string) error
+	Close() error
+	IsInterfaceNil() bool
+}
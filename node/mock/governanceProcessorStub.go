@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetGovernanceProposalsCalled func() ([]*api.GovernanceProposal, error)
+	GetGovernanceProposalCalled  func(commitHash string) (*api.GovernanceProposalTally, error)
+	GetGovernanceVotesCalled     func(address string) ([]*api.GovernanceVote, error)
+}
+
+// GetGovernanceProposals -
+func (gps *GovernanceProcessorStub) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	if gps.GetGovernanceProposalsCalled != nil {
+		return gps.GetGovernanceProposalsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (gps *GovernanceProcessorStub) GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error) {
+	if gps.GetGovernanceProposalCalled != nil {
+		return gps.GetGovernanceProposalCalled(commitHash)
+	}
+
+	return nil, nil
+}
+
+// GetGovernanceVotes -
+func (gps *GovernanceProcessorStub) GetGovernanceVotes(address string) ([]*api.GovernanceVote, error) {
+	if gps.GetGovernanceVotesCalled != nil {
+		return gps.GetGovernanceVotesCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -20,6 +20,15 @@ type ValidatorStatisticsProcessorMock struct {
 	ProcessRatingsEndOfEpochCalled           func(validatorInfos map[uint32][]*state.ValidatorInfo, epoch uint32) error
 	PeerAccountToValidatorInfoCalled         func(peerAccount state.PeerAccountHandler) *state.ValidatorInfo
 	SaveNodesCoordinatorUpdatesCalled        func(epoch uint32) (bool, error)
+	RotatePeerAccountKeyCalled               func(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error)
+}
+
+// RotatePeerAccountKey -
+func (vsp *ValidatorStatisticsProcessorMock) RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error) {
+	if vsp.RotatePeerAccountKeyCalled != nil {
+		return vsp.RotatePeerAccountKeyCalled(oldKey, newKey)
+	}
+	return nil, nil
 }
 
 // SaveNodesCoordinatorUpdates -
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// StakingQueueProcessorStub -
+type StakingQueueProcessorStub struct {
+	GetStakingQueueCalled func() ([]*api.StakingQueueEntry, error)
+}
+
+// GetStakingQueue -
+func (sqps *StakingQueueProcessorStub) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	if sqps.GetStakingQueueCalled != nil {
+		return sqps.GetStakingQueueCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (sqps *StakingQueueProcessorStub) IsInterfaceNil() bool {
+	return sqps == nil
+}
@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data/api"
+
+// StorageMaintainerStub -
+type StorageMaintainerStub struct {
+	DiskUsageCalled       func() (*api.StorageUsage, error)
+	StartCompactionCalled func(units []string) error
+	CloseCalled           func() error
+}
+
+// DiskUsage -
+func (sms *StorageMaintainerStub) DiskUsage() (*api.StorageUsage, error) {
+	if sms.DiskUsageCalled != nil {
+		return sms.DiskUsageCalled()
+	}
+
+	return &api.StorageUsage{}, nil
+}
+
+// StartCompaction -
+func (sms *StorageMaintainerStub) StartCompaction(units []string) error {
+	if sms.StartCompactionCalled != nil {
+		return sms.StartCompactionCalled(units)
+	}
+
+	return nil
+}
+
+// Close -
+func (sms *StorageMaintainerStub) Close() error {
+	if sms.CloseCalled != nil {
+		return sms.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (sms *StorageMaintainerStub) IsInterfaceNil() bool {
+	return sms == nil
+}
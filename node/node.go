@@ -103,8 +103,10 @@ type Node struct {
 
 	closableComponents        []mainFactory.Closer
 	enableSignTxWithHashEpoch uint32
+	enableRelayedTxV3Epoch    uint32
 	isInImportMode            bool
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	storageMaintainer         StorageMaintainer
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -782,6 +784,7 @@ func (n *Node) commonTransactionValidation(
 
 	currentEpoch := n.coreComponents.EpochNotifier().CurrentEpoch()
 	enableSignWithTxHash := currentEpoch >= n.enableSignTxWithHashEpoch
+	enableRelayedTxV3 := currentEpoch >= n.enableRelayedTxV3Epoch
 
 	txSingleSigner := n.cryptoComponents.TxSingleSigner()
 	if !checkSignature {
@@ -805,6 +808,7 @@ func (n *Node) commonTransactionValidation(
 		enableSignWithTxHash,
 		n.coreComponents.TxSignHasher(),
 		n.coreComponents.TxVersionChecker(),
+		enableRelayedTxV3,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -881,6 +885,9 @@ func (n *Node) CreateTransaction(
 	chainID string,
 	version uint32,
 	options uint32,
+	relayer string,
+	relayerNonce uint64,
+	relayerSignatureHex string,
 ) (*transaction.Transaction, []byte, error) {
 	if version == 0 {
 		return nil, nil, ErrInvalidTransactionVersion
@@ -898,12 +905,18 @@ func (n *Node) CreateTransaction(
 	if len(signatureHex) > n.addressSignatureHexSize {
 		return nil, nil, ErrInvalidSignatureLength
 	}
+	if len(relayerSignatureHex) > n.addressSignatureHexSize {
+		return nil, nil, ErrInvalidRelayerSignatureLength
+	}
 	if uint32(len(receiver)) > n.coreComponents.EncodedAddressLen() {
 		return nil, nil, fmt.Errorf("%w for receiver", ErrInvalidAddressLength)
 	}
 	if uint32(len(sender)) > n.coreComponents.EncodedAddressLen() {
 		return nil, nil, fmt.Errorf("%w for sender", ErrInvalidAddressLength)
 	}
+	if uint32(len(relayer)) > n.coreComponents.EncodedAddressLen() {
+		return nil, nil, fmt.Errorf("%w for relayer", ErrInvalidAddressLength)
+	}
 	if len(senderUsername) > core.MaxUserNameLength {
 		return nil, nil, ErrInvalidSenderUsernameLength
 	}
@@ -929,6 +942,19 @@ func (n *Node) CreateTransaction(
 		return nil, nil, errors.New("could not fetch signature bytes")
 	}
 
+	var relayerAddress []byte
+	if len(relayer) > 0 {
+		relayerAddress, err = addrPubKeyConverter.Decode(relayer)
+		if err != nil {
+			return nil, nil, errors.New("could not create relayer address from provided param")
+		}
+	}
+
+	relayerSignatureBytes, err := hex.DecodeString(relayerSignatureHex)
+	if err != nil {
+		return nil, nil, errors.New("could not fetch relayer signature bytes")
+	}
+
 	if len(value) > len(n.coreComponents.EconomicsData().GenesisTotalSupply().String())+1 {
 		return nil, nil, ErrTransactionValueLengthTooBig
 	}
@@ -953,6 +979,11 @@ func (n *Node) CreateTransaction(
 		Version:     version,
 		Options:     options,
 	}
+	if len(relayerAddress) > 0 || len(relayerSignatureBytes) > 0 {
+		tx.RelayerAddr = relayerAddress
+		tx.RelayerNonce = relayerNonce
+		tx.RelayerSignature = relayerSignatureBytes
+	}
 
 	var txHash []byte
 	txHash, err = core.CalculateHash(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher(), tx)
@@ -1204,6 +1235,10 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 func (n *Node) Close() error {
 	n.cancelFunc()
 
+	if !check.IfNil(n.storageMaintainer) {
+		log.LogIfError(n.storageMaintainer.Close())
+	}
+
 	for _, qh := range n.queryHandlers {
 		log.LogIfError(qh.Close())
 	}
@@ -0,0 +1,188 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	upgradeContractFunctionName = "upgradeContract"
+
+	contractActionDeploy  = "deploy"
+	contractActionUpgrade = "upgrade"
+)
+
+// GetContractInfo returns the code, the code metadata, the owner and, if the events index is enabled,
+// the deploy and upgrade history of the smart contract with the provided address
+func (n *Node) GetContractInfo(address string) (*api.ContractInfo, error) {
+	addressBytes, err := n.coreComponents.AddressPubKeyConverter().Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := n.loadUserAccount(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(account.GetCodeHash()) == 0 {
+		return nil, ErrAccountIsNotASmartContract
+	}
+
+	codeMetadata := vmcommon.CodeMetadataFromBytes(account.GetCodeMetadata())
+	owner := ""
+	if len(account.GetOwnerAddress()) > 0 {
+		owner = n.coreComponents.AddressPubKeyConverter().Encode(account.GetOwnerAddress())
+	}
+
+	history, err := n.getContractHistory(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ContractInfo{
+		Address:  address,
+		Code:     hex.EncodeToString(n.stateComponents.AccountsAdapter().GetCode(account.GetCodeHash())),
+		CodeHash: hex.EncodeToString(account.GetCodeHash()),
+		CodeMetadata: api.ContractCodeMetadata{
+			Upgradeable: codeMetadata.Upgradeable,
+			Readable:    codeMetadata.Readable,
+			Payable:     codeMetadata.Payable,
+		},
+		Owner:   owner,
+		History: history,
+	}, nil
+}
+
+// CreateContractUpgradeTransaction creates an unsigned upgrade transaction of the provided contract, sent by its owner,
+// that can be used to simulate the upgrade
+func (n *Node) CreateContractUpgradeTransaction(contract *api.ContractInfo, request api.UpgradeCheckRequest) (*transaction.Transaction, error) {
+	if contract == nil {
+		return nil, ErrNilContractInfo
+	}
+
+	contractAddress, err := n.coreComponents.AddressPubKeyConverter().Decode(contract.Address)
+	if err != nil {
+		return nil, err
+	}
+	ownerAddress, err := n.coreComponents.AddressPubKeyConverter().Decode(contract.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the contract owner", err)
+	}
+
+	txData, err := createContractUpgradeData(contract, request)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := uint64(0)
+	shardCoordinator := n.processComponents.ShardCoordinator()
+	if shardCoordinator.ComputeId(ownerAddress) == shardCoordinator.SelfId() {
+		ownerAccount, errLoad := n.loadUserAccount(ownerAddress)
+		if errLoad == nil {
+			nonce = ownerAccount.GetNonce()
+		}
+	}
+
+	economicsData := n.coreComponents.EconomicsData()
+	gasLimit := request.GasLimit
+	if gasLimit == 0 {
+		gasLimit = economicsData.MaxGasLimitPerBlock(shardCoordinator.SelfId())
+	}
+
+	return &transaction.Transaction{
+		Nonce:    nonce,
+		Value:    big.NewInt(0),
+		RcvAddr:  contractAddress,
+		SndAddr:  ownerAddress,
+		GasPrice: economicsData.MinGasPrice(),
+		GasLimit: gasLimit,
+		Data:     []byte(txData),
+		ChainID:  []byte(n.coreComponents.ChainID()),
+		Version:  n.coreComponents.MinTransactionVersion(),
+	}, nil
+}
+
+func createContractUpgradeData(contract *api.ContractInfo, request api.UpgradeCheckRequest) (string, error) {
+	_, err := hex.DecodeString(request.Code)
+	if err != nil || len(request.Code) == 0 {
+		return "", fmt.Errorf("%w: code should be a non-empty hex string", ErrInvalidUpgradeCheckRequest)
+	}
+
+	codeMetadata := request.CodeMetadata
+	if len(codeMetadata) == 0 {
+		currentMetadata := vmcommon.CodeMetadata{
+			Upgradeable: contract.CodeMetadata.Upgradeable,
+			Readable:    contract.CodeMetadata.Readable,
+			Payable:     contract.CodeMetadata.Payable,
+		}
+		codeMetadata = hex.EncodeToString(currentMetadata.ToBytes())
+	}
+	_, err = hex.DecodeString(codeMetadata)
+	if err != nil {
+		return "", fmt.Errorf("%w: code metadata should be a hex string", ErrInvalidUpgradeCheckRequest)
+	}
+
+	dataComponents := []string{upgradeContractFunctionName, request.Code, codeMetadata}
+	for i, argument := range request.Arguments {
+		_, err = hex.DecodeString(argument)
+		if err != nil {
+			return "", fmt.Errorf("%w: argument %d should be a hex string", ErrInvalidUpgradeCheckRequest, i)
+		}
+		dataComponents = append(dataComponents, argument)
+	}
+
+	return strings.Join(dataComponents, "@"), nil
+}
+
+func (n *Node) loadUserAccount(address []byte) (state.UserAccountHandler, error) {
+	accountHandler, err := n.stateComponents.AccountsAdapter().GetExistingAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccountHandler
+	}
+
+	return account, nil
+}
+
+func (n *Node) getContractHistory(address []byte) ([]*api.ContractHistoryEntry, error) {
+	pointers, err := n.processComponents.HistoryRepository().GetContractHistory(address)
+	if err == dblookupext.ErrEventsIndexNotEnabled {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*api.ContractHistoryEntry, 0, len(pointers))
+	for _, pointer := range pointers {
+		history = append(history, &api.ContractHistoryEntry{
+			TxHash:     hex.EncodeToString(pointer.TxHash),
+			BlockNonce: pointer.BlockNonce,
+			Epoch:      pointer.Epoch,
+			Action:     getContractAction(pointer),
+		})
+	}
+
+	return history, nil
+}
+
+func getContractAction(pointer *dblookupext.EventPointer) string {
+	if string(pointer.Identifier) == core.SCUpgradeIdentifier {
+		return contractActionUpgrade
+	}
+
+	return contractActionDeploy
+}
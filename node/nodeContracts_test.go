@@ -0,0 +1,189 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testContractAddress = []byte("contract")
+	testOwnerAddress    = []byte("owner")
+	testContractCode    = []byte("code")
+)
+
+func createNodeForContracts(historyRepo dblookupext.HistoryRepository) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = &mock.MarshalizerFake{}
+	coreComponents.AddrPubKeyConv = &mock.PubkeyConverterMock{}
+	coreComponents.EconomicsHandler = &economicsmocks.EconomicsHandlerMock{
+		MinGasPriceCalled: func() uint64 {
+			return 1000
+		},
+		MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+			return 1500000000
+		},
+	}
+
+	contract, _ := state.NewUserAccount(testContractAddress)
+	contract.SetCodeHash([]byte("codeHash"))
+	contract.SetOwnerAddress(testOwnerAddress)
+	contract.SetCodeMetadata([]byte{vmcommon.MetadataUpgradeable, vmcommon.MetadataPayable})
+	owner, _ := state.NewUserAccount(testOwnerAddress)
+	owner.Nonce = 7
+	user, _ := state.NewUserAccount([]byte("user"))
+	accounts := map[string]vmcommon.AccountHandler{
+		string(testContractAddress): contract,
+		string(testOwnerAddress):    owner,
+		"user":                      user,
+	}
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.Accounts = &testscommon.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, found := accounts[string(address)]
+			if !found {
+				return nil, state.ErrAccNotFound
+			}
+			return account, nil
+		},
+		GetCodeCalled: func(codeHash []byte) []byte {
+			if string(codeHash) == "codeHash" {
+				return testContractCode
+			}
+			return nil
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepo
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+	)
+
+	return n
+}
+
+func TestNode_GetContractInfoNotAContractShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historyRepo, _ := dblookupext.NewNilHistoryRepository()
+	n := createNodeForContracts(historyRepo)
+
+	contract, err := n.GetContractInfo(hex.EncodeToString([]byte("user")))
+	require.Nil(t, contract)
+	require.Equal(t, node.ErrAccountIsNotASmartContract, err)
+
+	contract, err = n.GetContractInfo(hex.EncodeToString([]byte("missing")))
+	require.Nil(t, contract)
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestNode_GetContractInfoEventsIndexNotEnabledShouldReturnNoHistory(t *testing.T) {
+	t.Parallel()
+
+	historyRepo, _ := dblookupext.NewNilHistoryRepository()
+	n := createNodeForContracts(historyRepo)
+
+	contract, err := n.GetContractInfo(hex.EncodeToString(testContractAddress))
+	require.Nil(t, err)
+	require.Equal(t, &api.ContractInfo{
+		Address:  hex.EncodeToString(testContractAddress),
+		Code:     hex.EncodeToString(testContractCode),
+		CodeHash: hex.EncodeToString([]byte("codeHash")),
+		CodeMetadata: api.ContractCodeMetadata{
+			Upgradeable: true,
+			Payable:     true,
+		},
+		Owner: hex.EncodeToString(testOwnerAddress),
+	}, contract)
+}
+
+func TestNode_GetContractInfoShouldReturnHistory(t *testing.T) {
+	t.Parallel()
+
+	historyRepo := &dbLookupExtMock.HistoryRepositoryStub{
+		GetContractHistoryCalled: func(address []byte) ([]*dblookupext.EventPointer, error) {
+			require.Equal(t, testContractAddress, address)
+			return []*dblookupext.EventPointer{
+				{BlockNonce: 3, Epoch: 1, TxHash: []byte("deployTx"), EventIndex: 0, Identifier: []byte(core.SCDeployIdentifier)},
+				{BlockNonce: 9, Epoch: 2, TxHash: []byte("upgradeTx"), EventIndex: 1, Identifier: []byte(core.SCUpgradeIdentifier)},
+			}, nil
+		},
+	}
+	n := createNodeForContracts(historyRepo)
+
+	contract, err := n.GetContractInfo(hex.EncodeToString(testContractAddress))
+	require.Nil(t, err)
+	require.Equal(t, []*api.ContractHistoryEntry{
+		{TxHash: hex.EncodeToString([]byte("deployTx")), BlockNonce: 3, Epoch: 1, Action: "deploy"},
+		{TxHash: hex.EncodeToString([]byte("upgradeTx")), BlockNonce: 9, Epoch: 2, Action: "upgrade"},
+	}, contract.History)
+}
+
+func TestNode_CreateContractUpgradeTransaction(t *testing.T) {
+	t.Parallel()
+
+	historyRepo, _ := dblookupext.NewNilHistoryRepository()
+	n := createNodeForContracts(historyRepo)
+	contract, _ := n.GetContractInfo(hex.EncodeToString(testContractAddress))
+
+	tx, err := n.CreateContractUpgradeTransaction(nil, api.UpgradeCheckRequest{})
+	require.Nil(t, tx)
+	require.Equal(t, node.ErrNilContractInfo, err)
+
+	invalidRequests := []api.UpgradeCheckRequest{
+		{},
+		{Code: "not hex"},
+		{Code: "aa", CodeMetadata: "not hex"},
+		{Code: "aa", Arguments: []string{"01", "not hex"}},
+	}
+	for _, request := range invalidRequests {
+		tx, err = n.CreateContractUpgradeTransaction(contract, request)
+		require.Nil(t, tx)
+		require.True(t, errors.Is(err, node.ErrInvalidUpgradeCheckRequest))
+	}
+
+	tx, err = n.CreateContractUpgradeTransaction(contract, api.UpgradeCheckRequest{
+		Code:      "aabb",
+		Arguments: []string{"01", "02"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, &transaction.Transaction{
+		Nonce:    7,
+		Value:    big.NewInt(0),
+		RcvAddr:  testContractAddress,
+		SndAddr:  testOwnerAddress,
+		GasPrice: 1000,
+		GasLimit: 1500000000,
+		Data:     []byte("upgradeContract@aabb@0102@01@02"),
+		ChainID:  []byte("chainID"),
+		Version:  1,
+	}, tx)
+
+	tx, err = n.CreateContractUpgradeTransaction(contract, api.UpgradeCheckRequest{
+		Code:         "aabb",
+		CodeMetadata: "0000",
+		GasLimit:     5000000,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []byte("upgradeContract@aabb@0000"), tx.Data)
+	require.Equal(t, uint64(5000000), tx.GasLimit)
+}
@@ -0,0 +1,75 @@
+package node
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetESDTAllowances returns the allowances given by the provided address through the ESDTApprove built-in function,
+// sorted by spender and token
+func (n *Node) GetESDTAllowances(address string) ([]*api.ESDTAllowance, error) {
+	account, err := n.getAccountHandlerAPIAccounts(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	allowances := make([]*api.ESDTAllowance, 0)
+	if check.IfNil(userAccount.DataTrie()) {
+		return allowances, nil
+	}
+
+	allowancePrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceIdentifier)
+	lenSpender := n.coreComponents.AddressPubKeyConverter().Len()
+
+	rootHash, err := userAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves, err := userAccount.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	for leaf := range chLeaves {
+		if !bytes.HasPrefix(leaf.Key(), allowancePrefix) {
+			continue
+		}
+
+		spenderAndToken := leaf.Key()[len(allowancePrefix):]
+		if len(spenderAndToken) <= lenSpender {
+			continue
+		}
+
+		suffix := append(leaf.Key(), userAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		allowances = append(allowances, &api.ESDTAllowance{
+			Spender:   n.coreComponents.AddressPubKeyConverter().Encode(spenderAndToken[:lenSpender]),
+			Token:     string(spenderAndToken[lenSpender:]),
+			Allowance: big.NewInt(0).SetBytes(value).String(),
+		})
+	}
+
+	sort.Slice(allowances, func(i, j int) bool {
+		if allowances[i].Spender == allowances[j].Spender {
+			return allowances[i].Token < allowances[j].Token
+		}
+		return allowances[i].Spender < allowances[j].Spender
+	})
+
+	return allowances, nil
+}
@@ -0,0 +1,99 @@
+package node_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetESDTAllowancesShouldReturnSortedAllowances(t *testing.T) {
+	t.Parallel()
+
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	spenderA := bytes.Repeat([]byte("a"), 32)
+	spenderB := bytes.Repeat([]byte("b"), 32)
+	allowancePrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTAllowanceIdentifier)
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + "TKN-abcdef")
+
+	createLeaf := func(key []byte, value []byte) core.KeyValueHolder {
+		suffix := append(key, acc.AddressBytes()...)
+		return keyValStorage.NewKeyValStorage(key, append(value, suffix...))
+	}
+	createAllowanceKey := func(spender []byte, token string) []byte {
+		key := append([]byte{}, allowancePrefix...)
+		key = append(key, spender...)
+		return append(key, token...)
+	}
+
+	acc.DataTrieTracker().SetDataTrie(
+		&testscommon.TrieStub{
+			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
+				ch := make(chan core.KeyValueHolder)
+
+				go func() {
+					ch <- createLeaf(createAllowanceKey(spenderB, "TKN-abcdef"), big.NewInt(7).Bytes())
+					ch <- createLeaf(esdtKey, []byte("esdt data"))
+					ch <- createLeaf(createAllowanceKey(spenderA, "TKN-bbbbbb"), big.NewInt(20).Bytes())
+					ch <- createLeaf(createAllowanceKey(spenderA, "TKN-aaaaaa"), big.NewInt(10).Bytes())
+					close(ch)
+				}()
+
+				return ch, nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return nil, nil
+			},
+		})
+
+	accDB := &testscommon.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return acc, nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = accDB
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+	)
+
+	allowances, err := n.GetESDTAllowances(createDummyHexAddress(64))
+	require.Nil(t, err)
+	expectedAllowances := []*api.ESDTAllowance{
+		{Spender: hex.EncodeToString(spenderA), Token: "TKN-aaaaaa", Allowance: "10"},
+		{Spender: hex.EncodeToString(spenderA), Token: "TKN-bbbbbb", Allowance: "20"},
+		{Spender: hex.EncodeToString(spenderB), Token: "TKN-abcdef", Allowance: "7"},
+	}
+	assert.Equal(t, expectedAllowances, allowances)
+}
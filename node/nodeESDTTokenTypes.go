@@ -0,0 +1,41 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+)
+
+// GetESDTTokenTypes returns the type of the provided tokens, as saved in the system account
+// of the shard by the ESDTSetTokenType built-in function. The tokens registered before the meta ESDT enable epoch are
+// missing from the result
+func (n *Node) GetESDTTokenTypes(tokenIdentifiers []string) (map[string]*api.ESDTTokenType, error) {
+	tokenTypes := make(map[string]*api.ESDTTokenType)
+
+	account, err := n.getAccountHandlerForPubKey(core.SystemAccountAddress)
+	if err == state.ErrAccNotFound {
+		return tokenTypes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	systemAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, ErrCannotCastAccountHandlerToUserAccountHandler
+	}
+
+	for _, tokenIdentifier := range tokenIdentifiers {
+		value, errRetrieve := systemAccount.DataTrieTracker().RetrieveValue(builtInFunctions.CreateESDTTokenTypeKey([]byte(tokenIdentifier)))
+		if errRetrieve != nil || len(value) == 0 {
+			continue
+		}
+
+		tokenTypes[tokenIdentifier] = &api.ESDTTokenType{
+			Type: string(value),
+		}
+	}
+
+	return tokenTypes, nil
+}
@@ -0,0 +1,77 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithSystemAccount(systemAccount vmcommon.AccountHandler, errGet error) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &testscommon.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			return nil
+		},
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if errGet != nil {
+				return nil, errGet
+			}
+			return systemAccount, nil
+		},
+	}
+	dataComponents := getDefaultDataComponents()
+	dataComponents.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+	)
+
+	return n
+}
+
+func TestNode_GetESDTTokenTypesShouldReadSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	systemAccount, _ := state.NewUserAccount(core.SystemAccountAddress)
+	_ = systemAccount.DataTrieTracker().SaveKeyValue(
+		builtInFunctions.CreateESDTTokenTypeKey([]byte("META-abcdef")),
+		[]byte(core.MetaESDT),
+	)
+	systemAccount.DataTrieTracker().SetDataTrie(&testscommon.TrieStub{
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return nil, nil
+		},
+	})
+
+	n := createNodeWithSystemAccount(systemAccount, nil)
+	tokenTypes, err := n.GetESDTTokenTypes([]string{"META-abcdef", "OLD-abcdef"})
+	require.Nil(t, err)
+	require.Equal(t, map[string]*api.ESDTTokenType{
+		"META-abcdef": {Type: core.MetaESDT},
+	}, tokenTypes)
+}
+
+func TestNode_GetESDTTokenTypesWithoutSystemAccountShouldReturnEmpty(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithSystemAccount(nil, state.ErrAccNotFound)
+	tokenTypes, err := n.GetESDTTokenTypes([]string{"META-abcdef"})
+	require.Nil(t, err)
+	require.Empty(t, tokenTypes)
+}
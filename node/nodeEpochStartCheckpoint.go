@@ -0,0 +1,32 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetEpochStartCheckpoint returns the epoch start metablock of the current epoch, as known by the node. It can be
+// provided as a trusted checkpoint to the nodes bootstrapping from the network
+func (n *Node) GetEpochStartCheckpoint() (*api.EpochStartCheckpoint, error) {
+	epochStartTrigger := n.processComponents.EpochStartTrigger()
+	if check.IfNil(epochStartTrigger) {
+		return nil, ErrNilEpochStartTrigger
+	}
+
+	metaBlockHash := epochStartTrigger.EpochStartMetaHdrHash()
+	if len(metaBlockHash) == 0 {
+		return nil, ErrEpochStartCheckpointNotAvailable
+	}
+
+	epoch := epochStartTrigger.MetaEpoch()
+	hexHash := hex.EncodeToString(metaBlockHash)
+
+	return &api.EpochStartCheckpoint{
+		Epoch:         epoch,
+		MetaBlockHash: hexHash,
+		FlagValue:     fmt.Sprintf("%d:%s", epoch, hexHash),
+	}, nil
+}
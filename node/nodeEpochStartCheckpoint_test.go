@@ -0,0 +1,47 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetEpochStartCheckpointNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+
+	checkpoint, err := n.GetEpochStartCheckpoint()
+	require.Nil(t, checkpoint)
+	require.Equal(t, node.ErrEpochStartCheckpointNotAvailable, err)
+}
+
+func TestNode_GetEpochStartCheckpointShouldWork(t *testing.T) {
+	t.Parallel()
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.EpochTrigger = &testscommon.EpochStartTriggerStub{
+		MetaEpochCalled: func() uint32 {
+			return 7
+		},
+		EpochStartMetaHdrHashCalled: func() []byte {
+			return []byte("hash")
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithProcessComponents(processComponents),
+	)
+
+	checkpoint, err := n.GetEpochStartCheckpoint()
+	require.Nil(t, err)
+	require.Equal(t, &api.EpochStartCheckpoint{
+		Epoch:         7,
+		MetaBlockHash: "68617368",
+		FlagValue:     "7:68617368",
+	}, checkpoint)
+}
@@ -0,0 +1,95 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+const (
+	maxEventsQueryBlockRange = 10000
+	defaultEventsQueryLimit  = 100
+	maxEventsQueryLimit      = 1000
+)
+
+// GetEvents returns a page of the indexed smart contract events matching the provided query
+func (n *Node) GetEvents(query api.EventsQuery) (*api.EventsPage, error) {
+	filter, err := n.createEventsFilter(query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultEventsQueryLimit
+	}
+	if limit > maxEventsQueryLimit {
+		return nil, fmt.Errorf("%w, maximum is %d", ErrEventsQueryLimitTooLarge, maxEventsQueryLimit)
+	}
+
+	pointers, err := n.processComponents.HistoryRepository().GetEventPointers(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &api.EventsPage{
+		Events: make([]*api.IndexedEvent, 0),
+		Total:  uint32(len(pointers)),
+	}
+	if uint64(query.Offset) >= uint64(len(pointers)) {
+		return page, nil
+	}
+
+	end := uint64(query.Offset) + uint64(limit)
+	if end > uint64(len(pointers)) {
+		end = uint64(len(pointers))
+	}
+
+	for _, pointer := range pointers[query.Offset:end] {
+		page.Events = append(page.Events, n.prepareIndexedEvent(pointer))
+	}
+
+	return page, nil
+}
+
+func (n *Node) createEventsFilter(query api.EventsQuery) (dblookupext.EventsFilter, error) {
+	if query.ToBlock < query.FromBlock {
+		return dblookupext.EventsFilter{}, dblookupext.ErrInvalidEventsBlockRange
+	}
+	if query.ToBlock-query.FromBlock >= maxEventsQueryBlockRange {
+		return dblookupext.EventsFilter{}, fmt.Errorf("%w, maximum is %d blocks", ErrEventsBlockRangeTooLarge, maxEventsQueryBlockRange)
+	}
+
+	address, err := n.coreComponents.AddressPubKeyConverter().Decode(query.Address)
+	if err != nil {
+		return dblookupext.EventsFilter{}, fmt.Errorf("%w for address %s", err, query.Address)
+	}
+
+	topic, err := hex.DecodeString(query.Topic)
+	if err != nil {
+		return dblookupext.EventsFilter{}, fmt.Errorf("%w for topic %s", err, query.Topic)
+	}
+
+	return dblookupext.EventsFilter{
+		Address:        address,
+		Identifier:     []byte(query.Identifier),
+		Topic:          topic,
+		FromBlockNonce: query.FromBlock,
+		ToBlockNonce:   query.ToBlock,
+	}, nil
+}
+
+func (n *Node) prepareIndexedEvent(pointer *dblookupext.EventPointer) *api.IndexedEvent {
+	return &api.IndexedEvent{
+		TxHash:     hex.EncodeToString(pointer.TxHash),
+		BlockNonce: pointer.BlockNonce,
+		Epoch:      pointer.Epoch,
+		EventIndex: pointer.EventIndex,
+		Address:    n.coreComponents.AddressPubKeyConverter().Encode(pointer.Address),
+		Identifier: string(pointer.Identifier),
+		Topics:     pointer.Topics,
+		Data:       pointer.Data,
+	}
+}
@@ -0,0 +1,150 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForEvents(historyRepo dblookupext.HistoryRepository) *node.Node {
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = &mock.MarshalizerFake{}
+	coreComponents.AddrPubKeyConv = &mock.PubkeyConverterMock{}
+
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			return &testscommon.StorerStub{
+				GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+					return nil, errors.New("the transaction logs should not be read")
+				},
+			}
+		},
+	}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepo
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithProcessComponents(processComponents),
+	)
+
+	return n
+}
+
+func TestNode_GetEventsInvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historyRepo := &dbLookupExtMock.HistoryRepositoryStub{
+		GetEventPointersCalled: func(filter dblookupext.EventsFilter) ([]*dblookupext.EventPointer, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	n := createNodeForEvents(historyRepo)
+
+	page, err := n.GetEvents(api.EventsQuery{Address: "aa", FromBlock: 10, ToBlock: 9})
+	require.Nil(t, page)
+	require.Equal(t, dblookupext.ErrInvalidEventsBlockRange, err)
+
+	page, err = n.GetEvents(api.EventsQuery{Address: "aa", FromBlock: 10, ToBlock: 100000})
+	require.Nil(t, page)
+	require.True(t, errors.Is(err, node.ErrEventsBlockRangeTooLarge))
+
+	page, err = n.GetEvents(api.EventsQuery{Address: "aa", Limit: 100000})
+	require.Nil(t, page)
+	require.True(t, errors.Is(err, node.ErrEventsQueryLimitTooLarge))
+
+	page, err = n.GetEvents(api.EventsQuery{Address: "not hex"})
+	require.Nil(t, page)
+	require.NotNil(t, err)
+
+	page, err = n.GetEvents(api.EventsQuery{Address: "aa", Topic: "not hex"})
+	require.Nil(t, page)
+	require.NotNil(t, err)
+}
+
+func TestNode_GetEventsShouldReturnRequestedPage(t *testing.T) {
+	t.Parallel()
+
+	contract := []byte("contract")
+	historyRepo := &dbLookupExtMock.HistoryRepositoryStub{
+		GetEventPointersCalled: func(filter dblookupext.EventsFilter) ([]*dblookupext.EventPointer, error) {
+			require.Equal(t, dblookupext.EventsFilter{
+				Address:        contract,
+				Identifier:     []byte("deposit"),
+				Topic:          []byte{},
+				FromBlockNonce: 5,
+				ToBlockNonce:   8,
+			}, filter)
+
+			return []*dblookupext.EventPointer{
+				{BlockNonce: 5, Epoch: 1, TxHash: []byte("txA"), EventIndex: 0, Address: contract, Identifier: []byte("deposit"), Topics: [][]byte{[]byte("alice")}},
+				{BlockNonce: 5, Epoch: 1, TxHash: []byte("txA"), EventIndex: 1, Address: contract, Identifier: []byte("deposit"), Topics: [][]byte{[]byte("bob")}},
+				{BlockNonce: 8, Epoch: 1, TxHash: []byte("txB"), EventIndex: 0, Address: contract, Identifier: []byte("deposit"), Data: []byte("data")},
+			}, nil
+		},
+	}
+	n := createNodeForEvents(historyRepo)
+
+	query := api.EventsQuery{
+		Address:    hex.EncodeToString(contract),
+		Identifier: "deposit",
+		FromBlock:  5,
+		ToBlock:    8,
+		Offset:     1,
+		Limit:      5,
+	}
+	page, err := n.GetEvents(query)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), page.Total)
+	require.Equal(t, []*api.IndexedEvent{
+		{
+			TxHash:     hex.EncodeToString([]byte("txA")),
+			BlockNonce: 5,
+			Epoch:      1,
+			EventIndex: 1,
+			Address:    hex.EncodeToString(contract),
+			Identifier: "deposit",
+			Topics:     [][]byte{[]byte("bob")},
+		},
+		{
+			TxHash:     hex.EncodeToString([]byte("txB")),
+			BlockNonce: 8,
+			Epoch:      1,
+			EventIndex: 0,
+			Address:    hex.EncodeToString(contract),
+			Identifier: "deposit",
+			Data:       []byte("data"),
+		},
+	}, page.Events)
+
+	query.Offset = 3
+	page, err = n.GetEvents(query)
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), page.Total)
+	require.Equal(t, 0, len(page.Events))
+}
+
+func TestNode_GetEventsIndexNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historyRepo, _ := dblookupext.NewNilHistoryRepository()
+	n := createNodeForEvents(historyRepo)
+
+	page, err := n.GetEvents(api.EventsQuery{Address: "aa"})
+	require.Nil(t, page)
+	require.Equal(t, dblookupext.ErrEventsIndexNotEnabled, err)
+}
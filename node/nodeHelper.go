@@ -16,11 +16,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage/maintenance"
 	"github.com/ElrondNetwork/elrond-go/update"
 	updateFactory "github.com/ElrondNetwork/elrond-go/update/factory"
 	"github.com/ElrondNetwork/elrond-go/update/trigger"
 )
 
+// delayBetweenStorageCompactions is the pause taken between compacting two storage units so the online compaction
+// does not compete with the block processing for the disk
+const delayBetweenStorageCompactions = time.Second * 10
+
+// storageDiskUsageCacheDuration is the interval for which the disk usage of the storage units is served from cache
+// instead of walking again the databases directory
+const storageDiskUsageCacheDuration = time.Minute
+
 // CreateHardForkTrigger is the hard fork trigger factory
 // TODO: move this to process components
 func CreateHardForkTrigger(
@@ -79,9 +88,11 @@ func CreateHardForkTrigger(
 		RoundHandler:              process.RoundHandler(),
 		InterceptorDebugConfig:    config.Debug.InterceptorResolver,
 		EnableSignTxWithHashEpoch: epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
+		EnableRelayedTxV3Epoch:    epochConfig.EnableEpochs.RelayedTransactionsV3EnableEpoch,
 		MaxHardCapForMissingNodes: config.TrieSync.MaxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:  config.TrieSync.NumConcurrentTrieSyncers,
 		TrieSyncerVersion:         config.TrieSync.TrieSyncerVersion,
+		ExportPortableFormat:      hardForkConfig.ExportPortableFormat,
 	}
 	hardForkExportFactory, err := updateFactory.NewExportHandlerFactory(argsExporter)
 	if err != nil {
@@ -135,6 +146,7 @@ func prepareOpenTopics(
 // CreateNode is the node factory
 func CreateNode(
 	config *config.Config,
+	epochConfig *config.EpochConfig,
 	bootstrapComponents factory.BootstrapComponentsHandler,
 	coreComponents factory.CoreComponentsHandler,
 	cryptoComponents factory.CryptoComponentsHandler,
@@ -183,6 +195,17 @@ func CreateNode(
 		return nil, err
 	}
 
+	storageMaintainer, err := maintenance.NewStorageMaintainer(maintenance.ArgsStorageMaintainer{
+		PathManager:             coreComponents.PathHandler(),
+		StorageService:          dataComponents.StorageService(),
+		TrieStorageManagers:     stateComponents.TrieStorageManagers(),
+		DelayBetweenCompactions: delayBetweenStorageCompactions,
+		DiskUsageCacheDuration:  storageDiskUsageCacheDuration,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var nd *Node
 	nd, err = NewNode(
 		WithCoreComponents(coreComponents),
@@ -210,6 +233,8 @@ func CreateNode(
 		WithPublicKeySize(config.ValidatorPubkeyConverter.Length),
 		WithNodeStopChannel(coreComponents.ChanStopNodeProcess()),
 		WithImportMode(isInImportMode),
+		WithEnableRelayedTxV3Epoch(epochConfig.EnableEpochs.RelayedTransactionsV3EnableEpoch),
+		WithStorageMaintainer(storageMaintainer),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
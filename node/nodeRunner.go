@@ -27,6 +27,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/forking"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/state/snapshotFile"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/facade/disabled"
@@ -140,6 +141,17 @@ func printEnableEpochs(configs *config.Configs) {
 	log.Debug(readEpochFor("re-delegate below minimum check"), "epoch", enableEpochs.ReDelegateBelowMinCheckEnableEpoch)
 	log.Debug(readEpochFor("waiting waiting list"), "epoch", enableEpochs.WaitingListFixEnableEpoch)
 	log.Debug(readEpochFor("increment SCR nonce in multi transfer"), "epoch", enableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch)
+	log.Debug(readEpochFor("relayed transactions v3"), "epoch", enableEpochs.RelayedTransactionsV3EnableEpoch)
+	log.Debug(readEpochFor("scheduled miniblocks"), "epoch", enableEpochs.ScheduledMiniBlocksEnableEpoch)
+	log.Debug(readEpochFor("meta ESDT"), "epoch", enableEpochs.MetaESDTEnableEpoch)
+	log.Debug(readEpochFor("liquid staking"), "epoch", enableEpochs.LiquidStakingEnableEpoch)
+	log.Debug(readEpochFor("unDelegate queue"), "epoch", enableEpochs.UnDelegateQueueEnableEpoch)
+	log.Debug(readEpochFor("governance param changes"), "epoch", enableEpochs.GovernanceParamChangesEnableEpoch)
+	log.Debug(readEpochFor("governance views"), "epoch", enableEpochs.GovernanceViewsEnableEpoch)
+	log.Debug(readEpochFor("validator key rotation"), "epoch", enableEpochs.ValidatorKeyRotationEnableEpoch)
+	log.Debug(readEpochFor("delegation auto-compounding"), "epoch", enableEpochs.DelegationAutoCompoundingEnableEpoch)
+	log.Debug(readEpochFor("esdt allowances"), "epoch", enableEpochs.ESDTAllowancesEnableEpoch)
+	log.Debug(readEpochFor("code change logs"), "epoch", enableEpochs.CodeChangeLogsEnableEpoch)
 
 	gasSchedule := configs.EpochConfig.GasSchedule
 
@@ -332,6 +344,11 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	err = nr.createStateSnapshotFilesExporter(managedCoreComponents, managedBootstrapComponents, managedStateComponents)
+	if err != nil {
+		return true, err
+	}
+
 	elasticIndexer := managedStatusComponents.ElasticIndexer()
 
 	log.Debug("starting node... executeOneComponentCreationCycle")
@@ -369,6 +386,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	log.Trace("creating node structure")
 	currentNode, err := CreateNode(
 		configs.GeneralConfig,
+		configs.EpochConfig,
 		managedBootstrapComponents,
 		managedCoreComponents,
 		managedCryptoComponents,
@@ -554,6 +572,40 @@ func (nr *nodeRunner) createMetrics(
 	return nil
 }
 
+func (nr *nodeRunner) createStateSnapshotFilesExporter(
+	coreComponents mainFactory.CoreComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+) error {
+	snapshotFilesConfig := nr.configs.GeneralConfig.StateSnapshotFiles
+	if !snapshotFilesConfig.ExportEnabled {
+		return nil
+	}
+
+	exportDirectory := snapshotFilesConfig.ExportDirectory
+	if len(exportDirectory) > 0 && !filepath.IsAbs(exportDirectory) {
+		exportDirectory = filepath.Join(nr.configs.FlagsConfig.WorkingDir, exportDirectory)
+	}
+
+	_, err := snapshotFile.NewEpochStartExporter(snapshotFile.ArgsEpochStartExporter{
+		Marshalizer:        coreComponents.InternalMarshalizer(),
+		Hasher:             coreComponents.Hasher(),
+		ShardCoordinator:   bootstrapComponents.ShardCoordinator(),
+		UserAccounts:       stateComponents.AccountsAdapter(),
+		PeerAccounts:       stateComponents.PeerAccounts(),
+		EpochStartNotifier: coreComponents.EpochStartNotifierWithConfirm(),
+		ExportDirectory:    exportDirectory,
+		NumNodesInChunk:    snapshotFilesConfig.NumNodesInChunk,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot files will be exported at each epoch start", "directory", exportDirectory)
+
+	return nil
+}
+
 func (nr *nodeRunner) createHealthService(flagsConfig *config.ContextFlagsConfig, managedDataComponents mainFactory.DataComponentsHandler) closing.Closer {
 	healthService := health.NewHealthService(nr.configs.GeneralConfig.Health, flagsConfig.WorkingDir)
 	if flagsConfig.UseHealthService {
@@ -611,6 +663,7 @@ func (nr *nodeRunner) CreateManagedConsensusComponents(
 		StateComponents:     managedStateComponents,
 		StatusComponents:    managedStatusComponents,
 		IsInImportMode:      nr.configs.ImportDbConfig.IsImportDBMode,
+		IsInReplicaMode:     nr.configs.ReplicaConfig.IsReplicaMode,
 	}
 
 	consensusFactory, err := mainFactory.NewConsensusComponentsFactory(consensusArgs)
@@ -654,6 +707,7 @@ func (nr *nodeRunner) CreateManagedHeartbeatComponents(
 		NetworkComponents: managedNetworkComponents,
 		CryptoComponents:  managedCryptoComponents,
 		ProcessComponents: managedProcessComponents,
+		IsInReplicaMode:   nr.configs.ReplicaConfig.IsReplicaMode,
 	}
 
 	heartbeatComponentsFactory, err := mainFactory.NewHeartbeatComponentsFactory(heartbeatArgs)
@@ -692,7 +746,7 @@ func waitForSignal(
 		log.Info("terminating at user's signal...")
 	case sig = <-chanStopNodeProcess:
 		log.Info("terminating at internal stop signal", "reason", sig.Reason, "description", sig.Description)
-		if sig.Reason == core.ShuffledOut {
+		if sig.Reason == core.ShuffledOut || sig.Reason == core.ReplicaEpochChanged || sig.Reason == core.EnableEpochsChanged {
 			reshuffled = true
 		}
 		if sig.Reason == core.WrongConfiguration {
@@ -926,6 +980,18 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		return nil, err
 	}
 
+	roundDuration := time.Duration(uint64(time.Millisecond) * managedCoreComponents.GenesisNodesSetup().GetRoundDuration())
+	enableEpochsChanger, err := forking.NewEnableEpochsChanger(forking.ArgsEnableEpochsChanger{
+		EnableEpochs:        &configs.EpochConfig.EnableEpochs,
+		ChanStopNodeProcess: managedCoreComponents.ChanStopNodeProcess(),
+		EpochNotifier:       managedCoreComponents.EpochNotifier(),
+		StartEpoch:          managedBootstrapComponents.EpochBootstrapParams().Epoch(),
+		MaxRestartDelay:     roundDuration * time.Duration(configs.GeneralConfig.EpochStartConfig.RoundsPerEpoch) / 2,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	log.Trace("creating time cache for requested items components")
 	requestedItemsHandler := timecache.NewTimeCache(
 		time.Duration(uint64(time.Millisecond) * managedCoreComponents.GenesisNodesSetup().GetRoundDuration()))
@@ -955,6 +1021,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		ImportStartHandler:     importStartHandler,
 		WorkingDir:             configs.FlagsConfig.WorkingDir,
 		HistoryRepo:            historyRepository,
+		EnableEpochsChanger:    enableEpochsChanger,
 	}
 	processComponentsFactory, err := mainFactory.NewProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -1064,7 +1131,7 @@ func (nr *nodeRunner) CreateManagedBootstrapComponents(
 		EpochConfig:       *nr.configs.EpochConfig,
 		PrefConfig:        *nr.configs.PreferencesConfig,
 		ImportDbConfig:    *nr.configs.ImportDbConfig,
-		WorkingDir:        nr.configs.FlagsConfig.WorkingDir,
+		WorkingDir:        nr.dbWorkingDir(),
 		CoreComponents:    managedCoreComponents,
 		CryptoComponents:  managedCryptoComponents,
 		NetworkComponents: managedNetworkComponents,
@@ -1144,7 +1211,7 @@ func (nr *nodeRunner) CreateManagedCoreComponents(
 		RatingsConfig:         *nr.configs.RatingsConfig,
 		EconomicsConfig:       *nr.configs.EconomicsConfig,
 		NodesFilename:         nr.configs.ConfigurationPathsHolder.Nodes,
-		WorkingDirectory:      nr.configs.FlagsConfig.WorkingDir,
+		WorkingDirectory:      nr.dbWorkingDir(),
 		ChanStopNodeProcess:   chanStopNodeProcess,
 		StatusHandlersFactory: statusHandlersFactory,
 	}
@@ -1251,6 +1318,16 @@ func createStringFromRatingsData(ratingsData process.RatingsInfoHandler) string
 	return computedRatingsDataStr
 }
 
+// dbWorkingDir returns the working directory holding the databases of the node. A replica node uses the databases of
+// the node it follows
+func (nr *nodeRunner) dbWorkingDir() string {
+	if nr.configs.ReplicaConfig.IsReplicaMode {
+		return nr.configs.ReplicaConfig.PrimaryWorkingDir
+	}
+
+	return nr.configs.FlagsConfig.WorkingDir
+}
+
 func cleanupStorageIfNecessary(workingDir string, cleanupStorage bool) error {
 	if !cleanupStorage {
 		return nil
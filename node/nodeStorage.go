@@ -0,0 +1,26 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetStorageUsage returns the size on disk of the storage units, per unit and per epoch, together with the status of
+// the last online compaction
+func (n *Node) GetStorageUsage() (*api.StorageUsage, error) {
+	if check.IfNil(n.storageMaintainer) {
+		return nil, ErrNilStorageMaintainer
+	}
+
+	return n.storageMaintainer.DiskUsage()
+}
+
+// CompactStorageUnits starts the online compaction of the provided storage units. The compaction runs in background,
+// one unit at a time, and its progress can be followed through the storage usage report
+func (n *Node) CompactStorageUnits(units []string) error {
+	if check.IfNil(n.storageMaintainer) {
+		return ErrNilStorageMaintainer
+	}
+
+	return n.storageMaintainer.StartCompaction(units)
+}
@@ -0,0 +1,67 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithStorageMaintainer_NilStorageMaintainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, err := node.NewNode(node.WithStorageMaintainer(nil))
+	assert.Nil(t, n)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), node.ErrNilStorageMaintainer.Error())
+}
+
+func TestNode_GetStorageUsageNoStorageMaintainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	usage, err := n.GetStorageUsage()
+	assert.Nil(t, usage)
+	assert.Equal(t, node.ErrNilStorageMaintainer, err)
+
+	err = n.CompactStorageUnits([]string{"TransactionUnit"})
+	assert.Equal(t, node.ErrNilStorageMaintainer, err)
+}
+
+func TestNode_GetStorageUsageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedUsage := &api.StorageUsage{
+		DatabasePath: "db",
+		TotalSize:    1024,
+	}
+	n, _ := node.NewNode(node.WithStorageMaintainer(&mock.StorageMaintainerStub{
+		DiskUsageCalled: func() (*api.StorageUsage, error) {
+			return expectedUsage, nil
+		},
+	}))
+
+	usage, err := n.GetStorageUsage()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedUsage, usage)
+}
+
+func TestNode_CompactStorageUnitsShouldStartTheCompaction(t *testing.T) {
+	t.Parallel()
+
+	var compactedUnits []string
+	n, _ := node.NewNode(node.WithStorageMaintainer(&mock.StorageMaintainerStub{
+		StartCompactionCalled: func(units []string) error {
+			compactedUnits = units
+			return nil
+		},
+	}))
+
+	err := n.CompactStorageUnits([]string{"TransactionUnit", "userAccountTrie"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"TransactionUnit", "userAccountTrie"}, compactedUnits)
+}
@@ -0,0 +1,25 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetSyncStatus returns the information gathered from the fork detector and the sync mechanism of the node
+func (n *Node) GetSyncStatus() (*api.SyncStatus, error) {
+	forkDetector := n.processComponents.ForkDetector()
+	syncIntrospector := n.processComponents.SyncIntrospector()
+	if check.IfNil(forkDetector) {
+		return nil, ErrNilForkDetector
+	}
+	if check.IfNil(syncIntrospector) {
+		return nil, ErrNilSyncIntrospector
+	}
+
+	return &api.SyncStatus{
+		State:           syncIntrospector.SyncState(),
+		ForkDetector:    forkDetector.GetStatus(),
+		RollBacks:       syncIntrospector.RollBacks(),
+		HeaderSuppliers: syncIntrospector.HeaderSuppliers(),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/sync/introspection"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetSyncStatusNilSyncIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithProcessComponents(getDefaultProcessComponents()),
+	)
+
+	status, err := n.GetSyncStatus()
+	require.Nil(t, status)
+	require.Equal(t, node.ErrNilSyncIntrospector, err)
+}
+
+func TestNode_GetSyncStatusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	syncIntrospector, _ := introspection.NewSyncIntrospector(introspection.ArgsSyncIntrospector{
+		MaxNumRollBacks:       10,
+		MaxNumHeaderSuppliers: 10,
+		StatusHandler:         &testscommon.AppStatusHandlerStub{},
+	})
+	syncIntrospector.SetSyncState(&api.SyncState{IsSynchronized: true, CurrentNonce: 7})
+	syncIntrospector.AddRollBack(&api.SyncRollBack{Reason: "fork detected", FromNonce: 8, ToNonce: 7})
+	syncIntrospector.AddHeaderSupplier("pid", &block.Header{Nonce: 8, Round: 9})
+
+	forkDetectorStatus := &api.ForkDetectorStatus{ProbableHighestNonce: 8}
+	processComponents := getDefaultProcessComponents()
+	processComponents.SyncIntrospectorInternal = syncIntrospector
+	processComponents.ForkDetect = &mock.ForkDetectorMock{
+		GetStatusCalled: func() *api.ForkDetectorStatus {
+			return forkDetectorStatus
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithProcessComponents(processComponents),
+	)
+
+	status, err := n.GetSyncStatus()
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), status.State.CurrentNonce)
+	require.True(t, status.State.IsSynchronized)
+	require.Equal(t, forkDetectorStatus, status.ForkDetector)
+	require.Equal(t, 1, len(status.RollBacks))
+	require.Equal(t, "fork detected", status.RollBacks[0].Reason)
+	require.Equal(t, 1, len(status.HeaderSuppliers))
+	require.Equal(t, uint64(8), status.HeaderSuppliers[0].LastNonce)
+}
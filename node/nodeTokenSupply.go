@@ -0,0 +1,30 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetTokenSupplyDelta returns the quantities of the provided token minted and burned in the shard of the node: the
+// initial supply and the mints of the esdt system smart contract received by the shard, the quantities created by the
+// ESDT built-in functions and the ESDTBurn/NFT burn executed in the shard. The total supply of the token is the sum of
+// the deltas returned by all the shards. The metachain executes no ESDT transfers and does not track any delta
+func (n *Node) GetTokenSupplyDelta(token string) (*api.ESDTSupplyDelta, error) {
+	selfShardID := n.processComponents.ShardCoordinator().SelfId()
+	if selfShardID == core.MetachainShardId {
+		return nil, ErrESDTSupplyDeltaNotTrackedOnMetachain
+	}
+
+	supply, err := n.processComponents.HistoryRepository().GetESDTSupply(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ESDTSupplyDelta{
+		Token:   token,
+		ShardID: selfShardID,
+		Delta:   supply.Supply.String(),
+		Minted:  supply.Minted.String(),
+		Burned:  supply.Burned.String(),
+	}, nil
+}
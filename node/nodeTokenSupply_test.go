@@ -0,0 +1,83 @@
+package node_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	dbLookupExtMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTokenSupplyOnShardShouldReadHistoryRepository(t *testing.T) {
+	t.Parallel()
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = &dbLookupExtMock.HistoryRepositoryStub{
+		GetESDTSupplyCalled: func(token string) (*dblookupext.SupplyESDT, error) {
+			require.Equal(t, "TKN-abcdef", token)
+			return &dblookupext.SupplyESDT{
+				Supply: big.NewInt(70),
+				Minted: big.NewInt(100),
+				Burned: big.NewInt(30),
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	supply, err := n.GetTokenSupplyDelta("TKN-abcdef")
+	require.Nil(t, err)
+	require.Equal(t, &api.ESDTSupplyDelta{
+		Token:   "TKN-abcdef",
+		ShardID: 0,
+		Delta:   "70",
+		Minted:  "100",
+		Burned:  "30",
+	}, supply)
+}
+
+func TestNode_GetTokenSupplyOnShardNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	historyRepo, _ := dblookupext.NewNilHistoryRepository()
+	processComponents := getDefaultProcessComponents()
+	processComponents.HistoryRepositoryInternal = historyRepo
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	supply, err := n.GetTokenSupplyDelta("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, dblookupext.ErrESDTSuppliesNotEnabled, err)
+}
+
+func TestNode_GetTokenSupplyOnMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = &mock.ShardCoordinatorMock{
+		SelfShardId: core.MetachainShardId,
+	}
+	processComponents.HistoryRepositoryInternal = &dbLookupExtMock.HistoryRepositoryStub{
+		GetESDTSupplyCalled: func(_ string) (*dblookupext.SupplyESDT, error) {
+			return nil, errors.New("the history repository should not be used on metachain")
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(getDefaultCoreComponents()),
+		node.WithProcessComponents(processComponents),
+	)
+
+	supply, err := n.GetTokenSupplyDelta("TKN-abcdef")
+	require.Nil(t, supply)
+	require.Equal(t, node.ErrESDTSupplyDeltaNotTrackedOnMetachain, err)
+}
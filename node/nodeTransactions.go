@@ -288,7 +288,7 @@ func (n *Node) unmarshalTransaction(txBytes []byte, txType transaction.TxType) (
 }
 
 func (n *Node) prepareNormalTx(tx *transaction.Transaction) (*transaction.ApiTransactionResult, error) {
-	return &transaction.ApiTransactionResult{
+	txResult := &transaction.ApiTransactionResult{
 		Tx:               tx,
 		Type:             string(transaction.TxTypeNormal),
 		Nonce:            tx.Nonce,
@@ -301,11 +301,14 @@ func (n *Node) prepareNormalTx(tx *transaction.Transaction) (*transaction.ApiTra
 		GasLimit:         tx.GasLimit,
 		Data:             tx.Data,
 		Signature:        hex.EncodeToString(tx.Signature),
-	}, nil
+	}
+	n.setRelayerFields(tx, txResult)
+
+	return txResult, nil
 }
 
 func (n *Node) prepareInvalidTx(tx *transaction.Transaction) (*transaction.ApiTransactionResult, error) {
-	return &transaction.ApiTransactionResult{
+	txResult := &transaction.ApiTransactionResult{
 		Tx:               tx,
 		Type:             string(transaction.TxTypeInvalid),
 		Nonce:            tx.Nonce,
@@ -318,7 +321,19 @@ func (n *Node) prepareInvalidTx(tx *transaction.Transaction) (*transaction.ApiTr
 		GasLimit:         tx.GasLimit,
 		Data:             tx.Data,
 		Signature:        hex.EncodeToString(tx.Signature),
-	}, nil
+	}
+	n.setRelayerFields(tx, txResult)
+
+	return txResult, nil
+}
+
+func (n *Node) setRelayerFields(tx *transaction.Transaction, txResult *transaction.ApiTransactionResult) {
+	if !tx.IsRelayedV3() {
+		return
+	}
+
+	txResult.RelayerAddress = n.coreComponents.AddressPubKeyConverter().Encode(tx.RelayerAddr)
+	txResult.RelayerSignature = hex.EncodeToString(tx.RelayerSignature)
 }
 
 func (n *Node) prepareRewardTx(tx *rewardTxData.RewardTx) (*transaction.ApiTransactionResult, error) {
@@ -345,6 +345,14 @@ func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	}
 }
 
+// WithEnableRelayedTxV3Epoch sets up enableRelayedTxV3Epoch for the node
+func WithEnableRelayedTxV3Epoch(enableRelayedTxV3Epoch uint32) Option {
+	return func(n *Node) error {
+		n.enableRelayedTxV3Epoch = enableRelayedTxV3Epoch
+		return nil
+	}
+}
+
 // WithImportMode sets up the flag if the node is running in import mode
 func WithImportMode(importMode bool) Option {
 	return func(n *Node) error {
@@ -363,3 +371,14 @@ func WithNodeRedundancyHandler(nodeRedundancyHandler consensus.NodeRedundancyHan
 		return nil
 	}
 }
+
+// WithStorageMaintainer sets up the storage maintainer used to report the disk usage and to compact the storage units
+func WithStorageMaintainer(storageMaintainer StorageMaintainer) Option {
+	return func(n *Node) error {
+		if check.IfNil(storageMaintainer) {
+			return ErrNilStorageMaintainer
+		}
+		n.storageMaintainer = storageMaintainer
+		return nil
+	}
+}
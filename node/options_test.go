@@ -317,6 +317,19 @@ func TestWithSignTxWithHashEpoch_EnableSignTxWithHashEpochShouldWork(t *testing.
 	assert.Nil(t, err)
 }
 
+func TestWithEnableRelayedTxV3Epoch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	epochEnable := uint32(10)
+	opt := WithEnableRelayedTxV3Epoch(epochEnable)
+	err := opt(node)
+
+	assert.Equal(t, epochEnable, node.enableRelayedTxV3Epoch)
+	assert.Nil(t, err)
+}
+
 func TestWithNodeRedundancyHandler_NilNodeRedundancyHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
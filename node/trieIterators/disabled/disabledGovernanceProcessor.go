@@ -0,0 +1,36 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+var errCannotReturnGovernanceDataFromShardNode = errors.New("governance data cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetGovernanceProposals returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceProposal returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceProposal(_ string) (*api.GovernanceProposalTally, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetGovernanceVotes returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetGovernanceVotes(_ string) ([]*api.GovernanceVote, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateStakingQueueHandler will create a new instance of StakingQueueHandler
+func CreateStakingQueueHandler(args trieIterators.ArgTrieIteratorProcessor) (external.StakingQueueHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledStakingQueueProcessor(), nil
+	}
+
+	return trieIterators.NewStakingQueueProcessor(args)
+}
@@ -0,0 +1,45 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStakingQueueHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+	}
+
+	stakingQueueHandler, err := CreateStakingQueueHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.stakingQueueProcessor", fmt.Sprintf("%T", stakingQueueHandler))
+}
+
+func TestCreateStakingQueueHandler_StakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &testscommon.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		BlockChain:         &mock.BlockChainMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+	}
+
+	stakingQueueHandler, err := CreateStakingQueueHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.stakingQueueProcessor", fmt.Sprintf("%T", stakingQueueHandler))
+}
@@ -0,0 +1,250 @@
+package trieIterators
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// the governance system SC stores the proposals under proposalPrefix + commit hash and the vote sets under
+// proposalPrefix + commit hash + voter address
+const governanceProposalPrefix = "proposal_"
+const governanceCommitHashLength = 40
+
+const (
+	proposalStatusOpen   = "open"
+	proposalStatusClosed = "closed"
+)
+
+const (
+	numViewProposalValues      = 10
+	numViewProposalTallyValues = 6
+	numViewUserVoteSetValues   = 5
+)
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(arg ArgTrieIteratorProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			blockChain:   arg.BlockChain,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+	}, nil
+}
+
+// GetGovernanceProposals will return all the proposals of the governance system SC
+func (gp *governanceProcessor) GetGovernanceProposals() ([]*api.GovernanceProposal, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	commitHashes, _, err := gp.getProposalsAndVoteKeys(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*api.GovernanceProposal, 0, len(commitHashes))
+	for _, commitHash := range commitHashes {
+		proposal, errGet := gp.getProposal(commitHash)
+		if errGet != nil {
+			return nil, errGet
+		}
+
+		proposals = append(proposals, proposal)
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].StartVoteNonce < proposals[j].StartVoteNonce
+	})
+
+	return proposals, nil
+}
+
+// GetGovernanceProposal will return a proposal together with its validators and funds vote tallies
+func (gp *governanceProcessor) GetGovernanceProposal(commitHash string) (*api.GovernanceProposalTally, error) {
+	proposal, err := gp.getProposal([]byte(commitHash))
+	if err != nil {
+		return nil, err
+	}
+
+	returnData, err := gp.executeGovernanceQuery("viewProposalTally", numViewProposalTallyValues, []byte(commitHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GovernanceProposalTally{
+		Proposal: proposal,
+		Validators: &api.GovernanceVotingPower{
+			Yes:  bigIntString(returnData[0]),
+			No:   bigIntString(returnData[1]),
+			Veto: bigIntString(returnData[2]),
+		},
+		Funds: &api.GovernanceVotingPower{
+			Yes:  bigIntString(returnData[3]),
+			No:   bigIntString(returnData[4]),
+			Veto: bigIntString(returnData[5]),
+		},
+	}, nil
+}
+
+// GetGovernanceVotes will return the votes cast by the provided address on all the proposals
+func (gp *governanceProcessor) GetGovernanceVotes(address string) ([]*api.GovernanceVote, error) {
+	voterAddress, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	_, votedCommitHashes, err := gp.getProposalsAndVoteKeys(voterAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]*api.GovernanceVote, 0, len(votedCommitHashes))
+	for _, commitHash := range votedCommitHashes {
+		returnData, errQuery := gp.executeGovernanceQuery("viewUserVoteSet", numViewUserVoteSetValues, commitHash, voterAddress)
+		if errQuery != nil {
+			return nil, errQuery
+		}
+
+		votes = append(votes, &api.GovernanceVote{
+			CommitHash:  string(commitHash),
+			UsedPower:   bigIntString(returnData[0]),
+			UsedBalance: bigIntString(returnData[1]),
+			Yes:         bigIntString(returnData[2]),
+			No:          bigIntString(returnData[3]),
+			Veto:        bigIntString(returnData[4]),
+		})
+	}
+
+	return votes, nil
+}
+
+// getProposalsAndVoteKeys iterates the governance system SC data trie and returns the commit hashes of all the
+// proposals and the commit hashes of the proposals voted by the provided address
+func (gp *governanceProcessor) getProposalsAndVoteKeys(voterAddress []byte) ([][]byte, [][]byte, error) {
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chLeaves, err := governanceAccount.DataTrie().GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefixLength := len(governanceProposalPrefix)
+	proposalKeyLength := prefixLength + governanceCommitHashLength
+	commitHashes := make([][]byte, 0)
+	votedCommitHashes := make([][]byte, 0)
+	for leaf := range chLeaves {
+		leafKey := leaf.Key()
+		if !bytes.HasPrefix(leafKey, []byte(governanceProposalPrefix)) {
+			continue
+		}
+
+		switch len(leafKey) {
+		case proposalKeyLength:
+			commitHashes = append(commitHashes, leafKey[prefixLength:])
+		case proposalKeyLength + len(voterAddress):
+			if len(voterAddress) > 0 && bytes.Equal(leafKey[proposalKeyLength:], voterAddress) {
+				votedCommitHashes = append(votedCommitHashes, leafKey[prefixLength:proposalKeyLength])
+			}
+		}
+	}
+
+	sortByteSlices(commitHashes)
+	sortByteSlices(votedCommitHashes)
+
+	return commitHashes, votedCommitHashes, nil
+}
+
+func (gp *governanceProcessor) getProposal(commitHash []byte) (*api.GovernanceProposal, error) {
+	returnData, err := gp.executeGovernanceQuery("viewProposal", numViewProposalValues, commitHash)
+	if err != nil {
+		return nil, err
+	}
+
+	status := proposalStatusOpen
+	if string(returnData[7]) == "true" {
+		status = proposalStatusClosed
+	}
+
+	return &api.GovernanceProposal{
+		CommitHash:     string(returnData[1]),
+		Issuer:         gp.publicKeyConverter.Encode(returnData[0]),
+		StartVoteNonce: big.NewInt(0).SetBytes(returnData[2]).Uint64(),
+		EndVoteNonce:   big.NewInt(0).SetBytes(returnData[3]).Uint64(),
+		Status:         status,
+		Passed:         string(returnData[8]) == "true",
+		Yes:            bigIntString(returnData[4]),
+		No:             bigIntString(returnData[5]),
+		Veto:           bigIntString(returnData[6]),
+		NumVoters:      big.NewInt(0).SetBytes(returnData[9]).Uint64(),
+	}, nil
+}
+
+func (gp *governanceProcessor) executeGovernanceQuery(funcName string, numReturnValues int, arguments ...[]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.GovernanceSCAddress,
+		FuncName:   funcName,
+		CallerAddr: vm.GovernanceSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, err := gp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	if len(vmOutput.ReturnData) != numReturnValues {
+		return nil, fmt.Errorf("%w, %s function should have returned %d values", epochStart.ErrExecutingSystemScCode, funcName, numReturnValues)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func bigIntString(value []byte) string {
+	return big.NewInt(0).SetBytes(value).String()
+}
+
+func sortByteSlices(slices [][]byte) {
+	sort.Slice(slices, func(i, j int) bool {
+		return bytes.Compare(slices[i], slices[j]) < 0
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,273 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGovernanceProcessorArgs(leaves [][]byte, queryService process.SCQueryService) ArgTrieIteratorProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+	arg.QueryService = queryService
+	arg.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{}
+		},
+	}
+	arg.Accounts.AccountsAdapter = &testscommon.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			return createDelegationScAccount(addressContainer, leaves, addressContainer), nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return nil
+		},
+	}
+
+	return arg
+}
+
+func governanceProposalKey(commitHash []byte) []byte {
+	return append([]byte(governanceProposalPrefix), commitHash...)
+}
+
+func governanceVoteKey(commitHash []byte, voter []byte) []byte {
+	return append(governanceProposalKey(commitHash), voter...)
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.QueryService = nil
+	gp, err := NewGovernanceProcessor(arg)
+	assert.True(t, check.IfNil(gp))
+	assert.Equal(t, ErrNilQueryService, err)
+
+	gp, err = NewGovernanceProcessor(createMockArgs())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(gp))
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsQueryFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	commitHash := bytes.Repeat([]byte("a"), governanceCommitHashLength)
+	arg := createGovernanceProcessorArgs([][]byte{governanceProposalKey(commitHash)}, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals()
+	assert.Nil(t, proposals)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	commitHash1 := bytes.Repeat([]byte("a"), governanceCommitHashLength)
+	commitHash2 := bytes.Repeat([]byte("b"), governanceCommitHashLength)
+	voter := bytes.Repeat([]byte("v"), 32)
+	whiteListed := bytes.Repeat([]byte("w"), 32)
+	leaves := [][]byte{
+		governanceProposalKey(commitHash2),
+		governanceProposalKey(commitHash1),
+		governanceVoteKey(commitHash1, voter),
+		governanceProposalKey(whiteListed),
+		[]byte("governanceConfig"),
+	}
+
+	queriedProposals := make([][]byte, 0)
+	arg := createGovernanceProcessorArgs(leaves, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, "viewProposal", query.FuncName)
+			require.Equal(t, vm.GovernanceSCAddress, query.ScAddress)
+			commitHash := query.Arguments[0]
+			queriedProposals = append(queriedProposals, commitHash)
+
+			startNonce := big.NewInt(20)
+			closed := []byte("false")
+			if bytes.Equal(commitHash, commitHash2) {
+				startNonce = big.NewInt(10)
+				closed = []byte("true")
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnData: [][]byte{
+					[]byte("issuer"),
+					commitHash,
+					startNonce.Bytes(),
+					big.NewInt(30).Bytes(),
+					big.NewInt(100).Bytes(),
+					big.NewInt(20).Bytes(),
+					big.NewInt(0).Bytes(),
+					closed,
+					closed,
+					big.NewInt(1).Bytes(),
+				},
+			}, nil
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetGovernanceProposals()
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{commitHash1, commitHash2}, queriedProposals)
+	require.Equal(t, 2, len(proposals))
+
+	expectedProposal := &api.GovernanceProposal{
+		CommitHash:     string(commitHash2),
+		Issuer:         hex.EncodeToString([]byte("issuer")),
+		StartVoteNonce: 10,
+		EndVoteNonce:   30,
+		Status:         proposalStatusClosed,
+		Passed:         true,
+		Yes:            "100",
+		No:             "20",
+		Veto:           "0",
+		NumVoters:      1,
+	}
+	assert.Equal(t, expectedProposal, proposals[0])
+	assert.Equal(t, string(commitHash1), proposals[1].CommitHash)
+	assert.Equal(t, proposalStatusOpen, proposals[1].Status)
+	assert.False(t, proposals[1].Passed)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	commitHash := bytes.Repeat([]byte("a"), governanceCommitHashLength)
+	arg := createGovernanceProcessorArgs(nil, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			switch query.FuncName {
+			case "viewProposal":
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{
+						[]byte("issuer"),
+						commitHash,
+						big.NewInt(1).Bytes(),
+						big.NewInt(2).Bytes(),
+						big.NewInt(700).Bytes(),
+						big.NewInt(50).Bytes(),
+						big.NewInt(5).Bytes(),
+						[]byte("false"),
+						[]byte("false"),
+						big.NewInt(3).Bytes(),
+					},
+				}, nil
+			case "viewProposalTally":
+				return &vmcommon.VMOutput{
+					ReturnData: [][]byte{
+						big.NewInt(600).Bytes(),
+						big.NewInt(50).Bytes(),
+						big.NewInt(0).Bytes(),
+						big.NewInt(100).Bytes(),
+						big.NewInt(0).Bytes(),
+						big.NewInt(5).Bytes(),
+					},
+				}, nil
+			}
+
+			return nil, fmt.Errorf("not an expected call")
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	tally, err := gp.GetGovernanceProposal(string(commitHash))
+	require.Nil(t, err)
+	assert.Equal(t, "700", tally.Proposal.Yes)
+	assert.Equal(t, uint64(3), tally.Proposal.NumVoters)
+	assert.Equal(t, &api.GovernanceVotingPower{Yes: "600", No: "50", Veto: "0"}, tally.Validators)
+	assert.Equal(t, &api.GovernanceVotingPower{Yes: "100", No: "0", Veto: "5"}, tally.Funds)
+}
+
+func TestGovernanceProcessor_GetGovernanceProposalWrongNumberOfValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createGovernanceProcessorArgs(nil, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("issuer")}}, nil
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	tally, err := gp.GetGovernanceProposal("commit")
+	assert.Nil(t, tally)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestGovernanceProcessor_GetGovernanceVotesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	commitHash1 := bytes.Repeat([]byte("a"), governanceCommitHashLength)
+	commitHash2 := bytes.Repeat([]byte("b"), governanceCommitHashLength)
+	voter := bytes.Repeat([]byte("v"), 32)
+	otherVoter := bytes.Repeat([]byte("o"), 32)
+	leaves := [][]byte{
+		governanceProposalKey(commitHash1),
+		governanceProposalKey(commitHash2),
+		governanceVoteKey(commitHash2, voter),
+		governanceVoteKey(commitHash1, otherVoter),
+		governanceVoteKey(commitHash1, voter),
+	}
+
+	arg := createGovernanceProcessorArgs(leaves, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, "viewUserVoteSet", query.FuncName)
+			require.Equal(t, voter, query.Arguments[1])
+
+			power := big.NewInt(10)
+			if bytes.Equal(query.Arguments[0], commitHash2) {
+				power = big.NewInt(20)
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnData: [][]byte{
+					power.Bytes(),
+					big.NewInt(0).Bytes(),
+					power.Bytes(),
+					big.NewInt(0).Bytes(),
+					big.NewInt(0).Bytes(),
+				},
+			}, nil
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	votes, err := gp.GetGovernanceVotes(hex.EncodeToString(voter))
+	require.Nil(t, err)
+	expectedVotes := []*api.GovernanceVote{
+		{CommitHash: string(commitHash1), UsedPower: "10", UsedBalance: "0", Yes: "10", No: "0", Veto: "0"},
+		{CommitHash: string(commitHash2), UsedPower: "20", UsedBalance: "0", Yes: "20", No: "0", Veto: "0"},
+	}
+	assert.Equal(t, expectedVotes, votes)
+}
+
+func TestGovernanceProcessor_GetGovernanceVotesInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGovernanceProcessor(createGovernanceProcessorArgs(nil, &mock.SCQueryServiceStub{}))
+
+	votes, err := gp.GetGovernanceVotes("not hex")
+	assert.Nil(t, votes)
+	assert.NotNil(t, err)
+}
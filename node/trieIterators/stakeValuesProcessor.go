@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -27,11 +28,12 @@ type stakedValuesProcessor struct {
 
 // ArgTrieIteratorProcessor represents the arguments DTO used in trie iterator processors constructors
 type ArgTrieIteratorProcessor struct {
-	ShardID            uint32
-	Accounts           *AccountsWrapper
-	BlockChain         data.ChainHandler
-	QueryService       process.SCQueryService
-	PublicKeyConverter core.PubkeyConverter
+	ShardID              uint32
+	Accounts             *AccountsWrapper
+	BlockChain           data.ChainHandler
+	QueryService         process.SCQueryService
+	PublicKeyConverter   core.PubkeyConverter
+	MaxNodesChangeConfig []config.MaxNodesChangeConfig
 }
 
 // NewTotalStakedValueProcessor will create a new instance of stakedValuesProc
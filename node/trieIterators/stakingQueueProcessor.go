@@ -0,0 +1,162 @@
+package trieIterators
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const numQueueRegisterNonceAndRewardAddressValues = 3
+
+type stakingQueueProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter   core.PubkeyConverter
+	maxNodesChangeConfig []config.MaxNodesChangeConfig
+}
+
+// NewStakingQueueProcessor will create a new instance of stakingQueueProcessor
+func NewStakingQueueProcessor(arg ArgTrieIteratorProcessor) (*stakingQueueProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	maxNodesChangeConfig := make([]config.MaxNodesChangeConfig, len(arg.MaxNodesChangeConfig))
+	copy(maxNodesChangeConfig, arg.MaxNodesChangeConfig)
+	sort.Slice(maxNodesChangeConfig, func(i, j int) bool {
+		return maxNodesChangeConfig[i].EpochEnable < maxNodesChangeConfig[j].EpochEnable
+	})
+
+	return &stakingQueueProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			blockChain:   arg.BlockChain,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter:   arg.PublicKeyConverter,
+		maxNodesChangeConfig: maxNodesChangeConfig,
+	}, nil
+}
+
+// GetStakingQueue will return the BLS keys waiting in the staking queue, in the order they will be staked
+func (sqp *stakingQueueProcessor) GetStakingQueue() ([]*api.StakingQueueEntry, error) {
+	currentHeader := sqp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return nil, ErrNodeNotInitialized
+	}
+
+	returnData, err := sqp.executeStakingQuery("getQueueSize")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData) != 1 {
+		return nil, fmt.Errorf("%w, getQueueSize function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+	queueSize, err := strconv.Atoi(string(returnData[0]))
+	if err != nil {
+		return nil, err
+	}
+	if queueSize == 0 {
+		return make([]*api.StakingQueueEntry, 0), nil
+	}
+
+	returnData, err = sqp.executeStakingQuery("getQueueRegisterNonceAndRewardAddress")
+	if err != nil {
+		return nil, err
+	}
+	if len(returnData)%numQueueRegisterNonceAndRewardAddressValues != 0 {
+		return nil, fmt.Errorf("%w, getQueueRegisterNonceAndRewardAddress function should have returned triplets", epochStart.ErrExecutingSystemScCode)
+	}
+
+	numQueued := len(returnData) / numQueueRegisterNonceAndRewardAddressValues
+	activationEpochs := sqp.computeActivationEpochs(currentHeader.GetEpoch(), numQueued)
+	queue := make([]*api.StakingQueueEntry, 0, numQueued)
+	for i := 0; i < numQueued; i++ {
+		blsKey := returnData[i*numQueueRegisterNonceAndRewardAddressValues]
+		rewardAddress := returnData[i*numQueueRegisterNonceAndRewardAddressValues+1]
+		registerNonce := returnData[i*numQueueRegisterNonceAndRewardAddressValues+2]
+
+		ownerData, errQuery := sqp.executeStakingQuery("getOwner", blsKey)
+		if errQuery != nil {
+			return nil, fmt.Errorf("%w for BLS key %s", errQuery, hex.EncodeToString(blsKey))
+		}
+		if len(ownerData) != 1 {
+			return nil, fmt.Errorf("%w, getOwner function should have returned one value", epochStart.ErrExecutingSystemScCode)
+		}
+
+		queue = append(queue, &api.StakingQueueEntry{
+			BLSKey:                  hex.EncodeToString(blsKey),
+			Owner:                   sqp.publicKeyConverter.Encode(ownerData[0]),
+			RewardAddress:           sqp.publicKeyConverter.Encode(rewardAddress),
+			Index:                   uint32(i + 1),
+			RegisterNonce:           big.NewInt(0).SetBytes(registerNonce).Uint64(),
+			ExpectedActivationEpoch: activationEpochs[i],
+		})
+	}
+
+	return queue, nil
+}
+
+// computeActivationEpochs returns, for each queue position, the epoch in which the node is expected to be staked from
+// the queue. Only the increases of the maximum number of nodes are predictable, the positions freed by nodes leaving
+// the network are not taken into account. A value of 0 means that no configured change will reach that position
+func (sqp *stakingQueueProcessor) computeActivationEpochs(currentEpoch uint32, numQueued int) []uint32 {
+	activationEpochs := make([]uint32, numQueued)
+
+	currentMaxNodes := uint32(0)
+	numFreedPositions := 0
+	for _, maxNodesConfig := range sqp.maxNodesChangeConfig {
+		if maxNodesConfig.EpochEnable <= currentEpoch {
+			currentMaxNodes = maxNodesConfig.MaxNumNodes
+			continue
+		}
+		if maxNodesConfig.MaxNumNodes <= currentMaxNodes {
+			continue
+		}
+
+		newPositions := int(maxNodesConfig.MaxNumNodes - currentMaxNodes)
+		for i := numFreedPositions; i < numFreedPositions+newPositions && i < numQueued; i++ {
+			activationEpochs[i] = maxNodesConfig.EpochEnable
+		}
+		numFreedPositions += newPositions
+		currentMaxNodes = maxNodesConfig.MaxNumNodes
+	}
+
+	return activationEpochs
+}
+
+func (sqp *stakingQueueProcessor) executeStakingQuery(funcName string, arguments ...[]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.StakingSCAddress,
+		FuncName:   funcName,
+		CallerAddr: vm.ValidatorSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  arguments,
+	}
+
+	vmOutput, err := sqp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqp *stakingQueueProcessor) IsInterfaceNil() bool {
+	return sqp == nil
+}
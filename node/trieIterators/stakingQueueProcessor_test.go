@@ -0,0 +1,178 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createStakingQueueProcessorArgs(epoch uint32, queryService process.SCQueryService) ArgTrieIteratorProcessor {
+	arg := createMockArgs()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+	arg.QueryService = queryService
+	arg.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Epoch: epoch}
+		},
+	}
+	arg.MaxNodesChangeConfig = []config.MaxNodesChangeConfig{
+		{EpochEnable: 10, MaxNumNodes: 12},
+		{EpochEnable: 0, MaxNumNodes: 10},
+		{EpochEnable: 5, MaxNumNodes: 11},
+		{EpochEnable: 20, MaxNumNodes: 12},
+	}
+
+	return arg
+}
+
+func TestNewStakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.PublicKeyConverter = nil
+	sqp, err := NewStakingQueueProcessor(arg)
+	assert.True(t, check.IfNil(sqp))
+	assert.Equal(t, ErrNilPubkeyConverter, err)
+
+	sqp, err = NewStakingQueueProcessor(createMockArgs())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sqp))
+}
+
+func TestStakingQueueProcessor_GetStakingQueueNodeNotInitializedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sqp, _ := NewStakingQueueProcessor(createMockArgs())
+
+	queue, err := sqp.GetStakingQueue()
+	assert.Nil(t, queue)
+	assert.Equal(t, ErrNodeNotInitialized, err)
+}
+
+func TestStakingQueueProcessor_GetStakingQueueEmptyQueue(t *testing.T) {
+	t.Parallel()
+
+	arg := createStakingQueueProcessorArgs(1, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, "getQueueSize", query.FuncName)
+			return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("0")}}, nil
+		},
+	})
+	sqp, _ := NewStakingQueueProcessor(arg)
+
+	queue, err := sqp.GetStakingQueue()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(queue))
+}
+
+func TestStakingQueueProcessor_GetStakingQueueGetOwnerFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createStakingQueueProcessorArgs(1, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			switch query.FuncName {
+			case "getQueueSize":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("1")}}, nil
+			case "getQueueRegisterNonceAndRewardAddress":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("bls"), []byte("reward"), {1}}}, nil
+			}
+
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+		},
+	})
+	sqp, _ := NewStakingQueueProcessor(arg)
+
+	queue, err := sqp.GetStakingQueue()
+	assert.Nil(t, queue)
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+}
+
+func TestStakingQueueProcessor_GetStakingQueueShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blsKeys := [][]byte{[]byte("bls1"), []byte("bls2"), []byte("bls3")}
+	owners := [][]byte{[]byte("owner1"), []byte("owner2"), []byte("owner3")}
+	arg := createStakingQueueProcessorArgs(6, &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			require.Equal(t, vm.StakingSCAddress, query.ScAddress)
+			require.Equal(t, vm.ValidatorSCAddress, query.CallerAddr)
+
+			switch query.FuncName {
+			case "getQueueSize":
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte("3")}}, nil
+			case "getQueueRegisterNonceAndRewardAddress":
+				returnData := make([][]byte, 0)
+				for i, blsKey := range blsKeys {
+					returnData = append(returnData, blsKey, []byte("reward"), big.NewInt(int64(100*(i+1))).Bytes())
+				}
+				return &vmcommon.VMOutput{ReturnData: returnData}, nil
+			case "getOwner":
+				for i, blsKey := range blsKeys {
+					if bytes.Equal(blsKey, query.Arguments[0]) {
+						return &vmcommon.VMOutput{ReturnData: [][]byte{owners[i]}}, nil
+					}
+				}
+			}
+
+			return nil, fmt.Errorf("not an expected call")
+		},
+	})
+	sqp, _ := NewStakingQueueProcessor(arg)
+
+	queue, err := sqp.GetStakingQueue()
+	require.Nil(t, err)
+
+	expectedQueue := []*api.StakingQueueEntry{
+		{
+			BLSKey:                  hex.EncodeToString(blsKeys[0]),
+			Owner:                   hex.EncodeToString(owners[0]),
+			RewardAddress:           hex.EncodeToString([]byte("reward")),
+			Index:                   1,
+			RegisterNonce:           100,
+			ExpectedActivationEpoch: 10,
+		},
+		{
+			BLSKey:        hex.EncodeToString(blsKeys[1]),
+			Owner:         hex.EncodeToString(owners[1]),
+			RewardAddress: hex.EncodeToString([]byte("reward")),
+			Index:         2,
+			RegisterNonce: 200,
+		},
+		{
+			BLSKey:        hex.EncodeToString(blsKeys[2]),
+			Owner:         hex.EncodeToString(owners[2]),
+			RewardAddress: hex.EncodeToString([]byte("reward")),
+			Index:         3,
+			RegisterNonce: 300,
+		},
+	}
+	assert.Equal(t, expectedQueue, queue)
+}
+
+func TestStakingQueueProcessor_ComputeActivationEpochs(t *testing.T) {
+	t.Parallel()
+
+	sqp, _ := NewStakingQueueProcessor(createStakingQueueProcessorArgs(0, &mock.SCQueryServiceStub{}))
+
+	assert.Equal(t, []uint32{5, 10, 0}, sqp.computeActivationEpochs(0, 3))
+	assert.Equal(t, []uint32{5, 10}, sqp.computeActivationEpochs(4, 2))
+	assert.Equal(t, []uint32{10, 0}, sqp.computeActivationEpochs(5, 2))
+	assert.Equal(t, []uint32{0}, sqp.computeActivationEpochs(10, 1))
+	assert.Equal(t, 0, len(sqp.computeActivationEpochs(1, 0)))
+}
@@ -71,12 +71,14 @@ type ArgBaseProcessor struct {
 	EpochNotifier       process.EpochNotifier
 	VMContainersFactory process.VirtualMachinesContainerFactory
 	VmContainer         process.VirtualMachinesContainer
+	ProcessingReporter  process.BlockProcessingReporter
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
 // new instances of shard processor
 type ArgShardProcessor struct {
 	ArgBaseProcessor
+	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 }
 
 // ArgMetaProcessor holds all dependencies required by the process data factory in order to create
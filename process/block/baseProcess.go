@@ -82,6 +82,7 @@ type baseProcessor struct {
 	epochNotifier      process.EpochNotifier
 	vmContainerFactory process.VirtualMachinesContainerFactory
 	vmContainer        process.VirtualMachinesContainer
+	processingReporter process.BlockProcessingReporter
 
 	processDataTriesOnCommitEpoch bool
 }
@@ -436,6 +437,9 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.CoreComponents.StatusHandler()) {
 		return process.ErrNilAppStatusHandler
 	}
+	if check.IfNil(arguments.ProcessingReporter) {
+		return process.ErrNilBlockProcessingReporter
+	}
 
 	return nil
 }
@@ -535,6 +539,7 @@ func (bp *baseProcessor) createMiniBlockHeaders(body *block.Body) (int, []block.
 			ReceiverShardID: body.MiniBlocks[i].ReceiverShardID,
 			TxCount:         uint32(txCount),
 			Type:            body.MiniBlocks[i].Type,
+			Reserved:        body.MiniBlocks[i].Reserved,
 		}
 	}
 
@@ -579,6 +584,10 @@ func (bp *baseProcessor) checkHeaderBodyCorrelation(miniBlockHeaders []block.Min
 		if mbHdr.SenderShardID != miniBlock.SenderShardID {
 			return process.ErrHeaderBodyMismatch
 		}
+
+		if !bytes.Equal(mbHdr.Reserved, miniBlock.Reserved) {
+			return process.ErrHeaderBodyMismatch
+		}
 	}
 
 	return nil
@@ -1143,14 +1152,17 @@ func (bp *baseProcessor) PruneStateOnRollback(currHeader data.HeaderHandler, pre
 		}
 
 		rootHash, prevRootHash := bp.getRootHashes(currHeader, prevHeader, key)
+		bp.pruneStateTransitionOnRollback(bp.accountsDB[key], prevRootHash, rootHash)
+	}
+}
 
-		if bytes.Equal(rootHash, prevRootHash) {
-			continue
-		}
-
-		bp.accountsDB[key].CancelPrune(prevRootHash, data.OldRoot)
-		bp.accountsDB[key].PruneTrie(rootHash, data.NewRoot)
+func (bp *baseProcessor) pruneStateTransitionOnRollback(accounts state.AccountsAdapter, prevRootHash []byte, rootHash []byte) {
+	if bytes.Equal(rootHash, prevRootHash) {
+		return
 	}
+
+	accounts.CancelPrune(prevRootHash, data.OldRoot)
+	accounts.PruneTrie(rootHash, data.NewRoot)
 }
 
 func (bp *baseProcessor) getRootHashes(currHeader data.HeaderHandler, prevHeader data.HeaderHandler, identifier state.AccountsDbIdentifier) ([]byte, []byte) {
@@ -1248,8 +1260,9 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
+	logs := bp.txCoordinator.GetAllCurrentLogs()
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, logs)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
@@ -1375,15 +1388,19 @@ func unmarshalUserAccount(address []byte, userAccountsBytes []byte, marshalizer
 
 // Close - closes all underlying components
 func (bp *baseProcessor) Close() error {
-	var err1, err2 error
+	var err1, err2, err3 error
 	if !check.IfNil(bp.vmContainer) {
 		err1 = bp.vmContainer.Close()
 	}
 	if !check.IfNil(bp.vmContainerFactory) {
 		err2 = bp.vmContainerFactory.Close()
 	}
-	if err1 != nil || err2 != nil {
-		return fmt.Errorf("vmContainer close error: %v, vmContainerFactory close error: %v", err1, err2)
+	if !check.IfNil(bp.processingReporter) {
+		err3 = bp.processingReporter.Close()
+	}
+	if err1 != nil || err2 != nil || err3 != nil {
+		return fmt.Errorf("vmContainer close error: %v, vmContainerFactory close error: %v, processingReporter close error: %v",
+			err1, err2, err3)
 	}
 
 	return nil
@@ -360,7 +360,9 @@ func CreateMockArguments(
 			Version:            "softwareVersion",
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
+			ProcessingReporter: &mock.BlockProcessingReporterStub{},
 		},
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
 	}
 
 	return arguments
@@ -388,6 +390,7 @@ func createMockTransactionCoordinatorArguments(
 		TxTypeHandler:                     &testscommon.TxTypeHandlerMock{},
 		BlockGasAndFeesReCheckEnableEpoch: 0,
 		TransactionsLogProcessor:          &mock.TxLogsProcessorStub{},
+		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 	}
 
 	return argsTransactionCoordinator
@@ -128,7 +128,9 @@ func NewShardProcessorEmptyWith3shards(
 			Version:            "softwareVersion",
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
+			ProcessingReporter: &mock.BlockProcessingReporterStub{},
 		},
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
 	}
 	shardProc, err := NewShardProcessor(arguments)
 	return shardProc, err
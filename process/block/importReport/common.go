@@ -0,0 +1,45 @@
+package importReport
+
+// BlockReport is the report record written for each processed block. The validator statistics root hashes are
+// reported only for the metachain blocks
+type BlockReport struct {
+	Nonce                          uint64           `json:"nonce"`
+	Round                          uint64           `json:"round"`
+	Epoch                          uint32           `json:"epoch"`
+	ShardID                        uint32           `json:"shardID"`
+	ExpectedRootHash               string           `json:"expectedRootHash"`
+	ComputedRootHash               string           `json:"computedRootHash"`
+	ExpectedReceiptsHash           string           `json:"expectedReceiptsHash"`
+	ComputedReceiptsHash           string           `json:"computedReceiptsHash"`
+	GasConsumed                    uint64           `json:"gasConsumed"`
+	GasRefunded                    uint64           `json:"gasRefunded"`
+	ExpectedAccumulatedFees        string           `json:"expectedAccumulatedFees"`
+	ComputedAccumulatedFees        string           `json:"computedAccumulatedFees"`
+	ExpectedDeveloperFees          string           `json:"expectedDeveloperFees"`
+	ComputedDeveloperFees          string           `json:"computedDeveloperFees"`
+	ExpectedValidatorStatsRootHash string           `json:"expectedValidatorStatsRootHash,omitempty"`
+	ComputedValidatorStatsRootHash string           `json:"computedValidatorStatsRootHash,omitempty"`
+	Mismatches                     []string         `json:"mismatches,omitempty"`
+	Error                          string           `json:"error,omitempty"`
+	TouchedAccounts                []*AccountReport `json:"touchedAccounts,omitempty"`
+}
+
+// AccountReport holds the state of an account touched by the transactions of a reported block, as computed
+// after the block processing
+type AccountReport struct {
+	Address         string `json:"address"`
+	Nonce           uint64 `json:"nonce"`
+	Balance         string `json:"balance"`
+	DeveloperReward string `json:"developerReward"`
+	RootHash        string `json:"rootHash,omitempty"`
+	CodeHash        string `json:"codeHash,omitempty"`
+	OwnerAddress    string `json:"ownerAddress,omitempty"`
+}
+
+const (
+	mismatchRootHash               = "rootHash"
+	mismatchReceiptsHash           = "receiptsHash"
+	mismatchAccumulatedFees        = "accumulatedFees"
+	mismatchDeveloperFees          = "developerFees"
+	mismatchValidatorStatsRootHash = "validatorStatsRootHash"
+)
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+type processingReporter struct {
+}
+
+// NewProcessingReporter returns a block processing reporter that does nothing
+func NewProcessingReporter() *processingReporter {
+	return &processingReporter{}
+}
+
+// ReportProcessedBlock does nothing
+func (pr *processingReporter) ReportProcessedBlock(_ data.HeaderHandler, _ error) {
+}
+
+// Close returns nil
+func (pr *processingReporter) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *processingReporter) IsInterfaceNil() bool {
+	return pr == nil
+}
@@ -0,0 +1,12 @@
+package importReport
+
+import "errors"
+
+// ErrEmptyReportFilePath signals that an empty report file path has been provided
+var ErrEmptyReportFilePath = errors.New("empty report file path")
+
+// ErrInvalidBisectInterval signals that the provided bisect nonces do not define a valid interval
+var ErrInvalidBisectInterval = errors.New("invalid bisect interval")
+
+// ErrNilChanStopNodeProcess signals that a nil stop node process channel has been provided
+var ErrNilChanStopNodeProcess = errors.New("nil stop node process channel")
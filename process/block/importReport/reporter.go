@@ -0,0 +1,368 @@
+package importReport
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/block/importReport")
+
+// mismatchErrors are the block processing errors caused by a computed value different from the one in the header
+var mismatchErrors = []error{
+	process.ErrRootStateDoesNotMatch,
+	process.ErrReceiptsHashMissmatch,
+	process.ErrAccumulatedFeesDoNotMatch,
+	process.ErrDeveloperFeesDoNotMatch,
+	process.ErrValidatorStatsRootHashDoesNotMatch,
+}
+
+// ArgsReporter holds the arguments needed to create a block processing reporter
+type ArgsReporter struct {
+	ReportFilePath      string
+	BisectStartNonce    uint64
+	BisectEndNonce      uint64
+	Accounts            state.AccountsAdapter
+	PeerAccounts        state.AccountsAdapter
+	TxCoordinator       process.TransactionCoordinator
+	GasHandler          process.GasHandler
+	FeeHandler          process.TransactionFeeHandler
+	PubkeyConverter     core.PubkeyConverter
+	ChanStopNodeProcess chan endProcess.ArgEndProcess
+}
+
+type reporter struct {
+	mutReport           sync.Mutex
+	file                *os.File
+	bisectStartNonce    uint64
+	bisectEndNonce      uint64
+	accounts            state.AccountsAdapter
+	peerAccounts        state.AccountsAdapter
+	txCoordinator       process.TransactionCoordinator
+	gasHandler          process.GasHandler
+	feeHandler          process.TransactionFeeHandler
+	pubkeyConverter     core.PubkeyConverter
+	chanStopNodeProcess chan endProcess.ArgEndProcess
+	stopped             bool
+	closed              bool
+}
+
+// NewReporter creates a reporter that appends one JSON line for each block processed in import-db mode. On the
+// first block that does not match its header, the report also contains the accounts touched by the block and the
+// node is stopped. When a bisect interval is provided, only the blocks from that interval are reported, all of them
+// with the touched accounts, and the node is stopped after the last block of the interval
+func NewReporter(args ArgsReporter) (*reporter, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(args.ReportFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, core.FileModeUserReadWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("import-db block processing report enabled",
+		"file", args.ReportFilePath,
+		"bisect start nonce", args.BisectStartNonce,
+		"bisect end nonce", args.BisectEndNonce,
+	)
+
+	return &reporter{
+		file:                file,
+		bisectStartNonce:    args.BisectStartNonce,
+		bisectEndNonce:      args.BisectEndNonce,
+		accounts:            args.Accounts,
+		peerAccounts:        args.PeerAccounts,
+		txCoordinator:       args.TxCoordinator,
+		gasHandler:          args.GasHandler,
+		feeHandler:          args.FeeHandler,
+		pubkeyConverter:     args.PubkeyConverter,
+		chanStopNodeProcess: args.ChanStopNodeProcess,
+	}, nil
+}
+
+func checkArgs(args ArgsReporter) error {
+	if len(args.ReportFilePath) == 0 {
+		return ErrEmptyReportFilePath
+	}
+	if args.BisectEndNonce > 0 && args.BisectEndNonce < args.BisectStartNonce {
+		return fmt.Errorf("%w: start nonce %d, end nonce %d", ErrInvalidBisectInterval, args.BisectStartNonce, args.BisectEndNonce)
+	}
+	if check.IfNil(args.Accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.PeerAccounts) {
+		return process.ErrNilPeerAccountsAdapter
+	}
+	if check.IfNil(args.TxCoordinator) {
+		return process.ErrNilTransactionCoordinator
+	}
+	if check.IfNil(args.GasHandler) {
+		return process.ErrNilGasHandler
+	}
+	if check.IfNil(args.FeeHandler) {
+		return process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return process.ErrNilPubkeyConverter
+	}
+	if args.ChanStopNodeProcess == nil {
+		return ErrNilChanStopNodeProcess
+	}
+
+	return nil
+}
+
+// ReportProcessedBlock writes the report of the provided block. It has to be called before the accounts state
+// is reverted, as the computed values are read from the components used in processing
+func (r *reporter) ReportProcessedBlock(header data.HeaderHandler, processingErr error) {
+	if check.IfNil(header) {
+		return
+	}
+
+	isMismatch := isMismatchError(processingErr)
+	if processingErr != nil && !isMismatch {
+		// the block was not fully processed, it will be retried
+		return
+	}
+
+	r.mutReport.Lock()
+	defer r.mutReport.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	isInBisectInterval := r.isBisectEnabled() && header.GetNonce() >= r.bisectStartNonce
+	if r.isBisectEnabled() && !isInBisectInterval && !isMismatch {
+		return
+	}
+
+	report := r.createBlockReport(header, processingErr)
+	if isMismatch || isInBisectInterval {
+		report.TouchedAccounts = r.createTouchedAccountsReport()
+	}
+
+	r.writeReport(report)
+
+	if isMismatch {
+		log.Error("import-db: block does not match its header, stopping the node",
+			"nonce", header.GetNonce(),
+			"round", header.GetRound(),
+			"mismatches", report.Mismatches,
+			"error", processingErr.Error(),
+		)
+		r.stop(fmt.Sprintf("block with nonce %d does not match its header: %s", header.GetNonce(), processingErr.Error()))
+		return
+	}
+
+	if isInBisectInterval && header.GetNonce() >= r.bisectEndNonce {
+		log.Info("import-db: bisect interval processed, stopping the node", "last nonce", header.GetNonce())
+		r.stop(fmt.Sprintf("bisect interval [%d, %d] processed", r.bisectStartNonce, r.bisectEndNonce))
+	}
+}
+
+func (r *reporter) isBisectEnabled() bool {
+	return r.bisectEndNonce > 0
+}
+
+func (r *reporter) createBlockReport(header data.HeaderHandler, processingErr error) *BlockReport {
+	computedRootHash, err := r.accounts.RootHash()
+	if err != nil {
+		log.Debug("import-db report: accounts root hash", "error", err.Error())
+	}
+	computedReceiptsHash, err := r.txCoordinator.CreateReceiptsHash()
+	if err != nil {
+		log.Debug("import-db report: receipts hash", "error", err.Error())
+	}
+	computedAccumulatedFees := r.feeHandler.GetAccumulatedFees()
+	computedDeveloperFees := r.feeHandler.GetDeveloperFees()
+
+	report := &BlockReport{
+		Nonce:                   header.GetNonce(),
+		Round:                   header.GetRound(),
+		Epoch:                   header.GetEpoch(),
+		ShardID:                 header.GetShardID(),
+		ExpectedRootHash:        hex.EncodeToString(header.GetRootHash()),
+		ComputedRootHash:        hex.EncodeToString(computedRootHash),
+		ExpectedReceiptsHash:    hex.EncodeToString(header.GetReceiptsHash()),
+		ComputedReceiptsHash:    hex.EncodeToString(computedReceiptsHash),
+		GasConsumed:             r.gasHandler.TotalGasConsumed(),
+		GasRefunded:             r.gasHandler.TotalGasRefunded(),
+		ExpectedAccumulatedFees: bigIntToString(header.GetAccumulatedFees()),
+		ComputedAccumulatedFees: bigIntToString(computedAccumulatedFees),
+		ExpectedDeveloperFees:   bigIntToString(header.GetDeveloperFees()),
+		ComputedDeveloperFees:   bigIntToString(computedDeveloperFees),
+		Mismatches:              make([]string, 0),
+	}
+	if report.ExpectedRootHash != report.ComputedRootHash {
+		report.Mismatches = append(report.Mismatches, mismatchRootHash)
+	}
+	if report.ExpectedReceiptsHash != report.ComputedReceiptsHash {
+		report.Mismatches = append(report.Mismatches, mismatchReceiptsHash)
+	}
+	if report.ExpectedAccumulatedFees != report.ComputedAccumulatedFees {
+		report.Mismatches = append(report.Mismatches, mismatchAccumulatedFees)
+	}
+	if report.ExpectedDeveloperFees != report.ComputedDeveloperFees {
+		report.Mismatches = append(report.Mismatches, mismatchDeveloperFees)
+	}
+	if header.GetShardID() == core.MetachainShardId {
+		r.addValidatorStatsRootHashes(report, header)
+	}
+	if processingErr != nil {
+		report.Error = processingErr.Error()
+	}
+
+	return report
+}
+
+func (r *reporter) addValidatorStatsRootHashes(report *BlockReport, header data.HeaderHandler) {
+	computedValidatorStatsRootHash, err := r.peerAccounts.RootHash()
+	if err != nil {
+		log.Debug("import-db report: validator statistics root hash", "error", err.Error())
+	}
+
+	report.ExpectedValidatorStatsRootHash = hex.EncodeToString(header.GetValidatorStatsRootHash())
+	report.ComputedValidatorStatsRootHash = hex.EncodeToString(computedValidatorStatsRootHash)
+	if report.ExpectedValidatorStatsRootHash != report.ComputedValidatorStatsRootHash {
+		report.Mismatches = append(report.Mismatches, mismatchValidatorStatsRootHash)
+	}
+}
+
+func (r *reporter) createTouchedAccountsReport() []*AccountReport {
+	addresses := make(map[string]struct{})
+	blockTypes := []block.Type{block.TxBlock, block.SmartContractResultBlock, block.RewardsBlock, block.InvalidBlock}
+	for _, blockType := range blockTypes {
+		for _, tx := range r.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			addresses[string(tx.GetSndAddr())] = struct{}{}
+			addresses[string(tx.GetRcvAddr())] = struct{}{}
+		}
+	}
+
+	sortedAddresses := make([]string, 0, len(addresses))
+	for address := range addresses {
+		if len(address) > 0 {
+			sortedAddresses = append(sortedAddresses, address)
+		}
+	}
+	sort.Strings(sortedAddresses)
+
+	accountsReport := make([]*AccountReport, 0, len(sortedAddresses))
+	for _, address := range sortedAddresses {
+		account, err := r.accounts.GetExistingAccount([]byte(address))
+		if err != nil {
+			// not an account from this shard
+			continue
+		}
+
+		accountReport := &AccountReport{
+			Address: r.pubkeyConverter.Encode([]byte(address)),
+			Nonce:   account.GetNonce(),
+		}
+		userAccount, ok := account.(state.UserAccountHandler)
+		if ok {
+			accountReport.Balance = bigIntToString(userAccount.GetBalance())
+			accountReport.DeveloperReward = bigIntToString(userAccount.GetDeveloperReward())
+			accountReport.RootHash = hex.EncodeToString(userAccount.GetRootHash())
+			accountReport.CodeHash = hex.EncodeToString(userAccount.GetCodeHash())
+			if len(userAccount.GetOwnerAddress()) > 0 {
+				accountReport.OwnerAddress = r.pubkeyConverter.Encode(userAccount.GetOwnerAddress())
+			}
+		}
+
+		accountsReport = append(accountsReport, accountReport)
+	}
+
+	return accountsReport
+}
+
+func (r *reporter) writeReport(report *BlockReport) {
+	buff, err := json.Marshal(report)
+	if err != nil {
+		log.Warn("import-db report: marshal", "nonce", report.Nonce, "error", err.Error())
+		return
+	}
+
+	buff = append(buff, '\n')
+	_, err = r.file.Write(buff)
+	if err != nil {
+		log.Warn("import-db report: write", "nonce", report.Nonce, "error", err.Error())
+	}
+}
+
+func (r *reporter) stop(description string) {
+	r.stopped = true
+
+	err := r.file.Sync()
+	if err != nil {
+		log.Warn("import-db report: sync", "error", err.Error())
+	}
+
+	argEndProcess := endProcess.ArgEndProcess{
+		Reason:      core.ImportComplete,
+		Description: description,
+	}
+
+	select {
+	case r.chanStopNodeProcess <- argEndProcess:
+	default:
+		log.Debug("import-db report: could not write on the end process channel")
+	}
+}
+
+// Close closes the report file, the blocks processed afterwards are no longer reported
+func (r *reporter) Close() error {
+	r.mutReport.Lock()
+	defer r.mutReport.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.stopped = true
+
+	return r.file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *reporter) IsInterfaceNil() bool {
+	return r == nil
+}
+
+func isMismatchError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, mismatchErr := range mismatchErrors {
+		if errors.Is(err, mismatchErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,259 @@
+package importReport
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs(t *testing.T) ArgsReporter {
+	sender := []byte("sender-address-in-self-shard----")
+	receiver := []byte("receiver-address-in-other-shard-")
+
+	dir, err := ioutil.TempDir("", "import_report")
+	require.Nil(t, err)
+
+	return ArgsReporter{
+		ReportFilePath: filepath.Join(dir, "report.jsonl"),
+		Accounts: &testscommon.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("computed root hash"), nil
+			},
+			GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+				if string(address) != string(sender) {
+					return nil, errors.New("account not found")
+				}
+
+				account, _ := state.NewUserAccount(address)
+				account.Nonce = 3
+				_ = account.AddToBalance(big.NewInt(100))
+				return account, nil
+			},
+		},
+		PeerAccounts: &testscommon.AccountsStub{
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("computed validator stats root hash"), nil
+			},
+		},
+		TxCoordinator: &mock.TransactionCoordinatorMock{
+			GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+				if blockType != block.TxBlock {
+					return make(map[string]data.TransactionHandler)
+				}
+
+				return map[string]data.TransactionHandler{
+					"txHash": &transaction.Transaction{SndAddr: sender, RcvAddr: receiver},
+				}
+			},
+		},
+		GasHandler: &mock.GasHandlerMock{
+			TotalGasConsumedCalled: func() uint64 {
+				return 1000
+			},
+			TotalGasRefundedCalled: func() uint64 {
+				return 10
+			},
+		},
+		FeeHandler: &mock.FeeAccumulatorStub{
+			GetAccumulatedFeesCalled: func() *big.Int {
+				return big.NewInt(7)
+			},
+		},
+		PubkeyConverter:     mock.NewPubkeyConverterMock(32),
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+	}
+}
+
+func readReports(t *testing.T, path string) []*BlockReport {
+	file, err := os.Open(path)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	reports := make([]*BlockReport, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		report := &BlockReport{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), report))
+		reports = append(reports, report)
+	}
+
+	return reports
+}
+
+func createHeader(nonce uint64) *block.Header {
+	return &block.Header{
+		Nonce:           nonce,
+		Round:           nonce + 1,
+		RootHash:        []byte("computed root hash"),
+		ReceiptsHash:    []byte("receiptHash"),
+		AccumulatedFees: big.NewInt(7),
+		DeveloperFees:   big.NewInt(0),
+	}
+}
+
+func TestNewReporter(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.ReportFilePath = ""
+	r, err := NewReporter(args)
+	assert.Nil(t, r)
+	assert.Equal(t, ErrEmptyReportFilePath, err)
+
+	args = createMockArgs(t)
+	args.BisectStartNonce = 10
+	args.BisectEndNonce = 5
+	r, err = NewReporter(args)
+	assert.Nil(t, r)
+	assert.True(t, errors.Is(err, ErrInvalidBisectInterval))
+
+	args = createMockArgs(t)
+	args.PeerAccounts = nil
+	r, err = NewReporter(args)
+	assert.Nil(t, r)
+	assert.Equal(t, process.ErrNilPeerAccountsAdapter, err)
+
+	args = createMockArgs(t)
+	args.TxCoordinator = nil
+	r, err = NewReporter(args)
+	assert.Nil(t, r)
+	assert.Equal(t, process.ErrNilTransactionCoordinator, err)
+
+	args = createMockArgs(t)
+	args.ChanStopNodeProcess = nil
+	r, err = NewReporter(args)
+	assert.Nil(t, r)
+	assert.Equal(t, ErrNilChanStopNodeProcess, err)
+
+	r, err = NewReporter(createMockArgs(t))
+	assert.Nil(t, err)
+	assert.False(t, r.IsInterfaceNil())
+	_ = r.Close()
+}
+
+func TestReporter_ReportProcessedBlockShouldWriteMatchingBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	r, _ := NewReporter(args)
+
+	r.ReportProcessedBlock(createHeader(1), nil)
+	r.ReportProcessedBlock(createHeader(2), process.ErrTimeIsOut)
+	_ = r.Close()
+
+	reports := readReports(t, args.ReportFilePath)
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, uint64(1), reports[0].Nonce)
+	assert.Equal(t, uint64(1000), reports[0].GasConsumed)
+	assert.Equal(t, uint64(10), reports[0].GasRefunded)
+	assert.Equal(t, "7", reports[0].ComputedAccumulatedFees)
+	assert.Equal(t, 0, len(reports[0].Mismatches))
+	assert.Equal(t, 0, len(reports[0].TouchedAccounts))
+	assert.Equal(t, 0, len(args.ChanStopNodeProcess))
+}
+
+func TestReporter_ReportProcessedBlockShouldDumpAccountsAndStopOnMismatch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	r, _ := NewReporter(args)
+
+	header := createHeader(5)
+	header.RootHash = []byte("expected root hash")
+	r.ReportProcessedBlock(header, process.ErrRootStateDoesNotMatch)
+	r.ReportProcessedBlock(header, process.ErrRootStateDoesNotMatch)
+	_ = r.Close()
+
+	reports := readReports(t, args.ReportFilePath)
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, []string{mismatchRootHash}, reports[0].Mismatches)
+	assert.Equal(t, process.ErrRootStateDoesNotMatch.Error(), reports[0].Error)
+	require.Equal(t, 1, len(reports[0].TouchedAccounts))
+	assert.Equal(t, uint64(3), reports[0].TouchedAccounts[0].Nonce)
+	assert.Equal(t, "100", reports[0].TouchedAccounts[0].Balance)
+
+	require.Equal(t, 1, len(args.ChanStopNodeProcess))
+}
+
+func TestReporter_ReportProcessedBlockBisectModeShouldReportOnlyTheInterval(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	args.BisectStartNonce = 2
+	args.BisectEndNonce = 3
+	r, _ := NewReporter(args)
+
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		r.ReportProcessedBlock(createHeader(nonce), nil)
+	}
+	_ = r.Close()
+
+	reports := readReports(t, args.ReportFilePath)
+	require.Equal(t, 2, len(reports))
+	assert.Equal(t, uint64(2), reports[0].Nonce)
+	assert.Equal(t, uint64(3), reports[1].Nonce)
+	assert.Equal(t, 1, len(reports[0].TouchedAccounts))
+	assert.Equal(t, 1, len(args.ChanStopNodeProcess))
+}
+
+func TestReporter_ReportProcessedBlockShouldReportTheValidatorStatsRootHashOfMetaBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	r, _ := NewReporter(args)
+
+	metaBlock := &block.MetaBlock{
+		Nonce:                  5,
+		RootHash:               []byte("computed root hash"),
+		ValidatorStatsRootHash: []byte("expected validator stats root hash"),
+		AccumulatedFees:        big.NewInt(7),
+		DeveloperFees:          big.NewInt(0),
+	}
+	r.ReportProcessedBlock(createHeader(4), nil)
+	r.ReportProcessedBlock(metaBlock, process.ErrValidatorStatsRootHashDoesNotMatch)
+	_ = r.Close()
+
+	reports := readReports(t, args.ReportFilePath)
+	require.Equal(t, 2, len(reports))
+	assert.Equal(t, "", reports[0].ComputedValidatorStatsRootHash)
+	assert.Equal(t, hex.EncodeToString([]byte("expected validator stats root hash")), reports[1].ExpectedValidatorStatsRootHash)
+	assert.Equal(t, hex.EncodeToString([]byte("computed validator stats root hash")), reports[1].ComputedValidatorStatsRootHash)
+	assert.Contains(t, reports[1].Mismatches, mismatchValidatorStatsRootHash)
+	require.Equal(t, 1, len(args.ChanStopNodeProcess))
+}
+
+func TestReporter_CloseShouldStopReporting(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	r, _ := NewReporter(args)
+
+	r.ReportProcessedBlock(createHeader(1), nil)
+	assert.Nil(t, r.Close())
+	assert.Nil(t, r.Close())
+	r.ReportProcessedBlock(createHeader(2), nil)
+
+	reports := readReports(t, args.ReportFilePath)
+	require.Equal(t, 1, len(reports))
+	assert.Equal(t, uint64(1), reports[0].Nonce)
+}
@@ -113,6 +113,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		epochNotifier:                 arguments.EpochNotifier,
 		vmContainerFactory:            arguments.VMContainersFactory,
 		vmContainer:                   arguments.VmContainer,
+		processingReporter:            arguments.ProcessingReporter,
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 	}
 
@@ -247,7 +248,13 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	// registered after the revert so the reporter sees the computed state
+	reportProcessedBlock := func() {
+		mp.processingReporter.ReportProcessedBlock(header, err)
+	}
+
 	if header.IsStartOfEpochBlock() {
+		defer reportProcessedBlock()
 		err = mp.processEpochStartMetaBlock(header, body)
 		return err
 	}
@@ -320,6 +327,7 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	defer reportProcessedBlock()
 	err = mp.txCoordinator.ProcessBlockTransaction(body, haveTime)
 	if err != nil {
 		return err
@@ -404,6 +412,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		}
 	}
 
+	err = mp.verifyGovernanceParamChanges(header)
+	if err != nil {
+		return err
+	}
+
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(body.MiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
 		return err
@@ -767,6 +780,29 @@ func (mp *metaProcessor) processIfFirstBlockAfterEpochStart() error {
 	return nil
 }
 
+// verifyGovernanceParamChanges checks that the parameter changes carried by the epoch start block are the ones accepted
+// through the governance system SC, as all the shards apply them at the start of the epoch
+func (mp *metaProcessor) verifyGovernanceParamChanges(header *block.MetaBlock) error {
+	paramChanges, err := mp.epochSystemSCProcessor.ProcessGovernanceParamChanges(header.Epoch)
+	if err != nil {
+		return err
+	}
+
+	receivedParamChangesHash, err := core.CalculateHash(mp.marshalizer, mp.hasher, &block.EpochStart{ParamChanges: header.EpochStart.ParamChanges})
+	if err != nil {
+		return err
+	}
+	computedParamChangesHash, err := core.CalculateHash(mp.marshalizer, mp.hasher, &block.EpochStart{ParamChanges: paramChanges})
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(receivedParamChangesHash, computedParamChangesHash) {
+		return process.ErrGovernanceParamChangesMismatch
+	}
+
+	return nil
+}
+
 func (mp *metaProcessor) updateEpochStartHeader(metaHdr *block.MetaBlock) error {
 	sw := core.NewStopWatch()
 	sw.Start("createEpochStartForMetablock")
@@ -857,6 +893,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 
 	metaBlock.EpochStart.Economics.RewardsForProtocolSustainability.Set(mp.epochRewardsCreator.GetProtocolSustainabilityRewards())
 
+	metaBlock.EpochStart.ParamChanges, err = mp.epochSystemSCProcessor.ProcessGovernanceParamChanges(metaBlock.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
 	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(rewardMiniBlocks, mp.epochRewardsCreator.GetLocalTxCache())
 	if err != nil {
 		return nil, err
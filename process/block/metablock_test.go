@@ -112,6 +112,7 @@ func createMockMetaArguments(
 			BlockSizeThrottler: &mock.BlockSizeThrottlerStub{},
 			HistoryRepository:  &dblookupext.HistoryRepositoryStub{},
 			EpochNotifier:      &mock.EpochNotifierStub{},
+			ProcessingReporter: &mock.BlockProcessingReporterStub{},
 		},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
 		PendingMiniBlocksHandler:     &mock.PendingMiniBlocksHandlerStub{},
@@ -251,7 +251,7 @@ func (gc *gasComputation) computeGasConsumedByTxV1(
 }
 
 func (gc *gasComputation) isRelayedTx(txType process.TransactionType) bool {
-	return txType == process.RelayedTx || txType == process.RelayedTxV2
+	return txType == process.RelayedTx || txType == process.RelayedTxV2 || txType == process.RelayedTxV3
 }
 
 // EpochConfirmed is called whenever a new epoch is confirmed
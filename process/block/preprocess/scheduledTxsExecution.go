@@ -0,0 +1,617 @@
+package preprocess
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.ScheduledTxsExecutionHandler = (*scheduledTxsExecution)(nil)
+
+type scheduledTx struct {
+	txHash []byte
+	tx     data.TransactionHandler
+}
+
+type scheduledTxLog struct {
+	txHash []byte
+	txLog  *transaction.Log
+}
+
+type scheduledResults struct {
+	scrs       []data.TransactionHandler
+	receipts   []data.TransactionHandler
+	invalidTxs []data.TransactionHandler
+	logs       []*scheduledTxLog
+}
+
+func newEmptyScheduledResults() *scheduledResults {
+	return &scheduledResults{
+		scrs:       make([]data.TransactionHandler, 0),
+		receipts:   make([]data.TransactionHandler, 0),
+		invalidTxs: make([]data.TransactionHandler, 0),
+		logs:       make([]*scheduledTxLog, 0),
+	}
+}
+
+// ArgsScheduledTxsExecution holds the arguments needed to create a scheduled transactions execution handler
+type ArgsScheduledTxsExecution struct {
+	TxProcessor       process.TransactionProcessor
+	InterimProcessors process.IntermediateProcessorContainer
+	TxFeeHandler      process.TransactionFeeHandler
+	GasHandler        process.GasHandler
+	Accounts          state.AccountsAdapter
+	TxLogsProcessor   process.TransactionLogProcessor
+	Storer            storage.Storer
+	Marshalizer       marshal.Marshalizer
+}
+
+type scheduledTxsExecution struct {
+	txProcessor       process.TransactionProcessor
+	interimProcessors process.IntermediateProcessorContainer
+	txFeeHandler      process.TransactionFeeHandler
+	gasHandler        process.GasHandler
+	accounts          state.AccountsAdapter
+	txLogsProcessor   process.TransactionLogProcessor
+	storer            storage.Storer
+	marshalizer       marshal.Marshalizer
+
+	scheduledTxs    []*scheduledTx
+	mapScheduledTxs map[string]struct{}
+
+	headerHash   []byte
+	rootHash     []byte
+	results      *scheduledResults
+	gasAndFees   scheduled.GasAndFees
+	mutScheduled sync.RWMutex
+}
+
+// NewScheduledTxsExecution creates a new object which executes the scheduled transactions after a block was committed
+func NewScheduledTxsExecution(args ArgsScheduledTxsExecution) (*scheduledTxsExecution, error) {
+	if check.IfNil(args.TxProcessor) {
+		return nil, process.ErrNilTxProcessor
+	}
+	if check.IfNil(args.InterimProcessors) {
+		return nil, process.ErrNilIntermediateProcessorContainer
+	}
+	if check.IfNil(args.TxFeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.GasHandler) {
+		return nil, process.ErrNilGasHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.TxLogsProcessor) {
+		return nil, process.ErrNilTxLogsProcessor
+	}
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilScheduledSCRsStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	ste := &scheduledTxsExecution{
+		txProcessor:       args.TxProcessor,
+		interimProcessors: args.InterimProcessors,
+		txFeeHandler:      args.TxFeeHandler,
+		gasHandler:        args.GasHandler,
+		accounts:          args.Accounts,
+		txLogsProcessor:   args.TxLogsProcessor,
+		storer:            args.Storer,
+		marshalizer:       args.Marshalizer,
+		scheduledTxs:      make([]*scheduledTx, 0),
+		mapScheduledTxs:   make(map[string]struct{}),
+		results:           newEmptyScheduledResults(),
+		gasAndFees:        scheduled.NewEmptyGasAndFees(),
+	}
+
+	return ste, nil
+}
+
+// Init method removes all the scheduled transactions
+func (ste *scheduledTxsExecution) Init() {
+	ste.mutScheduled.Lock()
+	ste.scheduledTxs = make([]*scheduledTx, 0)
+	ste.mapScheduledTxs = make(map[string]struct{})
+	ste.mutScheduled.Unlock()
+}
+
+// AddScheduledTx method adds the given transaction to be executed after the current block is committed
+func (ste *scheduledTxsExecution) AddScheduledTx(txHash []byte, tx data.TransactionHandler) bool {
+	ste.mutScheduled.Lock()
+	defer ste.mutScheduled.Unlock()
+
+	_, alreadyExists := ste.mapScheduledTxs[string(txHash)]
+	if alreadyExists {
+		return false
+	}
+
+	ste.mapScheduledTxs[string(txHash)] = struct{}{}
+	ste.scheduledTxs = append(ste.scheduledTxs, &scheduledTx{txHash: txHash, tx: tx})
+
+	return true
+}
+
+// Execute method executes all the scheduled transactions on top of the state of the committed block with the given
+// hash, commits the resulted state and saves the scheduled results, so they could be included in the next block.
+// The scheduled transactions were only checked for nonce, balance and gas when included in the committed block, so a
+// transaction which can not be executed is treated as a failed one: its changes are reverted and the execution
+// continues, as all the nodes reach the same outcome and the committed block must remain valid
+func (ste *scheduledTxsExecution) Execute(headerHash []byte) error {
+	ste.mutScheduled.Lock()
+	defer ste.mutScheduled.Unlock()
+
+	ste.txFeeHandler.CreateBlockStarted()
+	ste.gasHandler.Init()
+	ste.txLogsProcessor.Clean()
+	for _, key := range ste.interimProcessors.Keys() {
+		interimProcessor, err := ste.interimProcessors.Get(key)
+		if err != nil {
+			return err
+		}
+		interimProcessor.CreateBlockStarted()
+	}
+
+	gasProvided := uint64(0)
+	for _, scheduledTxInfo := range ste.scheduledTxs {
+		tx, ok := scheduledTxInfo.tx.(*transaction.Transaction)
+		if !ok {
+			return process.ErrWrongTypeAssertion
+		}
+
+		snapshot := ste.accounts.JournalLen()
+		_, err := ste.txProcessor.ProcessTransaction(tx)
+		if err != nil && !errors.Is(err, process.ErrFailedTransaction) {
+			err = ste.revertScheduledTx(scheduledTxInfo.txHash, snapshot, err)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		gasProvided += tx.GetGasLimit()
+	}
+
+	results, err := ste.getCurrentScheduledResults()
+	if err != nil {
+		return err
+	}
+
+	rootHash, err := ste.accounts.Commit()
+	if err != nil {
+		return err
+	}
+
+	gasAndFees := scheduled.GasAndFees{
+		AccumulatedFees: big.NewInt(0).Set(ste.txFeeHandler.GetAccumulatedFees()),
+		DeveloperFees:   big.NewInt(0).Set(ste.txFeeHandler.GetDeveloperFees()),
+		GasProvided:     gasProvided,
+		GasRefunded:     ste.gasHandler.TotalGasRefunded(),
+	}
+
+	err = ste.saveScheduledResults(headerHash, rootHash, results, gasAndFees)
+	if err != nil {
+		return err
+	}
+
+	ste.setScheduledResults(headerHash, rootHash, results, gasAndFees)
+
+	log.Debug("scheduledTxsExecution.Execute",
+		"header hash", headerHash,
+		"num scheduled txs", len(ste.scheduledTxs),
+		"num scheduled scrs", len(results.scrs),
+		"num scheduled receipts", len(results.receipts),
+		"num scheduled invalid txs", len(results.invalidTxs),
+		"num scheduled logs", len(results.logs),
+		"scheduled root hash", rootHash,
+		"accumulated fees", gasAndFees.AccumulatedFees,
+		"gas provided", gasAndFees.GasProvided,
+		"gas refunded", gasAndFees.GasRefunded,
+	)
+
+	return nil
+}
+
+func (ste *scheduledTxsExecution) revertScheduledTx(txHash []byte, snapshot int, processingErr error) error {
+	log.Debug("scheduledTxsExecution.Execute: scheduled tx could not be executed",
+		"tx hash", txHash,
+		"error", processingErr.Error(),
+	)
+
+	err := ste.accounts.RevertToSnapshot(snapshot)
+	if err != nil {
+		return fmt.Errorf("%w while reverting scheduled tx with hash %s", err, hex.EncodeToString(txHash))
+	}
+
+	txHashes := [][]byte{txHash}
+	ste.gasHandler.RemoveGasConsumed(txHashes)
+	ste.gasHandler.RemoveGasRefunded(txHashes)
+	for _, key := range ste.interimProcessors.Keys() {
+		interimProcessor, errGet := ste.interimProcessors.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+		interimProcessor.RemoveProcessedResultsFor(txHashes)
+	}
+
+	return nil
+}
+
+// ApplyScheduledResults method loads the results of the scheduled transactions executed after the block with the given
+// hash was committed and adds them in the block which is currently created or processed
+func (ste *scheduledTxsExecution) ApplyScheduledResults(headerHash []byte) error {
+	err := ste.RollBackToBlock(headerHash)
+	if err != nil {
+		return err
+	}
+
+	ste.mutScheduled.RLock()
+	defer ste.mutScheduled.RUnlock()
+
+	err = ste.addIntermediateTxs(block.SmartContractResultBlock, ste.results.scrs)
+	if err != nil {
+		return err
+	}
+	err = ste.addIntermediateTxs(block.ReceiptBlock, ste.results.receipts)
+	if err != nil {
+		return err
+	}
+	err = ste.addIntermediateTxs(block.InvalidBlock, ste.results.invalidTxs)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledLog := range ste.results.logs {
+		err = ste.saveScheduledLog(scheduledLog)
+		if err != nil {
+			return err
+		}
+	}
+
+	ste.txFeeHandler.ProcessTransactionFee(ste.gasAndFees.AccumulatedFees, ste.gasAndFees.DeveloperFees, headerHash)
+
+	return nil
+}
+
+func (ste *scheduledTxsExecution) addIntermediateTxs(blockType block.Type, txs []data.TransactionHandler) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	interimProcessor, err := ste.interimProcessors.Get(blockType)
+	if err != nil {
+		return err
+	}
+
+	return interimProcessor.AddIntermediateTransactions(txs)
+}
+
+func (ste *scheduledTxsExecution) saveScheduledLog(scheduledLog *scheduledTxLog) error {
+	logEntries := make([]*vmcommon.LogEntry, 0, len(scheduledLog.txLog.Events))
+	for _, event := range scheduledLog.txLog.Events {
+		logEntries = append(logEntries, &vmcommon.LogEntry{
+			Identifier: event.Identifier,
+			Address:    event.Address,
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	tx := &transaction.Transaction{RcvAddr: scheduledLog.txLog.Address}
+
+	return ste.txLogsProcessor.SaveLog(scheduledLog.txHash, tx, logEntries)
+}
+
+// GetScheduledSCRs returns the smart contract results generated by the last executed scheduled transactions
+func (ste *scheduledTxsExecution) GetScheduledSCRs() []data.TransactionHandler {
+	ste.mutScheduled.RLock()
+	defer ste.mutScheduled.RUnlock()
+
+	scheduledSCRs := make([]data.TransactionHandler, len(ste.results.scrs))
+	copy(scheduledSCRs, ste.results.scrs)
+
+	return scheduledSCRs
+}
+
+// GetScheduledGasAndFees returns the gas and fees accounted by the last executed scheduled transactions
+func (ste *scheduledTxsExecution) GetScheduledGasAndFees() scheduled.GasAndFees {
+	ste.mutScheduled.RLock()
+	defer ste.mutScheduled.RUnlock()
+
+	return scheduled.GasAndFees{
+		AccumulatedFees: big.NewInt(0).Set(ste.gasAndFees.AccumulatedFees),
+		DeveloperFees:   big.NewInt(0).Set(ste.gasAndFees.DeveloperFees),
+		GasProvided:     ste.gasAndFees.GasProvided,
+		GasRefunded:     ste.gasAndFees.GasRefunded,
+	}
+}
+
+// GetScheduledRootHash returns the root hash resulted after the last execution of the scheduled transactions
+func (ste *scheduledTxsExecution) GetScheduledRootHash() []byte {
+	ste.mutScheduled.RLock()
+	defer ste.mutScheduled.RUnlock()
+
+	return ste.rootHash
+}
+
+// GetScheduledRootHashForHeader returns the root hash resulted after the execution of the scheduled transactions
+// included in the block with the given hash
+func (ste *scheduledTxsExecution) GetScheduledRootHashForHeader(headerHash []byte) ([]byte, error) {
+	scheduledSCRs, err := ste.getScheduledResultsFromStorage(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledSCRs.RootHash, nil
+}
+
+// RollBackToBlock method sets the scheduled results to the ones saved for the block with the given hash. If there
+// are no scheduled results saved for the given block, the scheduled results will be cleaned.
+func (ste *scheduledTxsExecution) RollBackToBlock(headerHash []byte) error {
+	ste.mutScheduled.Lock()
+	defer ste.mutScheduled.Unlock()
+
+	if len(ste.headerHash) > 0 && bytes.Equal(ste.headerHash, headerHash) {
+		return nil
+	}
+
+	marshalizedResults, err := ste.getScheduledResultsFromStorage(headerHash)
+	if err != nil {
+		log.Trace("scheduledTxsExecution.RollBackToBlock: no scheduled results",
+			"header hash", headerHash,
+			"error", err.Error(),
+		)
+		ste.setScheduledResults(headerHash, nil, newEmptyScheduledResults(), scheduled.NewEmptyGasAndFees())
+		return nil
+	}
+
+	results, err := ste.unmarshalScheduledResults(marshalizedResults)
+	if err != nil {
+		return err
+	}
+
+	ste.setScheduledResults(headerHash, marshalizedResults.RootHash, results, marshalizedResults.GasAndFees)
+
+	return nil
+}
+
+func (ste *scheduledTxsExecution) getCurrentScheduledResults() (*scheduledResults, error) {
+	scrs, err := ste.getSortedIntermediateTxs(block.SmartContractResultBlock)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := ste.getSortedIntermediateTxs(block.ReceiptBlock)
+	if err != nil {
+		return nil, err
+	}
+	invalidTxs, err := ste.getSortedIntermediateTxs(block.InvalidBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scheduledResults{
+		scrs:       scrs,
+		receipts:   receipts,
+		invalidTxs: invalidTxs,
+		logs:       ste.getSortedLogs(),
+	}, nil
+}
+
+func (ste *scheduledTxsExecution) getSortedIntermediateTxs(blockType block.Type) ([]data.TransactionHandler, error) {
+	interimProcessor, err := ste.interimProcessors.Get(blockType)
+	if err != nil {
+		return nil, err
+	}
+
+	mapTxs := interimProcessor.GetAllCurrentFinishedTxs()
+	txHashes := make([]string, 0, len(mapTxs))
+	for txHash := range mapTxs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	txs := make([]data.TransactionHandler, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txs = append(txs, mapTxs[txHash])
+	}
+
+	return txs, nil
+}
+
+func (ste *scheduledTxsExecution) getSortedLogs() []*scheduledTxLog {
+	mapLogs := ste.txLogsProcessor.GetAllCurrentLogs()
+	txHashes := make([]string, 0, len(mapLogs))
+	for txHash := range mapLogs {
+		txHashes = append(txHashes, txHash)
+	}
+	sort.Strings(txHashes)
+
+	logs := make([]*scheduledTxLog, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		txLog, ok := mapLogs[txHash].(*transaction.Log)
+		if !ok {
+			continue
+		}
+
+		logs = append(logs, &scheduledTxLog{txHash: []byte(txHash), txLog: txLog})
+	}
+
+	return logs
+}
+
+func (ste *scheduledTxsExecution) saveScheduledResults(
+	headerHash []byte,
+	rootHash []byte,
+	results *scheduledResults,
+	gasAndFees scheduled.GasAndFees,
+) error {
+	scrs, err := ste.marshalTxs(results.scrs)
+	if err != nil {
+		return err
+	}
+	receipts, err := ste.marshalTxs(results.receipts)
+	if err != nil {
+		return err
+	}
+	invalidTxs, err := ste.marshalTxs(results.invalidTxs)
+	if err != nil {
+		return err
+	}
+
+	logs := make([]*scheduled.ScheduledLog, 0, len(results.logs))
+	for _, scheduledLog := range results.logs {
+		marshalizedLog, errMarshal := ste.marshalizer.Marshal(scheduledLog.txLog)
+		if errMarshal != nil {
+			return errMarshal
+		}
+
+		logs = append(logs, &scheduled.ScheduledLog{TxHash: scheduledLog.txHash, Log: marshalizedLog})
+	}
+
+	marshalizedResults := &scheduled.ScheduledSCRs{
+		RootHash:   rootHash,
+		Scrs:       scrs,
+		GasAndFees: gasAndFees,
+		Receipts:   receipts,
+		InvalidTxs: invalidTxs,
+		Logs:       logs,
+	}
+
+	buff, err := ste.marshalizer.Marshal(marshalizedResults)
+	if err != nil {
+		return err
+	}
+
+	return ste.storer.Put(headerHash, buff)
+}
+
+func (ste *scheduledTxsExecution) marshalTxs(txs []data.TransactionHandler) ([][]byte, error) {
+	marshalizedTxs := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		marshalizedTx, err := ste.marshalizer.Marshal(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		marshalizedTxs = append(marshalizedTxs, marshalizedTx)
+	}
+
+	return marshalizedTxs, nil
+}
+
+func (ste *scheduledTxsExecution) unmarshalScheduledResults(marshalizedResults *scheduled.ScheduledSCRs) (*scheduledResults, error) {
+	scrs, err := ste.unmarshalTxs(marshalizedResults.Scrs, func() data.TransactionHandler {
+		return &smartContractResult.SmartContractResult{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := ste.unmarshalTxs(marshalizedResults.Receipts, func() data.TransactionHandler {
+		return &receipt.Receipt{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidTxs, err := ste.unmarshalTxs(marshalizedResults.InvalidTxs, func() data.TransactionHandler {
+		return &transaction.Transaction{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*scheduledTxLog, 0, len(marshalizedResults.Logs))
+	for _, marshalizedLog := range marshalizedResults.Logs {
+		txLog := &transaction.Log{}
+		err = ste.marshalizer.Unmarshal(txLog, marshalizedLog.Log)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, &scheduledTxLog{txHash: marshalizedLog.TxHash, txLog: txLog})
+	}
+
+	return &scheduledResults{
+		scrs:       scrs,
+		receipts:   receipts,
+		invalidTxs: invalidTxs,
+		logs:       logs,
+	}, nil
+}
+
+func (ste *scheduledTxsExecution) unmarshalTxs(
+	marshalizedTxs [][]byte,
+	createTx func() data.TransactionHandler,
+) ([]data.TransactionHandler, error) {
+	txs := make([]data.TransactionHandler, 0, len(marshalizedTxs))
+	for _, marshalizedTx := range marshalizedTxs {
+		tx := createTx()
+		err := ste.marshalizer.Unmarshal(tx, marshalizedTx)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (ste *scheduledTxsExecution) getScheduledResultsFromStorage(headerHash []byte) (*scheduled.ScheduledSCRs, error) {
+	marshalizedScheduledResults, err := ste.storer.Get(headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledResults := &scheduled.ScheduledSCRs{}
+	err = ste.marshalizer.Unmarshal(scheduledResults, marshalizedScheduledResults)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledResults, nil
+}
+
+func (ste *scheduledTxsExecution) setScheduledResults(
+	headerHash []byte,
+	rootHash []byte,
+	results *scheduledResults,
+	gasAndFees scheduled.GasAndFees,
+) {
+	if gasAndFees.AccumulatedFees == nil {
+		gasAndFees.AccumulatedFees = big.NewInt(0)
+	}
+	if gasAndFees.DeveloperFees == nil {
+		gasAndFees.DeveloperFees = big.NewInt(0)
+	}
+
+	ste.headerHash = headerHash
+	ste.rootHash = rootHash
+	ste.results = results
+	ste.gasAndFees = gasAndFees
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ste *scheduledTxsExecution) IsInterfaceNil() bool {
+	return ste == nil
+}
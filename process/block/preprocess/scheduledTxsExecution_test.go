@@ -0,0 +1,346 @@
+package preprocess_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/receipt"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScheduledTxsExecution() preprocess.ArgsScheduledTxsExecution {
+	return preprocess.ArgsScheduledTxsExecution{
+		TxProcessor:       &testscommon.TxProcessorMock{},
+		InterimProcessors: &mock.IntermProcessorContainerStub{},
+		TxFeeHandler:      &mock.FeeAccumulatorStub{},
+		GasHandler: &mock.GasHandlerMock{
+			InitCalled: func() {},
+			TotalGasRefundedCalled: func() uint64 {
+				return 0
+			},
+		},
+		Accounts:        &testscommon.AccountsStub{},
+		TxLogsProcessor: &mock.TxLogsProcessorStub{},
+		Storer:          mock.NewStorerMock(),
+		Marshalizer:     &mock.MarshalizerMock{},
+	}
+}
+
+func createInterimProcessorsContainer(
+	interimProcessors map[block.Type]*mock.IntermediateTransactionHandlerMock,
+) *mock.IntermProcessorContainerStub {
+	return &mock.IntermProcessorContainerStub{
+		KeysCalled: func() []block.Type {
+			return []block.Type{block.SmartContractResultBlock, block.ReceiptBlock, block.InvalidBlock}
+		},
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			interimProcessor, ok := interimProcessors[key]
+			if !ok {
+				return &mock.IntermediateTransactionHandlerMock{}, nil
+			}
+			return interimProcessor, nil
+		},
+	}
+}
+
+func TestNewScheduledTxsExecution_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledTxsExecution()
+	args.TxProcessor = nil
+	ste, err := preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilTxProcessor, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.InterimProcessors = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilIntermediateProcessorContainer, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.TxFeeHandler = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.GasHandler = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilGasHandler, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.Accounts = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.TxLogsProcessor = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilTxLogsProcessor, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.Storer = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilScheduledSCRsStorer, err)
+
+	args = createMockArgsScheduledTxsExecution()
+	args.Marshalizer = nil
+	ste, err = preprocess.NewScheduledTxsExecution(args)
+	assert.True(t, check.IfNil(ste))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewScheduledTxsExecution_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ste, err := preprocess.NewScheduledTxsExecution(createMockArgsScheduledTxsExecution())
+	assert.False(t, check.IfNil(ste))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ste.GetScheduledSCRs()))
+	assert.Nil(t, ste.GetScheduledRootHash())
+	assert.Equal(t, big.NewInt(0), ste.GetScheduledGasAndFees().AccumulatedFees)
+}
+
+func TestScheduledTxsExecution_AddScheduledTxShouldNotAddTwice(t *testing.T) {
+	t.Parallel()
+
+	ste, _ := preprocess.NewScheduledTxsExecution(createMockArgsScheduledTxsExecution())
+
+	assert.True(t, ste.AddScheduledTx([]byte("hash"), &transaction.Transaction{}))
+	assert.False(t, ste.AddScheduledTx([]byte("hash"), &transaction.Transaction{}))
+
+	ste.Init()
+	assert.True(t, ste.AddScheduledTx([]byte("hash"), &transaction.Transaction{}))
+}
+
+func TestScheduledTxsExecution_ExecuteWithBadTxShouldRevertItAndContinue(t *testing.T) {
+	t.Parallel()
+
+	errBadTx := errors.New("bad tx")
+	journalLen := 0
+	revertedSnapshot := -1
+	removedGasConsumed := make([][]byte, 0)
+	removedResults := make([][]byte, 0)
+	args := createMockArgsScheduledTxsExecution()
+	args.TxProcessor = &testscommon.TxProcessorMock{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			journalLen++
+			if tx.Nonce == 2 {
+				return vmcommon.UserError, errBadTx
+			}
+			return vmcommon.Ok, nil
+		},
+	}
+	args.InterimProcessors = createInterimProcessorsContainer(map[block.Type]*mock.IntermediateTransactionHandlerMock{
+		block.SmartContractResultBlock: {
+			RemoveProcessedResultsForCalled: func(txHashes [][]byte) {
+				removedResults = append(removedResults, txHashes...)
+			},
+		},
+	})
+	args.GasHandler = &mock.GasHandlerMock{
+		InitCalled: func() {},
+		TotalGasRefundedCalled: func() uint64 {
+			return 0
+		},
+		RemoveGasConsumedCalled: func(hashes [][]byte) {
+			removedGasConsumed = append(removedGasConsumed, hashes...)
+		},
+		RemoveGasRefundedCalled: func(_ [][]byte) {},
+	}
+	args.Accounts = &testscommon.AccountsStub{
+		JournalLenCalled: func() int {
+			return journalLen
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertedSnapshot = snapshot
+			journalLen = snapshot
+			return nil
+		},
+		CommitCalled: func() ([]byte, error) {
+			return []byte("scheduled root hash"), nil
+		},
+	}
+	ste, _ := preprocess.NewScheduledTxsExecution(args)
+
+	ste.AddScheduledTx([]byte("tx1"), &transaction.Transaction{Nonce: 1, GasLimit: 50})
+	ste.AddScheduledTx([]byte("tx2"), &transaction.Transaction{Nonce: 2, GasLimit: 70})
+	ste.AddScheduledTx([]byte("tx3"), &transaction.Transaction{Nonce: 3, GasLimit: 30})
+
+	err := ste.Execute([]byte("header hash"))
+	require.Nil(t, err)
+	assert.Equal(t, 1, revertedSnapshot)
+	assert.Equal(t, [][]byte{[]byte("tx2")}, removedGasConsumed)
+	assert.Equal(t, [][]byte{[]byte("tx2")}, removedResults)
+	assert.Equal(t, []byte("scheduled root hash"), ste.GetScheduledRootHash())
+	assert.Equal(t, uint64(80), ste.GetScheduledGasAndFees().GasProvided)
+}
+
+func TestScheduledTxsExecution_ExecuteShouldSaveResultsAndRollBackShouldLoadThem(t *testing.T) {
+	t.Parallel()
+
+	scheduledRootHash := []byte("scheduled root hash")
+	headerHash := []byte("header hash")
+	scr := &smartContractResult.SmartContractResult{Nonce: 1, Value: big.NewInt(10)}
+
+	args := createMockArgsScheduledTxsExecution()
+	args.TxProcessor = &testscommon.TxProcessorMock{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			if tx.Nonce == 2 {
+				return vmcommon.UserError, process.ErrFailedTransaction
+			}
+			return vmcommon.Ok, nil
+		},
+	}
+	args.InterimProcessors = createInterimProcessorsContainer(map[block.Type]*mock.IntermediateTransactionHandlerMock{
+		block.SmartContractResultBlock: {
+			GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+				return map[string]data.TransactionHandler{"scr hash": scr}
+			},
+		},
+	})
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		GetAccumulatedFeesCalled: func() *big.Int {
+			return big.NewInt(100)
+		},
+		GetDeveloperFeesCalled: func() *big.Int {
+			return big.NewInt(10)
+		},
+	}
+	args.Accounts = &testscommon.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return scheduledRootHash, nil
+		},
+	}
+	ste, _ := preprocess.NewScheduledTxsExecution(args)
+
+	ste.Init()
+	ste.AddScheduledTx([]byte("tx1"), &transaction.Transaction{Nonce: 1, GasLimit: 50})
+	ste.AddScheduledTx([]byte("tx2"), &transaction.Transaction{Nonce: 2, GasLimit: 70})
+
+	err := ste.Execute(headerHash)
+	require.Nil(t, err)
+	assert.Equal(t, scheduledRootHash, ste.GetScheduledRootHash())
+	assert.Equal(t, []data.TransactionHandler{scr}, ste.GetScheduledSCRs())
+	assert.Equal(t, uint64(120), ste.GetScheduledGasAndFees().GasProvided)
+
+	err = ste.RollBackToBlock([]byte("other header hash"))
+	require.Nil(t, err)
+	assert.Nil(t, ste.GetScheduledRootHash())
+	assert.Equal(t, 0, len(ste.GetScheduledSCRs()))
+	assert.Equal(t, big.NewInt(0), ste.GetScheduledGasAndFees().AccumulatedFees)
+
+	err = ste.RollBackToBlock(headerHash)
+	require.Nil(t, err)
+	assert.Equal(t, scheduledRootHash, ste.GetScheduledRootHash())
+	require.Equal(t, 1, len(ste.GetScheduledSCRs()))
+	assert.Equal(t, scr.Value, ste.GetScheduledSCRs()[0].GetValue())
+	assert.Equal(t, big.NewInt(100), ste.GetScheduledGasAndFees().AccumulatedFees)
+	assert.Equal(t, big.NewInt(10), ste.GetScheduledGasAndFees().DeveloperFees)
+
+	rootHash, err := ste.GetScheduledRootHashForHeader(headerHash)
+	assert.Nil(t, err)
+	assert.Equal(t, scheduledRootHash, rootHash)
+}
+
+func TestScheduledTxsExecution_ApplyScheduledResultsShouldAddAllResultsAndFees(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	scr := &smartContractResult.SmartContractResult{Nonce: 1, Value: big.NewInt(10)}
+	rpt := &receipt.Receipt{Value: big.NewInt(5), TxHash: []byte("tx1")}
+	invalidTx := &transaction.Transaction{Nonce: 2}
+	txLog := &transaction.Log{
+		Address: []byte("sc address"),
+		Events: []*transaction.Event{
+			{Identifier: []byte("identifier"), Address: []byte("address"), Topics: [][]byte{[]byte("topic")}, Data: []byte("data")},
+		},
+	}
+
+	addedTxs := make(map[block.Type][]data.TransactionHandler)
+	createInterimProcessor := func(blockType block.Type, tx data.TransactionHandler) *mock.IntermediateTransactionHandlerMock {
+		return &mock.IntermediateTransactionHandlerMock{
+			GetAllCurrentFinishedTxsCalled: func() map[string]data.TransactionHandler {
+				return map[string]data.TransactionHandler{"hash": tx}
+			},
+			AddIntermediateTransactionsCalled: func(txs []data.TransactionHandler) error {
+				addedTxs[blockType] = append(addedTxs[blockType], txs...)
+				return nil
+			},
+		}
+	}
+
+	processedFees := big.NewInt(0)
+	var savedLogs []*vmcommon.LogEntry
+	var savedLogAddress []byte
+	args := createMockArgsScheduledTxsExecution()
+	args.InterimProcessors = createInterimProcessorsContainer(map[block.Type]*mock.IntermediateTransactionHandlerMock{
+		block.SmartContractResultBlock: createInterimProcessor(block.SmartContractResultBlock, scr),
+		block.ReceiptBlock:             createInterimProcessor(block.ReceiptBlock, rpt),
+		block.InvalidBlock:             createInterimProcessor(block.InvalidBlock, invalidTx),
+	})
+	args.TxLogsProcessor = &mock.TxLogsProcessorStub{
+		GetAllCurrentLogsCalled: func() map[string]data.LogHandler {
+			return map[string]data.LogHandler{"tx1": txLog}
+		},
+		SaveLogCalled: func(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error {
+			assert.Equal(t, []byte("tx1"), txHash)
+			savedLogAddress = tx.GetRcvAddr()
+			savedLogs = append(savedLogs, vmLogs...)
+			return nil
+		},
+	}
+	args.TxFeeHandler = &mock.FeeAccumulatorStub{
+		GetAccumulatedFeesCalled: func() *big.Int {
+			return big.NewInt(100)
+		},
+		GetDeveloperFeesCalled: func() *big.Int {
+			return big.NewInt(10)
+		},
+		ProcessTransactionFeeCalled: func(cost *big.Int, _ *big.Int, _ []byte) {
+			processedFees.Add(processedFees, cost)
+		},
+	}
+	args.Accounts = &testscommon.AccountsStub{
+		CommitCalled: func() ([]byte, error) {
+			return []byte("scheduled root hash"), nil
+		},
+	}
+	ste, _ := preprocess.NewScheduledTxsExecution(args)
+
+	ste.AddScheduledTx([]byte("tx1"), &transaction.Transaction{Nonce: 1})
+	err := ste.Execute(headerHash)
+	require.Nil(t, err)
+
+	err = ste.RollBackToBlock([]byte("other header hash"))
+	require.Nil(t, err)
+
+	err = ste.ApplyScheduledResults(headerHash)
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(addedTxs[block.SmartContractResultBlock]))
+	assert.Equal(t, scr.Value, addedTxs[block.SmartContractResultBlock][0].GetValue())
+	assert.Equal(t, []data.TransactionHandler{rpt}, addedTxs[block.ReceiptBlock])
+	assert.Equal(t, []data.TransactionHandler{invalidTx}, addedTxs[block.InvalidBlock])
+	assert.Equal(t, txLog.Address, savedLogAddress)
+	require.Equal(t, 1, len(savedLogs))
+	assert.Equal(t, txLog.Events[0].Identifier, savedLogs[0].Identifier)
+	assert.Equal(t, txLog.Events[0].Topics, savedLogs[0].Topics)
+	assert.Equal(t, big.NewInt(100), processedFees)
+}
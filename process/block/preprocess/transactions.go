@@ -11,6 +11,7 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/sliceUtil"
 	"github.com/ElrondNetwork/elrond-go/data"
@@ -50,6 +51,20 @@ type transactions struct {
 	accountsInfo         map[string]*txShardInfo
 	mutAccountsInfo      sync.RWMutex
 	emptyAddress         []byte
+
+	scheduledMiniBlocksEnableEpoch uint32
+	flagScheduledMiniBlocks        atomic.Flag
+}
+
+type scheduledSenderInfo struct {
+	numTxs    uint64
+	totalCost *big.Int
+}
+
+type scheduledTxsInfo struct {
+	miniBlock   *block.MiniBlock
+	gasProvided uint64
+	sendersInfo map[string]*scheduledSenderInfo
 }
 
 // NewTransactionPreprocessor creates a new transaction preprocessor object
@@ -69,6 +84,8 @@ func NewTransactionPreprocessor(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation BlockSizeComputationHandler,
 	balanceComputation BalanceComputationHandler,
+	epochNotifier process.EpochNotifier,
+	scheduledMiniBlocksEnableEpoch uint32,
 ) (*transactions, error) {
 
 	if check.IfNil(hasher) {
@@ -113,6 +130,9 @@ func NewTransactionPreprocessor(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	bpp := basePreProcess{
 		hasher:               hasher,
@@ -134,7 +154,10 @@ func NewTransactionPreprocessor(
 		txProcessor:          txProcessor,
 		blockTracker:         blockTracker,
 		blockType:            blockType,
+
+		scheduledMiniBlocksEnableEpoch: scheduledMiniBlocksEnableEpoch,
 	}
+	log.Debug("transactions: enable epoch for scheduled miniblocks", "epoch", txs.scheduledMiniBlocksEnableEpoch)
 
 	txs.chRcvAllTxs = make(chan bool)
 	txs.txPool.RegisterOnAdded(txs.receivedTransaction)
@@ -146,6 +169,8 @@ func NewTransactionPreprocessor(
 
 	txs.emptyAddress = make([]byte, txs.pubkeyConverter.Len())
 
+	epochNotifier.RegisterNotifyHandler(&txs)
+
 	return &txs, nil
 }
 
@@ -783,6 +808,7 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 	numTxsFailed := 0
 	numTxsWithInitialBalanceConsumed := 0
 	numCrossShardScCallsOrSpecialTxs := 0
+	numTxsScheduled := 0
 
 	totalTimeUsedForProcesss := time.Duration(0)
 	totalTimeUsedForComputeGasConsumed := time.Duration(0)
@@ -808,6 +834,8 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 
 	mapMiniBlocks[core.MetachainShardId] = txs.createEmptyMiniBlock(txs.shardCoordinator.SelfId(), core.MetachainShardId, block.TxBlock)
 
+	scheduledInfo := txs.createScheduledTxsInfo()
+
 	for index := range sortedTxs {
 		if !haveTime() {
 			log.Debug("time is out in createAndProcessMiniBlocksFromMe")
@@ -881,6 +909,27 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 			}
 		}
 
+		if txs.flagScheduledMiniBlocks.IsSet() {
+			isTxScheduled, shouldSkipTx := txs.scheduleTxIfPossible(
+				tx,
+				txHash,
+				senderShardID,
+				receiverShardID,
+				scheduledInfo,
+				&totalGasConsumedInSelfShard)
+			if isTxScheduled {
+				if isAddressSet {
+					_ = txs.balanceComputation.SubBalanceFromAddress(tx.GetSndAddr(), txMaxTotalCost)
+				}
+				numTxsScheduled++
+				continue
+			}
+			if shouldSkipTx {
+				numTxsSkipped++
+				continue
+			}
+		}
+
 		snapshot := txs.accounts.JournalLen()
 
 		gasConsumedByMiniBlockInReceiverShard := mapGasConsumedByMiniBlockInReceiverShard[receiverShardID]
@@ -994,11 +1043,15 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 	}
 
 	miniBlocks := txs.getMiniBlockSliceFromMap(mapMiniBlocks)
+	if len(scheduledInfo.miniBlock.TxHashes) > 0 {
+		miniBlocks = append(miniBlocks, scheduledInfo.miniBlock)
+	}
 
 	log.Debug("createAndProcessMiniBlocksFromMe",
 		"self shard", txs.shardCoordinator.SelfId(),
 		"gas consumed in sender shard", gasConsumedByMiniBlocksInSenderShard,
-		"total gas consumed in self shard", totalGasConsumedInSelfShard)
+		"total gas consumed in self shard", totalGasConsumedInSelfShard,
+		"gas provided by scheduled txs", scheduledInfo.gasProvided)
 
 	for _, miniBlock := range miniBlocks {
 		log.Debug("mini block info",
@@ -1017,12 +1070,127 @@ func (txs *transactions) createAndProcessMiniBlocksFromMe(
 		"num txs skipped", numTxsSkipped,
 		"num txs with initial balance consumed", numTxsWithInitialBalanceConsumed,
 		"num cross shard sc calls or special txs", numCrossShardScCallsOrSpecialTxs,
+		"num txs scheduled", numTxsScheduled,
 		"used time for computeGasConsumed", totalTimeUsedForComputeGasConsumed,
 		"used time for processAndRemoveBadTransaction", totalTimeUsedForProcesss)
 
 	return miniBlocks, nil
 }
 
+func (txs *transactions) createScheduledTxsInfo() *scheduledTxsInfo {
+	miniBlock := txs.createEmptyMiniBlock(txs.shardCoordinator.SelfId(), txs.shardCoordinator.SelfId(), block.TxBlock)
+	miniBlock.SetProcessingType(block.Scheduled)
+
+	return &scheduledTxsInfo{
+		miniBlock:   miniBlock,
+		sendersInfo: make(map[string]*scheduledSenderInfo),
+	}
+}
+
+// scheduleTxIfPossible adds the given transaction in the scheduled miniblock, without executing it, if it is an
+// intra-shard smart contract call which passes all the verifications. The first returned value is true if the
+// transaction has been scheduled and the second one is true if the transaction should be skipped, as its sender
+// already has scheduled transactions in the current block and the transaction could not be scheduled as well.
+// The gas limit of a scheduled transaction is counted in the total gas consumed in self shard, so that the normal and
+// the scheduled transactions of a block share the same max gas limit per block
+func (txs *transactions) scheduleTxIfPossible(
+	tx *transaction.Transaction,
+	txHash []byte,
+	senderShardID uint32,
+	receiverShardID uint32,
+	scheduledInfo *scheduledTxsInfo,
+	totalGasConsumedInSelfShard *uint64,
+) (bool, bool) {
+	senderInfo, senderHasScheduledTxs := scheduledInfo.sendersInfo[string(tx.GetSndAddr())]
+	if !txs.isTxEligibleForScheduling(tx, senderShardID, receiverShardID) {
+		return false, senderHasScheduledTxs
+	}
+	if !senderHasScheduledTxs {
+		senderInfo = &scheduledSenderInfo{
+			totalCost: big.NewInt(0),
+		}
+	}
+
+	txMaxTotalCost := txs.getTxMaxTotalCost(tx)
+	err := txs.verifyScheduledTx(tx, senderInfo, txMaxTotalCost, *totalGasConsumedInSelfShard)
+	if err != nil {
+		log.Trace("scheduleTxIfPossible.verifyScheduledTx",
+			"hash", txHash,
+			"error", err.Error(),
+		)
+		return false, senderHasScheduledTxs
+	}
+
+	senderInfo.numTxs++
+	senderInfo.totalCost.Add(senderInfo.totalCost, txMaxTotalCost)
+	scheduledInfo.sendersInfo[string(tx.GetSndAddr())] = senderInfo
+	scheduledInfo.gasProvided += tx.GetGasLimit()
+	*totalGasConsumedInSelfShard += tx.GetGasLimit()
+
+	if len(scheduledInfo.miniBlock.TxHashes) == 0 {
+		txs.blockSizeComputation.AddNumMiniBlocks(1)
+	}
+	scheduledInfo.miniBlock.TxHashes = append(scheduledInfo.miniBlock.TxHashes, txHash)
+	txs.blockSizeComputation.AddNumTxs(1)
+
+	return true, false
+}
+
+func (txs *transactions) isTxEligibleForScheduling(
+	tx *transaction.Transaction,
+	senderShardID uint32,
+	receiverShardID uint32,
+) bool {
+	selfShardID := txs.shardCoordinator.SelfId()
+	if selfShardID == core.MetachainShardId {
+		return false
+	}
+
+	isIntraShardTx := senderShardID == selfShardID && receiverShardID == selfShardID
+	return isIntraShardTx && core.IsSmartContractAddress(tx.GetRcvAddr()) && !tx.IsRelayedV3()
+}
+
+func (txs *transactions) verifyScheduledTx(
+	tx *transaction.Transaction,
+	senderInfo *scheduledSenderInfo,
+	txMaxTotalCost *big.Int,
+	totalGasConsumedInSelfShard uint64,
+) error {
+	err := txs.economicsFee.CheckValidityTxValues(tx)
+	if err != nil {
+		return err
+	}
+
+	maxGasLimitPerBlock := txs.economicsFee.MaxGasLimitPerBlock(txs.shardCoordinator.SelfId())
+	if totalGasConsumedInSelfShard+tx.GetGasLimit() > maxGasLimitPerBlock {
+		return process.ErrMaxGasLimitPerBlockInSelfShardIsReached
+	}
+
+	account, err := txs.accounts.GetExistingAccount(tx.GetSndAddr())
+	if err != nil {
+		return err
+	}
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	expectedNonce := userAccount.GetNonce() + senderInfo.numTxs
+	if tx.GetNonce() < expectedNonce {
+		return process.ErrLowerNonceInTransaction
+	}
+	if tx.GetNonce() > expectedNonce {
+		return process.ErrHigherNonceInTransaction
+	}
+
+	totalCost := big.NewInt(0).Add(senderInfo.totalCost, txMaxTotalCost)
+	if userAccount.GetBalance().Cmp(totalCost) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	return nil
+}
+
 func (txs *transactions) createEmptyMiniBlock(
 	senderShardID uint32,
 	receiverShardID uint32,
@@ -1203,6 +1371,12 @@ func (txs *transactions) GetAllCurrentUsedTxs() map[string]data.TransactionHandl
 	return txPool
 }
 
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (txs *transactions) EpochConfirmed(epoch uint32, _ uint64) {
+	txs.flagScheduledMiniBlocks.Toggle(epoch >= txs.scheduledMiniBlocksEnableEpoch)
+	log.Debug("transactions: scheduled miniblocks", "enabled", txs.flagScheduledMiniBlocks.IsSet())
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txs *transactions) IsInterfaceNil() bool {
 	return txs == nil
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -190,6 +191,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPool(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -217,6 +220,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilStore(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -244,6 +249,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilHasher(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -271,6 +278,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilMarsalizer(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -298,6 +307,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilTxProce(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -325,6 +336,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilShardCoord(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -352,6 +365,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilAccounts(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -378,6 +393,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilRequestFunc(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -405,6 +422,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilFeeHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -432,6 +451,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilGasHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -459,6 +480,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockTracker(t *testing.T)
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -486,6 +509,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilPubkeyConverter(t *testing
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -513,6 +538,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBlockSizeComputationHandle
 		createMockPubkeyConverter(),
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
@@ -540,12 +567,43 @@ func TestTxsPreprocessor_NewTransactionPreprocessorNilBalanceComputationHandler(
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, txs)
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 }
 
+func TestTxsPreprocessor_NewTransactionPreprocessorNilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	tdp := initDataPool()
+	requestTransaction := func(shardID uint32, txHashes [][]byte) {}
+	txs, err := NewTransactionPreprocessor(
+		tdp.Transactions(),
+		&mock.ChainStorerMock{},
+		&mock.HasherMock{},
+		&mock.MarshalizerMock{},
+		&testscommon.TxProcessorMock{},
+		mock.NewMultiShardsCoordinatorMock(3),
+		&testscommon.AccountsStub{},
+		requestTransaction,
+		feeHandlerMock(),
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		block.TxBlock,
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+		0,
+	)
+
+	assert.Nil(t, txs)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
 func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -567,6 +625,8 @@ func TestTxsPreprocessor_NewTransactionPreprocessorOkValsShouldWork(t *testing.T
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -790,6 +850,8 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAll(t *testi
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.NotNil(t, txs)
 
@@ -861,6 +923,8 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddAllAsNoSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.NotNil(t, txs)
 
@@ -942,6 +1006,8 @@ func TestTransactions_CreateAndProcessMiniBlockCrossShardGasLimitAddOnly5asSCCal
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.NotNil(t, txs)
 
@@ -1072,11 +1138,44 @@ func createGoodPreprocessor(dataPool dataRetriever.PoolsHolder) *transactions {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	return preprocessor
 }
 
+func TestTransactions_ScheduleTxIfPossibleShouldShareTheGasLimitPerBlock(t *testing.T) {
+	t.Parallel()
+
+	sndAddr := []byte("sender address")
+	scAddr := make([]byte, 32)
+	scAddr[10] = 1
+
+	txs := createGoodPreprocessor(initDataPool())
+	txs.accounts = &testscommon.AccountsStub{
+		GetExistingAccountCalled: func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+			account, _ := state.NewUserAccount(addressContainer)
+			_ = account.AddToBalance(big.NewInt(1000000))
+			return account, nil
+		},
+	}
+	scheduledInfo := txs.createScheduledTxsInfo()
+	selfShardID := txs.shardCoordinator.SelfId()
+
+	totalGasConsumedInSelfShard := MaxGasLimitPerBlock - 100
+	tx := &transaction.Transaction{SndAddr: sndAddr, RcvAddr: scAddr, Nonce: 0, GasLimit: 101, Value: big.NewInt(0)}
+	isTxScheduled, _ := txs.scheduleTxIfPossible(tx, []byte("hash1"), selfShardID, selfShardID, scheduledInfo, &totalGasConsumedInSelfShard)
+	assert.False(t, isTxScheduled)
+	assert.Equal(t, MaxGasLimitPerBlock-100, totalGasConsumedInSelfShard)
+
+	tx.GasLimit = 100
+	isTxScheduled, _ = txs.scheduleTxIfPossible(tx, []byte("hash1"), selfShardID, selfShardID, scheduledInfo, &totalGasConsumedInSelfShard)
+	assert.True(t, isTxScheduled)
+	assert.Equal(t, MaxGasLimitPerBlock, totalGasConsumedInSelfShard)
+	assert.Equal(t, uint64(100), scheduledInfo.gasProvided)
+}
+
 func TestTransactionPreprocessor_ProcessTxsToMeShouldUseCorrectSenderAndReceiverShards(t *testing.T) {
 	t.Parallel()
 
@@ -1117,6 +1216,8 @@ func TestTransactionPreprocessor_ProcessTxsToMeShouldUseCorrectSenderAndReceiver
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	tx := transaction.Transaction{SndAddr: []byte("2"), RcvAddr: []byte("0")}
@@ -1198,6 +1299,8 @@ func TestTransactionsPreprocessor_ProcessMiniBlockShouldWork(t *testing.T) {
 			},
 		},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.NotNil(t, txs)
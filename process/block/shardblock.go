@@ -30,8 +30,9 @@ type shardProcessor struct {
 	metaBlockFinality uint32
 	chRcvAllMetaHdrs  chan bool
 
-	processedMiniBlocks   *processedMb.ProcessedMiniBlockTracker
-	userStatePruningQueue core.Queue
+	processedMiniBlocks          *processedMb.ProcessedMiniBlockTracker
+	userStatePruningQueue        core.Queue
+	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 }
 
 // NewShardProcessor creates a new shardProcessor object
@@ -50,6 +51,9 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 	if check.IfNil(arguments.DataComponents.Datapool().Transactions()) {
 		return nil, process.ErrNilTransactionPool
 	}
+	if check.IfNil(arguments.ScheduledTxsExecutionHandler) {
+		return nil, process.ErrNilScheduledTxsExecutionHandler
+	}
 
 	genesisHdr := arguments.DataComponents.Blockchain().GetGenesisHeader()
 	base := &baseProcessor{
@@ -83,11 +87,13 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		epochNotifier:                 arguments.EpochNotifier,
 		vmContainerFactory:            arguments.VMContainersFactory,
 		vmContainer:                   arguments.VmContainer,
+		processingReporter:            arguments.ProcessingReporter,
 		processDataTriesOnCommitEpoch: arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 	}
 
 	sp := shardProcessor{
-		baseProcessor: base,
+		baseProcessor:                base,
+		scheduledTxsExecutionHandler: arguments.ScheduledTxsExecutionHandler,
 	}
 
 	sp.txCounter = NewTransactionCounter()
@@ -169,6 +175,11 @@ func (sp *shardProcessor) ProcessBlock(
 	sp.createBlockStarted()
 	sp.blockChainHook.SetCurrentHeader(headerHandler)
 
+	err = sp.scheduledTxsExecutionHandler.ApplyScheduledResults(header.GetPrevHash())
+	if err != nil {
+		return err
+	}
+
 	sp.txCoordinator.RequestBlockTransactions(body)
 	requestedMetaHdrs, requestedFinalityAttestingMetaHdrs := sp.requestMetaHeaders(header)
 
@@ -251,6 +262,10 @@ func (sp *shardProcessor) ProcessBlock(
 			sp.RevertAccountState(header)
 		}
 	}()
+	// registered after the revert so the reporter sees the computed state
+	defer func() {
+		sp.processingReporter.ReportProcessedBlock(header, err)
+	}()
 
 	startTime := time.Now()
 	err = sp.txCoordinator.ProcessBlockTransaction(body, haveTime)
@@ -328,12 +343,32 @@ func (sp *shardProcessor) requestEpochStartInfo(header *block.Header, haveTime f
 
 // RevertStateToBlock recreates the state tries to the root hashes indicated by the provided header
 func (sp *shardProcessor) RevertStateToBlock(header data.HeaderHandler) error {
+	headerHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, header)
+	if err != nil {
+		return err
+	}
 
-	err := sp.accountsDB[state.UserAccountsState].RecreateTrie(header.GetRootHash())
+	err = sp.scheduledTxsExecutionHandler.RollBackToBlock(headerHash)
+	if err != nil {
+		log.Debug("roll back scheduled txs execution for header",
+			"nonce", header.GetNonce(),
+			"hash", headerHash,
+			"error", err,
+		)
+		return err
+	}
+
+	rootHash := header.GetRootHash()
+	scheduledRootHash := sp.scheduledTxsExecutionHandler.GetScheduledRootHash()
+	if len(scheduledRootHash) > 0 {
+		rootHash = scheduledRootHash
+	}
+
+	err = sp.accountsDB[state.UserAccountsState].RecreateTrie(rootHash)
 	if err != nil {
 		log.Debug("recreate trie with error for header",
 			"nonce", header.GetNonce(),
-			"hash", header.GetRootHash(),
+			"hash", rootHash,
 			"error", err,
 		)
 
@@ -696,6 +731,11 @@ func (sp *shardProcessor) CreateBlock(
 
 	sp.createBlockStarted()
 
+	err := sp.scheduledTxsExecutionHandler.ApplyScheduledResults(shardHdr.GetPrevHash())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if sp.epochStartTrigger.IsEpochStart() {
 		log.Debug("CreateBlock", "IsEpochStart", sp.epochStartTrigger.IsEpochStart(),
 			"epoch start meta header hash", sp.epochStartTrigger.EpochStartMetaHdrHash())
@@ -932,6 +972,13 @@ func (sp *shardProcessor) CommitBlock(
 
 	sp.displayPoolsInfo()
 
+	err = sp.executeScheduledTxs(body, headerHash)
+	if err != nil {
+		log.Error("executeScheduledTxs", "hash", headerHash, "error", err.Error())
+		sp.revertScheduledTxsExecution(header)
+		return err
+	}
+
 	errNotCritical = sp.removeTxsFromPools(bodyHandler)
 	if errNotCritical != nil {
 		log.Debug("removeTxsFromPools", "error", errNotCritical.Error())
@@ -942,6 +989,91 @@ func (sp *shardProcessor) CommitBlock(
 	return nil
 }
 
+// executeScheduledTxs executes, on top of the committed state, the transactions included in the scheduled
+// miniblocks of the given block body. The results of this execution will be included in the next block.
+func (sp *shardProcessor) executeScheduledTxs(body *block.Body, headerHash []byte) error {
+	sp.scheduledTxsExecutionHandler.Init()
+
+	mapTxs := sp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	numScheduledTxs := 0
+	for _, miniBlock := range body.MiniBlocks {
+		if !miniBlock.IsScheduledMiniBlock() {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := mapTxs[string(txHash)]
+			if !ok {
+				return process.ErrMissingTransaction
+			}
+
+			sp.scheduledTxsExecutionHandler.AddScheduledTx(txHash, tx)
+			numScheduledTxs++
+		}
+	}
+
+	if numScheduledTxs == 0 {
+		return sp.scheduledTxsExecutionHandler.RollBackToBlock(headerHash)
+	}
+
+	return sp.scheduledTxsExecutionHandler.Execute(headerHash)
+}
+
+// revertScheduledTxsExecution brings the user accounts state back to the one of the given committed block, as the
+// scheduled execution could have already committed a partial state, and sets the rollback nonce in the fork detector,
+// so that the block, whose scheduled execution could not be completed (e.g. its results could not be saved), will be
+// rolled back and synced again. A scheduled transaction which can not be executed does not make the execution fail
+func (sp *shardProcessor) revertScheduledTxsExecution(header data.HeaderHandler) {
+	err := sp.accountsDB[state.UserAccountsState].RecreateTrie(header.GetRootHash())
+	if err != nil {
+		log.Debug("revertScheduledTxsExecution.RecreateTrie",
+			"root hash", header.GetRootHash(),
+			"error", err.Error(),
+		)
+	}
+
+	sp.forkDetector.SetRollBackNonce(header.GetNonce())
+}
+
+// getRootHashAfterScheduledExecution returns the root hash resulted after the execution of the scheduled transactions
+// included in the block with the given hash, or the given root hash if the block did not have scheduled transactions
+func (sp *shardProcessor) getRootHashAfterScheduledExecution(headerHash []byte, rootHash []byte) []byte {
+	scheduledRootHash, err := sp.scheduledTxsExecutionHandler.GetScheduledRootHashForHeader(headerHash)
+	if err != nil || len(scheduledRootHash) == 0 {
+		return rootHash
+	}
+
+	return scheduledRootHash
+}
+
+// PruneStateOnRollback prunes the states created by the rolled back block, including the one resulted after the
+// execution of its scheduled transactions, and cancels the pruning of the state of the previous block
+func (sp *shardProcessor) PruneStateOnRollback(currHeader data.HeaderHandler, prevHeader data.HeaderHandler) {
+	for key := range sp.accountsDB {
+		if !sp.accountsDB[key].IsPruningEnabled() {
+			continue
+		}
+
+		rootHash, prevRootHash := sp.getRootHashes(currHeader, prevHeader, key)
+		if key != state.UserAccountsState {
+			sp.pruneStateTransitionOnRollback(sp.accountsDB[key], prevRootHash, rootHash)
+			continue
+		}
+
+		currHeaderHash, err := core.CalculateHash(sp.marshalizer, sp.hasher, currHeader)
+		if err != nil {
+			log.Debug("PruneStateOnRollback.CalculateHash", "error", err.Error())
+			continue
+		}
+
+		scheduledRootHash := sp.getRootHashAfterScheduledExecution(currHeaderHash, rootHash)
+		prevRootHash = sp.getRootHashAfterScheduledExecution(currHeader.GetPrevHash(), prevRootHash)
+
+		sp.pruneStateTransitionOnRollback(sp.accountsDB[key], rootHash, scheduledRootHash)
+		sp.pruneStateTransitionOnRollback(sp.accountsDB[key], prevRootHash, rootHash)
+	}
+}
+
 func (sp *shardProcessor) notifyFinalMetaHdrs(processedMetaHeaders []data.HeaderHandler) {
 	metaHeaders := make([]data.HeaderHandler, 0)
 	metaHeadersHashes := make([][]byte, 0)
@@ -1024,10 +1156,26 @@ func (sp *shardProcessor) updateState(headers []data.HeaderHandler, currentHeade
 			"nonce", hdr.GetNonce(),
 			"root hash", hdr.GetRootHash())
 
+		prevRootHash := sp.getRootHashAfterScheduledExecution(hdr.GetPrevHash(), prevHeader.GetRootHash())
+		sp.updateStateStorage(
+			hdr,
+			hdr.GetRootHash(),
+			prevRootHash,
+			sp.accountsDB[state.UserAccountsState],
+			sp.userStatePruningQueue,
+		)
+
+		hdrHash, errNotCritical := core.CalculateHash(sp.marshalizer, sp.hasher, hdr)
+		if errNotCritical != nil {
+			log.Debug("updateState.CalculateHash", "error", errNotCritical.Error())
+			continue
+		}
+
+		scheduledRootHash := sp.getRootHashAfterScheduledExecution(hdrHash, hdr.GetRootHash())
 		sp.updateStateStorage(
 			hdr,
+			scheduledRootHash,
 			hdr.GetRootHash(),
-			prevHeader.GetRootHash(),
 			sp.accountsDB[state.UserAccountsState],
 			sp.userStatePruningQueue,
 		)
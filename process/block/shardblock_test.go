@@ -277,6 +277,18 @@ func TestNewShardProcessor_NilBlockSizeThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilScheduledTxsExecutionHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.ScheduledTxsExecutionHandler = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilScheduledTxsExecutionHandler, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -494,6 +506,8 @@ func TestShardProcessor_ProcessBlockWithInvalidTransactionShouldErr(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -711,6 +725,8 @@ func TestShardProcessor_ProcessBlockWithErrOnProcessBlockTransactionsCallShouldR
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -834,11 +850,21 @@ func TestShardProcessor_ProcessBlockWithErrOnVerifyStateRootCallShouldRevertStat
 		},
 	}
 
+	var reportedErr error
+	wasRevertedBeforeReport := false
+	arguments.ProcessingReporter = &mock.BlockProcessingReporterStub{
+		ReportProcessedBlockCalled: func(header data.HeaderHandler, processingErr error) {
+			reportedErr = processingErr
+			wasRevertedBeforeReport = wasCalled
+		},
+	}
 	sp, _ := blproc.NewShardProcessor(arguments)
 	// should return err
 	err := sp.ProcessBlock(&hdr, body, haveTime)
 	assert.Equal(t, process.ErrRootStateDoesNotMatch, err)
 	assert.True(t, wasCalled)
+	assert.Equal(t, process.ErrRootStateDoesNotMatch, reportedErr)
+	assert.False(t, wasRevertedBeforeReport)
 }
 
 func TestShardProcessor_ProcessBlockOnlyIntraShardShouldPass(t *testing.T) {
@@ -1817,6 +1843,120 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	time.Sleep(time.Second)
 }
 
+func TestShardProcessor_CommitBlockScheduledExecutionFailsShouldRevertStateAndSetRollBackNonce(t *testing.T) {
+	t.Parallel()
+	tdp := initDataPool([]byte("tx_hash1"))
+	txHash := []byte("tx_hash1")
+
+	rootHash := []byte("root hash")
+	hdrHash := []byte("header hash")
+	randSeed := []byte("rand seed")
+	expectedErr := errors.New("scheduled execution failed")
+
+	prevHdr := &block.Header{
+		Nonce:         0,
+		Round:         0,
+		PubKeysBitmap: rootHash,
+		PrevHash:      hdrHash,
+		Signature:     rootHash,
+		RootHash:      rootHash,
+		RandSeed:      randSeed,
+	}
+
+	hdr := &block.Header{
+		Nonce:           1,
+		Round:           1,
+		PubKeysBitmap:   rootHash,
+		PrevHash:        hdrHash,
+		Signature:       rootHash,
+		RootHash:        rootHash,
+		PrevRandSeed:    randSeed,
+		AccumulatedFees: big.NewInt(0),
+		DeveloperFees:   big.NewInt(0),
+	}
+	mb := block.MiniBlock{
+		TxHashes: [][]byte{txHash},
+	}
+	body := &block.Body{MiniBlocks: []*block.MiniBlock{&mb}}
+	hdr.MiniBlockHeaders = []block.MiniBlockHeader{
+		{
+			TxCount: uint32(len(mb.TxHashes)),
+			Hash:    hdrHash,
+		},
+	}
+
+	var recreatedRootHash []byte
+	accounts := &testscommon.AccountsStub{
+		CommitCalled: func() (i []byte, e error) {
+			return rootHash, nil
+		},
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHash = rootHash
+			return nil
+		},
+	}
+	rollBackNonce := uint64(0)
+	fd := &mock.ForkDetectorMock{
+		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
+			return nil
+		},
+		GetHighestFinalBlockNonceCalled: func() uint64 {
+			return 0
+		},
+		GetHighestFinalBlockHashCalled: func() []byte {
+			return nil
+		},
+		SetRollBackNonceCalled: func(nonce uint64) {
+			rollBackNonce = nonce
+		},
+	}
+	hasher := &mock.HasherStub{}
+	hasher.ComputeCalled = func(s string) []byte {
+		return hdrHash
+	}
+
+	blkc := createTestBlockchain()
+	blkc.GetCurrentBlockHeaderCalled = func() data.HeaderHandler {
+		return prevHdr
+	}
+	blkc.GetCurrentBlockHeaderHashCalled = func() []byte {
+		return hdrHash
+	}
+
+	coreComponents, dataComponents, bootstrapComponents, statusComponents := createComponentHolderMocks()
+	coreComponents.Hash = hasher
+	dataComponents.DataPool = tdp
+	dataComponents.Storage = initStore()
+	dataComponents.BlockChain = blkc
+	arguments := CreateMockArguments(coreComponents, dataComponents, bootstrapComponents, statusComponents)
+	arguments.AccountsDB[state.UserAccountsState] = accounts
+	arguments.ForkDetector = fd
+	arguments.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+		RollBackToBlockCalled: func(headerHash []byte) error {
+			return expectedErr
+		},
+	}
+	blockTrackerMock := mock.NewBlockTrackerMock(mock.NewOneShardCoordinatorMock(), createGenesisBlocks(mock.NewOneShardCoordinatorMock()))
+	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
+		return &block.MetaBlock{}, []byte("hash"), nil
+	}
+	arguments.BlockTracker = blockTrackerMock
+
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	err := sp.ProcessBlock(hdr, body, haveTime)
+	assert.Nil(t, err)
+	err = sp.CommitBlock(hdr, body)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, rootHash, recreatedRootHash)
+	assert.Equal(t, hdr.Nonce, rollBackNonce)
+	//this should sleep as there is an async call to display current hdr and block in CommitBlock
+	time.Sleep(time.Second)
+}
+
 func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -2280,6 +2420,8 @@ func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -2384,6 +2526,8 @@ func TestShardProcessor_MarshalizedDataMarshalWithoutSuccess(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -2772,6 +2916,8 @@ func TestShardProcessor_CreateMiniBlocksShouldWorkWithIntraShardTxs(t *testing.T
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -2949,6 +3095,8 @@ func TestShardProcessor_RestoreBlockIntoPoolsShouldWork(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	container, _ := factory.Create()
 
@@ -1,5 +1,7 @@
 package process
 
+import "fmt"
+
 // BlockHeaderState specifies which is the state of the block header received
 type BlockHeaderState int
 
@@ -16,6 +18,24 @@ const (
 	BHNotarized
 )
 
+// String returns the name of the block header state
+func (state BlockHeaderState) String() string {
+	switch state {
+	case BHReceived:
+		return "received"
+	case BHReceivedTooLate:
+		return "received too late"
+	case BHProcessed:
+		return "processed"
+	case BHProposed:
+		return "proposed"
+	case BHNotarized:
+		return "notarized"
+	default:
+		return fmt.Sprintf("unknown state %d", state)
+	}
+}
+
 // TransactionType specifies the type of the transaction
 type TransactionType int
 
@@ -36,6 +56,8 @@ const (
 	RewardTx
 	// InvalidTransaction defines unknown transaction type
 	InvalidTransaction
+	// RelayedTxV3 defines the ID of a relayed transaction that holds the relayer in dedicated fields
+	RelayedTxV3
 )
 
 // BlockFinality defines the block finality which is used in meta-chain/shards (the real finality in shards is given
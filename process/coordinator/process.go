@@ -46,6 +46,7 @@ type ArgTransactionCoordinator struct {
 	EconomicsFee                      process.FeeHandler
 	TxTypeHandler                     process.TxTypeHandler
 	TransactionsLogProcessor          process.TransactionLogProcessor
+	ScheduledTxsExecutionHandler      process.ScheduledTxsExecutionHandler
 	BlockGasAndFeesReCheckEnableEpoch uint32
 }
 
@@ -76,6 +77,7 @@ type transactionCoordinator struct {
 	economicsFee                      process.FeeHandler
 	txTypeHandler                     process.TxTypeHandler
 	transactionsLogProcessor          process.TransactionLogProcessor
+	scheduledTxsExecutionHandler      process.ScheduledTxsExecutionHandler
 	blockGasAndFeesReCheckEnableEpoch uint32
 }
 
@@ -99,6 +101,7 @@ func NewTransactionCoordinator(arguments ArgTransactionCoordinator) (*transactio
 		txTypeHandler:                     arguments.TxTypeHandler,
 		blockGasAndFeesReCheckEnableEpoch: arguments.BlockGasAndFeesReCheckEnableEpoch,
 		transactionsLogProcessor:          arguments.TransactionsLogProcessor,
+		scheduledTxsExecutionHandler:      arguments.ScheduledTxsExecutionHandler,
 	}
 	log.Debug("coordinator/process: enable epoch for block gas and fees re-check", "epoch", tc.blockGasAndFeesReCheckEnableEpoch)
 
@@ -1254,13 +1257,18 @@ func (tc *transactionCoordinator) verifyFees(
 	body *block.Body,
 	mapMiniBlockTypeAllTxs map[block.Type]map[string]data.TransactionHandler,
 ) error {
-	totalMaxAccumulatedFees := big.NewInt(0)
-	totalMaxDeveloperFees := big.NewInt(0)
+	scheduledGasAndFees := tc.scheduledTxsExecutionHandler.GetScheduledGasAndFees()
+	totalMaxAccumulatedFees := big.NewInt(0).Set(scheduledGasAndFees.AccumulatedFees)
+	totalMaxDeveloperFees := big.NewInt(0).Set(scheduledGasAndFees.DeveloperFees)
 
 	for _, miniBlock := range body.MiniBlocks {
 		if miniBlock.Type == block.PeerBlock {
 			continue
 		}
+		// the fees of the scheduled transactions are accounted in the next block, as they are executed after this one
+		if miniBlock.IsScheduledMiniBlock() {
+			continue
+		}
 
 		maxAccumulatedFeesFromMiniBlock, maxDeveloperFeesFromMiniBlock, err := tc.getMaxAccumulatedAndDeveloperFees(
 			miniBlock,
@@ -1355,6 +1363,9 @@ func checkTransactionCoordinatorNilParameters(arguments ArgTransactionCoordinato
 	if check.IfNil(arguments.TransactionsLogProcessor) {
 		return process.ErrNilTxLogsProcessor
 	}
+	if check.IfNil(arguments.ScheduledTxsExecutionHandler) {
+		return process.ErrNilScheduledTxsExecutionHandler
+	}
 
 	return nil
 }
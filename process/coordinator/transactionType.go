@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -75,6 +76,10 @@ func (tth *txTypeHandler) ComputeTransactionType(tx data.TransactionHandler) (pr
 		return process.InvalidTransaction, process.InvalidTransaction
 	}
 
+	if isRelayedTransactionV3(tx) {
+		return process.RelayedTxV3, process.RelayedTxV3
+	}
+
 	isEmptyAddress := tth.isDestAddressEmpty(tx)
 	if isEmptyAddress {
 		if len(tx.GetData()) > 0 {
@@ -185,6 +190,15 @@ func (tth *txTypeHandler) isRelayedTransactionV2(functionName string) bool {
 	return functionName == core.RelayedTransactionV2
 }
 
+func isRelayedTransactionV3(tx data.TransactionHandler) bool {
+	relayedTx, ok := tx.(*transaction.Transaction)
+	if !ok {
+		return false
+	}
+
+	return relayedTx.IsRelayedV3()
+}
+
 func (tth *txTypeHandler) isDestAddressEmpty(tx data.TransactionHandler) bool {
 	isEmptyAddress := bytes.Equal(tx.GetRcvAddr(), make([]byte, tth.pubkeyConv.Len()))
 	return isEmptyAddress
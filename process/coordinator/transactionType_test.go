@@ -388,6 +388,33 @@ func TestTxTypeHandler_ComputeTransactionTypeRelayedV2Func(t *testing.T) {
 	assert.Equal(t, process.MoveBalance, txTypeCross)
 }
 
+func TestTxTypeHandler_ComputeTransactionTypeRelayedV3(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("000")
+	tx.RcvAddr = []byte("001")
+	tx.Value = big.NewInt(45)
+	tx.RelayerAddr = []byte("002")
+	tx.RelayerSignature = []byte("sig")
+
+	arg := createMockArguments()
+	arg.PubkeyConverter = &mock.PubkeyConverterStub{
+		LenCalled: func() int {
+			return len(tx.RcvAddr)
+		},
+	}
+	tth, err := NewTxTypeHandler(arg)
+
+	assert.NotNil(t, tth)
+	assert.Nil(t, err)
+
+	txTypeIn, txTypeCross := tth.ComputeTransactionType(tx)
+	assert.Equal(t, process.RelayedTxV3, txTypeIn)
+	assert.Equal(t, process.RelayedTxV3, txTypeCross)
+}
+
 func TestTxTypeHandler_ComputeTransactionTypeForSCRCallBack(t *testing.T) {
 	t.Parallel()
 
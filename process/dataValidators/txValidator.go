@@ -81,17 +81,9 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
-	accountNonce := accountHandler.GetNonce()
-	txNonce := interceptedTx.Nonce()
-	lowerNonceInTx := txNonce < accountNonce
-	veryHighNonceInTx := txNonce > accountNonce+uint64(txv.maxNonceDeltaAllowed)
-	isTxRejected := lowerNonceInTx || veryHighNonceInTx
-	if isTxRejected {
-		return fmt.Errorf("%w lowerNonceInTx: %v, veryHighNonceInTx: %v",
-			process.ErrWrongTransaction,
-			lowerNonceInTx,
-			veryHighNonceInTx,
-		)
+	err = txv.checkNonce(accountHandler.GetNonce(), interceptedTx.Nonce())
+	if err != nil {
+		return err
 	}
 
 	account, ok := accountHandler.(state.UserAccountHandler)
@@ -102,12 +94,17 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 		)
 	}
 
-	accountBalance := account.GetBalance()
+	feePayerAddress, feePayerAccount, err := txv.getFeePayer(interceptedTx, account)
+	if err != nil {
+		return err
+	}
+
+	accountBalance := feePayerAccount.GetBalance()
 	txFee := interceptedTx.Fee()
 	if accountBalance.Cmp(txFee) < 0 {
 		return fmt.Errorf("%w, for address: %s, wanted %v, have %v",
 			process.ErrInsufficientFunds,
-			txv.pubkeyConverter.Encode(senderAddress),
+			txv.pubkeyConverter.Encode(feePayerAddress),
 			txFee,
 			accountBalance,
 		)
@@ -116,6 +113,58 @@ func (txv *txValidator) CheckTxValidity(interceptedTx process.TxValidatorHandler
 	return nil
 }
 
+func (txv *txValidator) checkNonce(accountNonce uint64, txNonce uint64) error {
+	lowerNonceInTx := txNonce < accountNonce
+	veryHighNonceInTx := txNonce > accountNonce+uint64(txv.maxNonceDeltaAllowed)
+	isTxRejected := lowerNonceInTx || veryHighNonceInTx
+	if isTxRejected {
+		return fmt.Errorf("%w lowerNonceInTx: %v, veryHighNonceInTx: %v",
+			process.ErrWrongTransaction,
+			lowerNonceInTx,
+			veryHighNonceInTx,
+		)
+	}
+
+	return nil
+}
+
+// getFeePayer returns the account that pays the fees of the provided transaction: the relayer of a relayed
+// transaction v3 or the sender otherwise
+func (txv *txValidator) getFeePayer(
+	interceptedTx process.TxValidatorHandler,
+	senderAccount state.UserAccountHandler,
+) ([]byte, state.UserAccountHandler, error) {
+	relayedTx, ok := interceptedTx.(process.RelayedTxValidatorHandler)
+	if !ok || len(relayedTx.RelayerAddress()) == 0 {
+		return interceptedTx.SenderAddress(), senderAccount, nil
+	}
+
+	relayerAddress := relayedTx.RelayerAddress()
+	accountHandler, err := txv.accounts.GetExistingAccount(relayerAddress)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for relayer address %s, err: %s",
+			process.ErrAccountNotFound,
+			txv.pubkeyConverter.Encode(relayerAddress),
+			err.Error(),
+		)
+	}
+
+	relayerAccount, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return nil, nil, fmt.Errorf("%w, account is not of type *state.Account, address: %s",
+			process.ErrWrongTypeAssertion,
+			txv.pubkeyConverter.Encode(relayerAddress),
+		)
+	}
+
+	err = txv.checkNonce(relayerAccount.GetNonce(), relayedTx.RelayerNonce())
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for relayer address %s", err, txv.pubkeyConverter.Encode(relayerAddress))
+	}
+
+	return relayerAddress, relayerAccount, nil
+}
+
 // CheckTxWhiteList will check if the cross shard transactions are whitelisted and could be added in pools
 func (txv *txValidator) CheckTxWhiteList(data process.InterceptedData) error {
 	interceptedTx, ok := data.(processor.InterceptedTransactionHandler)
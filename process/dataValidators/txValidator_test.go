@@ -373,6 +373,72 @@ func TestTxValidator_CheckTxValidityTxIsOkShouldReturnTrue(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func getRelayedTxValidatorHandler(nonce uint64, relayerNonce uint64) process.TxValidatorHandler {
+	return &mock.RelayedTxValidatorHandlerStub{
+		TxValidatorHandlerStub: mock.TxValidatorHandlerStub{
+			SenderShardIdCalled: func() uint32 {
+				return 0
+			},
+			ReceiverShardIdCalled: func() uint32 {
+				return 0
+			},
+			NonceCalled: func() uint64 {
+				return nonce
+			},
+			SenderAddressCalled: func() []byte {
+				return []byte("sender")
+			},
+			FeeCalled: func() *big.Int {
+				return big.NewInt(0)
+			},
+		},
+		RelayerAddressCalled: func() []byte {
+			return []byte("relayer")
+		},
+		RelayerNonceCalled: func() uint64 {
+			return relayerNonce
+		},
+	}
+}
+
+func TestTxValidator_CheckTxValidityRelayedTxWrongRelayerNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(100)
+	maxNonceDeltaAllowed := 100
+	adb := getAccAdapter(accountNonce, big.NewInt(10))
+	txValidator, _ := dataValidators.NewTxValidator(
+		adb,
+		createMockCoordinator("_", 0),
+		&testscommon.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		maxNonceDeltaAllowed,
+	)
+
+	result := txValidator.CheckTxValidity(getRelayedTxValidatorHandler(accountNonce, accountNonce-1))
+	assert.True(t, errors.Is(result, process.ErrWrongTransaction))
+
+	result = txValidator.CheckTxValidity(getRelayedTxValidatorHandler(accountNonce, accountNonce+uint64(maxNonceDeltaAllowed)+1))
+	assert.True(t, errors.Is(result, process.ErrWrongTransaction))
+}
+
+func TestTxValidator_CheckTxValidityRelayedTxShouldWork(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(100)
+	adb := getAccAdapter(accountNonce, big.NewInt(10))
+	txValidator, _ := dataValidators.NewTxValidator(
+		adb,
+		createMockCoordinator("_", 0),
+		&testscommon.WhiteListHandlerStub{},
+		mock.NewPubkeyConverterMock(32),
+		100,
+	)
+
+	result := txValidator.CheckTxValidity(getRelayedTxValidatorHandler(accountNonce, accountNonce+1))
+	assert.Nil(t, result)
+}
+
 //------- IsInterfaceNil
 
 func TestTxValidator_IsInterfaceNil(t *testing.T) {
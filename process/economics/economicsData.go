@@ -41,6 +41,7 @@ type economicsData struct {
 	minGasPrice                      uint64
 	gasPriceModifier                 float64
 	minGasLimit                      uint64
+	mutFeeSettings                   sync.RWMutex
 	genesisTotalSupply               *big.Int
 	minInflation                     float64
 	yearSettings                     map[uint32]*config.YearSetting
@@ -270,6 +271,9 @@ func (ed *economicsData) GenesisTotalSupply() *big.Int {
 
 // MinGasPrice will return min gas price
 func (ed *economicsData) MinGasPrice() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.minGasPrice
 }
 
@@ -277,7 +281,7 @@ func (ed *economicsData) MinGasPrice() uint64 {
 func (ed *economicsData) MinGasPriceForProcessing() uint64 {
 	priceModifier := ed.GasPriceModifier()
 
-	return uint64(float64(ed.minGasPrice) * priceModifier)
+	return uint64(float64(ed.MinGasPrice()) * priceModifier)
 }
 
 // GasPriceModifier will return the gas price modifier
@@ -285,16 +289,26 @@ func (ed *economicsData) GasPriceModifier() float64 {
 	if !ed.flagGasPriceModifier.IsSet() {
 		return 1.0
 	}
+
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.gasPriceModifier
 }
 
 // MinGasLimit will return min gas limit
 func (ed *economicsData) MinGasLimit() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.minGasLimit
 }
 
 // GasPerDataByte will return the gas required for a economicsData byte
 func (ed *economicsData) GasPerDataByte() uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	return ed.gasPerDataByte
 }
 
@@ -371,7 +385,7 @@ func (ed *economicsData) SplitTxGasInCategories(tx process.TransactionWithFeeHan
 
 // CheckValidityTxValues checks if the provided transaction is economically correct
 func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHandler) error {
-	if ed.minGasPrice > tx.GetGasPrice() {
+	if ed.MinGasPrice() > tx.GetGasPrice() {
 		return process.ErrInsufficientGasPriceInTx
 	}
 
@@ -382,7 +396,7 @@ func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 		}
 	}
 
-	if tx.GetGasLimit() >= ed.maxGasLimitPerBlock {
+	if tx.GetGasLimit() >= ed.MaxGasLimitPerBlock(0) {
 		return process.ErrMoreGasThanGasLimitPerBlock
 	}
 
@@ -400,8 +414,8 @@ func (ed *economicsData) CheckValidityTxValues(tx process.TransactionWithFeeHand
 
 // MaxGasLimitPerBlock will return maximum gas limit allowed per block
 func (ed *economicsData) MaxGasLimitPerBlock(shardID uint32) uint64 {
-	ed.mutRewardsSettings.RLock()
-	defer ed.mutRewardsSettings.RUnlock()
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
 
 	if shardID == core.MetachainShardId {
 		return ed.maxGasLimitPerMetaBlock
@@ -451,6 +465,9 @@ func (ed *economicsData) RewardsTopUpFactor() float64 {
 
 // ComputeGasLimit returns the gas limit need by the provided transaction in order to be executed
 func (ed *economicsData) ComputeGasLimit(tx process.TransactionWithFeeHandler) uint64 {
+	ed.mutFeeSettings.RLock()
+	defer ed.mutFeeSettings.RUnlock()
+
 	gasLimit := ed.minGasLimit
 
 	dataLen := uint64(len(tx.GetData()))
@@ -579,6 +596,60 @@ func (ed *economicsData) setRewardsEpochConfig(currentEpoch uint32) {
 	)
 }
 
+// ApplyGovernanceParamChange changes one of the fee settings to the value accepted through governance
+func (ed *economicsData) ApplyGovernanceParamChange(name string, value string) error {
+	if name == "GasPriceModifier" {
+		gasPriceModifier, err := strconv.ParseFloat(value, 64)
+		if err != nil || gasPriceModifier > 1.0 || gasPriceModifier < epsilon {
+			return process.ErrInvalidGasModifier
+		}
+
+		ed.mutFeeSettings.Lock()
+		ed.gasPriceModifier = gasPriceModifier
+		ed.mutFeeSettings.Unlock()
+
+		ed.statusHandler.SetStringValue(core.MetricGasPriceModifier, fmt.Sprintf("%g", ed.GasPriceModifier()))
+		log.Debug("economics: fee setting changed through governance", "name", name, "value", value)
+		return nil
+	}
+
+	newValue, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w for %s", process.ErrInvalidGovernanceParamChange, name)
+	}
+
+	ed.mutFeeSettings.Lock()
+	defer ed.mutFeeSettings.Unlock()
+
+	switch name {
+	case "MinGasPrice":
+		ed.minGasPrice = newValue
+	case "MinGasLimit":
+		if newValue > ed.maxGasLimitPerBlock {
+			return process.ErrInvalidMaxGasLimitPerBlock
+		}
+		ed.minGasLimit = newValue
+	case "GasPerDataByte":
+		ed.gasPerDataByte = newValue
+	case "MaxGasLimitPerBlock":
+		if newValue < ed.minGasLimit {
+			return process.ErrInvalidMaxGasLimitPerBlock
+		}
+		ed.maxGasLimitPerBlock = newValue
+	case "MaxGasLimitPerMetaBlock":
+		if newValue < ed.minGasLimit {
+			return process.ErrInvalidMaxGasLimitPerBlock
+		}
+		ed.maxGasLimitPerMetaBlock = newValue
+	default:
+		return fmt.Errorf("%w, unknown fee setting %s", process.ErrInvalidGovernanceParamChange, name)
+	}
+
+	log.Debug("economics: fee setting changed through governance", "name", name, "value", value)
+
+	return nil
+}
+
 // ComputeGasLimitBasedOnBalance will compute gas limit for the given transaction based on the balance
 func (ed *economicsData) ComputeGasLimitBasedOnBalance(tx process.TransactionWithFeeHandler, balance *big.Int) (uint64, error) {
 	balanceWithoutTransferValue := big.NewInt(0).Sub(balance, tx.GetValue())
@@ -1,6 +1,7 @@
 package economics_test
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -825,3 +826,50 @@ func TestEconomicsData_ComputeGasLimitBasedOnBalance(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, uint64(11894070000), gasLimit)
 }
+
+func TestEconomicsData_ApplyGovernanceParamChangeInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	economicsData, _ := economics.NewEconomicsData(args)
+
+	err := economicsData.ApplyGovernanceParamChange("GasPriceModifier", "1.5")
+	assert.Equal(t, process.ErrInvalidGasModifier, err)
+
+	err = economicsData.ApplyGovernanceParamChange("MinGasPrice", "invalid")
+	assert.True(t, errors.Is(err, process.ErrInvalidGovernanceParamChange))
+
+	err = economicsData.ApplyGovernanceParamChange("UnknownSetting", "10")
+	assert.True(t, errors.Is(err, process.ErrInvalidGovernanceParamChange))
+
+	err = economicsData.ApplyGovernanceParamChange("MaxGasLimitPerBlock", "100")
+	assert.Equal(t, process.ErrInvalidMaxGasLimitPerBlock, err)
+	assert.Equal(t, uint64(100000), economicsData.MaxGasLimitPerBlock(0))
+}
+
+func TestEconomicsData_ApplyGovernanceParamChangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsForEconomicsData(1)
+	economicsData, _ := economics.NewEconomicsData(args)
+
+	err := economicsData.ApplyGovernanceParamChange("MinGasPrice", "1000")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1000), economicsData.MinGasPrice())
+
+	err = economicsData.ApplyGovernanceParamChange("MinGasLimit", "700")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(700), economicsData.MinGasLimit())
+
+	err = economicsData.ApplyGovernanceParamChange("GasPerDataByte", "3")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(703), economicsData.ComputeGasLimit(&transaction.Transaction{Data: []byte("a")}))
+
+	err = economicsData.ApplyGovernanceParamChange("MaxGasLimitPerMetaBlock", "2000000")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2000000), economicsData.MaxGasLimitPerBlock(core.MetachainShardId))
+
+	err = economicsData.ApplyGovernanceParamChange("GasPriceModifier", "0.5")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, economicsData.GasPriceModifier())
+}
@@ -884,6 +884,18 @@ var ErrRelayedTxV2Disabled = errors.New("relayed tx v2 is disabled")
 // ErrRelayedTxV2ZeroVal signals that the v2 version of relayed tx should be created with 0 as value
 var ErrRelayedTxV2ZeroVal = errors.New("relayed tx v2 value should be 0")
 
+// ErrRelayedTxV3Disabled signals that the v3 version of relayed tx is disabled
+var ErrRelayedTxV3Disabled = errors.New("relayed tx v3 is disabled")
+
+// ErrRelayedTxV3RelayerShardMismatch signals that the relayer of a relayed tx v3 is not in the same shard as the sender
+var ErrRelayedTxV3RelayerShardMismatch = errors.New("relayed tx v3 relayer is not in the sender's shard")
+
+// ErrRelayedTxV3RelayerIsSender signals that the relayer of a relayed tx v3 is the sender itself
+var ErrRelayedTxV3RelayerIsSender = errors.New("relayed tx v3 relayer should be different than the sender")
+
+// ErrInvalidRelayerAddress signals that an invalid relayer address was provided
+var ErrInvalidRelayerAddress = errors.New("invalid relayer address")
+
 // ErrEmptyConsensusGroup is raised when an operation is attempted with an empty consensus group
 var ErrEmptyConsensusGroup = errors.New("consensusGroup is empty")
 
@@ -973,3 +985,43 @@ var ErrNilAccountsDBSyncer = errors.New("nil accounts DB syncer")
 
 // ErrNilCurrentNetworkEpochProvider signals that a nil CurrentNetworkEpochProvider handler has been provided
 var ErrNilCurrentNetworkEpochProvider = errors.New("nil current network epoch provider")
+
+// ErrNilScheduledTxsExecutionHandler signals that a nil scheduled txs execution handler has been provided
+var ErrNilScheduledTxsExecutionHandler = errors.New("nil scheduled txs execution handler")
+
+// ErrNilScheduledSCRsStorer signals that a nil scheduled SCRs storer has been provided
+var ErrNilScheduledSCRsStorer = errors.New("nil scheduled SCRs storer")
+
+// ErrRelayedTxCostEstimationNotSupported signals that the cost of the inner transaction of a relayed transaction cannot be estimated
+var ErrRelayedTxCostEstimationNotSupported = errors.New("cost estimation is not supported for this type of relayed inner transaction")
+
+// ErrGovernanceParamChangesMismatch signals that the parameter changes from the epoch start block do not match the ones
+// accepted through governance
+var ErrGovernanceParamChangesMismatch = errors.New("governance param changes do not match")
+
+// ErrInvalidGovernanceParamChange signals that a network parameter change accepted through governance can not be applied
+var ErrInvalidGovernanceParamChange = errors.New("invalid governance param change")
+
+// ErrInvalidValidatorKeyRotation signals that a validator key can not be rotated to the provided key
+var ErrInvalidValidatorKeyRotation = errors.New("invalid validator key rotation")
+
+// ErrESDTAllowancesDisabled signals that the esdt allowances built-in functions are not yet enabled
+var ErrESDTAllowancesDisabled = errors.New("esdt allowances are disabled")
+
+// ErrInvalidESDTAllowanceArguments signals that an esdt allowance built-in function was called with invalid arguments
+var ErrInvalidESDTAllowanceArguments = errors.New("invalid esdt allowance arguments")
+
+// ErrESDTSetTokenTypeDisabled signals that the esdt set token type built-in function is not yet enabled
+var ErrESDTSetTokenTypeDisabled = errors.New("esdt set token type is disabled")
+
+// ErrInvalidESDTTokenType signals that an invalid esdt token type value was provided
+var ErrInvalidESDTTokenType = errors.New("invalid esdt token type")
+
+// ErrInsufficientESDTAllowance signals that the spender is not allowed to transfer the requested value
+var ErrInsufficientESDTAllowance = errors.New("insufficient esdt allowance")
+
+// ErrNilBlockProcessingReporter signals that a nil block processing reporter has been provided
+var ErrNilBlockProcessingReporter = errors.New("nil block processing reporter")
+
+// ErrNilSyncIntrospectionHandler signals that a nil sync introspection handler has been provided
+var ErrNilSyncIntrospectionHandler = errors.New("nil sync introspection handler")
@@ -31,5 +31,7 @@ type CommonInterceptorsContainerFactoryArgs struct {
 	PreferredPeersHolder      process.PreferredPeersHolderHandler
 	SizeCheckDelta            uint32
 	EnableSignTxWithHashEpoch uint32
+	EnableRelayedTxV3Epoch    uint32
 	RequestHandler            process.RequestHandler
+	SyncIntrospector          process.SyncIntrospectionHandler
 }
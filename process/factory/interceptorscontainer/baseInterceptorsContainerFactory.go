@@ -39,6 +39,7 @@ type baseInterceptorsContainerFactory struct {
 	preferredPeersHolder   process.PreferredPeersHolderHandler
 	hasher                 hashing.Hasher
 	requestHandler         process.RequestHandler
+	syncIntrospector       process.SyncIntrospectionHandler
 }
 
 func checkBaseParams(
@@ -56,6 +57,7 @@ func checkBaseParams(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	preferredPeersHolder process.PreferredPeersHolderHandler,
 	requestHandler process.RequestHandler,
+	syncIntrospector process.SyncIntrospectionHandler,
 ) error {
 	if check.IfNil(coreComponents) {
 		return process.ErrNilCoreComponentsHolder
@@ -138,6 +140,9 @@ func checkBaseParams(
 	if check.IfNil(requestHandler) {
 		return process.ErrNilRequestHandler
 	}
+	if check.IfNil(syncIntrospector) {
+		return process.ErrNilSyncIntrospectionHandler
+	}
 
 	return nil
 }
@@ -361,9 +366,10 @@ func (bicf *baseInterceptorsContainerFactory) generateHeaderInterceptors() error
 	}
 
 	argProcessor := &processor.ArgHdrInterceptorProcessor{
-		Headers:        bicf.dataPool.Headers(),
-		HdrValidator:   hdrValidator,
-		BlockBlackList: bicf.blockBlackList,
+		Headers:          bicf.dataPool.Headers(),
+		HdrValidator:     hdrValidator,
+		BlockBlackList:   bicf.blockBlackList,
+		SyncIntrospector: bicf.syncIntrospector,
 	}
 	hdrProcessor, err := processor.NewHdrInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -498,9 +504,10 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 	}
 
 	argProcessor := &processor.ArgHdrInterceptorProcessor{
-		Headers:        bicf.dataPool.Headers(),
-		HdrValidator:   hdrValidator,
-		BlockBlackList: bicf.blockBlackList,
+		Headers:          bicf.dataPool.Headers(),
+		HdrValidator:     hdrValidator,
+		BlockBlackList:   bicf.blockBlackList,
+		SyncIntrospector: bicf.syncIntrospector,
 	}
 	hdrProcessor, err := processor.NewHdrInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -40,6 +40,7 @@ func NewMetaInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.SyncIntrospector,
 	)
 	if err != nil {
 		return nil, err
@@ -84,6 +85,7 @@ func NewMetaInterceptorsContainerFactory(
 		WhiteListerVerifiedTxs:    args.WhiteListerVerifiedTxs,
 		ArgsParser:                args.ArgumentsParser,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    args.EnableRelayedTxV3Epoch,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -104,6 +106,7 @@ func NewMetaInterceptorsContainerFactory(
 		preferredPeersHolder:   args.PreferredPeersHolder,
 		hasher:                 args.CoreComponents.Hasher(),
 		requestHandler:         args.RequestHandler,
+		syncIntrospector:       args.SyncIntrospector,
 	}
 
 	icf := &metaInterceptorsContainerFactory{
@@ -220,9 +223,10 @@ func (micf *metaInterceptorsContainerFactory) createOneShardHeaderInterceptor(to
 	}
 
 	argProcessor := &processor.ArgHdrInterceptorProcessor{
-		Headers:        micf.dataPool.Headers(),
-		HdrValidator:   hdrValidator,
-		BlockBlackList: micf.blockBlackList,
+		Headers:          micf.dataPool.Headers(),
+		HdrValidator:     hdrValidator,
+		BlockBlackList:   micf.blockBlackList,
+		SyncIntrospector: micf.syncIntrospector,
 	}
 	hdrProcessor, err := processor.NewHdrInterceptorProcessor(argProcessor)
 	if err != nil {
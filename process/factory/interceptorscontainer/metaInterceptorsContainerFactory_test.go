@@ -378,6 +378,18 @@ func TestNewMetaInterceptorsContainerFactory_NilRequestHandlerShouldErr(t *testi
 	assert.Equal(t, process.ErrNilRequestHandler, err)
 }
 
+func TestNewMetaInterceptorsContainerFactory_NilSyncIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsMeta(coreComp, cryptoComp)
+	args.SyncIntrospector = nil
+	icf, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilSyncIntrospectionHandler, err)
+}
+
 func TestNewMetaInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -574,5 +586,6 @@ func getArgumentsMeta(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		SyncIntrospector:        &mock.SyncIntrospectionHandlerStub{},
 	}
 }
@@ -37,6 +37,7 @@ func NewShardInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.SyncIntrospector,
 	)
 	if err != nil {
 		return nil, err
@@ -82,6 +83,7 @@ func NewShardInterceptorsContainerFactory(
 		WhiteListerVerifiedTxs:    args.WhiteListerVerifiedTxs,
 		ArgsParser:                args.ArgumentsParser,
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    args.EnableRelayedTxV3Epoch,
 	}
 
 	container := containers.NewInterceptorsContainer()
@@ -102,6 +104,7 @@ func NewShardInterceptorsContainerFactory(
 		preferredPeersHolder:   args.PreferredPeersHolder,
 		hasher:                 args.CoreComponents.Hasher(),
 		requestHandler:         args.RequestHandler,
+		syncIntrospector:       args.SyncIntrospector,
 	}
 
 	icf := &shardInterceptorsContainerFactory{
@@ -32,6 +32,9 @@ type preProcessorsContainerFactory struct {
 	pubkeyConverter      core.PubkeyConverter
 	blockSizeComputation preprocess.BlockSizeComputationHandler
 	balanceComputation   preprocess.BalanceComputationHandler
+
+	epochNotifier                  process.EpochNotifier
+	scheduledMiniBlocksEnableEpoch uint32
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -51,6 +54,8 @@ func NewPreProcessorsContainerFactory(
 	pubkeyConverter core.PubkeyConverter,
 	blockSizeComputation preprocess.BlockSizeComputationHandler,
 	balanceComputation preprocess.BalanceComputationHandler,
+	epochNotifier process.EpochNotifier,
+	scheduledMiniBlocksEnableEpoch uint32,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -98,6 +103,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:     shardCoordinator,
@@ -115,6 +123,9 @@ func NewPreProcessorsContainerFactory(
 		pubkeyConverter:      pubkeyConverter,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+
+		epochNotifier:                  epochNotifier,
+		scheduledMiniBlocksEnableEpoch: scheduledMiniBlocksEnableEpoch,
 	}, nil
 }
 
@@ -162,6 +173,8 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.epochNotifier,
+		ppcm.scheduledMiniBlocksEnableEpoch,
 	)
 
 	return txPreprocessor, err
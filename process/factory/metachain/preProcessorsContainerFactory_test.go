@@ -30,6 +30,8 @@ func TestNewPreProcessorsContainerFactory_NilShardCoordinator(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -55,6 +57,8 @@ func TestNewPreProcessorsContainerFactory_NilStore(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilStore, err)
@@ -80,6 +84,8 @@ func TestNewPreProcessorsContainerFactory_NilMarshalizer(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -105,6 +111,8 @@ func TestNewPreProcessorsContainerFactory_NilHasher(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -130,6 +138,8 @@ func TestNewPreProcessorsContainerFactory_NilDataPool(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -155,6 +165,8 @@ func TestNewPreProcessorsContainerFactory_NilAccounts(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -180,6 +192,8 @@ func TestNewPreProcessorsContainerFactory_NilFeeHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -205,6 +219,8 @@ func TestNewPreProcessorsContainerFactory_NilTxProcessor(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilTxProcessor, err)
@@ -230,6 +246,8 @@ func TestNewPreProcessorsContainerFactory_NilRequestHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilRequestHandler, err)
 	assert.Nil(t, ppcm)
@@ -254,6 +272,8 @@ func TestNewPreProcessorsContainerFactory_NilGasHandler(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilGasHandler, err)
 	assert.Nil(t, ppcm)
@@ -278,6 +298,8 @@ func TestNewPreProcessorsContainerFactory_NilBlockTracker(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilBlockTracker, err)
 	assert.Nil(t, ppcm)
@@ -302,6 +324,8 @@ func TestNewPreProcessorsContainerFactory_NilPubkeyConverter(t *testing.T) {
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
 	assert.Nil(t, ppcm)
@@ -326,6 +350,8 @@ func TestNewPreProcessorsContainerFactory_NilBlockSizeComputationHandler(t *test
 		createMockPubkeyConverter(),
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilBlockSizeComputationHandler, err)
 	assert.Nil(t, ppcm)
@@ -350,11 +376,39 @@ func TestNewPreProcessorsContainerFactory_NilBalanceComputationHandler(t *testin
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.EpochNotifierStub{},
+		0,
 	)
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 	assert.Nil(t, ppcm)
 }
 
+func TestNewPreProcessorsContainerFactory_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	ppcm, err := metachain.NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.ChainStorerMock{},
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		testscommon.NewPoolsHolderMock(),
+		&testscommon.AccountsStub{},
+		&testscommon.RequestHandlerStub{},
+		&testscommon.TxProcessorMock{},
+		&testscommon.SmartContractResultsProcessorMock{},
+		&mock.FeeHandlerStub{},
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		createMockPubkeyConverter(),
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+		0,
+	)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, ppcm)
+}
+
 func TestNewPreProcessorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
@@ -374,6 +428,8 @@ func TestNewPreProcessorsContainerFactory(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -405,6 +461,8 @@ func TestPreProcessorsContainerFactory_CreateErrTxPreproc(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -434,6 +492,8 @@ func TestPreProcessorsContainerFactory_Create(t *testing.T) {
 		createMockPubkeyConverter(),
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
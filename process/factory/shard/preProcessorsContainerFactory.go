@@ -34,6 +34,9 @@ type preProcessorsContainerFactory struct {
 	blockTracker         preprocess.BlockTracker
 	blockSizeComputation preprocess.BlockSizeComputationHandler
 	balanceComputation   preprocess.BalanceComputationHandler
+
+	epochNotifier                  process.EpochNotifier
+	scheduledMiniBlocksEnableEpoch uint32
 }
 
 // NewPreProcessorsContainerFactory is responsible for creating a new preProcessors factory object
@@ -55,6 +58,8 @@ func NewPreProcessorsContainerFactory(
 	blockTracker preprocess.BlockTracker,
 	blockSizeComputation preprocess.BlockSizeComputationHandler,
 	balanceComputation preprocess.BalanceComputationHandler,
+	epochNotifier process.EpochNotifier,
+	scheduledMiniBlocksEnableEpoch uint32,
 ) (*preProcessorsContainerFactory, error) {
 
 	if check.IfNil(shardCoordinator) {
@@ -108,6 +113,9 @@ func NewPreProcessorsContainerFactory(
 	if check.IfNil(balanceComputation) {
 		return nil, process.ErrNilBalanceComputationHandler
 	}
+	if check.IfNil(epochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	return &preProcessorsContainerFactory{
 		shardCoordinator:     shardCoordinator,
@@ -127,6 +135,9 @@ func NewPreProcessorsContainerFactory(
 		blockTracker:         blockTracker,
 		blockSizeComputation: blockSizeComputation,
 		balanceComputation:   balanceComputation,
+
+		epochNotifier:                  epochNotifier,
+		scheduledMiniBlocksEnableEpoch: scheduledMiniBlocksEnableEpoch,
 	}, nil
 }
 
@@ -194,6 +205,8 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		ppcm.pubkeyConverter,
 		ppcm.blockSizeComputation,
 		ppcm.balanceComputation,
+		ppcm.epochNotifier,
+		ppcm.scheduledMiniBlocksEnableEpoch,
 	)
 
 	return txPreprocessor, err
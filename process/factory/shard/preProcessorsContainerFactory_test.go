@@ -35,6 +35,8 @@ func TestNewPreProcessorsContainerFactory_NilShardCoordinator(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -62,6 +64,8 @@ func TestNewPreProcessorsContainerFactory_NilStore(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilStore, err)
@@ -89,6 +93,8 @@ func TestNewPreProcessorsContainerFactory_NilMarshalizer(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilMarshalizer, err)
@@ -116,6 +122,8 @@ func TestNewPreProcessorsContainerFactory_NilHasher(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilHasher, err)
@@ -143,6 +151,8 @@ func TestNewPreProcessorsContainerFactory_NilDataPool(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilDataPoolHolder, err)
@@ -170,6 +180,8 @@ func TestNewPreProcessorsContainerFactory_NilAddrConv(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilPubkeyConverter, err)
@@ -197,6 +209,8 @@ func TestNewPreProcessorsContainerFactory_NilAccounts(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilAccountsAdapter, err)
@@ -224,6 +238,8 @@ func TestNewPreProcessorsContainerFactory_NilTxProcessor(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilTxProcessor, err)
@@ -251,6 +267,8 @@ func TestNewPreProcessorsContainerFactory_NilSCProcessor(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractProcessor, err)
@@ -278,6 +296,8 @@ func TestNewPreProcessorsContainerFactory_NilSCR(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilSmartContractResultProcessor, err)
@@ -305,6 +325,8 @@ func TestNewPreProcessorsContainerFactory_NilRewardTxProcessor(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilRewardsTxProcessor, err)
@@ -332,6 +354,8 @@ func TestNewPreProcessorsContainerFactory_NilRequestHandler(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilRequestHandler, err)
@@ -359,6 +383,8 @@ func TestNewPreProcessorsContainerFactory_NilFeeHandler(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -386,6 +412,8 @@ func TestNewPreProcessorsContainerFactory_NilGasHandler(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilGasHandler, err)
@@ -413,6 +441,8 @@ func TestNewPreProcessorsContainerFactory_NilBlockTracker(t *testing.T) {
 		nil,
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilBlockTracker, err)
@@ -440,6 +470,8 @@ func TestNewPreProcessorsContainerFactory_NilBlockSizeComputationHandler(t *test
 		&mock.BlockTrackerMock{},
 		nil,
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilBlockSizeComputationHandler, err)
@@ -467,12 +499,43 @@ func TestNewPreProcessorsContainerFactory_NilBalanceComputationHandler(t *testin
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		nil,
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Equal(t, process.ErrNilBalanceComputationHandler, err)
 	assert.Nil(t, ppcm)
 }
 
+func TestNewPreProcessorsContainerFactory_NilEpochNotifier(t *testing.T) {
+	t.Parallel()
+
+	ppcm, err := NewPreProcessorsContainerFactory(
+		mock.NewMultiShardsCoordinatorMock(3),
+		&mock.ChainStorerMock{},
+		&mock.MarshalizerMock{},
+		&mock.HasherMock{},
+		testscommon.NewPoolsHolderMock(),
+		createMockPubkeyConverter(),
+		&testscommon.AccountsStub{},
+		&testscommon.RequestHandlerStub{},
+		&testscommon.TxProcessorMock{},
+		&testscommon.SCProcessorMock{},
+		&testscommon.SmartContractResultsProcessorMock{},
+		&testscommon.RewardTxProcessorMock{},
+		&mock.FeeHandlerStub{},
+		&mock.GasHandlerMock{},
+		&mock.BlockTrackerMock{},
+		&mock.BlockSizeComputationStub{},
+		&mock.BalanceComputationStub{},
+		nil,
+		0,
+	)
+
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, ppcm)
+}
+
 func TestNewPreProcessorsContainerFactory(t *testing.T) {
 	t.Parallel()
 
@@ -494,6 +557,8 @@ func TestNewPreProcessorsContainerFactory(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -526,6 +591,8 @@ func TestPreProcessorsContainerFactory_CreateErrTxPreproc(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -564,6 +631,8 @@ func TestPreProcessorsContainerFactory_CreateErrScrPreproc(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
@@ -605,6 +674,8 @@ func TestPreProcessorsContainerFactory_Create(t *testing.T) {
 		&mock.BlockTrackerMock{},
 		&mock.BlockSizeComputationStub{},
 		&mock.BalanceComputationStub{},
+		&mock.EpochNotifierStub{},
+		0,
 	)
 
 	assert.Nil(t, err)
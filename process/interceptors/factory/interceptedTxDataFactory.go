@@ -31,10 +31,12 @@ type interceptedTxDataFactory struct {
 	chainID                     []byte
 	minTransactionVersion       uint32
 	enableSignedTxWithHashEpoch uint32
+	enableRelayedTxV3Epoch      uint32
 	epochStartTrigger           process.EpochStartTriggerHandler
 	txSignHasher                hashing.Hasher
 	txVersionChecker            process.TxVersionCheckerHandler
 	flagEnableSignedTxWithHash  atomic.Flag
+	flagEnableRelayedTxV3       atomic.Flag
 }
 
 // NewInterceptedTxDataFactory creates an instance of interceptedTxDataFactory
@@ -109,6 +111,7 @@ func NewInterceptedTxDataFactory(argument *ArgInterceptedDataFactory) (*intercep
 		minTransactionVersion:       argument.CoreComponents.MinTransactionVersion(),
 		epochStartTrigger:           argument.EpochStartTrigger,
 		enableSignedTxWithHashEpoch: argument.EnableSignTxWithHashEpoch,
+		enableRelayedTxV3Epoch:      argument.EnableRelayedTxV3Epoch,
 		txSignHasher:                argument.CoreComponents.TxSignHasher(),
 		txVersionChecker:            argument.CoreComponents.TxVersionChecker(),
 	}
@@ -136,6 +139,7 @@ func (itdf *interceptedTxDataFactory) Create(buff []byte) (process.InterceptedDa
 		itdf.flagEnableSignedTxWithHash.IsSet(),
 		itdf.txSignHasher,
 		itdf.txVersionChecker,
+		itdf.flagEnableRelayedTxV3.IsSet(),
 	)
 }
 
@@ -148,4 +152,7 @@ func (itdf *interceptedTxDataFactory) IsInterfaceNil() bool {
 func (itdf *interceptedTxDataFactory) EpochConfirmed(epoch uint32, _ uint64) {
 	itdf.flagEnableSignedTxWithHash.Toggle(epoch >= itdf.enableSignedTxWithHashEpoch)
 	log.Debug("interceptors: transaction signed with hash", "enabled", itdf.flagEnableSignedTxWithHash.IsSet())
+
+	itdf.flagEnableRelayedTxV3.Toggle(epoch >= itdf.enableRelayedTxV3Epoch)
+	log.Debug("interceptors: relayed transactions v3", "enabled", itdf.flagEnableRelayedTxV3.IsSet())
 }
@@ -7,7 +7,8 @@ import (
 
 // ArgHdrInterceptorProcessor is the argument for the interceptor processor used for headers (shard, meta and so on)
 type ArgHdrInterceptorProcessor struct {
-	Headers        dataRetriever.HeadersPool
-	HdrValidator   process.HeaderValidator
-	BlockBlackList process.TimeCacher
+	Headers          dataRetriever.HeadersPool
+	HdrValidator     process.HeaderValidator
+	BlockBlackList   process.TimeCacher
+	SyncIntrospector process.SyncIntrospectionHandler
 }
@@ -18,6 +18,7 @@ type HdrInterceptorProcessor struct {
 	headers            dataRetriever.HeadersPool
 	hdrValidator       process.HeaderValidator
 	blackList          process.TimeCacher
+	syncIntrospector   process.SyncIntrospectionHandler
 	registeredHandlers []func(topic string, hash []byte, data interface{})
 	mutHandlers        sync.RWMutex
 }
@@ -36,11 +37,15 @@ func NewHdrInterceptorProcessor(argument *ArgHdrInterceptorProcessor) (*HdrInter
 	if check.IfNil(argument.BlockBlackList) {
 		return nil, process.ErrNilBlackListCacher
 	}
+	if check.IfNil(argument.SyncIntrospector) {
+		return nil, process.ErrNilSyncIntrospectionHandler
+	}
 
 	return &HdrInterceptorProcessor{
 		headers:            argument.Headers,
 		hdrValidator:       argument.HdrValidator,
 		blackList:          argument.BlockBlackList,
+		syncIntrospector:   argument.SyncIntrospector,
 		registeredHandlers: make([]func(topic string, hash []byte, data interface{}), 0),
 	}, nil
 }
@@ -63,12 +68,14 @@ func (hip *HdrInterceptorProcessor) Validate(data process.InterceptedData, _ cor
 
 // Save will save the received data into the headers cacher as hash<->[plain header structure]
 // and in headersNonces as nonce<->hash
-func (hip *HdrInterceptorProcessor) Save(data process.InterceptedData, _ core.PeerID, topic string) error {
+func (hip *HdrInterceptorProcessor) Save(data process.InterceptedData, fromConnectedPeer core.PeerID, topic string) error {
 	interceptedHdr, ok := data.(process.HdrValidatorHandler)
 	if !ok {
 		return process.ErrWrongTypeAssertion
 	}
 
+	hip.syncIntrospector.AddHeaderSupplier(fromConnectedPeer, interceptedHdr.HeaderHandler())
+
 	go hip.notify(interceptedHdr.HeaderHandler(), interceptedHdr.Hash(), topic)
 
 	hip.headers.AddHeader(interceptedHdr.Hash(), interceptedHdr.HeaderHandler())
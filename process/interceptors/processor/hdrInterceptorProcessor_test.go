@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -16,9 +17,10 @@ import (
 
 func createMockHdrArgument() *processor.ArgHdrInterceptorProcessor {
 	arg := &processor.ArgHdrInterceptorProcessor{
-		Headers:        &mock.HeadersCacherStub{},
-		HdrValidator:   &mock.HeaderValidatorStub{},
-		BlockBlackList: &mock.BlackListHandlerStub{},
+		Headers:          &mock.HeadersCacherStub{},
+		HdrValidator:     &mock.HeaderValidatorStub{},
+		BlockBlackList:   &mock.BlackListHandlerStub{},
+		SyncIntrospector: &mock.SyncIntrospectionHandlerStub{},
 	}
 
 	return arg
@@ -68,6 +70,17 @@ func TestNewHdrInterceptorProcessor_NilBlackListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlackListCacher, err)
 }
 
+func TestNewHdrInterceptorProcessor_NilSyncIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockHdrArgument()
+	arg.SyncIntrospector = nil
+	hip, err := processor.NewHdrInterceptorProcessor(arg)
+
+	assert.Nil(t, hip)
+	assert.Equal(t, process.ErrNilSyncIntrospectionHandler, err)
+}
+
 func TestNewHdrInterceptorProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -190,16 +203,24 @@ func TestHdrInterceptorProcessor_SaveShouldWork(t *testing.T) {
 		},
 	}
 
+	var headerSupplier core.PeerID
+	arg.SyncIntrospector = &mock.SyncIntrospectionHandlerStub{
+		AddHeaderSupplierCalled: func(pid core.PeerID, header data.HeaderHandler) {
+			headerSupplier = pid
+		},
+	}
+
 	hip, _ := processor.NewHdrInterceptorProcessor(arg)
 	chanCalled := make(chan struct{}, 1)
 	hip.RegisterHandler(func(topic string, hash []byte, data interface{}) {
 		chanCalled <- struct{}{}
 	})
 
-	err := hip.Save(hdrInterceptedData, "", "")
+	err := hip.Save(hdrInterceptedData, "pid", "")
 
 	assert.Nil(t, err)
 	assert.True(t, wasAddedHeaders)
+	assert.Equal(t, core.PeerID("pid"), headerSupplier)
 
 	timeout := time.Second * 2
 	select {
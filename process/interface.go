@@ -8,11 +8,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -75,6 +77,14 @@ type TxValidatorHandler interface {
 	Fee() *big.Int
 }
 
+// RelayedTxValidatorHandler defines the extra functionality needed for a TxValidator to validate a transaction
+// which has its fees paid by a relayer
+type RelayedTxValidatorHandler interface {
+	TxValidatorHandler
+	RelayerAddress() []byte
+	RelayerNonce() uint64
+}
+
 // TxVersionCheckerHandler defines the functionality that is needed for a TxVersionChecker to validate transaction version
 type TxVersionCheckerHandler interface {
 	IsSignedWithHash(tx *transaction.Transaction) bool
@@ -260,6 +270,7 @@ type ValidatorStatisticsProcessor interface {
 	LastFinalizedRootHash() []byte
 	PeerAccountToValidatorInfo(peerAccount state.PeerAccountHandler) *state.ValidatorInfo
 	SaveNodesCoordinatorUpdates(epoch uint32) (bool, error)
+	RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error)
 }
 
 // TransactionLogProcessor is the main interface for saving logs generated by smart contract calls
@@ -344,6 +355,7 @@ type ForkDetector interface {
 	GetNotarizedHeaderHash(nonce uint64) []byte
 	ResetProbableHighestNonce()
 	SetFinalToLastCheckpoint()
+	GetStatus() *api.ForkDetectorStatus
 	IsInterfaceNil() bool
 }
 
@@ -877,6 +889,7 @@ type EpochStartSystemSCProcessor interface {
 		rewardTxs epochStart.TransactionCacher,
 	) error
 	ToggleUnStakeUnBond(value bool) error
+	ProcessGovernanceParamChanges(epoch uint32) ([]block.GovernanceParamChange, error)
 	IsInterfaceNil() bool
 }
 
@@ -1129,3 +1142,37 @@ type CurrentNetworkEpochProviderHandler interface {
 	EpochIsActiveInNetwork(epoch uint32) bool
 	IsInterfaceNil() bool
 }
+
+// ScheduledTxsExecutionHandler defines the functionality for the execution of the scheduled transactions
+type ScheduledTxsExecutionHandler interface {
+	Init()
+	AddScheduledTx(txHash []byte, tx data.TransactionHandler) bool
+	Execute(headerHash []byte) error
+	ApplyScheduledResults(headerHash []byte) error
+	GetScheduledSCRs() []data.TransactionHandler
+	GetScheduledGasAndFees() scheduled.GasAndFees
+	GetScheduledRootHash() []byte
+	GetScheduledRootHashForHeader(headerHash []byte) ([]byte, error)
+	RollBackToBlock(headerHash []byte) error
+	IsInterfaceNil() bool
+}
+
+// BlockProcessingReporter defines the component notified with the outcome of each processed block, before the
+// accounts state is reverted in case of a processing error
+type BlockProcessingReporter interface {
+	ReportProcessedBlock(header data.HeaderHandler, processingErr error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// SyncIntrospectionHandler defines the behavior of a component which gathers the information about the sync process:
+// the last computed sync state, the roll backs done together with their reasons and the peers supplying headers
+type SyncIntrospectionHandler interface {
+	SetSyncState(state *api.SyncState)
+	AddRollBack(rollBack *api.SyncRollBack)
+	AddHeaderSupplier(pid core.PeerID, header data.HeaderHandler)
+	SyncState() *api.SyncState
+	RollBacks() []*api.SyncRollBack
+	HeaderSuppliers() []*api.SyncHeaderSupplier
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,32 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// BlockProcessingReporterStub -
+type BlockProcessingReporterStub struct {
+	ReportProcessedBlockCalled func(header data.HeaderHandler, processingErr error)
+	CloseCalled                func() error
+}
+
+// ReportProcessedBlock -
+func (stub *BlockProcessingReporterStub) ReportProcessedBlock(header data.HeaderHandler, processingErr error) {
+	if stub.ReportProcessedBlockCalled != nil {
+		stub.ReportProcessedBlockCalled(header, processingErr)
+	}
+}
+
+// Close -
+func (stub *BlockProcessingReporterStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockProcessingReporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,18 @@
+package mock
+
+// RelayedTxValidatorHandlerStub -
+type RelayedTxValidatorHandlerStub struct {
+	TxValidatorHandlerStub
+	RelayerAddressCalled func() []byte
+	RelayerNonceCalled   func() uint64
+}
+
+// RelayerAddress -
+func (rtvhs *RelayedTxValidatorHandlerStub) RelayerAddress() []byte {
+	return rtvhs.RelayerAddressCalled()
+}
+
+// RelayerNonce -
+func (rtvhs *RelayedTxValidatorHandlerStub) RelayerNonce() uint64 {
+	return rtvhs.RelayerNonceCalled()
+}
@@ -0,0 +1,70 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// SyncIntrospectionHandlerStub -
+type SyncIntrospectionHandlerStub struct {
+	SetSyncStateCalled      func(state *api.SyncState)
+	AddRollBackCalled       func(rollBack *api.SyncRollBack)
+	AddHeaderSupplierCalled func(pid core.PeerID, header data.HeaderHandler)
+	SyncStateCalled         func() *api.SyncState
+	RollBacksCalled         func() []*api.SyncRollBack
+	HeaderSuppliersCalled   func() []*api.SyncHeaderSupplier
+}
+
+// SetSyncState -
+func (stub *SyncIntrospectionHandlerStub) SetSyncState(state *api.SyncState) {
+	if stub.SetSyncStateCalled != nil {
+		stub.SetSyncStateCalled(state)
+	}
+}
+
+// AddRollBack -
+func (stub *SyncIntrospectionHandlerStub) AddRollBack(rollBack *api.SyncRollBack) {
+	if stub.AddRollBackCalled != nil {
+		stub.AddRollBackCalled(rollBack)
+	}
+}
+
+// AddHeaderSupplier -
+func (stub *SyncIntrospectionHandlerStub) AddHeaderSupplier(pid core.PeerID, header data.HeaderHandler) {
+	if stub.AddHeaderSupplierCalled != nil {
+		stub.AddHeaderSupplierCalled(pid, header)
+	}
+}
+
+// SyncState -
+func (stub *SyncIntrospectionHandlerStub) SyncState() *api.SyncState {
+	if stub.SyncStateCalled != nil {
+		return stub.SyncStateCalled()
+	}
+
+	return &api.SyncState{}
+}
+
+// RollBacks -
+func (stub *SyncIntrospectionHandlerStub) RollBacks() []*api.SyncRollBack {
+	if stub.RollBacksCalled != nil {
+		return stub.RollBacksCalled()
+	}
+
+	return make([]*api.SyncRollBack, 0)
+}
+
+// HeaderSuppliers -
+func (stub *SyncIntrospectionHandlerStub) HeaderSuppliers() []*api.SyncHeaderSupplier {
+	if stub.HeaderSuppliersCalled != nil {
+		return stub.HeaderSuppliersCalled()
+	}
+
+	return make([]*api.SyncHeaderSupplier, 0)
+}
+
+// IsInterfaceNil -
+func (stub *SyncIntrospectionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -19,6 +19,15 @@ type ValidatorStatisticsProcessorStub struct {
 	CommitCalled                             func() ([]byte, error)
 	PeerAccountToValidatorInfoCalled         func(peerAccount state.PeerAccountHandler) *state.ValidatorInfo
 	SaveNodesCoordinatorUpdatesCalled        func(epoch uint32) (bool, error)
+	RotatePeerAccountKeyCalled               func(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error)
+}
+
+// RotatePeerAccountKey -
+func (vsp *ValidatorStatisticsProcessorStub) RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error) {
+	if vsp.RotatePeerAccountKeyCalled != nil {
+		return vsp.RotatePeerAccountKeyCalled(oldKey, newKey)
+	}
+	return nil, nil
 }
 
 // SaveNodesCoordinatorUpdates -
@@ -1,6 +1,7 @@
 package peer
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"math"
@@ -691,6 +692,59 @@ func (vs *validatorStatistics) ResetValidatorStatisticsAtNewEpoch(vInfos map[uin
 	return nil
 }
 
+// RotatePeerAccountKey moves the peer account of the old BLS key (rating, temp rating, list, shard and the collected
+// statistics) under the new BLS key and removes the old peer account
+func (vs *validatorStatistics) RotatePeerAccountKey(oldKey []byte, newKey []byte) (state.PeerAccountHandler, error) {
+	if len(oldKey) == 0 || len(newKey) == 0 || bytes.Equal(oldKey, newKey) {
+		return nil, process.ErrInvalidValidatorKeyRotation
+	}
+
+	account, err := vs.peerAdapter.GetExistingAccount(oldKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w for old key %s", err, hex.EncodeToString(oldKey))
+	}
+
+	buff, err := vs.marshalizer.Marshal(account)
+	if err != nil {
+		return nil, err
+	}
+
+	newPeerAccount, err := vs.loadPeerAccount(newKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vs.marshalizer.Unmarshal(newPeerAccount, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	err = newPeerAccount.SetBLSPublicKey(newKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vs.peerAdapter.SaveAccount(newPeerAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vs.peerAdapter.RemoveAccount(oldKey)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("rotated validator key",
+		"old key", oldKey,
+		"new key", newKey,
+		"list", newPeerAccount.GetList(),
+		"shard", newPeerAccount.GetShardId(),
+		"tempRating", newPeerAccount.GetTempRating(),
+	)
+
+	return newPeerAccount, nil
+}
+
 func (vs *validatorStatistics) setToJailedIfNeeded(
 	peerAccount state.PeerAccountHandler,
 	validator *state.ValidatorInfo,
@@ -2569,3 +2569,84 @@ func TestValidatorStatisticsProcessor_getActualList(t *testing.T) {
 	computedJailedList := peer.GetActualList(jailedPeer)
 	assert.Equal(t, jailedList, computedJailedList)
 }
+
+func TestValidatorStatistics_RotatePeerAccountKeyInvalidKeysShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(createMockArguments())
+
+	account, err := validatorStatistics.RotatePeerAccountKey([]byte("key"), []byte("key"))
+	assert.Nil(t, account)
+	assert.Equal(t, process.ErrInvalidValidatorKeyRotation, err)
+
+	account, err = validatorStatistics.RotatePeerAccountKey(nil, []byte("key"))
+	assert.Nil(t, account)
+	assert.Equal(t, process.ErrInvalidValidatorKeyRotation, err)
+}
+
+func TestValidatorStatistics_RotatePeerAccountKeyMissingOldAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("account not found")
+	arguments := createMockArguments()
+	peerAdapter := getAccountsMock()
+	peerAdapter.GetExistingAccountCalled = func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+		return nil, expectedErr
+	}
+	arguments.PeerAdapter = peerAdapter
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	account, err := validatorStatistics.RotatePeerAccountKey([]byte("oldKey"), []byte("newKey"))
+	assert.Nil(t, account)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestValidatorStatistics_RotatePeerAccountKeyShouldMoveTheAccount(t *testing.T) {
+	t.Parallel()
+
+	oldKey := []byte("oldKey")
+	newKey := []byte("newKey")
+	oldAccount, _ := state.NewPeerAccount(oldKey)
+	_ = oldAccount.SetBLSPublicKey(oldKey)
+	_ = oldAccount.SetRewardAddress([]byte("reward address"))
+	oldAccount.SetListAndIndex(2, string(core.EligibleList), 7)
+	oldAccount.SetRating(70)
+	oldAccount.SetTempRating(65)
+	oldAccount.IncreaseValidatorSuccessRate(3)
+
+	savedAccounts := make(map[string]state.PeerAccountHandler)
+	removedKeys := make([][]byte, 0)
+	arguments := createMockArguments()
+	peerAdapter := getAccountsMock()
+	arguments.PeerAdapter = peerAdapter
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	peerAdapter.GetExistingAccountCalled = func(addressContainer []byte) (vmcommon.AccountHandler, error) {
+		return oldAccount, nil
+	}
+	peerAdapter.LoadAccountCalled = func(address []byte) (vmcommon.AccountHandler, error) {
+		return state.NewPeerAccount(address)
+	}
+	peerAdapter.SaveAccountCalled = func(account vmcommon.AccountHandler) error {
+		savedAccounts[string(account.AddressBytes())] = account.(state.PeerAccountHandler)
+		return nil
+	}
+	peerAdapter.RemoveAccountCalled = func(addressContainer []byte) error {
+		removedKeys = append(removedKeys, addressContainer)
+		return nil
+	}
+
+	account, err := validatorStatistics.RotatePeerAccountKey(oldKey, newKey)
+	assert.Nil(t, err)
+	assert.Equal(t, account, savedAccounts[string(newKey)])
+	assert.Equal(t, [][]byte{oldKey}, removedKeys)
+
+	assert.Equal(t, newKey, account.GetBLSPublicKey())
+	assert.Equal(t, oldAccount.GetRewardAddress(), account.GetRewardAddress())
+	assert.Equal(t, uint32(2), account.GetShardId())
+	assert.Equal(t, string(core.EligibleList), account.GetList())
+	assert.Equal(t, uint32(7), account.GetIndexInList())
+	assert.Equal(t, uint32(70), account.GetRating())
+	assert.Equal(t, uint32(65), account.GetTempRating())
+	assert.Equal(t, uint32(3), account.GetValidatorSuccessRate().NumSuccess)
+}
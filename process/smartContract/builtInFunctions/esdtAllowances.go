@@ -0,0 +1,412 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
+)
+
+const lenArgumentsESDTAllowances = 3
+
+var log = logger.GetOrCreate("process/smartcontract/builtInFunctions")
+
+var zero = big.NewInt(0)
+
+// ArgsNewESDTAllowancesFunc defines the arguments needed to create the esdt allowances built-in functions
+type ArgsNewESDTAllowancesFunc struct {
+	FuncGasCost      uint64
+	Marshalizer      marshal.Marshalizer
+	PauseHandler     vmcommon.ESDTPauseHandler
+	Accounts         vmcommon.AccountsAdapter
+	ShardCoordinator sharding.Coordinator
+	EpochNotifier    process.EpochNotifier
+	EnableEpoch      uint32
+}
+
+// esdtAllowanceBase holds the components shared by the ESDTApprove and ESDTTransferFrom built-in functions
+type esdtAllowanceBase struct {
+	funcGasCost      uint64
+	marshalizer      marshal.Marshalizer
+	pauseHandler     vmcommon.ESDTPauseHandler
+	accounts         vmcommon.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+	enableEpoch      uint32
+	flagEnabled      atomic.Flag
+	mutExecution     sync.RWMutex
+}
+
+// esdtApprove sets the value of a fungible token which a spender is allowed to transfer from the caller's balance
+type esdtApprove struct {
+	*esdtAllowanceBase
+}
+
+// esdtTransferFrom transfers fungible tokens from the balance of an owner, within the allowance given to the caller
+type esdtTransferFrom struct {
+	*esdtAllowanceBase
+}
+
+// NewESDTApproveFunc returns the esdt approve built-in function component
+func NewESDTApproveFunc(args ArgsNewESDTAllowancesFunc) (*esdtApprove, error) {
+	base, err := newESDTAllowanceBase(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ea := &esdtApprove{esdtAllowanceBase: base}
+	args.EpochNotifier.RegisterNotifyHandler(ea)
+
+	return ea, nil
+}
+
+// NewESDTTransferFromFunc returns the esdt transfer from built-in function component
+func NewESDTTransferFromFunc(args ArgsNewESDTAllowancesFunc) (*esdtTransferFrom, error) {
+	base, err := newESDTAllowanceBase(args)
+	if err != nil {
+		return nil, err
+	}
+
+	etf := &esdtTransferFrom{esdtAllowanceBase: base}
+	args.EpochNotifier.RegisterNotifyHandler(etf)
+
+	return etf, nil
+}
+
+func newESDTAllowanceBase(args ArgsNewESDTAllowancesFunc) (*esdtAllowanceBase, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.PauseHandler) {
+		return nil, vmcommonBuiltInFunctions.ErrNilPauseHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	base := &esdtAllowanceBase{
+		funcGasCost:      args.FuncGasCost,
+		marshalizer:      args.Marshalizer,
+		pauseHandler:     args.PauseHandler,
+		accounts:         args.Accounts,
+		shardCoordinator: args.ShardCoordinator,
+		enableEpoch:      args.EnableEpoch,
+	}
+	log.Debug("esdt allowances: enable epoch", "epoch", base.enableEpoch)
+
+	return base, nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (eab *esdtAllowanceBase) EpochConfirmed(epoch uint32, _ uint64) {
+	eab.flagEnabled.Toggle(epoch >= eab.enableEpoch)
+	log.Debug("esdt allowances", "enabled", eab.flagEnabled.IsSet())
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (eab *esdtAllowanceBase) SetNewGasConfig(gasCost *vmcommon.GasCost) {
+	if gasCost == nil {
+		return
+	}
+
+	eab.mutExecution.Lock()
+	eab.funcGasCost = gasCost.BuiltInCost.ESDTTransfer
+	eab.mutExecution.Unlock()
+}
+
+// checkArguments verifies the common arguments of the allowances built-in functions: token identifier, a positive
+// (or zero, if allowed) value and an address
+func (eab *esdtAllowanceBase) checkArguments(vmInput *vmcommon.ContractCallInput, allowZeroValue bool) (*big.Int, error) {
+	if !eab.flagEnabled.IsSet() {
+		return nil, process.ErrESDTAllowancesDisabled
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, fmt.Errorf("%w, call value must be zero", process.ErrInvalidESDTAllowanceArguments)
+	}
+	if len(vmInput.Arguments) != lenArgumentsESDTAllowances {
+		return nil, fmt.Errorf("%w, expected %d arguments", process.ErrInvalidESDTAllowanceArguments, lenArgumentsESDTAllowances)
+	}
+	if len(vmInput.Arguments[0]) == 0 {
+		return nil, fmt.Errorf("%w, empty token identifier", process.ErrInvalidESDTAllowanceArguments)
+	}
+	if len(vmInput.Arguments[2]) != len(vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, invalid address", process.ErrInvalidESDTAllowanceArguments)
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) == 0 && !allowZeroValue {
+		return nil, fmt.Errorf("%w, value must be positive", process.ErrInvalidESDTAllowanceArguments)
+	}
+
+	return value, nil
+}
+
+// ProcessBuiltinFunction sets the allowance of a spender for one of the caller's tokens. The expected arguments are
+// the token identifier, the allowed value (zero removes the allowance) and the spender address
+func (ea *esdtApprove) ProcessBuiltinFunction(
+	acntSnd, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	ea.mutExecution.RLock()
+	defer ea.mutExecution.RUnlock()
+
+	value, err := ea.checkArguments(vmInput, true)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, fmt.Errorf("%w, the transaction must be sent to self", process.ErrInvalidESDTAllowanceArguments)
+	}
+	if check.IfNil(acntSnd) {
+		return nil, process.ErrNilUserAccount
+	}
+	if vmInput.GasProvided < ea.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	tokenID := vmInput.Arguments[0]
+	spender := vmInput.Arguments[2]
+	if bytes.Equal(spender, vmInput.CallerAddr) {
+		return nil, fmt.Errorf("%w, the spender can not be the owner", process.ErrInvalidESDTAllowanceArguments)
+	}
+
+	err = saveAllowance(acntSnd, CreateESDTAllowanceKey(spender, tokenID), value)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: vmInput.GasProvided - ea.funcGasCost,
+		ReturnCode:   vmcommon.Ok,
+	}
+	addAllowanceLogEntry(vmOutput, core.BuiltInFunctionESDTApprove, vmInput.CallerAddr, tokenID, value, spender)
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ea *esdtApprove) IsInterfaceNil() bool {
+	return ea == nil
+}
+
+// ProcessBuiltinFunction transfers tokens from the balance of the transaction receiver (the owner) to the provided
+// receiver, consuming the allowance given by the owner to the caller. The expected arguments are the token identifier,
+// the value and the receiver address. The allowance and the owner's balance are updated in the owner's shard, while a
+// receiver from another shard is credited through a smart contract result
+func (etf *esdtTransferFrom) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	etf.mutExecution.RLock()
+	defer etf.mutExecution.RUnlock()
+
+	value, err := etf.checkArguments(vmInput, false)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := vmInput.RecipientAddr
+	receiver := vmInput.Arguments[2]
+	if bytes.Equal(owner, vmInput.CallerAddr) || bytes.Equal(owner, receiver) {
+		return nil, fmt.Errorf("%w, the owner can not be the caller or the receiver", process.ErrInvalidESDTAllowanceArguments)
+	}
+	if etf.shardCoordinator.ComputeId(receiver) == core.MetachainShardId {
+		return nil, process.ErrInvalidRcvAddr
+	}
+
+	gasRemaining := uint64(0)
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by the caller, in its shard
+		if vmInput.GasProvided < etf.funcGasCost {
+			return nil, process.ErrNotEnoughGas
+		}
+		gasRemaining = vmInput.GasProvided - etf.funcGasCost
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining, ReturnCode: vmcommon.Ok}
+	if check.IfNil(acntDst) {
+		// the allowance and the tokens are in the owner's shard
+		return vmOutput, nil
+	}
+
+	tokenID := vmInput.Arguments[0]
+	allowanceKey := CreateESDTAllowanceKey(vmInput.CallerAddr, tokenID)
+	allowance := getAllowance(acntDst, allowanceKey)
+	if allowance.Cmp(value) < 0 {
+		return nil, process.ErrInsufficientESDTAllowance
+	}
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	err = etf.addToBalance(acntDst, esdtTokenKey, big.NewInt(0).Neg(value))
+	if err != nil {
+		return nil, err
+	}
+
+	err = saveAllowance(acntDst, allowanceKey, allowance.Sub(allowance, value))
+	if err != nil {
+		return nil, err
+	}
+
+	if etf.shardCoordinator.ComputeId(receiver) != etf.shardCoordinator.SelfId() {
+		addESDTTransferToVMOutput(vmOutput, owner, receiver, tokenID, value)
+	} else {
+		err = etf.creditReceiver(acntSnd, receiver, esdtTokenKey, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	addAllowanceLogEntry(vmOutput, core.BuiltInFunctionESDTTransferFrom, owner, tokenID, value, receiver)
+
+	return vmOutput, nil
+}
+
+func (etf *esdtTransferFrom) creditReceiver(
+	acntSnd vmcommon.UserAccountHandler,
+	receiver []byte,
+	esdtTokenKey []byte,
+	value *big.Int,
+) error {
+	if !check.IfNil(acntSnd) && bytes.Equal(acntSnd.AddressBytes(), receiver) {
+		// the caller account is saved after the built-in function is processed
+		return etf.addToBalance(acntSnd, esdtTokenKey, value)
+	}
+
+	account, err := etf.accounts.LoadAccount(receiver)
+	if err != nil {
+		return err
+	}
+	receiverAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	if core.IsSmartContractAddress(receiver) {
+		codeMetadata := vmcommon.CodeMetadataFromBytes(receiverAccount.GetCodeMetadata())
+		if !codeMetadata.Payable {
+			return process.ErrAccountNotPayable
+		}
+	}
+
+	err = etf.addToBalance(receiverAccount, esdtTokenKey, value)
+	if err != nil {
+		return err
+	}
+
+	return etf.accounts.SaveAccount(receiverAccount)
+}
+
+func (etf *esdtTransferFrom) addToBalance(account vmcommon.UserAccountHandler, esdtTokenKey []byte, value *big.Int) error {
+	tokenData := &esdt.ESDigitalToken{Value: big.NewInt(0), Type: uint32(vmcommon.Fungible)}
+	marshaledData, err := account.AccountDataHandler().RetrieveValue(esdtTokenKey)
+	if err == nil && len(marshaledData) > 0 {
+		err = etf.marshalizer.Unmarshal(tokenData, marshaledData)
+		if err != nil {
+			return err
+		}
+	}
+	if tokenData.Value == nil {
+		tokenData.Value = big.NewInt(0)
+	}
+
+	if tokenData.Type != uint32(vmcommon.Fungible) {
+		return fmt.Errorf("%w, only fungible tokens have allowances", process.ErrInvalidESDTAllowanceArguments)
+	}
+	if vmcommonBuiltInFunctions.ESDTUserMetadataFromBytes(tokenData.Properties).Frozen {
+		return vmcommonBuiltInFunctions.ErrESDTIsFrozenForAccount
+	}
+	if etf.pauseHandler.IsPaused(esdtTokenKey) {
+		return vmcommonBuiltInFunctions.ErrESDTTokenIsPaused
+	}
+
+	tokenData.Value.Add(tokenData.Value, value)
+	if tokenData.Value.Cmp(zero) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	marshaledData, err = etf.marshalizer.Marshal(tokenData)
+	if err != nil {
+		return err
+	}
+
+	return account.AccountDataHandler().SaveKeyValue(esdtTokenKey, marshaledData)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (etf *esdtTransferFrom) IsInterfaceNil() bool {
+	return etf == nil
+}
+
+// CreateESDTAllowanceKey returns the key under which the allowance of a spender for a token is kept in the data trie
+// of the owner. The spender address has a fixed length, so the key can be split back into spender and token
+func CreateESDTAllowanceKey(spender []byte, tokenID []byte) []byte {
+	key := make([]byte, 0, len(core.ElrondProtectedKeyPrefix)+len(core.ESDTAllowanceIdentifier)+len(spender)+len(tokenID))
+	key = append(key, core.ElrondProtectedKeyPrefix+core.ESDTAllowanceIdentifier...)
+	key = append(key, spender...)
+
+	return append(key, tokenID...)
+}
+
+// getAllowance returns the allowance stored under the provided key. As for the esdt balances, a missing data trie
+// means that no allowance was given
+func getAllowance(account vmcommon.UserAccountHandler, allowanceKey []byte) *big.Int {
+	value, err := account.AccountDataHandler().RetrieveValue(allowanceKey)
+	if err != nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).SetBytes(value)
+}
+
+func saveAllowance(account vmcommon.UserAccountHandler, allowanceKey []byte, value *big.Int) error {
+	if value.Cmp(zero) == 0 {
+		return account.AccountDataHandler().SaveKeyValue(allowanceKey, nil)
+	}
+
+	return account.AccountDataHandler().SaveKeyValue(allowanceKey, value.Bytes())
+}
+
+func addESDTTransferToVMOutput(vmOutput *vmcommon.VMOutput, sender []byte, receiver []byte, tokenID []byte, value *big.Int) {
+	data := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(value.Bytes())
+	vmOutput.OutputAccounts = map[string]*vmcommon.OutputAccount{
+		string(receiver): {
+			Address: receiver,
+			OutputTransfers: []vmcommon.OutputTransfer{
+				{
+					Value:         big.NewInt(0),
+					Data:          []byte(data),
+					CallType:      vmcommon.DirectCall,
+					SenderAddress: sender,
+				},
+			},
+		},
+	}
+}
+
+func addAllowanceLogEntry(vmOutput *vmcommon.VMOutput, identifier string, address []byte, tokenID []byte, value *big.Int, otherAddress []byte) {
+	vmOutput.Logs = append(vmOutput.Logs, &vmcommon.LogEntry{
+		Identifier: []byte(identifier),
+		Address:    address,
+		Topics:     [][]byte{tokenID, value.Bytes(), otherAddress},
+	})
+}
@@ -0,0 +1,304 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allowanceToken    = []byte("TKN-abcdef")
+	allowanceOwner    = []byte("owner-address-of-32-bytes-length")
+	allowanceSpender  = []byte("spender-address-32-bytes-length-")
+	allowanceReceiver = []byte("receiver-address-32-bytes-length")
+)
+
+func createMockArgsESDTAllowances() ArgsNewESDTAllowancesFunc {
+	return ArgsNewESDTAllowancesFunc{
+		FuncGasCost:      10,
+		Marshalizer:      &mock.MarshalizerMock{},
+		PauseHandler:     &mock.PauseHandlerStub{},
+		Accounts:         &testscommon.AccountsStub{},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+}
+
+func createApproveInput(value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  allowanceOwner,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{allowanceToken, big.NewInt(value).Bytes(), allowanceSpender},
+		},
+		RecipientAddr: allowanceOwner,
+		Function:      core.BuiltInFunctionESDTApprove,
+	}
+}
+
+func createTransferFromInput(value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  allowanceSpender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{allowanceToken, big.NewInt(value).Bytes(), allowanceReceiver},
+		},
+		RecipientAddr: allowanceOwner,
+		Function:      core.BuiltInFunctionESDTTransferFrom,
+	}
+}
+
+func setESDTBalance(t *testing.T, account vmcommon.UserAccountHandler, value int64) {
+	marshaledData, err := (&mock.MarshalizerMock{}).Marshal(&esdt.ESDigitalToken{Value: big.NewInt(value)})
+	require.Nil(t, err)
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(allowanceToken))
+	require.Nil(t, account.AccountDataHandler().SaveKeyValue(esdtTokenKey, marshaledData))
+}
+
+func getESDTBalance(t *testing.T, account vmcommon.UserAccountHandler) *big.Int {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(allowanceToken))
+	marshaledData, err := account.AccountDataHandler().RetrieveValue(esdtTokenKey)
+	require.Nil(t, err)
+
+	tokenData := &esdt.ESDigitalToken{}
+	require.Nil(t, (&mock.MarshalizerMock{}).Unmarshal(tokenData, marshaledData))
+
+	return tokenData.Value
+}
+
+func TestNewESDTApproveFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTAllowances()
+	args.Marshalizer = nil
+	_, err := NewESDTApproveFunc(args)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsESDTAllowances()
+	args.Accounts = nil
+	_, err = NewESDTTransferFromFunc(args)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsESDTAllowances()
+	args.ShardCoordinator = nil
+	_, err = NewESDTTransferFromFunc(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+
+	args = createMockArgsESDTAllowances()
+	args.EpochNotifier = nil
+	_, err = NewESDTApproveFunc(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+
+	args = createMockArgsESDTAllowances()
+	approveFunc, err := NewESDTApproveFunc(args)
+	assert.Nil(t, err)
+	assert.False(t, approveFunc.IsInterfaceNil())
+}
+
+func TestESDTApprove_ProcessBuiltinFunctionDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTAllowances()
+	args.EnableEpoch = 1
+	approveFunc, _ := NewESDTApproveFunc(args)
+
+	_, err := approveFunc.ProcessBuiltinFunction(mock.NewAccountWrapMock(allowanceOwner), nil, createApproveInput(10))
+	assert.Equal(t, process.ErrESDTAllowancesDisabled, err)
+
+	approveFunc.EpochConfirmed(1, 0)
+	_, err = approveFunc.ProcessBuiltinFunction(mock.NewAccountWrapMock(allowanceOwner), nil, createApproveInput(10))
+	assert.Nil(t, err)
+}
+
+func TestESDTApprove_ProcessBuiltinFunctionInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	approveFunc, _ := NewESDTApproveFunc(createMockArgsESDTAllowances())
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+
+	vmInput := createApproveInput(10)
+	vmInput.CallValue = big.NewInt(1)
+	_, err := approveFunc.ProcessBuiltinFunction(owner, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidESDTAllowanceArguments))
+
+	vmInput = createApproveInput(10)
+	vmInput.Arguments = vmInput.Arguments[:2]
+	_, err = approveFunc.ProcessBuiltinFunction(owner, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidESDTAllowanceArguments))
+
+	vmInput = createApproveInput(10)
+	vmInput.RecipientAddr = allowanceReceiver
+	_, err = approveFunc.ProcessBuiltinFunction(owner, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidESDTAllowanceArguments))
+
+	vmInput = createApproveInput(10)
+	vmInput.Arguments[2] = allowanceOwner
+	_, err = approveFunc.ProcessBuiltinFunction(owner, nil, vmInput)
+	assert.True(t, errors.Is(err, process.ErrInvalidESDTAllowanceArguments))
+
+	vmInput = createApproveInput(10)
+	vmInput.GasProvided = 1
+	_, err = approveFunc.ProcessBuiltinFunction(owner, nil, vmInput)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestESDTApprove_ProcessBuiltinFunctionShouldSetAndRemoveTheAllowance(t *testing.T) {
+	t.Parallel()
+
+	approveFunc, _ := NewESDTApproveFunc(createMockArgsESDTAllowances())
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	allowanceKey := CreateESDTAllowanceKey(allowanceSpender, allowanceToken)
+
+	vmOutput, err := approveFunc.ProcessBuiltinFunction(owner, nil, createApproveInput(10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	assert.Equal(t, big.NewInt(10), getAllowance(owner, allowanceKey))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(core.BuiltInFunctionESDTApprove), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, [][]byte{allowanceToken, {10}, allowanceSpender}, vmOutput.Logs[0].Topics)
+
+	_, err = approveFunc.ProcessBuiltinFunction(owner, nil, createApproveInput(0))
+	require.Nil(t, err)
+	value, _ := owner.AccountDataHandler().RetrieveValue(allowanceKey)
+	assert.Equal(t, 0, len(value))
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionCallerShardShouldOnlyConsumeGas(t *testing.T) {
+	t.Parallel()
+
+	transferFromFunc, _ := NewESDTTransferFromFunc(createMockArgsESDTAllowances())
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(mock.NewAccountWrapMock(allowanceSpender), nil, createTransferFromInput(10))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.Equal(t, 0, len(vmOutput.Logs))
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionInsufficientAllowanceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transferFromFunc, _ := NewESDTTransferFromFunc(createMockArgsESDTAllowances())
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	setESDTBalance(t, owner, 100)
+
+	_, err := transferFromFunc.ProcessBuiltinFunction(nil, owner, createTransferFromInput(10))
+	assert.Equal(t, process.ErrInsufficientESDTAllowance, err)
+
+	_ = saveAllowance(owner, CreateESDTAllowanceKey(allowanceSpender, allowanceToken), big.NewInt(5))
+	_, err = transferFromFunc.ProcessBuiltinFunction(nil, owner, createTransferFromInput(10))
+	assert.Equal(t, process.ErrInsufficientESDTAllowance, err)
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionInsufficientFundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transferFromFunc, _ := NewESDTTransferFromFunc(createMockArgsESDTAllowances())
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	setESDTBalance(t, owner, 5)
+	_ = saveAllowance(owner, CreateESDTAllowanceKey(allowanceSpender, allowanceToken), big.NewInt(50))
+
+	_, err := transferFromFunc.ProcessBuiltinFunction(nil, owner, createTransferFromInput(10))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionSameShardShouldCreditTheReceiver(t *testing.T) {
+	t.Parallel()
+
+	receiver := mock.NewAccountWrapMock(allowanceReceiver)
+	savedAccounts := 0
+	args := createMockArgsESDTAllowances()
+	args.Accounts = &testscommon.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return receiver, nil
+		},
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			savedAccounts++
+			return nil
+		},
+	}
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	setESDTBalance(t, owner, 100)
+	allowanceKey := CreateESDTAllowanceKey(allowanceSpender, allowanceToken)
+	_ = saveAllowance(owner, allowanceKey, big.NewInt(50))
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(mock.NewAccountWrapMock(allowanceSpender), owner, createTransferFromInput(30))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+	assert.Equal(t, 0, len(vmOutput.OutputAccounts))
+	assert.Equal(t, 1, savedAccounts)
+
+	assert.Equal(t, big.NewInt(70), getESDTBalance(t, owner))
+	assert.Equal(t, big.NewInt(30), getESDTBalance(t, receiver))
+	assert.Equal(t, big.NewInt(20), getAllowance(owner, allowanceKey))
+	require.Equal(t, 1, len(vmOutput.Logs))
+	assert.Equal(t, []byte(core.BuiltInFunctionESDTTransferFrom), vmOutput.Logs[0].Identifier)
+	assert.Equal(t, allowanceOwner, vmOutput.Logs[0].Address)
+	assert.Equal(t, [][]byte{allowanceToken, {30}, allowanceReceiver}, vmOutput.Logs[0].Topics)
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionCrossShardShouldCreateTheTransfer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTAllowances()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if string(address) == string(allowanceReceiver) {
+			return 1
+		}
+		return 0
+	}
+	args.ShardCoordinator = shardCoordinator
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	setESDTBalance(t, owner, 100)
+	allowanceKey := CreateESDTAllowanceKey(allowanceSpender, allowanceToken)
+	_ = saveAllowance(owner, allowanceKey, big.NewInt(30))
+
+	vmOutput, err := transferFromFunc.ProcessBuiltinFunction(nil, owner, createTransferFromInput(30))
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(70), getESDTBalance(t, owner))
+	value, _ := owner.AccountDataHandler().RetrieveValue(allowanceKey)
+	assert.Equal(t, 0, len(value))
+
+	outputAccount := vmOutput.OutputAccounts[string(allowanceReceiver)]
+	require.NotNil(t, outputAccount)
+	require.Equal(t, 1, len(outputAccount.OutputTransfers))
+	assert.Equal(t, "ESDTTransfer@544b4e2d616263646566@1e", string(outputAccount.OutputTransfers[0].Data))
+	assert.Equal(t, allowanceOwner, outputAccount.OutputTransfers[0].SenderAddress)
+}
+
+func TestESDTTransferFrom_ProcessBuiltinFunctionPausedTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsESDTAllowances()
+	args.PauseHandler = &mock.PauseHandlerStub{
+		IsPausedCalled: func(token []byte) bool {
+			return true
+		},
+	}
+	transferFromFunc, _ := NewESDTTransferFromFunc(args)
+
+	owner := mock.NewAccountWrapMock(allowanceOwner)
+	setESDTBalance(t, owner, 100)
+	_ = saveAllowance(owner, CreateESDTAllowanceKey(allowanceSpender, allowanceToken), big.NewInt(30))
+
+	_, err := transferFromFunc.ProcessBuiltinFunction(nil, owner, createTransferFromInput(30))
+	assert.NotNil(t, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(t, owner))
+}
@@ -0,0 +1,120 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+)
+
+const lenArgumentsESDTSetTokenType = 2
+
+// ArgsNewESDTSetTokenTypeFunc defines the arguments needed to create the esdt set token type built-in function
+type ArgsNewESDTSetTokenTypeFunc struct {
+	Accounts      vmcommon.AccountsAdapter
+	EpochNotifier process.EpochNotifier
+	EnableEpoch   uint32
+}
+
+// esdtSetTokenType saves the type of a token, as sent by the esdt system smart contract, in the system account of the
+// shard, so that the NFT built-in functions can check it outside the metachain
+type esdtSetTokenType struct {
+	accounts    vmcommon.AccountsAdapter
+	enableEpoch uint32
+	flagEnabled atomic.Flag
+}
+
+// NewESDTSetTokenTypeFunc returns the esdt set token type built-in function component
+func NewESDTSetTokenTypeFunc(args ArgsNewESDTSetTokenTypeFunc) (*esdtSetTokenType, error) {
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	e := &esdtSetTokenType{
+		accounts:    args.Accounts,
+		enableEpoch: args.EnableEpoch,
+	}
+	log.Debug("esdt set token type: enable epoch", "epoch", e.enableEpoch)
+
+	args.EpochNotifier.RegisterNotifyHandler(e)
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction saves the token type in the system account. The expected arguments are the token identifier
+// and the token type
+func (e *esdtSetTokenType) ProcessBuiltinFunction(
+	_, _ vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if !e.flagEnabled.IsSet() {
+		return nil, process.ErrESDTSetTokenTypeDisabled
+	}
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, vmcommonBuiltInFunctions.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) != lenArgumentsESDTSetTokenType || len(vmInput.Arguments[0]) == 0 {
+		return nil, vmcommonBuiltInFunctions.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmcommon.ESDTSCAddress) {
+		return nil, vmcommonBuiltInFunctions.ErrAddressIsNotESDTSystemSC
+	}
+	if !vmcommon.IsSystemAccountAddress(vmInput.RecipientAddr) {
+		return nil, vmcommonBuiltInFunctions.ErrOnlySystemAccountAccepted
+	}
+
+	if len(vmInput.Arguments[1]) == 0 {
+		return nil, process.ErrInvalidESDTTokenType
+	}
+
+	account, err := e.accounts.LoadAccount(core.SystemAccountAddress)
+	if err != nil {
+		return nil, err
+	}
+	systemAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+
+	err = systemAccount.AccountDataHandler().SaveKeyValue(CreateESDTTokenTypeKey(vmInput.Arguments[0]), vmInput.Arguments[1])
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.accounts.SaveAccount(systemAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+}
+
+// SetNewGasConfig is called whenever gas cost is changed
+func (e *esdtSetTokenType) SetNewGasConfig(_ *vmcommon.GasCost) {
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (e *esdtSetTokenType) EpochConfirmed(epoch uint32, _ uint64) {
+	e.flagEnabled.Toggle(epoch >= e.enableEpoch)
+	log.Debug("esdt set token type", "enabled", e.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *esdtSetTokenType) IsInterfaceNil() bool {
+	return e == nil
+}
+
+// CreateESDTTokenTypeKey returns the key under which the type of the provided token is saved in the system account
+func CreateESDTTokenTypeKey(tokenID []byte) []byte {
+	return append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTTokenTypeIdentifier), tokenID...)
+}
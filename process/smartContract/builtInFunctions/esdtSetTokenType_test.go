@@ -0,0 +1,110 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSetTokenTypeInput(tokenType []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vmcommon.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{allowanceToken, tokenType},
+		},
+		RecipientAddr: core.SystemAccountAddress,
+		Function:      core.BuiltInFunctionESDTSetTokenType,
+	}
+}
+
+func TestNewESDTSetTokenTypeFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewESDTSetTokenTypeFunc(ArgsNewESDTSetTokenTypeFunc{EpochNotifier: &mock.EpochNotifierStub{}})
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	_, err = NewESDTSetTokenTypeFunc(ArgsNewESDTSetTokenTypeFunc{Accounts: &testscommon.AccountsStub{}})
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestESDTSetTokenType_ProcessBuiltinFunctionInvalidInputShouldErr(t *testing.T) {
+	t.Parallel()
+
+	setTokenTypeFunc, _ := NewESDTSetTokenTypeFunc(ArgsNewESDTSetTokenTypeFunc{
+		Accounts:      &testscommon.AccountsStub{},
+		EpochNotifier: &mock.EpochNotifierStub{},
+		EnableEpoch:   1,
+	})
+
+	_, err := setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, createSetTokenTypeInput([]byte(core.MetaESDT)))
+	assert.Equal(t, process.ErrESDTSetTokenTypeDisabled, err)
+
+	setTokenTypeFunc.EpochConfirmed(1, 0)
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createSetTokenTypeInput([]byte(core.MetaESDT))
+	input.CallValue = big.NewInt(1)
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, vmcommonBuiltInFunctions.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createSetTokenTypeInput([]byte(core.MetaESDT))
+	input.Arguments = input.Arguments[:1]
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, vmcommonBuiltInFunctions.ErrInvalidArguments, err)
+
+	input = createSetTokenTypeInput([]byte(core.MetaESDT))
+	input.CallerAddr = allowanceOwner
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, vmcommonBuiltInFunctions.ErrAddressIsNotESDTSystemSC, err)
+
+	input = createSetTokenTypeInput([]byte(core.MetaESDT))
+	input.RecipientAddr = allowanceOwner
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, vmcommonBuiltInFunctions.ErrOnlySystemAccountAccepted, err)
+
+	_, err = setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, createSetTokenTypeInput(nil))
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+}
+
+func TestESDTSetTokenType_ProcessBuiltinFunctionShouldSaveInSystemAccount(t *testing.T) {
+	t.Parallel()
+
+	systemAccount := mock.NewAccountWrapMock(core.SystemAccountAddress)
+	saveAccountCalled := false
+	accounts := &testscommon.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			require.Equal(t, core.SystemAccountAddress, address)
+			return systemAccount, nil
+		},
+		SaveAccountCalled: func(account vmcommon.AccountHandler) error {
+			saveAccountCalled = true
+			return nil
+		},
+	}
+	setTokenTypeFunc, _ := NewESDTSetTokenTypeFunc(ArgsNewESDTSetTokenTypeFunc{
+		Accounts:      accounts,
+		EpochNotifier: &mock.EpochNotifierStub{},
+	})
+	setTokenTypeFunc.EpochConfirmed(0, 0)
+
+	input := createSetTokenTypeInput([]byte(core.MetaESDT))
+	input.RecipientAddr = append(core.SystemAccountAddress[:len(core.SystemAccountAddress)-1:len(core.SystemAccountAddress)-1], 1)
+	vmOutput, err := setTokenTypeFunc.ProcessBuiltinFunction(nil, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+	assert.True(t, saveAccountCalled)
+
+	value, err := systemAccount.AccountDataHandler().RetrieveValue(CreateESDTTokenTypeKey(allowanceToken))
+	require.Nil(t, err)
+	assert.Equal(t, []byte(core.MetaESDT), value)
+}
@@ -0,0 +1,168 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
+)
+
+const (
+	nftCreateQuantityIndex      = 1
+	nftAddQuantityQuantityIndex = 2
+	nftBurnQuantityIndex        = 2
+
+	tokenSeparator          = "-"
+	tokenRandomSuffixLength = 6
+)
+
+// nftQuantityLogDecorator appends the quantity argument as the last topic of the log entry of the NFT built-in functions
+// which modify the supply of a token (create, add quantity, burn), as the wrapped functions do not log the quantity
+type nftQuantityLogDecorator struct {
+	vmcommon.BuiltinFunction
+	identifier    []byte
+	quantityIndex int
+}
+
+// ProcessBuiltinFunction calls the wrapped built-in function and adds the quantity to its log entry
+func (nqld *nftQuantityLogDecorator) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	vmOutput, err := nqld.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	if err != nil || vmOutput == nil {
+		return vmOutput, err
+	}
+	if len(vmInput.Arguments) <= nqld.quantityIndex {
+		return vmOutput, nil
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[nqld.quantityIndex])
+	for _, logEntry := range vmOutput.Logs {
+		if logEntry == nil || !bytes.Equal(logEntry.Identifier, nqld.identifier) {
+			continue
+		}
+		logEntry.Topics = append(logEntry.Topics, quantity.Bytes())
+	}
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nqld *nftQuantityLogDecorator) IsInterfaceNil() bool {
+	return nqld == nil
+}
+
+// wipeValueLogDecorator writes the wiped value in the log entry of the wipe built-in function, as the wrapped
+// function always logs a zero value
+type wipeValueLogDecorator struct {
+	vmcommon.BuiltinFunction
+	marshalizer marshal.Marshalizer
+}
+
+// ProcessBuiltinFunction reads the balance which is going to be wiped, calls the wrapped built-in function and
+// writes the wiped balance in its log entry
+func (wvld *wipeValueLogDecorator) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	wipedValue := wvld.getBalance(acntDst, vmInput)
+
+	vmOutput, err := wvld.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	if err != nil || vmOutput == nil {
+		return vmOutput, err
+	}
+
+	for _, logEntry := range vmOutput.Logs {
+		if logEntry == nil || string(logEntry.Identifier) != core.BuiltInFunctionESDTWipe || len(logEntry.Topics) < 2 {
+			continue
+		}
+		logEntry.Topics[1] = wipedValue.Bytes()
+	}
+
+	return vmOutput, nil
+}
+
+func (wvld *wipeValueLogDecorator) getBalance(account vmcommon.UserAccountHandler, vmInput *vmcommon.ContractCallInput) *big.Int {
+	if check.IfNil(account) || vmInput == nil || len(vmInput.Arguments) == 0 {
+		return big.NewInt(0)
+	}
+
+	dataHandler := account.AccountDataHandler()
+	if check.IfNil(dataHandler) {
+		return big.NewInt(0)
+	}
+
+	marshalledData, err := dataHandler.RetrieveValue(createWipedTokenKey(vmInput.Arguments[0]))
+	if err != nil || len(marshalledData) == 0 {
+		return big.NewInt(0)
+	}
+
+	tokenData := &esdt.ESDigitalToken{Value: big.NewInt(0)}
+	err = wvld.marshalizer.Unmarshal(tokenData, marshalledData)
+	if err != nil || tokenData.Value == nil {
+		return big.NewInt(0)
+	}
+
+	return tokenData.Value
+}
+
+// createWipedTokenKey returns the key of the wiped balance. The esdt system smart contract sends the token identifier
+// followed by the nonce for the wipe of a single NFT, so the key is built from the token identifier and the nonce, as
+// saved by the NFT built-in functions
+func createWipedTokenKey(wipeArgument []byte) []byte {
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+	separatorIndex := bytes.Index(wipeArgument, []byte(tokenSeparator))
+	tokenIDLength := separatorIndex + len(tokenSeparator) + tokenRandomSuffixLength
+	if separatorIndex < 0 || len(wipeArgument) <= tokenIDLength {
+		return append(tokenKey, wipeArgument...)
+	}
+
+	tokenKey = append(tokenKey, wipeArgument[:tokenIDLength]...)
+	nonce := big.NewInt(0).SetBytes(wipeArgument[tokenIDLength:])
+
+	return append(tokenKey, nonce.Bytes()...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wvld *wipeValueLogDecorator) IsInterfaceNil() bool {
+	return wvld == nil
+}
+
+func addSupplyLogsDecorators(container vmcommon.BuiltInFunctionContainer, marshalizer marshal.Marshalizer) error {
+	quantityIndexes := map[string]int{
+		core.BuiltInFunctionESDTNFTCreate:      nftCreateQuantityIndex,
+		core.BuiltInFunctionESDTNFTAddQuantity: nftAddQuantityQuantityIndex,
+		core.BuiltInFunctionESDTNFTBurn:        nftBurnQuantityIndex,
+	}
+	for name, quantityIndex := range quantityIndexes {
+		builtInFunction, err := container.Get(name)
+		if err != nil {
+			return err
+		}
+
+		err = container.Replace(name, &nftQuantityLogDecorator{
+			BuiltinFunction: builtInFunction,
+			identifier:      []byte(name),
+			quantityIndex:   quantityIndex,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	wipeFunction, err := container.Get(core.BuiltInFunctionESDTWipe)
+	if err != nil {
+		return err
+	}
+
+	return container.Replace(core.BuiltInFunctionESDTWipe, &wipeValueLogDecorator{
+		BuiltinFunction: wipeFunction,
+		marshalizer:     marshalizer,
+	})
+}
@@ -0,0 +1,168 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/data/esdt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNftQuantityLogDecorator_ProcessBuiltinFunctionShouldAppendQuantity(t *testing.T) {
+	t.Parallel()
+
+	decorator := &nftQuantityLogDecorator{
+		BuiltinFunction: &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					Logs: []*vmcommon.LogEntry{
+						{Identifier: []byte("other"), Topics: [][]byte{[]byte("topic")}},
+						{Identifier: []byte(core.BuiltInFunctionESDTNFTAddQuantity), Topics: [][]byte{[]byte("TKN-abcdef"), {1}}},
+					},
+				}, nil
+			},
+		},
+		identifier:    []byte(core.BuiltInFunctionESDTNFTAddQuantity),
+		quantityIndex: nftAddQuantityQuantityIndex,
+	}
+
+	vmInput := &vmcommon.ContractCallInput{}
+	vmInput.Arguments = [][]byte{[]byte("TKN-abcdef"), {1}, {0, 0, 5}}
+	vmOutput, err := decorator.ProcessBuiltinFunction(nil, nil, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("topic")}, vmOutput.Logs[0].Topics)
+	assert.Equal(t, [][]byte{[]byte("TKN-abcdef"), {1}, {5}}, vmOutput.Logs[1].Topics)
+}
+
+func TestNftQuantityLogDecorator_ProcessBuiltinFunctionErrorShouldNotAlterOutput(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	decorator := &nftQuantityLogDecorator{
+		BuiltinFunction: &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return nil, expectedErr
+			},
+		},
+		identifier:    []byte(core.BuiltInFunctionESDTNFTBurn),
+		quantityIndex: nftBurnQuantityIndex,
+	}
+
+	vmInput := &vmcommon.ContractCallInput{}
+	vmInput.Arguments = [][]byte{[]byte("TKN-abcdef"), {1}, {5}}
+	vmOutput, err := decorator.ProcessBuiltinFunction(nil, nil, vmInput)
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestWipeValueLogDecorator_ProcessBuiltinFunctionShouldWriteWipedValue(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("TKN-abcdef")
+	account, _ := state.NewUserAccount([]byte("frozen"))
+	tokenData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(250)})
+	tokenKey := append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier), tokenID...)
+	_ = account.DataTrieTracker().SaveKeyValue(tokenKey, tokenData)
+
+	decorator := &wipeValueLogDecorator{
+		BuiltinFunction: &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					Logs: []*vmcommon.LogEntry{
+						{Identifier: []byte(core.BuiltInFunctionESDTWipe), Topics: [][]byte{tokenID, big.NewInt(0).Bytes(), []byte("frozen")}},
+					},
+				}, nil
+			},
+		},
+		marshalizer: marshalizer,
+	}
+
+	vmInput := &vmcommon.ContractCallInput{}
+	vmInput.Arguments = [][]byte{tokenID, []byte("frozen")}
+	vmOutput, err := decorator.ProcessBuiltinFunction(nil, account, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(250).Bytes(), vmOutput.Logs[0].Topics[1])
+}
+
+func TestCreateWipedTokenKey(t *testing.T) {
+	t.Parallel()
+
+	prefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	assert.Equal(t, []byte(prefix+"TKN-abcdef"), createWipedTokenKey([]byte("TKN-abcdef")))
+	assert.Equal(t, []byte(prefix+"TKN-abcdef\x05"), createWipedTokenKey([]byte("TKN-abcdef\x05")))
+	assert.Equal(t, []byte(prefix+"TKN-abcdef\x05"), createWipedTokenKey([]byte("TKN-abcdef\x00\x05")))
+	assert.Equal(t, []byte(prefix+"TKN-abcdef"), createWipedTokenKey([]byte("TKN-abcdef\x00")))
+}
+
+func TestWipeValueLogDecorator_ProcessBuiltinFunctionNFTWipeShouldReadTheNonceBalance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	tokenID := []byte("NFT-abcdef")
+	nonceBytes := big.NewInt(7).Bytes()
+	account, _ := state.NewUserAccount([]byte("frozen"))
+	tokenData, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(3)})
+	tokenKey := append([]byte(core.ElrondProtectedKeyPrefix+core.ESDTKeyIdentifier), tokenID...)
+	_ = account.DataTrieTracker().SaveKeyValue(append(tokenKey, nonceBytes...), tokenData)
+
+	wipeArgument := append(append([]byte{}, tokenID...), 0, 7)
+	decorator := &wipeValueLogDecorator{
+		BuiltinFunction: &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				return &vmcommon.VMOutput{
+					Logs: []*vmcommon.LogEntry{
+						{Identifier: []byte(core.BuiltInFunctionESDTWipe), Topics: [][]byte{wipeArgument, big.NewInt(0).Bytes(), []byte("frozen")}},
+					},
+				}, nil
+			},
+		},
+		marshalizer: marshalizer,
+	}
+
+	vmInput := &vmcommon.ContractCallInput{}
+	vmInput.Arguments = [][]byte{wipeArgument}
+	vmOutput, err := decorator.ProcessBuiltinFunction(nil, account, vmInput)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(3).Bytes(), vmOutput.Logs[0].Topics[1])
+}
+
+func TestCreateBuiltInFunctionContainer_SupplyLogsDisabledShouldNotDecorate(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	container, err := CreateBuiltInFunctionContainer(args)
+	require.Nil(t, err)
+
+	wipeFunction, err := container.Get(core.BuiltInFunctionESDTWipe)
+	require.Nil(t, err)
+	_, ok := wipeFunction.(*wipeValueLogDecorator)
+	assert.False(t, ok)
+}
+
+func TestAddSupplyLogsDecorators(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.EnableESDTSupplyLogs = true
+	container, err := CreateBuiltInFunctionContainer(args)
+	require.Nil(t, err)
+
+	for _, name := range []string{core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity, core.BuiltInFunctionESDTNFTBurn} {
+		builtInFunction, errGet := container.Get(name)
+		require.Nil(t, errGet)
+		_, ok := builtInFunction.(*nftQuantityLogDecorator)
+		assert.True(t, ok, name)
+	}
+
+	wipeFunction, err := container.Get(core.BuiltInFunctionESDTWipe)
+	require.Nil(t, err)
+	_, ok := wipeFunction.(*wipeValueLogDecorator)
+	assert.True(t, ok)
+}
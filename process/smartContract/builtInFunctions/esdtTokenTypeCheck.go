@@ -0,0 +1,120 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// nftTokenTypeCheckDecorator checks the type of the token, as saved in the system account by the ESDTSetTokenType
+// built-in function, before calling the wrapped NFT built-in function (create, add quantity, burn). The tokens
+// registered before the meta ESDT enable epoch have no saved type and are only checked by the wrapped function
+type nftTokenTypeCheckDecorator struct {
+	vmcommon.BuiltinFunction
+	accounts     vmcommon.AccountsAdapter
+	allowedTypes map[string]struct{}
+	checkNFT     bool
+}
+
+// ProcessBuiltinFunction checks the token type and calls the wrapped built-in function
+func (ntcd *nftTokenTypeCheckDecorator) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput != nil && len(vmInput.Arguments) > nftCreateQuantityIndex {
+		err := ntcd.checkTokenType(vmInput.Arguments[0], vmInput.Arguments[nftCreateQuantityIndex])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ntcd.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+}
+
+func (ntcd *nftTokenTypeCheckDecorator) checkTokenType(tokenID []byte, quantity []byte) error {
+	tokenType, err := ntcd.getTokenType(tokenID)
+	if err != nil {
+		return err
+	}
+	if len(tokenType) == 0 {
+		return nil
+	}
+
+	_, isAllowed := ntcd.allowedTypes[tokenType]
+	if !isAllowed {
+		return process.ErrInvalidESDTTokenType
+	}
+
+	isNFTWithQuantity := ntcd.checkNFT && tokenType == core.NonFungibleESDT &&
+		big.NewInt(0).SetBytes(quantity).Cmp(big.NewInt(1)) != 0
+	if isNFTWithQuantity {
+		return process.ErrInvalidESDTTokenType
+	}
+
+	return nil
+}
+
+func (ntcd *nftTokenTypeCheckDecorator) getTokenType(tokenID []byte) (string, error) {
+	account, err := ntcd.accounts.LoadAccount(core.SystemAccountAddress)
+	if err != nil {
+		return "", err
+	}
+	systemAccount, ok := account.(vmcommon.UserAccountHandler)
+	if !ok {
+		return "", process.ErrWrongTypeAssertion
+	}
+
+	tokenType, err := systemAccount.AccountDataHandler().RetrieveValue(CreateESDTTokenTypeKey(tokenID))
+	if err == state.ErrNilTrie {
+		// no token type was saved in this shard yet
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return string(tokenType), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ntcd *nftTokenTypeCheckDecorator) IsInterfaceNil() bool {
+	return ntcd == nil
+}
+
+// addTokenTypeCheckDecorators makes the NFT built-in functions respect the token types: the non fungible tokens are
+// created one by one and their quantity cannot be changed, while the fungible tokens cannot be used at all
+func addTokenTypeCheckDecorators(container vmcommon.BuiltInFunctionContainer, accounts vmcommon.AccountsAdapter) error {
+	withQuantity := map[string]struct{}{
+		core.SemiFungibleESDT: {},
+		core.MetaESDT:         {},
+	}
+	withNonce := map[string]struct{}{
+		core.NonFungibleESDT:  {},
+		core.SemiFungibleESDT: {},
+		core.MetaESDT:         {},
+	}
+
+	decorators := map[string]*nftTokenTypeCheckDecorator{
+		core.BuiltInFunctionESDTNFTCreate:      {allowedTypes: withNonce, checkNFT: true},
+		core.BuiltInFunctionESDTNFTAddQuantity: {allowedTypes: withQuantity},
+		core.BuiltInFunctionESDTNFTBurn:        {allowedTypes: withNonce},
+	}
+	for name, decorator := range decorators {
+		builtInFunction, err := container.Get(name)
+		if err != nil {
+			return err
+		}
+
+		decorator.BuiltinFunction = builtInFunction
+		decorator.accounts = accounts
+		err = container.Replace(name, decorator)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package builtInFunctions
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTokenTypeCheckContainer(t *testing.T, tokenType string) (vmcommon.BuiltInFunctionContainer, *bool) {
+	systemAccount := mock.NewAccountWrapMock(core.SystemAccountAddress)
+	if len(tokenType) > 0 {
+		_ = systemAccount.AccountDataHandler().SaveKeyValue(CreateESDTTokenTypeKey(allowanceToken), []byte(tokenType))
+	}
+	accounts := &testscommon.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return systemAccount, nil
+		},
+	}
+
+	wrappedCalled := false
+	container := vmcommonBuiltInFunctions.NewBuiltInFunctionContainer()
+	for _, name := range []string{core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity, core.BuiltInFunctionESDTNFTBurn} {
+		_ = container.Add(name, &mock.BuiltInFunctionStub{
+			ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+				wrappedCalled = true
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+			},
+		})
+	}
+
+	err := addTokenTypeCheckDecorators(container, accounts)
+	require.Nil(t, err)
+
+	return container, &wrappedCalled
+}
+
+func processNFTBuiltInFunction(container vmcommon.BuiltInFunctionContainer, name string, quantity []byte) error {
+	builtInFunction, _ := container.Get(name)
+
+	vmInput := &vmcommon.ContractCallInput{}
+	vmInput.Arguments = [][]byte{allowanceToken, quantity, {1}}
+	_, err := builtInFunction.ProcessBuiltinFunction(nil, nil, vmInput)
+
+	return err
+}
+
+func TestNftTokenTypeCheckDecorator_MetaESDTShouldWork(t *testing.T) {
+	t.Parallel()
+
+	container, wrappedCalled := createTokenTypeCheckContainer(t, core.MetaESDT)
+	for _, name := range []string{core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity, core.BuiltInFunctionESDTNFTBurn} {
+		*wrappedCalled = false
+		err := processNFTBuiltInFunction(container, name, []byte{100})
+		assert.Nil(t, err, name)
+		assert.True(t, *wrappedCalled, name)
+	}
+}
+
+func TestNftTokenTypeCheckDecorator_NonFungibleESDT(t *testing.T) {
+	t.Parallel()
+
+	container, wrappedCalled := createTokenTypeCheckContainer(t, core.NonFungibleESDT)
+
+	err := processNFTBuiltInFunction(container, core.BuiltInFunctionESDTNFTCreate, []byte{1})
+	assert.Nil(t, err)
+	assert.True(t, *wrappedCalled)
+
+	*wrappedCalled = false
+	err = processNFTBuiltInFunction(container, core.BuiltInFunctionESDTNFTCreate, []byte{2})
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+	assert.False(t, *wrappedCalled)
+
+	err = processNFTBuiltInFunction(container, core.BuiltInFunctionESDTNFTAddQuantity, []byte{1})
+	assert.Equal(t, process.ErrInvalidESDTTokenType, err)
+	assert.False(t, *wrappedCalled)
+
+	err = processNFTBuiltInFunction(container, core.BuiltInFunctionESDTNFTBurn, []byte{1})
+	assert.Nil(t, err)
+	assert.True(t, *wrappedCalled)
+}
+
+func TestNftTokenTypeCheckDecorator_FungibleESDTShouldErr(t *testing.T) {
+	t.Parallel()
+
+	container, wrappedCalled := createTokenTypeCheckContainer(t, core.FungibleESDT)
+	for _, name := range []string{core.BuiltInFunctionESDTNFTCreate, core.BuiltInFunctionESDTNFTAddQuantity, core.BuiltInFunctionESDTNFTBurn} {
+		err := processNFTBuiltInFunction(container, name, []byte{1})
+		assert.Equal(t, process.ErrInvalidESDTTokenType, err, name)
+	}
+	assert.False(t, *wrappedCalled)
+}
+
+func TestNftTokenTypeCheckDecorator_TokenWithoutTypeShouldCallTheWrappedFunction(t *testing.T) {
+	t.Parallel()
+
+	container, wrappedCalled := createTokenTypeCheckContainer(t, "")
+
+	err := processNFTBuiltInFunction(container, core.BuiltInFunctionESDTNFTCreate, []byte{5})
+	assert.Nil(t, err)
+	assert.True(t, *wrappedCalled)
+}
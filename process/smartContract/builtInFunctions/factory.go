@@ -19,6 +19,14 @@ type ArgsCreateBuiltInFunctionContainer struct {
 	Marshalizer          marshal.Marshalizer
 	Accounts             state.AccountsAdapter
 	ShardCoordinator     sharding.Coordinator
+	EpochNotifier        process.EpochNotifier
+
+	// EnableESDTSupplyLogs adds the quantities and the wiped values to the logs of the built-in functions which change
+	// the supply of a token. It is set by the nodes which track the ESDT supplies
+	EnableESDTSupplyLogs bool
+
+	ESDTAllowancesEnableEpoch uint32
+	MetaESDTEnableEpoch       uint32
 }
 
 // CreateBuiltInFunctionContainer creates a container that will hold all the available built in functions
@@ -38,6 +46,9 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (vm
 	if check.IfNil(args.ShardCoordinator) {
 		return nil, process.ErrNilShardCoordinator
 	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
 
 	vmcommonAccounts, ok := args.Accounts.(vmcommon.AccountsAdapter)
 	if !ok {
@@ -63,7 +74,78 @@ func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (vm
 		return nil, err
 	}
 
+	err = addTokenTypeCheckDecorators(container, vmcommonAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.EnableESDTSupplyLogs {
+		err = addSupplyLogsDecorators(container, args.Marshalizer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = addESDTAllowancesFunctions(container, args, vmcommonAccounts)
+	if err != nil {
+		return nil, err
+	}
+
+	setTokenTypeFunc, err := NewESDTSetTokenTypeFunc(ArgsNewESDTSetTokenTypeFunc{
+		Accounts:      vmcommonAccounts,
+		EpochNotifier: args.EpochNotifier,
+		EnableEpoch:   args.MetaESDTEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = container.Add(core.BuiltInFunctionESDTSetTokenType, setTokenTypeFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	args.GasSchedule.RegisterNotifyHandler(bContainerFactory)
 
 	return container, nil
 }
+
+func addESDTAllowancesFunctions(
+	container vmcommon.BuiltInFunctionContainer,
+	args ArgsCreateBuiltInFunctionContainer,
+	accounts vmcommon.AccountsAdapter,
+) error {
+	pauseFunc, err := container.Get(core.BuiltInFunctionESDTPause)
+	if err != nil {
+		return err
+	}
+	pauseHandler, ok := pauseFunc.(vmcommon.ESDTPauseHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	argsAllowances := ArgsNewESDTAllowancesFunc{
+		FuncGasCost:      args.GasSchedule.LatestGasSchedule()[core.BuiltInCost]["ESDTTransfer"],
+		Marshalizer:      args.Marshalizer,
+		PauseHandler:     pauseHandler,
+		Accounts:         accounts,
+		ShardCoordinator: args.ShardCoordinator,
+		EpochNotifier:    args.EpochNotifier,
+		EnableEpoch:      args.ESDTAllowancesEnableEpoch,
+	}
+
+	approveFunc, err := NewESDTApproveFunc(argsAllowances)
+	if err != nil {
+		return err
+	}
+	err = container.Add(core.BuiltInFunctionESDTApprove, approveFunc)
+	if err != nil {
+		return err
+	}
+
+	transferFromFunc, err := NewESDTTransferFromFunc(argsAllowances)
+	if err != nil {
+		return err
+	}
+
+	return container.Add(core.BuiltInFunctionESDTTransferFrom, transferFromFunc)
+}
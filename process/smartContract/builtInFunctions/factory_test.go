@@ -23,6 +23,7 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &testscommon.AccountsStub{},
 		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(1),
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -87,10 +88,16 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Equal(t, process.ErrNilDnsAddresses, err)
 	assert.Nil(t, container)
 
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, container)
+
 	args = createMockArguments()
 	container, err = CreateBuiltInFunctionContainer(args)
 	assert.Nil(t, err)
-	assert.Equal(t, len(container.Keys()), 20)
+	assert.Equal(t, len(container.Keys()), 23)
 
 	err = vmcommonBuiltInFunctions.SetPayableHandler(container, &mock.BlockChainHookHandlerMock{})
 	assert.Nil(t, err)
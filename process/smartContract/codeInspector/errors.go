@@ -0,0 +1,9 @@
+package codeInspector
+
+import "errors"
+
+// ErrInvalidWasmCode signals that the provided code is not a valid WebAssembly module
+var ErrInvalidWasmCode = errors.New("invalid wasm code")
+
+// ErrUnexpectedEndOfCode signals that the code ended before a complete section could be read
+var ErrUnexpectedEndOfCode = errors.New("unexpected end of wasm code")
@@ -0,0 +1,404 @@
+package codeInspector
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	sectionType     = 1
+	sectionImport   = 2
+	sectionFunction = 3
+	sectionExport   = 7
+
+	externalKindFunction = 0
+	externalKindTable    = 1
+	externalKindMemory   = 2
+	externalKindGlobal   = 3
+
+	functionTypeForm = 0x60
+)
+
+var wasmMagic = []byte{0x00, 0x61, 0x73, 0x6d}
+
+var valueTypeNames = map[byte]string{
+	0x7f: "i32",
+	0x7e: "i64",
+	0x7d: "f32",
+	0x7c: "f64",
+	0x7b: "v128",
+	0x70: "funcref",
+	0x6f: "externref",
+}
+
+// ExportedFunction holds the name and the signature of a function exported by a wasm module
+type ExportedFunction struct {
+	Name      string
+	Signature string
+}
+
+// ExportsDifferences holds the differences between the functions exported by two versions of a contract
+type ExportsDifferences struct {
+	Added            []string
+	Removed          []string
+	ChangedSignature []string
+}
+
+// GetExportedFunctions parses the wasm code and returns the exported functions, sorted by name
+func GetExportedFunctions(code []byte) ([]ExportedFunction, error) {
+	reader, err := newModuleReader(code)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]string, 0)
+	functionTypes := make([]uint32, 0)
+	exports := make([]ExportedFunction, 0)
+	exportedIndexes := make([]uint32, 0)
+
+	for !reader.isEmpty() {
+		sectionID, errRead := reader.readByte()
+		if errRead != nil {
+			return nil, errRead
+		}
+		sectionSize, errRead := reader.readU32()
+		if errRead != nil {
+			return nil, errRead
+		}
+		section, errRead := reader.readBytes(sectionSize)
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		sectionReader := &moduleReader{code: section}
+		switch sectionID {
+		case sectionType:
+			signatures, err = sectionReader.readTypeSection()
+		case sectionImport:
+			functionTypes, err = sectionReader.readImportSection(functionTypes)
+		case sectionFunction:
+			functionTypes, err = sectionReader.readFunctionSection(functionTypes)
+		case sectionExport:
+			exports, exportedIndexes, err = sectionReader.readExportSection()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, functionIndex := range exportedIndexes {
+		if int(functionIndex) >= len(functionTypes) || int(functionTypes[functionIndex]) >= len(signatures) {
+			return nil, fmt.Errorf("%w: exported function %s has no type", ErrInvalidWasmCode, exports[i].Name)
+		}
+		exports[i].Signature = signatures[functionTypes[functionIndex]]
+	}
+
+	sort.Slice(exports, func(i, j int) bool {
+		return exports[i].Name < exports[j].Name
+	})
+
+	return exports, nil
+}
+
+// CompareExportedFunctions returns the differences between the functions exported by the old and the new code
+func CompareExportedFunctions(oldCode []byte, newCode []byte) (*ExportsDifferences, error) {
+	oldExports, err := GetExportedFunctions(oldCode)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the current code", err)
+	}
+	newExports, err := GetExportedFunctions(newCode)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the new code", err)
+	}
+
+	oldSignatures := make(map[string]string, len(oldExports))
+	for _, export := range oldExports {
+		oldSignatures[export.Name] = export.Signature
+	}
+
+	differences := &ExportsDifferences{
+		Added:            make([]string, 0),
+		Removed:          make([]string, 0),
+		ChangedSignature: make([]string, 0),
+	}
+	for _, export := range newExports {
+		oldSignature, found := oldSignatures[export.Name]
+		if !found {
+			differences.Added = append(differences.Added, export.Name)
+			continue
+		}
+		if oldSignature != export.Signature {
+			differences.ChangedSignature = append(differences.ChangedSignature,
+				fmt.Sprintf("%s: %s -> %s", export.Name, oldSignature, export.Signature))
+		}
+		delete(oldSignatures, export.Name)
+	}
+	for _, export := range oldExports {
+		if _, stillPresent := oldSignatures[export.Name]; stillPresent {
+			differences.Removed = append(differences.Removed, export.Name)
+		}
+	}
+
+	return differences, nil
+}
+
+type moduleReader struct {
+	code   []byte
+	offset int
+}
+
+func newModuleReader(code []byte) (*moduleReader, error) {
+	if len(code) < 8 || !bytes.Equal(code[:4], wasmMagic) {
+		return nil, ErrInvalidWasmCode
+	}
+
+	return &moduleReader{code: code, offset: 8}, nil
+}
+
+func (mr *moduleReader) isEmpty() bool {
+	return mr.offset >= len(mr.code)
+}
+
+func (mr *moduleReader) readByte() (byte, error) {
+	if mr.isEmpty() {
+		return 0, ErrUnexpectedEndOfCode
+	}
+
+	value := mr.code[mr.offset]
+	mr.offset++
+
+	return value, nil
+}
+
+func (mr *moduleReader) readBytes(length uint32) ([]byte, error) {
+	if uint64(mr.offset)+uint64(length) > uint64(len(mr.code)) {
+		return nil, ErrUnexpectedEndOfCode
+	}
+
+	value := mr.code[mr.offset : mr.offset+int(length)]
+	mr.offset += int(length)
+
+	return value, nil
+}
+
+// readU32 reads an unsigned LEB128 encoded integer
+func (mr *moduleReader) readU32() (uint32, error) {
+	result := uint32(0)
+	for shift := uint(0); shift < 35; shift += 7 {
+		current, err := mr.readByte()
+		if err != nil {
+			return 0, err
+		}
+
+		result |= uint32(current&0x7f) << shift
+		if current&0x80 == 0 {
+			return result, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: integer too large", ErrInvalidWasmCode)
+}
+
+// readCount reads the number of entries of a vector, rejecting counts that could not fit in the remaining bytes,
+// as each entry takes at least one byte
+func (mr *moduleReader) readCount() (uint32, error) {
+	count, err := mr.readU32()
+	if err != nil {
+		return 0, err
+	}
+	if uint64(count) > uint64(len(mr.code)-mr.offset) {
+		return 0, fmt.Errorf("%w: vector of %d entries exceeds the remaining %d bytes", ErrInvalidWasmCode, count, len(mr.code)-mr.offset)
+	}
+
+	return count, nil
+}
+
+func (mr *moduleReader) readName() (string, error) {
+	length, err := mr.readU32()
+	if err != nil {
+		return "", err
+	}
+
+	name, err := mr.readBytes(length)
+	if err != nil {
+		return "", err
+	}
+
+	return string(name), nil
+}
+
+func (mr *moduleReader) readValueTypes() ([]string, error) {
+	count, err := mr.readCount()
+	if err != nil {
+		return nil, err
+	}
+
+	valueTypes := make([]string, 0)
+	for i := uint32(0); i < count; i++ {
+		valueType, errRead := mr.readByte()
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		name, found := valueTypeNames[valueType]
+		if !found {
+			return nil, fmt.Errorf("%w: unknown value type %#x", ErrInvalidWasmCode, valueType)
+		}
+		valueTypes = append(valueTypes, name)
+	}
+
+	return valueTypes, nil
+}
+
+func (mr *moduleReader) readTypeSection() ([]string, error) {
+	count, err := mr.readCount()
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := make([]string, 0)
+	for i := uint32(0); i < count; i++ {
+		form, errRead := mr.readByte()
+		if errRead != nil {
+			return nil, errRead
+		}
+		if form != functionTypeForm {
+			return nil, fmt.Errorf("%w: unknown type form %#x", ErrInvalidWasmCode, form)
+		}
+
+		params, errRead := mr.readValueTypes()
+		if errRead != nil {
+			return nil, errRead
+		}
+		results, errRead := mr.readValueTypes()
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		signatures = append(signatures, fmt.Sprintf("(%s) -> (%s)", strings.Join(params, ", "), strings.Join(results, ", ")))
+	}
+
+	return signatures, nil
+}
+
+func (mr *moduleReader) readImportSection(functionTypes []uint32) ([]uint32, error) {
+	count, err := mr.readCount()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < count; i++ {
+		_, err = mr.readName()
+		if err != nil {
+			return nil, err
+		}
+		_, err = mr.readName()
+		if err != nil {
+			return nil, err
+		}
+
+		functionTypes, err = mr.readImportDescriptor(functionTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return functionTypes, nil
+}
+
+func (mr *moduleReader) readImportDescriptor(functionTypes []uint32) ([]uint32, error) {
+	kind, err := mr.readByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case externalKindFunction:
+		typeIndex, errRead := mr.readU32()
+		if errRead != nil {
+			return nil, errRead
+		}
+		return append(functionTypes, typeIndex), nil
+	case externalKindTable:
+		_, err = mr.readByte()
+		if err != nil {
+			return nil, err
+		}
+		return functionTypes, mr.readLimits()
+	case externalKindMemory:
+		return functionTypes, mr.readLimits()
+	case externalKindGlobal:
+		_, err = mr.readBytes(2)
+		return functionTypes, err
+	default:
+		return nil, fmt.Errorf("%w: unknown import kind %#x", ErrInvalidWasmCode, kind)
+	}
+}
+
+func (mr *moduleReader) readLimits() error {
+	hasMaximum, err := mr.readByte()
+	if err != nil {
+		return err
+	}
+
+	_, err = mr.readU32()
+	if err != nil {
+		return err
+	}
+	if hasMaximum == 1 {
+		_, err = mr.readU32()
+	}
+
+	return err
+}
+
+func (mr *moduleReader) readFunctionSection(functionTypes []uint32) ([]uint32, error) {
+	count, err := mr.readCount()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < count; i++ {
+		typeIndex, errRead := mr.readU32()
+		if errRead != nil {
+			return nil, errRead
+		}
+		functionTypes = append(functionTypes, typeIndex)
+	}
+
+	return functionTypes, nil
+}
+
+func (mr *moduleReader) readExportSection() ([]ExportedFunction, []uint32, error) {
+	count, err := mr.readCount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exports := make([]ExportedFunction, 0)
+	indexes := make([]uint32, 0)
+	for i := uint32(0); i < count; i++ {
+		name, errRead := mr.readName()
+		if errRead != nil {
+			return nil, nil, errRead
+		}
+		kind, errRead := mr.readByte()
+		if errRead != nil {
+			return nil, nil, errRead
+		}
+		index, errRead := mr.readU32()
+		if errRead != nil {
+			return nil, nil, errRead
+		}
+
+		if kind != externalKindFunction {
+			continue
+		}
+		exports = append(exports, ExportedFunction{Name: name})
+		indexes = append(indexes, index)
+	}
+
+	return exports, indexes, nil
+}
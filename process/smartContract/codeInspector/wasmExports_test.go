@@ -0,0 +1,139 @@
+package codeInspector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createSection(id byte, content ...byte) []byte {
+	return append([]byte{id, byte(len(content))}, content...)
+}
+
+func createName(name string) []byte {
+	return append([]byte{byte(len(name))}, []byte(name)...)
+}
+
+func createExport(name string, kind byte, index byte) []byte {
+	return append(createName(name), kind, index)
+}
+
+// createModule returns a module importing one function and the memory, and exporting the provided functions,
+// defined as: 1 -> () -> (), 2 -> (i32) -> (i64)
+func createModule(exports ...[]byte) []byte {
+	module := append([]byte{}, wasmMagic...)
+	module = append(module, 0x01, 0x00, 0x00, 0x00)
+
+	module = append(module, createSection(sectionType,
+		0x02,
+		functionTypeForm, 0x00, 0x00,
+		functionTypeForm, 0x01, 0x7f, 0x01, 0x7e,
+	)...)
+
+	imports := []byte{0x02}
+	imports = append(imports, createName("env")...)
+	imports = append(imports, createName("f")...)
+	imports = append(imports, externalKindFunction, 0x00)
+	imports = append(imports, createName("env")...)
+	imports = append(imports, createName("mem")...)
+	imports = append(imports, externalKindMemory, 0x00, 0x01)
+	module = append(module, createSection(sectionImport, imports...)...)
+
+	module = append(module, createSection(sectionFunction, 0x02, 0x00, 0x01)...)
+
+	exportSection := []byte{byte(len(exports))}
+	for _, export := range exports {
+		exportSection = append(exportSection, export...)
+	}
+
+	return append(module, createSection(sectionExport, exportSection...)...)
+}
+
+func TestGetExportedFunctions_InvalidCodeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exports, err := GetExportedFunctions([]byte("not wasm code"))
+	require.Nil(t, exports)
+	require.Equal(t, ErrInvalidWasmCode, err)
+
+	module := createModule(createExport("init", externalKindFunction, 1))
+	exports, err = GetExportedFunctions(module[:len(module)-2])
+	require.Nil(t, exports)
+	require.Equal(t, ErrUnexpectedEndOfCode, err)
+
+	module = createModule(createExport("init", externalKindFunction, 7))
+	exports, err = GetExportedFunctions(module)
+	require.Nil(t, exports)
+	require.True(t, errors.Is(err, ErrInvalidWasmCode))
+}
+
+func TestGetExportedFunctions_CountLargerThanSectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	// a type section declaring 0xffffffff entries in a 5 bytes body
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x05, 0xff, 0xff, 0xff, 0xff, 0x0f}
+	exports, err := GetExportedFunctions(module)
+	require.Nil(t, exports)
+	require.True(t, errors.Is(err, ErrInvalidWasmCode))
+
+	module = append([]byte{}, wasmMagic...)
+	module = append(module, 0x01, 0x00, 0x00, 0x00)
+	module = append(module, createSection(sectionType, 0x01, functionTypeForm, 0xff, 0xff, 0xff, 0xff, 0x0f)...)
+	exports, err = GetExportedFunctions(module)
+	require.Nil(t, exports)
+	require.True(t, errors.Is(err, ErrInvalidWasmCode))
+
+	module = append([]byte{}, wasmMagic...)
+	module = append(module, 0x01, 0x00, 0x00, 0x00)
+	module = append(module, createSection(sectionExport, 0xff, 0xff, 0xff, 0xff, 0x0f)...)
+	exports, err = GetExportedFunctions(module)
+	require.Nil(t, exports)
+	require.True(t, errors.Is(err, ErrInvalidWasmCode))
+}
+
+func TestGetExportedFunctions_ShouldReturnSortedFunctionExports(t *testing.T) {
+	t.Parallel()
+
+	module := createModule(
+		createExport("init", externalKindFunction, 1),
+		createExport("get", externalKindFunction, 2),
+		createExport("memory", externalKindMemory, 0),
+		createExport("imported", externalKindFunction, 0),
+	)
+
+	exports, err := GetExportedFunctions(module)
+	require.Nil(t, err)
+	require.Equal(t, []ExportedFunction{
+		{Name: "get", Signature: "(i32) -> (i64)"},
+		{Name: "imported", Signature: "() -> ()"},
+		{Name: "init", Signature: "() -> ()"},
+	}, exports)
+}
+
+func TestCompareExportedFunctions(t *testing.T) {
+	t.Parallel()
+
+	oldCode := createModule(
+		createExport("init", externalKindFunction, 1),
+		createExport("get", externalKindFunction, 2),
+		createExport("removed", externalKindFunction, 1),
+	)
+	newCode := createModule(
+		createExport("init", externalKindFunction, 1),
+		createExport("get", externalKindFunction, 1),
+		createExport("added", externalKindFunction, 2),
+	)
+
+	differences, err := CompareExportedFunctions(oldCode, newCode)
+	require.Nil(t, err)
+	require.Equal(t, &ExportsDifferences{
+		Added:            []string{"added"},
+		Removed:          []string{"removed"},
+		ChangedSignature: []string{"get: (i32) -> (i64) -> () -> ()"},
+	}, differences)
+
+	differences, err = CompareExportedFunctions(oldCode, []byte("invalid"))
+	require.Nil(t, differences)
+	require.True(t, errors.Is(err, ErrInvalidWasmCode))
+}
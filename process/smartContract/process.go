@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -58,6 +59,7 @@ type scProcessor struct {
 	returnDataToLastTransferEnableEpoch         uint32
 	senderInOutTransferEnableEpoch              uint32
 	incrementSCRNonceInMultiTransferEnableEpoch uint32
+	codeChangeLogsEnableEpoch                   uint32
 	flagStakingV2                               atomic.Flag
 	flagDeploy                                  atomic.Flag
 	flagBuiltin                                 atomic.Flag
@@ -66,6 +68,7 @@ type scProcessor struct {
 	flagReturnDataToLastTransfer                atomic.Flag
 	flagSenderInOutTransfer                     atomic.Flag
 	flagIncrementSCRNonceInMultiTransfer        atomic.Flag
+	flagCodeChangeLogs                          atomic.Flag
 	arwenChangeLocker                           process.Locker
 
 	badTxForwarder process.IntermediateTransactionHandler
@@ -108,6 +111,7 @@ type ArgsNewSmartContractProcessor struct {
 	ReturnDataToLastTransferEnableEpoch         uint32
 	SenderInOutTransferEnableEpoch              uint32
 	IncrementSCRNonceInMultiTransferEnableEpoch uint32
+	CodeChangeLogsEnableEpoch                   uint32
 	EpochNotifier                               process.EpochNotifier
 	VMOutputCacher                              storage.Cacher
 	ArwenChangeLocker                           process.Locker
@@ -204,6 +208,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		vmOutputCacher:                      args.VMOutputCacher,
 
 		incrementSCRNonceInMultiTransferEnableEpoch: args.IncrementSCRNonceInMultiTransferEnableEpoch,
+		codeChangeLogsEnableEpoch:                   args.CodeChangeLogsEnableEpoch,
 	}
 
 	log.Debug("smartContract/process: enable epoch for sc deploy", "epoch", sc.deployEnableEpoch)
@@ -350,6 +355,14 @@ func (sc *scProcessor) doExecuteSmartContractTransaction(
 		return vmcommon.ExecutionFailed, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(vmOutput.ReturnMessage), snapshot, vmInput.GasLocked)
 	}
 
+	if sc.flagCodeChangeLogs.IsSet() {
+		var upgradedAddress []byte
+		if vmInput.Function == upgradeFunctionName {
+			upgradedAddress = tx.GetRcvAddr()
+		}
+		addCodeChangeLogs(vmOutput, tx.GetSndAddr(), upgradedAddress)
+	}
+
 	return sc.finishSCExecution(results, txHash, tx, vmOutput, 0)
 }
 
@@ -1427,6 +1440,14 @@ func (sc *scProcessor) DeploySmartContract(tx data.TransactionHandler, acntSnd s
 		return 0, err
 	}
 
+	if sc.flagCodeChangeLogs.IsSet() {
+		addCodeChangeLogs(vmOutput, tx.GetSndAddr(), nil)
+		ignorableError := sc.txLogsProcessor.SaveLog(txHash, tx, vmOutput.Logs)
+		if ignorableError != nil {
+			log.Debug("scProcessor.DeploySmartContract txLogsProcessor.SaveLog()", "error", ignorableError.Error())
+		}
+	}
+
 	totalConsumedFee, totalDevRwd := sc.computeTotalConsumedFeeAndDevRwd(tx, vmOutput, 0)
 	sc.txFeeHandler.ProcessTransactionFee(totalConsumedFee, totalDevRwd, txHash)
 	sc.printScDeployed(vmOutput, tx)
@@ -1469,6 +1490,39 @@ func (sc *scProcessor) printScDeployed(vmOutput *vmcommon.VMOutput, tx data.Tran
 		"SC address(es)", strings.Join(scGenerated, ", "))
 }
 
+// addCodeChangeLogs appends a deploy or upgrade log entry for each output account that received code, so that the
+// code changes of a contract can be followed through its events
+func addCodeChangeLogs(vmOutput *vmcommon.VMOutput, sender []byte, upgradedAddress []byte) {
+	accountsWithCode := make([]*vmcommon.OutputAccount, 0)
+	for _, account := range vmOutput.OutputAccounts {
+		if account == nil || len(account.Code) == 0 {
+			continue
+		}
+		accountsWithCode = append(accountsWithCode, account)
+	}
+	sort.Slice(accountsWithCode, func(i, j int) bool {
+		return bytes.Compare(accountsWithCode[i].Address, accountsWithCode[j].Address) < 0
+	})
+
+	for _, account := range accountsWithCode {
+		deployer := account.CodeDeployerAddress
+		if len(deployer) == 0 {
+			deployer = sender
+		}
+
+		identifier := core.SCDeployIdentifier
+		if bytes.Equal(account.Address, upgradedAddress) {
+			identifier = core.SCUpgradeIdentifier
+		}
+
+		vmOutput.Logs = append(vmOutput.Logs, &vmcommon.LogEntry{
+			Identifier: []byte(identifier),
+			Address:    account.Address,
+			Topics:     [][]byte{account.Address, deployer},
+		})
+	}
+}
+
 // taking money from sender, as VM might not have access to him because of state sharding
 func (sc *scProcessor) processSCPayment(tx data.TransactionHandler, acntSnd state.UserAccountHandler) error {
 	if check.IfNil(acntSnd) {
@@ -2367,6 +2421,9 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 
 	sc.flagIncrementSCRNonceInMultiTransfer.Toggle(epoch >= sc.incrementSCRNonceInMultiTransferEnableEpoch)
 	log.Debug("scProcessor: increment SCR nonce in multi transfer", "enabled", sc.flagIncrementSCRNonceInMultiTransfer.IsSet())
+
+	sc.flagCodeChangeLogs.Toggle(epoch >= sc.codeChangeLogsEnableEpoch)
+	log.Debug("scProcessor: code change logs", "enabled", sc.flagCodeChangeLogs.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1378,6 +1378,46 @@ func TestScProcessor_DeploySmartContract(t *testing.T) {
 	require.Nil(t, tsp.GetLatestTestError())
 }
 
+func TestScProcessor_DeploySmartContractCodeChangeLogs(t *testing.T) {
+	t.Parallel()
+
+	deployWithCodeChangeLogsEnableEpoch := func(enableEpoch uint32) bool {
+		vm := &mock.VMContainerMock{}
+		accntState := &testscommon.AccountsStub{}
+		arguments := createMockSmartContractProcessorArguments()
+		arguments.VmContainer = vm
+		arguments.ArgsParser = NewArgumentParser()
+		arguments.AccountsDB = accntState
+		arguments.CodeChangeLogsEnableEpoch = enableEpoch
+		saveLogCalled := false
+		arguments.TxLogsProcessor = &mock.TxLogsProcessorStub{
+			SaveLogCalled: func(_ []byte, _ data.TransactionHandler, _ []*vmcommon.LogEntry) error {
+				saveLogCalled = true
+				return nil
+			},
+		}
+		sc, _ := NewSmartContractProcessor(arguments)
+
+		tx := &transaction.Transaction{}
+		tx.SndAddr = []byte("SRC")
+		tx.RcvAddr = generateEmptyByteSlice(createMockPubkeyConverter().Len())
+		tx.Data = []byte("abba@0500@0000")
+		tx.Value = big.NewInt(0)
+		acntSrc, _ := createAccounts(tx)
+		accntState.LoadAccountCalled = func(address []byte) (handler vmcommon.AccountHandler, e error) {
+			return acntSrc, nil
+		}
+
+		_, err := sc.DeploySmartContract(tx, acntSrc)
+		require.Nil(t, err)
+
+		return saveLogCalled
+	}
+
+	require.False(t, deployWithCodeChangeLogsEnableEpoch(maxEpoch))
+	require.True(t, deployWithCodeChangeLogsEnableEpoch(0))
+}
+
 func TestScProcessor_ExecuteSmartContractTransactionNilTx(t *testing.T) {
 	t.Parallel()
 
@@ -3914,3 +3954,34 @@ func TestMergeVmOutputLogs(t *testing.T) {
 	mergeVMOutputLogs(vmOutput1, vmOutput2)
 	require.Len(t, vmOutput1.Logs, 2)
 }
+
+func TestAddCodeChangeLogs(t *testing.T) {
+	t.Parallel()
+
+	existingLog := &vmcommon.LogEntry{Identifier: []byte("existing")}
+	vmOutput := &vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"upgraded": {Address: []byte("upgraded"), Code: []byte("code")},
+			"deployed": {Address: []byte("deployed"), Code: []byte("code"), CodeDeployerAddress: []byte("parent")},
+			"noCode":   {Address: []byte("noCode")},
+			"nil":      nil,
+		},
+		Logs: []*vmcommon.LogEntry{existingLog},
+	}
+
+	addCodeChangeLogs(vmOutput, []byte("sender"), []byte("upgraded"))
+
+	require.Equal(t, []*vmcommon.LogEntry{
+		existingLog,
+		{
+			Identifier: []byte(core.SCDeployIdentifier),
+			Address:    []byte("deployed"),
+			Topics:     [][]byte{[]byte("deployed"), []byte("parent")},
+		},
+		{
+			Identifier: []byte(core.SCUpgradeIdentifier),
+			Address:    []byte("upgraded"),
+			Topics:     [][]byte{[]byte("upgraded"), []byte("sender")},
+		},
+	}, vmOutput.Logs)
+}
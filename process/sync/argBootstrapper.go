@@ -41,6 +41,7 @@ type ArgBaseBootstrapper struct {
 	Indexer              process.Indexer
 	AccountsDBSyncer     process.AccountsDBSyncer
 	CurrentEpochProvider process.CurrentNetworkEpochProviderHandler
+	SyncIntrospector     process.SyncIntrospectionHandler
 	IsInImportMode       bool
 }
 
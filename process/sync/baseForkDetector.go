@@ -2,12 +2,15 @@ package sync
 
 import (
 	"bytes"
+	"encoding/hex"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -725,3 +728,57 @@ func (bfd *baseForkDetector) processReceivedBlock(
 func (bfd *baseForkDetector) SetFinalToLastCheckpoint() {
 	bfd.setFinalCheckpoint(bfd.lastCheckpoint())
 }
+
+// GetStatus returns a snapshot of the headers, checkpoints and nonces the fork detector uses in its decisions
+func (bfd *baseForkDetector) GetStatus() *api.ForkDetectorStatus {
+	status := &api.ForkDetectorStatus{
+		Headers:     make([]*api.ForkDetectorHeader, 0),
+		Checkpoints: make([]*api.ForkDetectorCheckpoint, 0),
+	}
+
+	bfd.mutHeaders.RLock()
+	for _, hdrInfos := range bfd.headers {
+		for _, hdrInfo := range hdrInfos {
+			status.Headers = append(status.Headers, &api.ForkDetectorHeader{
+				Nonce: hdrInfo.nonce,
+				Round: hdrInfo.round,
+				Epoch: hdrInfo.epoch,
+				Hash:  hex.EncodeToString(hdrInfo.hash),
+				State: hdrInfo.state.String(),
+			})
+		}
+	}
+	bfd.mutHeaders.RUnlock()
+
+	sort.Slice(status.Headers, func(i, j int) bool {
+		if status.Headers[i].Nonce == status.Headers[j].Nonce {
+			return status.Headers[i].Round < status.Headers[j].Round
+		}
+		return status.Headers[i].Nonce < status.Headers[j].Nonce
+	})
+
+	bfd.mutFork.RLock()
+	for _, checkpoint := range bfd.fork.checkpoint {
+		status.Checkpoints = append(status.Checkpoints, checkpointToAPI(checkpoint))
+	}
+	status.FinalCheckpoint = checkpointToAPI(bfd.fork.finalCheckpoint)
+	status.ProbableHighestNonce = bfd.fork.probableHighestNonce
+	status.HighestNonceReceived = bfd.fork.highestNonceReceived
+	status.RollBackNonce = bfd.fork.rollBackNonce
+	status.LastRoundWithForcedFork = bfd.fork.lastRoundWithForcedFork
+	bfd.mutFork.RUnlock()
+
+	return status
+}
+
+func checkpointToAPI(checkpoint *checkpointInfo) *api.ForkDetectorCheckpoint {
+	if checkpoint == nil {
+		return nil
+	}
+
+	return &api.ForkDetectorCheckpoint{
+		Nonce: checkpoint.nonce,
+		Round: checkpoint.round,
+		Hash:  hex.EncodeToString(checkpoint.hash),
+	}
+}
@@ -1,6 +1,7 @@
 package sync_test
 
 import (
+	"encoding/hex"
 	"math"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewBasicForkDetector_ShouldErrNilRoundHandler(t *testing.T) {
@@ -1179,3 +1181,44 @@ func TestBasicForkDetector_SetFinalToLastCheckpointShouldWork(t *testing.T) {
 	assert.Equal(t, uint64(900), bfd.GetHighestFinalBlockNonce())
 	assert.Equal(t, []byte("hash"), bfd.GetHighestFinalBlockHash())
 }
+
+func TestBasicForkDetector_GetStatusShouldWork(t *testing.T) {
+	t.Parallel()
+
+	roundHandlerMock := &mock.RoundHandlerMock{}
+	bfd, _ := sync.NewMetaForkDetector(
+		roundHandlerMock,
+		&mock.BlackListHandlerStub{},
+		&mock.BlockTrackerMock{},
+		0,
+	)
+
+	roundHandlerMock.RoundIndex = 6
+	_ = bfd.AddHeader(
+		&block.MetaBlock{Nonce: 5, Round: 6, PubKeysBitmap: []byte("X")},
+		[]byte("hash2"),
+		process.BHReceived,
+		nil,
+		nil)
+	_ = bfd.AddHeader(
+		&block.MetaBlock{Nonce: 4, Round: 5, PubKeysBitmap: []byte("X")},
+		[]byte("hash1"),
+		process.BHProcessed,
+		nil,
+		nil)
+	bfd.SetRollBackNonce(3)
+
+	status := bfd.GetStatus()
+	require.Equal(t, 2, len(status.Headers))
+	assert.Equal(t, uint64(4), status.Headers[0].Nonce)
+	assert.Equal(t, hex.EncodeToString([]byte("hash1")), status.Headers[0].Hash)
+	assert.Equal(t, process.BHProcessed.String(), status.Headers[0].State)
+	assert.Equal(t, uint64(5), status.Headers[1].Nonce)
+	assert.Equal(t, process.BHReceived.String(), status.Headers[1].State)
+	require.Equal(t, 2, len(status.Checkpoints))
+	assert.Equal(t, uint64(4), status.Checkpoints[1].Nonce)
+	assert.Equal(t, uint64(0), status.FinalCheckpoint.Nonce)
+	assert.Equal(t, uint64(5), status.ProbableHighestNonce)
+	assert.Equal(t, uint64(5), status.HighestNonceReceived)
+	assert.Equal(t, uint64(3), status.RollBackNonce)
+}
@@ -3,6 +3,8 @@ package sync
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"fmt"
 	"math"
 	"sync"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/typeConverters"
@@ -31,6 +34,14 @@ var _ closing.Closer = (*baseBootstrap)(nil)
 // sleepTime defines the time in milliseconds between each iteration made in syncBlocks method
 const sleepTime = 5 * time.Millisecond
 
+const (
+	rollBackReasonForkDetected        = "fork detected"
+	rollBackReasonForcedOneBlock      = "roll back one block forced"
+	rollBackReasonForcedToNonce       = "roll back to nonce forced"
+	rollBackReasonProcessingError     = "block processing failed"
+	rollBackReasonSyncWithErrorsLimit = "sync with errors limit reached"
+)
+
 // hdrInfo hold the data related to a header
 type hdrInfo struct {
 	Nonce uint64
@@ -114,6 +125,7 @@ type baseBootstrap struct {
 	mutRequestHeaders  sync.Mutex
 	cancelFunc         func()
 	isInImportMode     bool
+	syncIntrospector   process.SyncIntrospectionHandler
 }
 
 // setRequestedHeaderNonce method sets the header nonce requested by the sync mechanism
@@ -308,12 +320,39 @@ func (boot *baseBootstrap) computeNodeState() {
 	}
 
 	boot.statusHandler.SetUInt64Value(core.MetricIsSyncing, result)
+	boot.syncIntrospector.SetSyncState(boot.createSyncState(isNodeConnectedToTheNetwork))
 
 	if boot.shouldTryToRequestHeaders() {
 		go boot.requestHeadersIfSyncIsStuck()
 	}
 }
 
+func (boot *baseBootstrap) createSyncState(isNodeConnectedToTheNetwork bool) *api.SyncState {
+	syncState := &api.SyncState{
+		IsSynchronized:          boot.isNodeSynchronized,
+		HasLastBlock:            boot.hasLastBlock,
+		IsConnectedToTheNetwork: isNodeConnectedToTheNetwork,
+		IsForkDetected:          boot.forkInfo.IsDetected,
+		ProbableHighestNonce:    boot.forkDetector.ProbableHighestNonce(),
+		HighestFinalNonce:       boot.forkDetector.GetHighestFinalBlockNonce(),
+		Round:                   boot.roundIndex,
+		Timestamp:               time.Now().Unix(),
+	}
+	if boot.forkInfo.IsDetected {
+		syncState.ForkNonce = boot.forkInfo.Nonce
+		syncState.ForkRound = boot.forkInfo.Round
+		syncState.ForkHash = hex.EncodeToString(boot.forkInfo.Hash)
+	}
+
+	currentHeader := boot.chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		syncState.CurrentNonce = currentHeader.GetNonce()
+		syncState.CurrentHash = hex.EncodeToString(boot.chainHandler.GetCurrentBlockHeaderHash())
+	}
+
+	return syncState
+}
+
 func (boot *baseBootstrap) shouldTryToRequestHeaders() bool {
 	if boot.roundHandler.BeforeGenesis() {
 		return false
@@ -457,6 +496,9 @@ func checkBootstrapNilParameters(arguments ArgBaseBootstrapper) error {
 	if check.IfNil(arguments.CurrentEpochProvider) {
 		return process.ErrNilCurrentNetworkEpochProvider
 	}
+	if check.IfNil(arguments.SyncIntrospector) {
+		return process.ErrNilSyncIntrospectionHandler
+	}
 
 	return nil
 }
@@ -516,7 +558,12 @@ func (boot *baseBootstrap) doJobOnSyncBlockFail(bodyHandler data.BodyHandler, he
 			boot.forkDetector.RemoveHeader(headerHandler.GetNonce(), hash)
 		}
 
-		errNotCritical := boot.rollBack(false)
+		reason := rollBackReasonProcessingError
+		if !isProcessWithError {
+			reason = rollBackReasonSyncWithErrorsLimit
+		}
+
+		errNotCritical := boot.rollBackAndRecord(false, reason, err)
 		if errNotCritical != nil {
 			log.Debug("rollBack", "error", errNotCritical.Error())
 		}
@@ -575,7 +622,7 @@ func (boot *baseBootstrap) syncBlock() error {
 			"nonce", boot.forkInfo.Nonce,
 			"hash", boot.forkInfo.Hash,
 		)
-		err := boot.rollBack(true)
+		err := boot.rollBackAndRecord(true, rollBackReasonForkDetected, nil)
 		if err != nil {
 			return err
 		}
@@ -664,6 +711,45 @@ func (boot *baseBootstrap) cleanNoncesSyncedWithErrorsBehindFinal() {
 	}
 }
 
+// rollBackAndRecord calls rollBack and records the roll back, together with the reason which triggered it
+func (boot *baseBootstrap) rollBackAndRecord(revertUsingForkNonce bool, reason string, cause error) error {
+	if cause != nil {
+		reason = fmt.Sprintf("%s: %s", reason, cause.Error())
+	}
+
+	rollBackInfo := &api.SyncRollBack{
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+	}
+	if revertUsingForkNonce {
+		rollBackInfo.ForkNonce = boot.forkInfo.Nonce
+		rollBackInfo.ForkRound = boot.forkInfo.Round
+		rollBackInfo.ForkHash = hex.EncodeToString(boot.forkInfo.Hash)
+	}
+
+	currentHeader := boot.chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		rollBackInfo.FromNonce = currentHeader.GetNonce()
+		rollBackInfo.FromRound = currentHeader.GetRound()
+		rollBackInfo.FromHash = hex.EncodeToString(boot.chainHandler.GetCurrentBlockHeaderHash())
+	}
+
+	err := boot.rollBack(revertUsingForkNonce)
+	if err != nil {
+		rollBackInfo.Error = err.Error()
+	}
+
+	currentHeader = boot.chainHandler.GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		rollBackInfo.ToNonce = currentHeader.GetNonce()
+		rollBackInfo.ToHash = hex.EncodeToString(boot.chainHandler.GetCurrentBlockHeaderHash())
+	}
+
+	boot.syncIntrospector.AddRollBack(rollBackInfo)
+
+	return err
+}
+
 // rollBack decides if rollBackOneBlock must be called
 func (boot *baseBootstrap) rollBack(revertUsingForkNonce bool) error {
 	if boot.headerStore == nil {
@@ -822,7 +908,7 @@ func (boot *baseBootstrap) isForcedRollBackToNonce() bool {
 }
 
 func (boot *baseBootstrap) rollBackOneBlockForced() {
-	err := boot.rollBack(false)
+	err := boot.rollBackAndRecord(false, rollBackReasonForcedOneBlock, nil)
 	if err != nil {
 		log.Debug("rollBackOneBlockForced", "error", err.Error())
 	}
@@ -832,7 +918,7 @@ func (boot *baseBootstrap) rollBackOneBlockForced() {
 }
 
 func (boot *baseBootstrap) rollBackToNonceForced() {
-	err := boot.rollBack(true)
+	err := boot.rollBackAndRecord(true, rollBackReasonForcedToNonce, nil)
 	if err != nil {
 		log.Debug("rollBackToNonceForced", "error", err.Error())
 	}
@@ -0,0 +1,47 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+type syncIntrospector struct {
+}
+
+// NewSyncIntrospector returns a sync introspector which does not record anything
+func NewSyncIntrospector() *syncIntrospector {
+	return &syncIntrospector{}
+}
+
+// SetSyncState does nothing
+func (si *syncIntrospector) SetSyncState(_ *api.SyncState) {
+}
+
+// AddRollBack does nothing
+func (si *syncIntrospector) AddRollBack(_ *api.SyncRollBack) {
+}
+
+// AddHeaderSupplier does nothing
+func (si *syncIntrospector) AddHeaderSupplier(_ core.PeerID, _ data.HeaderHandler) {
+}
+
+// SyncState returns an empty sync state
+func (si *syncIntrospector) SyncState() *api.SyncState {
+	return &api.SyncState{}
+}
+
+// RollBacks returns an empty slice
+func (si *syncIntrospector) RollBacks() []*api.SyncRollBack {
+	return make([]*api.SyncRollBack, 0)
+}
+
+// HeaderSuppliers returns an empty slice
+func (si *syncIntrospector) HeaderSuppliers() []*api.SyncHeaderSupplier {
+	return make([]*api.SyncHeaderSupplier, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *syncIntrospector) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,9 @@
+package introspection
+
+import "errors"
+
+// ErrInvalidMaxNumRollBacks signals that an invalid maximum number of roll backs has been provided
+var ErrInvalidMaxNumRollBacks = errors.New("invalid maximum number of roll backs")
+
+// ErrInvalidMaxNumHeaderSuppliers signals that an invalid maximum number of header suppliers has been provided
+var ErrInvalidMaxNumHeaderSuppliers = errors.New("invalid maximum number of header suppliers")
@@ -0,0 +1,184 @@
+package introspection
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// ArgsSyncIntrospector holds the arguments needed to create a sync introspector
+type ArgsSyncIntrospector struct {
+	MaxNumRollBacks       int
+	MaxNumHeaderSuppliers int
+	StatusHandler         core.AppStatusHandler
+}
+
+type syncIntrospector struct {
+	maxNumRollBacks       int
+	maxNumHeaderSuppliers int
+	statusHandler         core.AppStatusHandler
+
+	mutState  sync.RWMutex
+	syncState *api.SyncState
+
+	mutRollBacks sync.RWMutex
+	rollBacks    []*api.SyncRollBack
+
+	mutSuppliers sync.RWMutex
+	suppliers    map[core.PeerID]*api.SyncHeaderSupplier
+}
+
+// NewSyncIntrospector creates a component which keeps the last computed sync state, the last roll backs done by the
+// sync mechanism and the peers which supplied headers, in order to be queried through the API
+func NewSyncIntrospector(args ArgsSyncIntrospector) (*syncIntrospector, error) {
+	if args.MaxNumRollBacks < 1 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidMaxNumRollBacks, args.MaxNumRollBacks)
+	}
+	if args.MaxNumHeaderSuppliers < 1 {
+		return nil, fmt.Errorf("%w, provided %d", ErrInvalidMaxNumHeaderSuppliers, args.MaxNumHeaderSuppliers)
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, process.ErrNilAppStatusHandler
+	}
+
+	return &syncIntrospector{
+		maxNumRollBacks:       args.MaxNumRollBacks,
+		maxNumHeaderSuppliers: args.MaxNumHeaderSuppliers,
+		statusHandler:         args.StatusHandler,
+		syncState:             &api.SyncState{},
+		rollBacks:             make([]*api.SyncRollBack, 0, args.MaxNumRollBacks),
+		suppliers:             make(map[core.PeerID]*api.SyncHeaderSupplier),
+	}, nil
+}
+
+// SetSyncState stores the last sync state computed by the bootstrapper
+func (si *syncIntrospector) SetSyncState(state *api.SyncState) {
+	if state == nil {
+		return
+	}
+
+	stateCopy := *state
+	si.mutState.Lock()
+	si.syncState = &stateCopy
+	si.mutState.Unlock()
+}
+
+// AddRollBack stores the provided roll back, keeping only the most recent ones
+func (si *syncIntrospector) AddRollBack(rollBack *api.SyncRollBack) {
+	if rollBack == nil {
+		return
+	}
+
+	rollBackCopy := *rollBack
+	si.mutRollBacks.Lock()
+	si.rollBacks = append(si.rollBacks, &rollBackCopy)
+	if len(si.rollBacks) > si.maxNumRollBacks {
+		si.rollBacks = si.rollBacks[len(si.rollBacks)-si.maxNumRollBacks:]
+	}
+	si.mutRollBacks.Unlock()
+
+	si.statusHandler.Increment(core.MetricNumSyncRollBacks)
+	si.statusHandler.SetStringValue(core.MetricLastSyncRollBackReason, rollBack.Reason)
+}
+
+// AddHeaderSupplier records that the provided peer supplied the provided header. When the maximum number of
+// suppliers is reached, the peer which did not supply headers for the longest time is removed
+func (si *syncIntrospector) AddHeaderSupplier(pid core.PeerID, header data.HeaderHandler) {
+	if len(pid) == 0 || check.IfNil(header) {
+		return
+	}
+
+	si.mutSuppliers.Lock()
+	supplier, exists := si.suppliers[pid]
+	if !exists {
+		si.evictOldestSupplierIfNeeded()
+		supplier = &api.SyncHeaderSupplier{
+			Pid: pid.Pretty(),
+		}
+		si.suppliers[pid] = supplier
+	}
+
+	supplier.NumHeaders++
+	supplier.LastNonce = header.GetNonce()
+	supplier.LastRound = header.GetRound()
+	supplier.LastShardID = header.GetShardID()
+	supplier.LastReceived = time.Now().Unix()
+	numSuppliers := len(si.suppliers)
+	si.mutSuppliers.Unlock()
+
+	if !exists {
+		si.statusHandler.SetUInt64Value(core.MetricNumHeaderSuppliers, uint64(numSuppliers))
+	}
+}
+
+func (si *syncIntrospector) evictOldestSupplierIfNeeded() {
+	if len(si.suppliers) < si.maxNumHeaderSuppliers {
+		return
+	}
+
+	var oldestPid core.PeerID
+	var oldestSupplier *api.SyncHeaderSupplier
+	for pid, supplier := range si.suppliers {
+		if oldestSupplier == nil || supplier.LastReceived < oldestSupplier.LastReceived {
+			oldestPid = pid
+			oldestSupplier = supplier
+		}
+	}
+
+	delete(si.suppliers, oldestPid)
+}
+
+// SyncState returns the last sync state computed by the bootstrapper
+func (si *syncIntrospector) SyncState() *api.SyncState {
+	si.mutState.RLock()
+	stateCopy := *si.syncState
+	si.mutState.RUnlock()
+
+	return &stateCopy
+}
+
+// RollBacks returns the most recent roll backs, the newest being the last one
+func (si *syncIntrospector) RollBacks() []*api.SyncRollBack {
+	si.mutRollBacks.RLock()
+	defer si.mutRollBacks.RUnlock()
+
+	rollBacks := make([]*api.SyncRollBack, 0, len(si.rollBacks))
+	for _, rollBack := range si.rollBacks {
+		rollBackCopy := *rollBack
+		rollBacks = append(rollBacks, &rollBackCopy)
+	}
+
+	return rollBacks
+}
+
+// HeaderSuppliers returns the peers which supplied headers, sorted descending by the number of supplied headers
+func (si *syncIntrospector) HeaderSuppliers() []*api.SyncHeaderSupplier {
+	si.mutSuppliers.RLock()
+	suppliers := make([]*api.SyncHeaderSupplier, 0, len(si.suppliers))
+	for _, supplier := range si.suppliers {
+		supplierCopy := *supplier
+		suppliers = append(suppliers, &supplierCopy)
+	}
+	si.mutSuppliers.RUnlock()
+
+	sort.Slice(suppliers, func(i, j int) bool {
+		if suppliers[i].NumHeaders == suppliers[j].NumHeaders {
+			return suppliers[i].Pid < suppliers[j].Pid
+		}
+		return suppliers[i].NumHeaders > suppliers[j].NumHeaders
+	})
+
+	return suppliers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *syncIntrospector) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,135 @@
+package introspection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsSyncIntrospector {
+	return ArgsSyncIntrospector{
+		MaxNumRollBacks:       2,
+		MaxNumHeaderSuppliers: 2,
+		StatusHandler:         &testscommon.AppStatusHandlerStub{},
+	}
+}
+
+func TestNewSyncIntrospector(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.MaxNumRollBacks = 0
+	si, err := NewSyncIntrospector(args)
+	assert.Nil(t, si)
+	assert.True(t, errors.Is(err, ErrInvalidMaxNumRollBacks))
+
+	args = createMockArgs()
+	args.MaxNumHeaderSuppliers = 0
+	si, err = NewSyncIntrospector(args)
+	assert.Nil(t, si)
+	assert.True(t, errors.Is(err, ErrInvalidMaxNumHeaderSuppliers))
+
+	args = createMockArgs()
+	args.StatusHandler = nil
+	si, err = NewSyncIntrospector(args)
+	assert.Nil(t, si)
+	assert.Equal(t, process.ErrNilAppStatusHandler, err)
+
+	si, err = NewSyncIntrospector(createMockArgs())
+	assert.Nil(t, err)
+	assert.False(t, si.IsInterfaceNil())
+}
+
+func TestSyncIntrospector_SetSyncStateShouldStoreACopy(t *testing.T) {
+	t.Parallel()
+
+	si, _ := NewSyncIntrospector(createMockArgs())
+	assert.Equal(t, &api.SyncState{}, si.SyncState())
+
+	state := &api.SyncState{CurrentNonce: 10, ProbableHighestNonce: 20}
+	si.SetSyncState(state)
+	state.CurrentNonce = 11
+
+	assert.Equal(t, uint64(10), si.SyncState().CurrentNonce)
+	assert.Equal(t, uint64(20), si.SyncState().ProbableHighestNonce)
+}
+
+func TestSyncIntrospector_AddRollBackShouldKeepTheLastOnesAndSetMetrics(t *testing.T) {
+	t.Parallel()
+
+	numRollBacks := 0
+	lastReason := ""
+	args := createMockArgs()
+	args.StatusHandler = &testscommon.AppStatusHandlerStub{
+		IncrementHandler: func(key string) {
+			if key == core.MetricNumSyncRollBacks {
+				numRollBacks++
+			}
+		},
+		SetStringValueHandler: func(key string, value string) {
+			if key == core.MetricLastSyncRollBackReason {
+				lastReason = value
+			}
+		},
+	}
+	si, _ := NewSyncIntrospector(args)
+
+	si.AddRollBack(&api.SyncRollBack{Reason: "reason 1", FromNonce: 1})
+	si.AddRollBack(&api.SyncRollBack{Reason: "reason 2", FromNonce: 2})
+	si.AddRollBack(&api.SyncRollBack{Reason: "reason 3", FromNonce: 3})
+
+	rollBacks := si.RollBacks()
+	require.Equal(t, 2, len(rollBacks))
+	assert.Equal(t, uint64(2), rollBacks[0].FromNonce)
+	assert.Equal(t, uint64(3), rollBacks[1].FromNonce)
+	assert.Equal(t, 3, numRollBacks)
+	assert.Equal(t, "reason 3", lastReason)
+}
+
+func TestSyncIntrospector_AddHeaderSupplierShouldCountAndEvictTheOldestPeer(t *testing.T) {
+	t.Parallel()
+
+	numSuppliers := uint64(0)
+	args := createMockArgs()
+	args.StatusHandler = &testscommon.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			if key == core.MetricNumHeaderSuppliers {
+				numSuppliers = value
+			}
+		},
+	}
+	si, _ := NewSyncIntrospector(args)
+
+	si.AddHeaderSupplier("pid1", &block.Header{Nonce: 1, Round: 1})
+	si.AddHeaderSupplier("pid1", &block.Header{Nonce: 2, Round: 3})
+	si.AddHeaderSupplier("pid2", &block.Header{Nonce: 2, Round: 3})
+	si.AddHeaderSupplier("", &block.Header{Nonce: 2, Round: 3})
+	si.AddHeaderSupplier("pid2", nil)
+
+	suppliers := si.HeaderSuppliers()
+	require.Equal(t, 2, len(suppliers))
+	assert.Equal(t, core.PeerID("pid1").Pretty(), suppliers[0].Pid)
+	assert.Equal(t, uint64(2), suppliers[0].NumHeaders)
+	assert.Equal(t, uint64(2), suppliers[0].LastNonce)
+	assert.Equal(t, uint64(3), suppliers[0].LastRound)
+	assert.Equal(t, uint64(1), suppliers[1].NumHeaders)
+	assert.Equal(t, uint64(2), numSuppliers)
+
+	for _, supplier := range si.suppliers {
+		supplier.LastReceived = 0
+	}
+	si.suppliers["pid2"].LastReceived = 1
+	si.AddHeaderSupplier("pid3", &block.Header{Nonce: 3, Round: 4})
+
+	suppliers = si.HeaderSuppliers()
+	require.Equal(t, 2, len(suppliers))
+	_, pid1Exists := si.suppliers["pid1"]
+	assert.False(t, pid1Exists)
+}
@@ -74,6 +74,7 @@ func NewMetaBootstrap(arguments ArgMetaBootstrapper) (*MetaBootstrap, error) {
 		accountsDBSyncer:     arguments.AccountsDBSyncer,
 		currentEpochProvider: arguments.CurrentEpochProvider,
 		isInImportMode:       arguments.IsInImportMode,
+		syncIntrospector:     arguments.SyncIntrospector,
 	}
 
 	if base.isInImportMode {
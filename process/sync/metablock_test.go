@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -20,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMetaBlockProcessor(blk data.ChainHandler) *mock.BlockProcessorMock {
@@ -70,6 +72,7 @@ func CreateMetaBootstrapMockArguments() sync.ArgMetaBootstrapper {
 		Indexer:              &mock.IndexerMock{},
 		AccountsDBSyncer:     &mock.AccountsDBSyncerStub{},
 		CurrentEpochProvider: &testscommon.CurrentEpochProviderStub{},
+		SyncIntrospector:     &mock.SyncIntrospectionHandlerStub{},
 	}
 
 	argsMetaBootstrapper := sync.ArgMetaBootstrapper{
@@ -352,6 +355,18 @@ func TestNewMetaBootstrap_NilCurrentEpochProviderShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilCurrentNetworkEpochProvider, err)
 }
 
+func TestNewMetaBootstrap_NilSyncIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateMetaBootstrapMockArguments()
+	args.SyncIntrospector = nil
+
+	bs, err := sync.NewMetaBootstrap(args)
+
+	assert.Nil(t, bs)
+	assert.Equal(t, process.ErrNilSyncIntrospectionHandler, err)
+}
+
 func TestNewMetaBootstrap_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -736,11 +751,22 @@ func TestMetaBootstrap_GetNodeStateShouldReturnSynchronizedWhenCurrentBlockIsNil
 	args.ForkDetector = forkDetector
 	args.RoundHandler, _ = round.NewRound(time.Now(), time.Now(), 200*time.Millisecond, &mock.SyncTimerMock{}, 0)
 
+	var syncState *api.SyncState
+	args.SyncIntrospector = &mock.SyncIntrospectionHandlerStub{
+		SetSyncStateCalled: func(state *api.SyncState) {
+			syncState = state
+		},
+	}
+
 	bs, err := sync.NewMetaBootstrap(args)
 	assert.Nil(t, err)
 
 	bs.ComputeNodeState()
 	assert.Equal(t, core.NsSynchronized, bs.GetNodeState())
+	require.NotNil(t, syncState)
+	assert.True(t, syncState.IsSynchronized)
+	assert.True(t, syncState.HasLastBlock)
+	assert.False(t, syncState.IsForkDetected)
 }
 
 func TestMetaBootstrap_GetNodeStateShouldReturnNotSynchronizedWhenCurrentBlockIsNilAndRoundIndexIsGreaterThanZero(t *testing.T) {
@@ -0,0 +1,371 @@
+package replica
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("process/sync/replica")
+
+// ArgsChainFollower holds the arguments needed to create a chain follower
+type ArgsChainFollower struct {
+	ShardCoordinator        sharding.Coordinator
+	BlockChain              data.ChainHandler
+	StorageService          dataRetriever.StorageService
+	Accounts                state.AccountsAdapter
+	PeerAccounts            state.AccountsAdapter
+	AccountsAPI             state.AccountsAdapter
+	TrieStorageManagers     map[string]data.StorageManager
+	Marshalizer             marshal.Marshalizer
+	PathManager             storage.PathManagerHandler
+	PersisterFactory        storage.PersisterFactory
+	BootstrapDataProvider   BootstrapDataProvider
+	BootstrapUnitIdentifier string
+	AppStatusHandler        core.AppStatusHandler
+	Epoch                   uint32
+	ChanStopNodeProcess     chan endProcess.ArgEndProcess
+	PollInterval            time.Duration
+}
+
+type chainFollower struct {
+	shardCoordinator        sharding.Coordinator
+	blockChain              data.ChainHandler
+	storageService          dataRetriever.StorageService
+	accounts                state.AccountsAdapter
+	peerAccounts            state.AccountsAdapter
+	accountsAPI             state.AccountsAdapter
+	trieStorageManagers     map[string]data.StorageManager
+	marshalizer             marshal.Marshalizer
+	pathManager             storage.PathManagerHandler
+	persisterFactory        storage.PersisterFactory
+	bootstrapDataProvider   BootstrapDataProvider
+	bootstrapUnitIdentifier string
+	appStatusHandler        core.AppStatusHandler
+	epoch                   uint32
+	chanStopNodeProcess     chan endProcess.ArgEndProcess
+	pollInterval            time.Duration
+
+	mutFollow           sync.Mutex
+	epochChangeSignaled bool
+	cancelFunc          context.CancelFunc
+}
+
+// NewChainFollower creates a component that makes a replica node follow the blocks committed by the node owning the
+// databases it reads. The last committed header is read from the bootstrap storage of the other node and set on the
+// blockchain, without processing the block, after the accounts tries are recreated from its root hashes. When the other node starts writing the bootstrap data of the next epoch,
+// the replica node is restarted so it opens the storage of the new epoch
+func NewChainFollower(args ArgsChainFollower) (*chainFollower, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chainFollower{
+		shardCoordinator:        args.ShardCoordinator,
+		blockChain:              args.BlockChain,
+		storageService:          args.StorageService,
+		accounts:                args.Accounts,
+		peerAccounts:            args.PeerAccounts,
+		accountsAPI:             args.AccountsAPI,
+		trieStorageManagers:     args.TrieStorageManagers,
+		marshalizer:             args.Marshalizer,
+		pathManager:             args.PathManager,
+		persisterFactory:        args.PersisterFactory,
+		bootstrapDataProvider:   args.BootstrapDataProvider,
+		bootstrapUnitIdentifier: args.BootstrapUnitIdentifier,
+		appStatusHandler:        args.AppStatusHandler,
+		epoch:                   args.Epoch,
+		chanStopNodeProcess:     args.ChanStopNodeProcess,
+		pollInterval:            args.PollInterval,
+	}, nil
+}
+
+func checkArgs(args ArgsChainFollower) error {
+	if check.IfNil(args.ShardCoordinator) {
+		return process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.BlockChain) {
+		return process.ErrNilBlockChain
+	}
+	if check.IfNil(args.StorageService) {
+		return process.ErrNilStore
+	}
+	if check.IfNil(args.Accounts) {
+		return process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.PeerAccounts) {
+		return process.ErrNilPeerAccountsAdapter
+	}
+	if check.IfNil(args.AccountsAPI) {
+		return fmt.Errorf("%w for the API", process.ErrNilAccountsAdapter)
+	}
+	for _, trieStorageManager := range args.TrieStorageManagers {
+		if check.IfNil(trieStorageManager) {
+			return ErrNilTrieStorageManager
+		}
+	}
+	if check.IfNil(args.Marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.PathManager) {
+		return storage.ErrNilPathManager
+	}
+	if check.IfNil(args.PersisterFactory) {
+		return storage.ErrNilPersisterFactory
+	}
+	if check.IfNil(args.BootstrapDataProvider) {
+		return ErrNilBootstrapDataProvider
+	}
+	if len(args.BootstrapUnitIdentifier) == 0 {
+		return ErrEmptyBootstrapUnitIdentifier
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return process.ErrNilAppStatusHandler
+	}
+	if args.ChanStopNodeProcess == nil {
+		return ErrNilChanStopNodeProcess
+	}
+	if args.PollInterval <= 0 {
+		return ErrInvalidPollInterval
+	}
+
+	return nil
+}
+
+// StartFollowing sets the last committed header on the blockchain and starts polling for new ones
+func (cf *chainFollower) StartFollowing() {
+	err := cf.followOnce()
+	if err != nil {
+		log.Warn("replica: could not load the last committed header", "error", err)
+	}
+
+	var ctx context.Context
+	cf.mutFollow.Lock()
+	ctx, cf.cancelFunc = context.WithCancel(context.Background())
+	cf.mutFollow.Unlock()
+
+	go cf.follow(ctx)
+}
+
+func (cf *chainFollower) follow(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("replica: chain follower closing")
+			return
+		case <-time.After(cf.pollInterval):
+		}
+
+		err := cf.followOnce()
+		if err != nil {
+			log.Debug("replica: could not follow the last committed header", "error", err)
+		}
+	}
+}
+
+func (cf *chainFollower) followOnce() error {
+	cf.mutFollow.Lock()
+	defer cf.mutFollow.Unlock()
+
+	if cf.epochChangeSignaled {
+		return nil
+	}
+
+	if cf.isNextEpochStarted() {
+		cf.signalEpochChange()
+		return nil
+	}
+
+	bootstrapData, err := cf.loadBootstrapData(cf.epoch)
+	if err != nil {
+		return err
+	}
+
+	lastHeaderHash := bootstrapData.LastHeader.Hash
+	if bytes.Equal(lastHeaderHash, cf.blockChain.GetCurrentBlockHeaderHash()) {
+		return nil
+	}
+
+	cf.refreshViews()
+
+	header, err := cf.getHeader(lastHeaderHash)
+	if err != nil {
+		return err
+	}
+
+	// the header is set only after the tries are recreated, so a failed recreation is retried on the next poll
+	err = cf.recreateTries(lastHeaderHash, header)
+	if err != nil {
+		return err
+	}
+
+	err = cf.blockChain.SetCurrentBlockHeader(header)
+	if err != nil {
+		return err
+	}
+	cf.blockChain.SetCurrentBlockHeaderHash(lastHeaderHash)
+
+	cf.appStatusHandler.SetStringValue(core.MetricCurrentBlockHash, logger.DisplayByteSlice(lastHeaderHash))
+	cf.appStatusHandler.SetUInt64Value(core.MetricEpochNumber, uint64(header.GetEpoch()))
+	cf.appStatusHandler.SetUInt64Value(core.MetricHighestFinalBlock, bootstrapData.HighestFinalBlockNonce)
+	cf.appStatusHandler.SetUInt64Value(core.MetricIsSyncing, 0)
+
+	log.Debug("replica: followed committed header",
+		"nonce", header.GetNonce(),
+		"round", header.GetRound(),
+		"hash", lastHeaderHash,
+	)
+
+	return nil
+}
+
+// refreshViews makes the data committed by the followed node visible through the read-only databases, once for each
+// followed header. The cached values might have been changed by the node owning the databases (e.g. the nonce to hash
+// mappings after a rollback), so the caches are cleared as well
+func (cf *chainFollower) refreshViews() {
+	for _, storer := range cf.storageService.GetAllStorers() {
+		storer.ClearCache()
+		refreshView(storer)
+	}
+
+	for _, trieStorageManager := range cf.trieStorageManagers {
+		refreshView(trieStorageManager.Database())
+	}
+}
+
+func refreshView(component interface{}) {
+	refresher, ok := component.(storage.ViewRefresher)
+	if ok {
+		refresher.RefreshView()
+	}
+}
+
+func (cf *chainFollower) recreateTries(headerHash []byte, header data.HeaderHandler) error {
+	rootHash := cf.getRootHashAfterScheduledExecution(headerHash, header.GetRootHash())
+	err := cf.accounts.RecreateTrie(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the accounts trie", err)
+	}
+
+	err = cf.accountsAPI.RecreateTrie(rootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the API accounts trie", err)
+	}
+
+	// only the metachain headers hold the validator statistics root hash
+	validatorStatsRootHash := header.GetValidatorStatsRootHash()
+	if len(validatorStatsRootHash) == 0 {
+		return nil
+	}
+
+	err = cf.peerAccounts.RecreateTrie(validatorStatsRootHash)
+	if err != nil {
+		return fmt.Errorf("%w while recreating the peer accounts trie", err)
+	}
+
+	return nil
+}
+
+// getRootHashAfterScheduledExecution returns the root hash resulted after the execution of the scheduled transactions
+// included in the block with the given hash, as committed by the followed node, or the given root hash if the block
+// did not have scheduled transactions
+func (cf *chainFollower) getRootHashAfterScheduledExecution(headerHash []byte, rootHash []byte) []byte {
+	marshalizedScheduledSCRs, err := cf.storageService.Get(dataRetriever.ScheduledSCRsUnit, headerHash)
+	if err != nil {
+		return rootHash
+	}
+
+	scheduledSCRs := &scheduled.ScheduledSCRs{}
+	err = cf.marshalizer.Unmarshal(scheduledSCRs, marshalizedScheduledSCRs)
+	if err != nil || len(scheduledSCRs.RootHash) == 0 {
+		return rootHash
+	}
+
+	return scheduledSCRs.RootHash
+}
+
+func (cf *chainFollower) isNextEpochStarted() bool {
+	_, err := cf.loadBootstrapData(cf.epoch + 1)
+
+	return err == nil
+}
+
+func (cf *chainFollower) signalEpochChange() {
+	log.Info("replica: the followed node started a new epoch, the node will be restarted",
+		"current epoch", cf.epoch,
+	)
+
+	cf.epochChangeSignaled = true
+	argEndProcess := endProcess.ArgEndProcess{
+		Reason:      core.ReplicaEpochChanged,
+		Description: "the followed node started a new epoch",
+	}
+
+	select {
+	case cf.chanStopNodeProcess <- argEndProcess:
+	default:
+		log.Debug("replica: could not write on the end process channel")
+	}
+}
+
+func (cf *chainFollower) loadBootstrapData(epoch uint32) (*bootstrapStorage.BootstrapData, error) {
+	shardIDStr := core.GetShardIDString(cf.shardCoordinator.SelfId())
+	path := cf.pathManager.PathForEpoch(shardIDStr, epoch, cf.bootstrapUnitIdentifier)
+
+	bootstrapData, storer, err := cf.bootstrapDataProvider.LoadForPath(cf.persisterFactory, path)
+	if err != nil {
+		return nil, err
+	}
+
+	errClose := storer.Close()
+	if errClose != nil {
+		log.Debug("replica: encountered a non-critical error closing bootstrap storer",
+			"path", path,
+			"error", errClose,
+		)
+	}
+
+	return bootstrapData, nil
+}
+
+func (cf *chainFollower) getHeader(hash []byte) (data.HeaderHandler, error) {
+	if cf.shardCoordinator.SelfId() == core.MetachainShardId {
+		return process.GetMetaHeaderFromStorage(hash, cf.marshalizer, cf.storageService)
+	}
+
+	return process.GetShardHeaderFromStorage(hash, cf.marshalizer, cf.storageService)
+}
+
+// Close stops following the committed headers
+func (cf *chainFollower) Close() error {
+	cf.mutFollow.Lock()
+	defer cf.mutFollow.Unlock()
+
+	if cf.cancelFunc != nil {
+		cf.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cf *chainFollower) IsInterfaceNil() bool {
+	return cf == nil
+}
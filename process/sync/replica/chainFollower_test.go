@@ -0,0 +1,409 @@
+package replica
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/blockchain"
+	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageMock "github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bootstrapUnitIdentifier = "BootstrapData"
+
+func createMockArgs() ArgsChainFollower {
+	chainHandler, _ := blockchain.NewBlockChain(&testscommon.AppStatusHandlerStub{})
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.BlockHeaderUnit, genericMocks.NewStorerMock("BlockHeaders", 0))
+
+	return ArgsChainFollower{
+		ShardCoordinator:        mock.NewOneShardCoordinatorMock(),
+		BlockChain:              chainHandler,
+		StorageService:          storageService,
+		Accounts:                createAccountsStub(),
+		PeerAccounts:            createAccountsStub(),
+		AccountsAPI:             createAccountsStub(),
+		Marshalizer:             &testscommon.MarshalizerMock{},
+		PathManager:             &testscommon.PathManagerStub{},
+		PersisterFactory:        &storageMock.PersisterFactoryStub{},
+		BootstrapDataProvider:   &storageMock.BootStrapDataProviderStub{},
+		BootstrapUnitIdentifier: bootstrapUnitIdentifier,
+		AppStatusHandler:        &testscommon.AppStatusHandlerStub{},
+		Epoch:                   2,
+		ChanStopNodeProcess:     make(chan endProcess.ArgEndProcess, 1),
+		PollInterval:            time.Millisecond * 10,
+	}
+}
+
+func epochPath(epoch uint32) string {
+	return (&testscommon.PathManagerStub{}).PathForEpoch("0", epoch, bootstrapUnitIdentifier)
+}
+
+func createAccountsStub() *testscommon.AccountsStub {
+	return &testscommon.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			return nil
+		},
+	}
+}
+
+// createBootstrapDataProvider returns a provider that holds bootstrap data only for the provided epoch
+func createBootstrapDataProvider(epoch uint32, lastHeaderHash []byte) *storageMock.BootStrapDataProviderStub {
+	return &storageMock.BootStrapDataProviderStub{
+		LoadForPathCalled: func(_ storage.PersisterFactory, path string) (*bootstrapStorage.BootstrapData, storage.Storer, error) {
+			if path != epochPath(epoch) {
+				return nil, nil, errors.New("missing database")
+			}
+
+			bootstrapData := &bootstrapStorage.BootstrapData{
+				LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+					Hash: lastHeaderHash,
+				},
+				HighestFinalBlockNonce: 9,
+			}
+
+			return bootstrapData, genericMocks.NewStorerMock("BootstrapData", epoch), nil
+		},
+	}
+}
+
+func putHeader(t *testing.T, args ArgsChainFollower, hash []byte, header *block.Header) {
+	buff, err := args.Marshalizer.Marshal(header)
+	require.Nil(t, err)
+
+	err = args.StorageService.Put(dataRetriever.BlockHeaderUnit, hash, buff)
+	require.Nil(t, err)
+}
+
+func TestNewChainFollower_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		changeArgs  func(args *ArgsChainFollower)
+		expectedErr error
+	}{
+		{"nil shard coordinator", func(args *ArgsChainFollower) { args.ShardCoordinator = nil }, process.ErrNilShardCoordinator},
+		{"nil blockchain", func(args *ArgsChainFollower) { args.BlockChain = nil }, process.ErrNilBlockChain},
+		{"nil storage service", func(args *ArgsChainFollower) { args.StorageService = nil }, process.ErrNilStore},
+		{"nil accounts", func(args *ArgsChainFollower) { args.Accounts = nil }, process.ErrNilAccountsAdapter},
+		{"nil peer accounts", func(args *ArgsChainFollower) { args.PeerAccounts = nil }, process.ErrNilPeerAccountsAdapter},
+		{"nil API accounts", func(args *ArgsChainFollower) { args.AccountsAPI = nil }, process.ErrNilAccountsAdapter},
+		{"nil trie storage manager", func(args *ArgsChainFollower) {
+			args.TrieStorageManagers = map[string]data.StorageManager{"accounts": nil}
+		}, ErrNilTrieStorageManager},
+		{"nil marshalizer", func(args *ArgsChainFollower) { args.Marshalizer = nil }, process.ErrNilMarshalizer},
+		{"nil path manager", func(args *ArgsChainFollower) { args.PathManager = nil }, storage.ErrNilPathManager},
+		{"nil persister factory", func(args *ArgsChainFollower) { args.PersisterFactory = nil }, storage.ErrNilPersisterFactory},
+		{"nil bootstrap data provider", func(args *ArgsChainFollower) { args.BootstrapDataProvider = nil }, ErrNilBootstrapDataProvider},
+		{"empty bootstrap unit identifier", func(args *ArgsChainFollower) { args.BootstrapUnitIdentifier = "" }, ErrEmptyBootstrapUnitIdentifier},
+		{"nil app status handler", func(args *ArgsChainFollower) { args.AppStatusHandler = nil }, process.ErrNilAppStatusHandler},
+		{"nil stop node process channel", func(args *ArgsChainFollower) { args.ChanStopNodeProcess = nil }, ErrNilChanStopNodeProcess},
+		{"invalid poll interval", func(args *ArgsChainFollower) { args.PollInterval = 0 }, ErrInvalidPollInterval},
+	}
+
+	for _, tt := range tests {
+		args := createMockArgs()
+		tt.changeArgs(&args)
+
+		cf, err := NewChainFollower(args)
+		assert.True(t, check.IfNil(cf), tt.name)
+		assert.True(t, errors.Is(err, tt.expectedErr), tt.name)
+	}
+}
+
+func TestNewChainFollower(t *testing.T) {
+	t.Parallel()
+
+	cf, err := NewChainFollower(createMockArgs())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(cf))
+}
+
+func TestChainFollower_FollowOnceShouldSetTheLastCommittedHeader(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("header hash")
+	header := &block.Header{Nonce: 10, Round: 11, Epoch: 2}
+
+	metrics := make(map[string]interface{})
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, hash)
+	args.AppStatusHandler = &testscommon.AppStatusHandlerStub{
+		SetUInt64ValueHandler: func(key string, value uint64) {
+			metrics[key] = value
+		},
+		SetStringValueHandler: func(key string, value string) {
+			metrics[key] = value
+		},
+	}
+	putHeader(t, args, hash, header)
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	require.Nil(t, err)
+
+	assert.Equal(t, hash, args.BlockChain.GetCurrentBlockHeaderHash())
+	assert.Equal(t, header, args.BlockChain.GetCurrentBlockHeader())
+	assert.Equal(t, uint64(2), metrics[core.MetricEpochNumber])
+	assert.Equal(t, uint64(9), metrics[core.MetricHighestFinalBlock])
+	assert.Equal(t, uint64(0), metrics[core.MetricIsSyncing])
+	assert.NotEmpty(t, metrics[core.MetricCurrentBlockHash])
+	assert.Equal(t, 0, len(args.ChanStopNodeProcess))
+}
+
+func TestChainFollower_FollowOnceShouldRecreateTheAccountsTries(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("header hash")
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, hash)
+	args.StorageService.AddStorer(dataRetriever.ScheduledSCRsUnit, genericMocks.NewStorerMock("ScheduledSCRs", 0))
+	putHeader(t, args, hash, &block.Header{Nonce: 10, RootHash: []byte("root hash")})
+
+	recreatedRootHashes := make(map[string][]byte)
+	createRecordingAccountsStub := func(name string) *testscommon.AccountsStub {
+		return &testscommon.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHashes[name] = rootHash
+				return nil
+			},
+		}
+	}
+	args.Accounts = createRecordingAccountsStub("accounts")
+	args.PeerAccounts = createRecordingAccountsStub("peer accounts")
+	args.AccountsAPI = createRecordingAccountsStub("API accounts")
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	require.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"accounts": []byte("root hash"), "API accounts": []byte("root hash")}, recreatedRootHashes)
+
+	// the state committed by the followed node includes the execution of the scheduled transactions
+	scheduledHash := []byte("scheduled header hash")
+	args.BootstrapDataProvider.(*storageMock.BootStrapDataProviderStub).LoadForPathCalled = createBootstrapDataProvider(args.Epoch, scheduledHash).LoadForPathCalled
+	putHeader(t, args, scheduledHash, &block.Header{Nonce: 11, RootHash: []byte("root hash")})
+	buff, _ := args.Marshalizer.Marshal(&scheduled.ScheduledSCRs{RootHash: []byte("scheduled root hash")})
+	_ = args.StorageService.Put(dataRetriever.ScheduledSCRsUnit, scheduledHash, buff)
+
+	err = cf.FollowOnce()
+	require.Nil(t, err)
+	assert.Equal(t, []byte("scheduled root hash"), recreatedRootHashes["accounts"])
+	assert.Equal(t, []byte("scheduled root hash"), recreatedRootHashes["API accounts"])
+}
+
+type refreshableStorerMock struct {
+	*genericMocks.StorerMock
+	numRefreshes uint32
+}
+
+func (rsm *refreshableStorerMock) RefreshView() {
+	atomic.AddUint32(&rsm.numRefreshes, 1)
+}
+
+func TestChainFollower_FollowOnceShouldRefreshTheViewsOncePerHeader(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("header hash")
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, hash)
+	headersStorer := &refreshableStorerMock{StorerMock: genericMocks.NewStorerMock("BlockHeaders", 0)}
+	args.StorageService.AddStorer(dataRetriever.BlockHeaderUnit, headersStorer)
+	trieStorer := &refreshableStorerMock{StorerMock: genericMocks.NewStorerMock("AccountsTrie", 0)}
+	args.TrieStorageManagers = map[string]data.StorageManager{
+		"accounts": &testscommon.StorageManagerStub{
+			DatabaseCalled: func() data.DBWriteCacher {
+				return trieStorer
+			},
+		},
+	}
+	putHeader(t, args, hash, &block.Header{Nonce: 10})
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	require.Nil(t, err)
+	err = cf.FollowOnce()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&headersStorer.numRefreshes))
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&trieStorer.numRefreshes))
+
+	nextHash := []byte("next header hash")
+	args.BootstrapDataProvider.(*storageMock.BootStrapDataProviderStub).LoadForPathCalled = createBootstrapDataProvider(args.Epoch, nextHash).LoadForPathCalled
+	putHeader(t, args, nextHash, &block.Header{Nonce: 11})
+
+	err = cf.FollowOnce()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&headersStorer.numRefreshes))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&trieStorer.numRefreshes))
+}
+
+func TestChainFollower_FollowOnceMetaBlockShouldRecreateThePeerAccountsTrie(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("meta header hash")
+	args := createMockArgs()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	args.ShardCoordinator = shardCoordinator
+	args.BlockChain, _ = blockchain.NewMetaChain(&testscommon.AppStatusHandlerStub{})
+	metaPath := args.PathManager.PathForEpoch(core.GetShardIDString(core.MetachainShardId), args.Epoch, bootstrapUnitIdentifier)
+	args.BootstrapDataProvider = &storageMock.BootStrapDataProviderStub{
+		LoadForPathCalled: func(_ storage.PersisterFactory, path string) (*bootstrapStorage.BootstrapData, storage.Storer, error) {
+			if path != metaPath {
+				return nil, nil, errors.New("missing database")
+			}
+
+			bootstrapData := &bootstrapStorage.BootstrapData{
+				LastHeader: bootstrapStorage.BootstrapHeaderInfo{Hash: hash},
+			}
+
+			return bootstrapData, genericMocks.NewStorerMock("BootstrapData", args.Epoch), nil
+		},
+	}
+	args.StorageService.AddStorer(dataRetriever.MetaBlockUnit, genericMocks.NewStorerMock("MetaBlocks", 0))
+	metaBlock := &block.MetaBlock{Nonce: 10, RootHash: []byte("root hash"), ValidatorStatsRootHash: []byte("validator stats root hash")}
+	buff, _ := args.Marshalizer.Marshal(metaBlock)
+	_ = args.StorageService.Put(dataRetriever.MetaBlockUnit, hash, buff)
+
+	var recreatedPeerRootHash []byte
+	args.PeerAccounts = &testscommon.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedPeerRootHash = rootHash
+			return nil
+		},
+	}
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	require.Nil(t, err)
+	assert.Equal(t, []byte("validator stats root hash"), recreatedPeerRootHash)
+	assert.Equal(t, hash, args.BlockChain.GetCurrentBlockHeaderHash())
+}
+
+func TestChainFollower_FollowOnceRecreateTrieErrorShouldNotSetTheHeader(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("header hash")
+	expectedErr := errors.New("expected error")
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, hash)
+	args.AccountsAPI = &testscommon.AccountsStub{
+		RecreateTrieCalled: func(_ []byte) error {
+			return expectedErr
+		},
+	}
+	putHeader(t, args, hash, &block.Header{Nonce: 10, RootHash: []byte("root hash")})
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	assert.True(t, errors.Is(err, expectedErr))
+	assert.Nil(t, args.BlockChain.GetCurrentBlockHeaderHash())
+}
+
+func TestChainFollower_FollowOnceSameHeaderShouldNotSetAgain(t *testing.T) {
+	t.Parallel()
+
+	hash := []byte("header hash")
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, hash)
+	numSetCalls := 0
+	args.BlockChain = &mock.BlockChainMock{
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return hash
+		},
+		SetCurrentBlockHeaderCalled: func(_ data.HeaderHandler) error {
+			numSetCalls++
+			return nil
+		},
+	}
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numSetCalls)
+}
+
+func TestChainFollower_FollowOnceMissingHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch, []byte("missing header hash"))
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	assert.NotNil(t, err)
+	assert.Nil(t, args.BlockChain.GetCurrentBlockHeaderHash())
+}
+
+func TestChainFollower_FollowOnceMissingBootstrapDataShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch+5, []byte("hash"))
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	assert.NotNil(t, err)
+}
+
+func TestChainFollower_FollowOnceNextEpochShouldSignalOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.BootstrapDataProvider = createBootstrapDataProvider(args.Epoch+1, []byte("hash"))
+
+	cf, _ := NewChainFollower(args)
+	err := cf.FollowOnce()
+	require.Nil(t, err)
+	err = cf.FollowOnce()
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(args.ChanStopNodeProcess))
+	argEndProcess := <-args.ChanStopNodeProcess
+	assert.Equal(t, core.ReplicaEpochChanged, argEndProcess.Reason)
+	assert.Nil(t, args.BlockChain.GetCurrentBlockHeaderHash())
+}
+
+func TestChainFollower_StartFollowingShouldPollUntilClosed(t *testing.T) {
+	t.Parallel()
+
+	numLoads := int32(0)
+	args := createMockArgs()
+	args.BootstrapDataProvider = &storageMock.BootStrapDataProviderStub{
+		LoadForPathCalled: func(_ storage.PersisterFactory, _ string) (*bootstrapStorage.BootstrapData, storage.Storer, error) {
+			atomic.AddInt32(&numLoads, 1)
+			return nil, nil, fmt.Errorf("missing database")
+		},
+	}
+
+	cf, _ := NewChainFollower(args)
+	cf.StartFollowing()
+	time.Sleep(time.Millisecond * 100)
+
+	err := cf.Close()
+	assert.Nil(t, err)
+	time.Sleep(time.Millisecond * 30)
+
+	numLoadsAfterClose := atomic.LoadInt32(&numLoads)
+	assert.True(t, numLoadsAfterClose > 4)
+
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, numLoadsAfterClose, atomic.LoadInt32(&numLoads))
+}
@@ -0,0 +1,18 @@
+package replica
+
+import "errors"
+
+// ErrNilBootstrapDataProvider signals that a nil bootstrap data provider has been provided
+var ErrNilBootstrapDataProvider = errors.New("nil bootstrap data provider")
+
+// ErrEmptyBootstrapUnitIdentifier signals that an empty bootstrap unit identifier has been provided
+var ErrEmptyBootstrapUnitIdentifier = errors.New("empty bootstrap unit identifier")
+
+// ErrNilChanStopNodeProcess signals that a nil stop node process channel has been provided
+var ErrNilChanStopNodeProcess = errors.New("nil stop node process channel")
+
+// ErrNilTrieStorageManager signals that a nil trie storage manager has been provided
+var ErrNilTrieStorageManager = errors.New("nil trie storage manager")
+
+// ErrInvalidPollInterval signals that an invalid poll interval has been provided
+var ErrInvalidPollInterval = errors.New("invalid poll interval")
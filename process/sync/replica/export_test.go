@@ -0,0 +1,6 @@
+package replica
+
+// FollowOnce -
+func (cf *chainFollower) FollowOnce() error {
+	return cf.followOnce()
+}
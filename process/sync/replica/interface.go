@@ -0,0 +1,12 @@
+package replica
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// BootstrapDataProvider is able to load the bootstrap data found at a given path
+type BootstrapDataProvider interface {
+	LoadForPath(persisterFactory storage.PersisterFactory, path string) (*bootstrapStorage.BootstrapData, storage.Storer, error)
+	IsInterfaceNil() bool
+}
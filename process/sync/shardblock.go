@@ -62,6 +62,7 @@ func NewShardBootstrap(arguments ArgShardBootstrapper) (*ShardBootstrap, error)
 		accountsDBSyncer:     arguments.AccountsDBSyncer,
 		currentEpochProvider: arguments.CurrentEpochProvider,
 		isInImportMode:       arguments.IsInImportMode,
+		syncIntrospector:     arguments.SyncIntrospector,
 	}
 
 	if base.isInImportMode {
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -24,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // waitTime defines the time in milliseconds until node waits the requested info from the network
@@ -192,6 +194,7 @@ func CreateShardBootstrapMockArguments() sync.ArgShardBootstrapper {
 		Indexer:              &mock.IndexerMock{},
 		AccountsDBSyncer:     &mock.AccountsDBSyncerStub{},
 		CurrentEpochProvider: &testscommon.CurrentEpochProviderStub{},
+		SyncIntrospector:     &mock.SyncIntrospectionHandlerStub{},
 	}
 
 	argsShardBootstrapper := sync.ArgShardBootstrapper{
@@ -403,6 +406,18 @@ func TestNewShardBootstrap_NilBlackListHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBlackListCacher, err)
 }
 
+func TestNewShardBootstrap_NilSyncIntrospectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := CreateShardBootstrapMockArguments()
+	args.SyncIntrospector = nil
+
+	bs, err := sync.NewShardBootstrap(args)
+
+	assert.Nil(t, bs)
+	assert.Equal(t, process.ErrNilSyncIntrospectionHandler, err)
+}
+
 func TestNewShardBootstrap_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1936,11 +1951,22 @@ func TestShardBootstrap_DoJobOnSyncBlockFailShouldResetProbableHighestNonce(t *t
 		},
 	}
 
+	var recordedRollBack *api.SyncRollBack
+	args.SyncIntrospector = &mock.SyncIntrospectionHandlerStub{
+		AddRollBackCalled: func(rollBack *api.SyncRollBack) {
+			recordedRollBack = rollBack
+		},
+	}
+
 	bs, _ := sync.NewShardBootstrap(args)
 	bs.SetNumSyncedWithErrorsForNonce(2, 9)
 	bs.DoJobOnSyncBlockFail(nil, nil, errors.New("error"))
 
 	assert.True(t, wasCalled)
+	require.NotNil(t, recordedRollBack)
+	assert.Equal(t, "sync with errors limit reached: error", recordedRollBack.Reason)
+	assert.Equal(t, uint64(1), recordedRollBack.FromNonce)
+	assert.Equal(t, sync.ErrRollBackBehindFinalHeader.Error(), recordedRollBack.Error)
 }
 
 func TestShardBootstrap_CleanNoncesSyncedWithErrorsBehindFinalShouldWork(t *testing.T) {
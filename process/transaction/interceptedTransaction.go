@@ -41,6 +41,7 @@ type InterceptedTransaction struct {
 	sndShard               uint32
 	isForCurrentShard      bool
 	enableSignedTxWithHash bool
+	enableRelayedTxV3      bool
 }
 
 // NewInterceptedTransaction returns a new instance of InterceptedTransaction
@@ -60,6 +61,7 @@ func NewInterceptedTransaction(
 	enableSignedTxWithHash bool,
 	txSignHasher hashing.Hasher,
 	txVersionChecker process.TxVersionCheckerHandler,
+	enableRelayedTxV3 bool,
 ) (*InterceptedTransaction, error) {
 
 	if txBuff == nil {
@@ -126,6 +128,7 @@ func NewInterceptedTransaction(
 		enableSignedTxWithHash: enableSignedTxWithHash,
 		txVersionChecker:       txVersionChecker,
 		txSignHasher:           txSignHasher,
+		enableRelayedTxV3:      enableRelayedTxV3,
 	}
 
 	err = inTx.processFields(txBuff)
@@ -191,6 +194,11 @@ func (inTx *InterceptedTransaction) CheckValidity() error {
 			return err
 		}
 
+		err = inTx.verifyIfRelayedTxV3(inTx.tx)
+		if err != nil {
+			return err
+		}
+
 		inTx.whiteListerVerifiedTxs.Add([][]byte{inTx.Hash()})
 	}
 
@@ -233,6 +241,39 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTxV2(tx *transaction.Transact
 	return nil
 }
 
+func (inTx *InterceptedTransaction) verifyIfRelayedTxV3(tx *transaction.Transaction) error {
+	if !tx.IsRelayedV3() {
+		return nil
+	}
+
+	if len(tx.RelayerAddr) != inTx.pubkeyConv.Len() {
+		return process.ErrInvalidRelayerAddress
+	}
+	if bytes.Equal(tx.RelayerAddr, tx.SndAddr) {
+		return process.ErrRelayedTxV3RelayerIsSender
+	}
+	if inTx.coordinator.ComputeId(tx.RelayerAddr) != inTx.sndShard {
+		return process.ErrRelayedTxV3RelayerShardMismatch
+	}
+
+	err := inTx.verifyRelayerSig(tx)
+	if err != nil {
+		return err
+	}
+
+	funcName, _, err := inTx.argsParser.ParseCallData(string(tx.Data))
+	if err != nil {
+		return nil
+	}
+
+	// recursive relayed transactions are not allowed
+	if isRelayedTx(funcName) {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
+
+	return nil
+}
+
 func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transaction) error {
 	funcName, userTxArgs, err := inTx.argsParser.ParseCallData(string(tx.Data))
 	if err != nil {
@@ -254,6 +295,9 @@ func (inTx *InterceptedTransaction) verifyIfRelayedTx(tx *transaction.Transactio
 	if !bytes.Equal(userTx.SndAddr, tx.RcvAddr) {
 		return process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
 	}
+	if userTx.IsRelayedV3() {
+		return process.ErrRecursiveRelayedTxIsNotAllowed
+	}
 
 	err = inTx.integrity(userTx)
 	if err != nil {
@@ -320,6 +364,9 @@ func (inTx *InterceptedTransaction) integrity(tx *transaction.Transaction) error
 	if len(tx.SndAddr) != inTx.pubkeyConv.Len() {
 		return process.ErrInvalidSndAddr
 	}
+	if tx.IsRelayedV3() && !inTx.enableRelayedTxV3 {
+		return process.ErrRelayedTxV3Disabled
+	}
 
 	return inTx.feeHandler.CheckValidityTxValues(tx)
 }
@@ -349,6 +396,37 @@ func (inTx *InterceptedTransaction) verifySig(tx *transaction.Transaction) error
 	return inTx.singleSigner.Verify(senderPubKey, txHash, tx.Signature)
 }
 
+// verifyRelayerSig checks if the relayer signed the same data as the sender of the transaction
+func (inTx *InterceptedTransaction) verifyRelayerSig(tx *transaction.Transaction) error {
+	buffCopiedTx, err := tx.GetDataForSigning(inTx.pubkeyConv, inTx.signMarshalizer)
+	if err != nil {
+		return err
+	}
+
+	relayerPubKey, err := inTx.keyGen.PublicKeyFromByteArray(tx.RelayerAddr)
+	if err != nil {
+		return err
+	}
+
+	if !inTx.txVersionChecker.IsSignedWithHash(tx) {
+		return inTx.singleSigner.Verify(relayerPubKey, buffCopiedTx, tx.RelayerSignature)
+	}
+
+	txHash := inTx.txSignHasher.Compute(string(buffCopiedTx))
+
+	return inTx.singleSigner.Verify(relayerPubKey, txHash, tx.RelayerSignature)
+}
+
+// RelayerAddress returns the relayer address of a relayed transaction v3, if any
+func (inTx *InterceptedTransaction) RelayerAddress() []byte {
+	return inTx.tx.RelayerAddr
+}
+
+// RelayerNonce returns the relayer nonce of a relayed transaction v3
+func (inTx *InterceptedTransaction) RelayerNonce() uint64 {
+	return inTx.tx.RelayerNonce
+}
+
 // ReceiverShardId returns the receiver shard id
 func (inTx *InterceptedTransaction) ReceiverShardId() uint32 {
 	return inTx.rcvShard
@@ -70,6 +70,16 @@ func createFreeTxFeeHandler() *mock.FeeHandlerStub {
 }
 
 func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandler process.FeeHandler, chainID []byte, minTxVersion uint32) (*transaction.InterceptedTransaction, error) {
+	return createInterceptedTxFromPlainTxWithRelayedTxV3Flag(tx, txFeeHandler, chainID, minTxVersion, true)
+}
+
+func createInterceptedTxFromPlainTxWithRelayedTxV3Flag(
+	tx *dataTransaction.Transaction,
+	txFeeHandler process.FeeHandler,
+	chainID []byte,
+	minTxVersion uint32,
+	enableRelayedTxV3 bool,
+) (*transaction.InterceptedTransaction, error) {
 	marshalizer := &mock.MarshalizerMock{}
 	txBuff, err := marshalizer.Marshal(tx)
 	if err != nil {
@@ -109,6 +119,7 @@ func createInterceptedTxFromPlainTx(tx *dataTransaction.Transaction, txFeeHandle
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		enableRelayedTxV3,
 	)
 }
 
@@ -152,6 +163,7 @@ func createInterceptedTxFromPlainTxWithArgParser(tx *dataTransaction.Transaction
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(tx.Version),
+		true,
 	)
 }
 
@@ -176,6 +188,7 @@ func TestNewInterceptedTransaction_NilBufferShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -201,6 +214,7 @@ func TestNewInterceptedTransaction_NilArgsParser(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -226,6 +240,7 @@ func TestNewInterceptedTransaction_NilVersionChecker(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		nil,
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -251,6 +266,7 @@ func TestNewInterceptedTransaction_NilMarshalizerShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -276,6 +292,7 @@ func TestNewInterceptedTransaction_NilSignMarshalizerShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -301,6 +318,7 @@ func TestNewInterceptedTransaction_NilHasherShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -326,6 +344,7 @@ func TestNewInterceptedTransaction_NilKeyGenShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -351,6 +370,7 @@ func TestNewInterceptedTransaction_NilSignerShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -376,6 +396,7 @@ func TestNewInterceptedTransaction_NilPubkeyConverterShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -401,6 +422,7 @@ func TestNewInterceptedTransaction_NilCoordinatorShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -426,6 +448,7 @@ func TestNewInterceptedTransaction_NilFeeHandlerShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -451,6 +474,7 @@ func TestNewInterceptedTransaction_NilWhiteListerVerifiedTxsShouldErr(t *testing
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -476,6 +500,7 @@ func TestNewInterceptedTransaction_InvalidChainIDShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -501,6 +526,7 @@ func TestNewInterceptedTransaction_NilTxSignHasherShouldErr(t *testing.T) {
 		false,
 		nil,
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -532,6 +558,7 @@ func TestNewInterceptedTransaction_UnmarshalingTxFailsShouldErr(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(1),
+		true,
 	)
 
 	assert.Nil(t, txi)
@@ -589,6 +616,42 @@ func TestInterceptedTransaction_CheckValidityNilSignatureShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilSignature, err)
 }
 
+func TestInterceptedTransaction_CheckValidityRelayedTxV3(t *testing.T) {
+	t.Parallel()
+
+	minTxVersion := uint32(1)
+	chainID := []byte("chain")
+	tx := &dataTransaction.Transaction{
+		Nonce:            1,
+		Value:            big.NewInt(2),
+		GasLimit:         3,
+		GasPrice:         4,
+		RcvAddr:          recvAddress,
+		SndAddr:          senderAddress,
+		Signature:        sigOk,
+		ChainID:          chainID,
+		Version:          minTxVersion,
+		RelayerAddr:      []byte("34567890123456789012345678901234"),
+		RelayerNonce:     5,
+		RelayerSignature: sigOk,
+	}
+
+	t.Run("relayed transactions v3 not enabled should err", func(t *testing.T) {
+		txi, _ := createInterceptedTxFromPlainTxWithRelayedTxV3Flag(tx, createFreeTxFeeHandler(), chainID, minTxVersion, false)
+
+		err := txi.CheckValidity()
+		assert.Equal(t, process.ErrRelayedTxV3Disabled, err)
+	})
+	t.Run("relayed transactions v3 enabled should check the relayer", func(t *testing.T) {
+		txi, _ := createInterceptedTxFromPlainTxWithRelayedTxV3Flag(tx, createFreeTxFeeHandler(), chainID, minTxVersion, true)
+
+		// the relayer address is computed in a different shard than the sender by the test shard coordinator
+		err := txi.CheckValidity()
+		assert.Equal(t, process.ErrRelayedTxV3RelayerShardMismatch, err)
+		assert.Equal(t, tx.RelayerNonce, txi.RelayerNonce())
+	})
+}
+
 func TestInterceptedTransaction_CheckValidityNilRecvAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1002,6 +1065,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashButNotEnabled(t *test
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		true,
 	)
 
 	err := txi.CheckValidity()
@@ -1062,6 +1126,7 @@ func TestInterceptedTransaction_CheckValiditySignedWithHashShoudWork(t *testing.
 		true,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		true,
 	)
 
 	err := txi.CheckValidity()
@@ -1147,6 +1212,7 @@ func TestInterceptedTransaction_ScTxDeployRecvShardIdShouldBeSendersShardId(t *t
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		true,
 	)
 
 	assert.Nil(t, err)
@@ -1286,6 +1352,7 @@ func TestInterceptedTransaction_CheckValiditySecondTimeDoesNotVerifySig(t *testi
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(minTxVersion),
+		true,
 	)
 	require.Nil(t, err)
 
@@ -1577,6 +1644,7 @@ func TestInterceptedTransaction_Fee(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		true,
 	)
 
 	assert.Equal(t, big.NewInt(0), txin.Fee())
@@ -1618,6 +1686,7 @@ func TestInterceptedTransaction_String(t *testing.T) {
 		false,
 		mock.HasherMock{},
 		versioning.NewTxVersionChecker(0),
+		true,
 	)
 
 	expectedFormat := fmt.Sprintf(
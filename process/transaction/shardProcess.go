@@ -45,9 +45,11 @@ type txProcessor struct {
 	signMarshalizer                marshal.Marshalizer
 	flagRelayedTx                  atomic.Flag
 	flagRelayedTxV2                atomic.Flag
+	flagRelayedTxV3                atomic.Flag
 	flagMetaProtection             atomic.Flag
 	relayedTxEnableEpoch           uint32
 	relayedTxV2EnableEpoch         uint32
+	relayedTxV3EnableEpoch         uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	metaProtectionEnableEpoch      uint32
 }
@@ -70,6 +72,7 @@ type ArgsNewTxProcessor struct {
 	ScrForwarder                   process.IntermediateTransactionHandler
 	RelayedTxEnableEpoch           uint32
 	RelayedTxV2EnableEpoch         uint32
+	RelayedTxV3EnableEpoch         uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	MetaProtectionEnableEpoch      uint32
 	EpochNotifier                  process.EpochNotifier
@@ -144,6 +147,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 		signMarshalizer:                args.SignMarshalizer,
 		relayedTxEnableEpoch:           args.RelayedTxEnableEpoch,
 		relayedTxV2EnableEpoch:         args.RelayedTxV2EnableEpoch,
+		relayedTxV3EnableEpoch:         args.RelayedTxV3EnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 		metaProtectionEnableEpoch:      args.MetaProtectionEnableEpoch,
 	}
@@ -152,6 +156,7 @@ func NewTxProcessor(args ArgsNewTxProcessor) (*txProcessor, error) {
 	log.Debug("shardProcess: enable epoch for penalized too much gas", "epoch", txProc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("shardProcess: enable epoch for meta protection", "epoch", txProc.metaProtectionEnableEpoch)
 	log.Debug("shardTxProcessor: enable epoch for relayed transactions v2", "epoch", txProc.relayedTxV2EnableEpoch)
+	log.Debug("shardTxProcessor: enable epoch for relayed transactions v3", "epoch", txProc.relayedTxV3EnableEpoch)
 	args.EpochNotifier.RegisterNotifyHandler(txProc)
 
 	return txProc, nil
@@ -176,6 +181,10 @@ func (txProc *txProcessor) ProcessTransaction(tx *transaction.Transaction) (vmco
 	)
 
 	txType, dstShardTxType := txProc.txTypeHandler.ComputeTransactionType(tx)
+	if txType == process.RelayedTxV3 {
+		return txProc.processRelayedTxV3(tx, acntSnd, acntDst)
+	}
+
 	err = txProc.checkTxValues(tx, acntSnd, acntDst, false)
 	if err != nil {
 		if errors.Is(err, process.ErrInsufficientFunds) {
@@ -621,6 +630,177 @@ func (txProc *txProcessor) processRelayedTxV2(
 	return txProc.finishExecutionOfRelayedTx(relayerAcnt, acntDst, tx, userTx)
 }
 
+func makeUserTxFromRelayedTxV3(tx *transaction.Transaction) *transaction.Transaction {
+	userTx := *tx
+	userTx.RelayerAddr = nil
+	userTx.RelayerSignature = nil
+	userTx.Value = big.NewInt(0).Set(tx.Value)
+
+	return &userTx
+}
+
+func (txProc *txProcessor) processRelayedTxV3(
+	tx *transaction.Transaction,
+	acntSnd, acntDst state.UserAccountHandler,
+) (vmcommon.ReturnCode, error) {
+	if check.IfNil(acntSnd) {
+		// the user transaction was already executed in the sender shard and forwarded as smart contract result
+		return vmcommon.Ok, nil
+	}
+	if bytes.Equal(tx.RelayerAddr, tx.SndAddr) {
+		return vmcommon.UserError, process.ErrRelayedTxV3RelayerIsSender
+	}
+
+	relayerAcnt, err := txProc.getAccountFromAddress(tx.RelayerAddr)
+	if err != nil {
+		return 0, err
+	}
+	if check.IfNil(relayerAcnt) {
+		return vmcommon.UserError, process.ErrRelayedTxV3RelayerShardMismatch
+	}
+	if relayerAcnt.GetNonce() < tx.RelayerNonce {
+		return vmcommon.UserError, process.ErrHigherNonceInTransaction
+	}
+	if relayerAcnt.GetNonce() > tx.RelayerNonce {
+		return vmcommon.UserError, process.ErrLowerNonceInTransaction
+	}
+
+	if !txProc.flagRelayedTxV3.IsSet() {
+		return vmcommon.UserError, txProc.executingFailedRelayedTxV3(tx, relayerAcnt, acntSnd, process.ErrRelayedTxV3Disabled)
+	}
+
+	err = txProc.checkRelayedTxV3Values(tx, relayerAcnt, acntSnd, acntDst)
+	if err != nil {
+		if errors.Is(err, process.ErrInsufficientFunds) {
+			receiptErr := txProc.executingFailedRelayedTxV3(tx, relayerAcnt, acntSnd, err)
+			if receiptErr != nil {
+				return 0, receiptErr
+			}
+		}
+		return vmcommon.UserError, err
+	}
+
+	// the relayer pays the whole gas of the transaction: the move balance part is consumed directly while the
+	// remaining part is made available to the user, the unused gas being refunded to the relayer
+	computedFees := txProc.computeRelayedTxFees(tx)
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	relayerAcnt.IncreaseNonce(1)
+	err = relayerAcnt.SubFromBalance(computedFees.totalFee)
+	if err != nil {
+		return 0, err
+	}
+	err = txProc.accounts.SaveAccount(relayerAcnt)
+	if err != nil {
+		return 0, err
+	}
+	txProc.txFeeHandler.ProcessTransactionFee(computedFees.relayerFee, big.NewInt(0), txHash)
+
+	err = acntSnd.AddToBalance(computedFees.remainingFee)
+	if err != nil {
+		return 0, err
+	}
+	err = txProc.accounts.SaveAccount(acntSnd)
+	if err != nil {
+		return 0, err
+	}
+
+	userTx := makeUserTxFromRelayedTxV3(tx)
+	userTx.GasLimit = tx.GasLimit - txProc.economicsFee.ComputeGasLimit(tx)
+
+	return txProc.processUserTx(tx, userTx, big.NewInt(0), tx.Nonce, txHash)
+}
+
+func (txProc *txProcessor) checkRelayedTxV3Values(
+	tx *transaction.Transaction,
+	relayerAcnt, acntSnd, acntDst state.UserAccountHandler,
+) error {
+	err := txProc.checkUserNames(tx, acntSnd, acntDst)
+	if err != nil {
+		return err
+	}
+	if acntSnd.GetNonce() < tx.Nonce {
+		return process.ErrHigherNonceInTransaction
+	}
+	if acntSnd.GetNonce() > tx.Nonce {
+		return process.ErrLowerNonceInTransaction
+	}
+
+	err = txProc.economicsFee.CheckValidityTxValues(tx)
+	if err != nil {
+		return err
+	}
+
+	txFee := txProc.economicsFee.ComputeTxFee(tx)
+	if relayerAcnt.GetBalance().Cmp(txFee) < 0 {
+		return fmt.Errorf("%w, relayer has: %s, wanted: %s",
+			process.ErrInsufficientFee,
+			relayerAcnt.GetBalance().String(),
+			txFee.String(),
+		)
+	}
+	if acntSnd.GetBalance().Cmp(tx.Value) < 0 {
+		return process.ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+func (txProc *txProcessor) executingFailedRelayedTxV3(
+	tx *transaction.Transaction,
+	relayerAcnt, acntSnd state.UserAccountHandler,
+	txError error,
+) error {
+	txFee := txProc.economicsFee.ComputeTxFee(tx)
+	relayerAcnt.IncreaseNonce(1)
+	err := relayerAcnt.SubFromBalance(txFee)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.accounts.SaveAccount(relayerAcnt)
+	if err != nil {
+		return err
+	}
+
+	acntSnd.IncreaseNonce(1)
+	err = txProc.accounts.SaveAccount(acntSnd)
+	if err != nil {
+		return err
+	}
+
+	err = txProc.badTxForwarder.AddIntermediateTransactions([]data.TransactionHandler{tx})
+	if err != nil {
+		return err
+	}
+
+	txHash, err := core.CalculateHash(txProc.marshalizer, txProc.hasher, tx)
+	if err != nil {
+		return err
+	}
+
+	log.Trace("executingFailedRelayedTxV3", "fail reason(error)", txError, "tx hash", txHash)
+
+	rpt := &receipt.Receipt{
+		Value:   big.NewInt(0).Set(txFee),
+		SndAddr: tx.RelayerAddr,
+		Data:    []byte(txError.Error()),
+		TxHash:  txHash,
+	}
+
+	err = txProc.receiptForwarder.AddIntermediateTransactions([]data.TransactionHandler{rpt})
+	if err != nil {
+		return err
+	}
+
+	txProc.txFeeHandler.ProcessTransactionFee(txFee, big.NewInt(0), txHash)
+
+	return process.ErrFailedTransaction
+}
+
 func (txProc *txProcessor) processRelayedTx(
 	tx *transaction.Transaction,
 	relayerAcnt, acntDst state.UserAccountHandler,
@@ -737,6 +917,9 @@ func (txProc *txProcessor) processUserTx(
 	}
 
 	relayerAdr := originalTx.SndAddr
+	if originalTx.IsRelayedV3() {
+		relayerAdr = originalTx.RelayerAddr
+	}
 	txType, dstShardTxType := txProc.txTypeHandler.ComputeTransactionType(userTx)
 	err = txProc.checkTxValues(userTx, acntSnd, acntDst, true)
 	if err != nil {
@@ -945,6 +1128,9 @@ func (txProc *txProcessor) EpochConfirmed(epoch uint32, _ uint64) {
 	txProc.flagRelayedTxV2.Toggle(epoch >= txProc.relayedTxV2EnableEpoch)
 	log.Debug("txProcessor: relayed transactions v2", "enabled", txProc.flagRelayedTxV2.IsSet())
 
+	txProc.flagRelayedTxV3.Toggle(epoch >= txProc.relayedTxV3EnableEpoch)
+	log.Debug("txProcessor: relayed transactions v3", "enabled", txProc.flagRelayedTxV3.IsSet())
+
 	txProc.flagPenalizedTooMuchGas.Toggle(epoch >= txProc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("txProcessor: penalized too much gas", "enabled", txProc.flagPenalizedTooMuchGas.IsSet())
 
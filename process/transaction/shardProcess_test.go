@@ -1608,6 +1608,139 @@ func TestTxProcessor_ProcessTransactionShouldTreatAsInvalidTxIfTxTypeIsWrong(t *
 	assert.Equal(t, uint64(45), acntSrc.Balance.Uint64())
 }
 
+func createRelayedTxV3ProcessorForTest(tx *transaction.Transaction, relayedTxV3EnableEpoch uint32) (process.TransactionProcessor, map[string]state.UserAccountHandler) {
+	pubKeyConverter := mock.NewPubkeyConverterMock(4)
+
+	accounts := make(map[string]state.UserAccountHandler)
+	for _, address := range [][]byte{tx.SndAddr, tx.RcvAddr, tx.RelayerAddr} {
+		acnt, _ := state.NewUserAccount(address)
+		acnt.Balance = big.NewInt(100)
+		accounts[string(address)] = acnt
+	}
+
+	adb := &testscommon.AccountsStub{}
+	adb.LoadAccountCalled = func(address []byte) (vmcommon.AccountHandler, error) {
+		acnt, ok := accounts[string(address)]
+		if !ok {
+			return nil, errors.New("failure")
+		}
+		return acnt, nil
+	}
+	shardC, _ := sharding.NewMultiShardCoordinator(1, 0)
+
+	argTxTypeHandler := coordinator.ArgNewTxTypeHandler{
+		PubkeyConverter:  pubKeyConverter,
+		ShardCoordinator: shardC,
+		BuiltInFuncNames: make(map[string]struct{}),
+		ArgumentParser:   parsers.NewCallArgsParser(),
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+	txTypeHandler, _ := coordinator.NewTxTypeHandler(argTxTypeHandler)
+
+	args := createArgsForTxProcessor()
+	args.Accounts = adb
+	args.ScProcessor = &testscommon.SCProcessorMock{}
+	args.ShardCoordinator = shardC
+	args.TxTypeHandler = txTypeHandler
+	args.PubkeyConv = pubKeyConverter
+	args.RelayedTxV3EnableEpoch = relayedTxV3EnableEpoch
+	args.ArgsParser = smartContract.NewArgumentParser()
+	args.EconomicsFee = &mock.FeeHandlerStub{
+		ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+			return 1
+		},
+		ComputeMoveBalanceFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(int64(tx.GetGasPrice()))
+		},
+		ComputeTxFeeCalled: func(tx process.TransactionWithFeeHandler) *big.Int {
+			return big.NewInt(int64(tx.GetGasLimit() * tx.GetGasPrice()))
+		},
+		ComputeFeeForProcessingCalled: func(tx process.TransactionWithFeeHandler, gasToUse uint64) *big.Int {
+			return big.NewInt(int64(gasToUse * tx.GetGasPrice()))
+		},
+	}
+	execTx, _ := txproc.NewTxProcessor(args)
+
+	return execTx, accounts
+}
+
+func createRelayedTxV3ForTest() *transaction.Transaction {
+	return &transaction.Transaction{
+		Nonce:            0,
+		SndAddr:          []byte("sSRC"),
+		RcvAddr:          []byte("sDST"),
+		Value:            big.NewInt(10),
+		GasPrice:         1,
+		GasLimit:         3,
+		RelayerAddr:      []byte("sRLY"),
+		RelayerSignature: []byte("relayer signature"),
+	}
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV3RelayerIsSenderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxV3ForTest()
+	tx.RelayerAddr = tx.SndAddr
+	execTx, _ := createRelayedTxV3ProcessorForTest(tx, 0)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrRelayedTxV3RelayerIsSender, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV3NotActiveShouldConsumeRelayerFee(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxV3ForTest()
+	execTx, accounts := createRelayedTxV3ProcessorForTest(tx, 1)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, uint64(1), accounts[string(tx.SndAddr)].GetNonce())
+	assert.Equal(t, big.NewInt(100), accounts[string(tx.SndAddr)].GetBalance())
+	assert.Equal(t, big.NewInt(97), accounts[string(tx.RelayerAddr)].GetBalance())
+	assert.Equal(t, uint64(1), accounts[string(tx.RelayerAddr)].GetNonce())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV3WrongRelayerNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxV3ForTest()
+	execTx, accounts := createRelayedTxV3ProcessorForTest(tx, 0)
+	accounts[string(tx.RelayerAddr)].IncreaseNonce(1)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrLowerNonceInTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+
+	tx.RelayerNonce = 2
+	returnCode, err = execTx.ProcessTransaction(tx)
+	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+
+	assert.Equal(t, uint64(0), accounts[string(tx.SndAddr)].GetNonce())
+	assert.Equal(t, big.NewInt(100), accounts[string(tx.RelayerAddr)].GetBalance())
+	assert.Equal(t, uint64(1), accounts[string(tx.RelayerAddr)].GetNonce())
+}
+
+func TestTxProcessor_ProcessRelayedTransactionV3ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tx := createRelayedTxV3ForTest()
+	execTx, accounts := createRelayedTxV3ProcessorForTest(tx, 0)
+
+	returnCode, err := execTx.ProcessTransaction(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.Equal(t, uint64(1), accounts[string(tx.SndAddr)].GetNonce())
+	assert.Equal(t, big.NewInt(90), accounts[string(tx.SndAddr)].GetBalance())
+	assert.Equal(t, big.NewInt(110), accounts[string(tx.RcvAddr)].GetBalance())
+	assert.Equal(t, big.NewInt(97), accounts[string(tx.RelayerAddr)].GetBalance())
+	assert.Equal(t, uint64(1), accounts[string(tx.RelayerAddr)].GetNonce())
+}
+
 func TestTxProcessor_ProcessRelayedTransactionV2NotActiveShouldErr(t *testing.T) {
 	t.Parallel()
 
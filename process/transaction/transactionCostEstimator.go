@@ -1,16 +1,21 @@
 package transaction
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/facade"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
@@ -20,22 +25,32 @@ import (
 
 const dummySignature = "01010101"
 
+// maxScrHopsDepth limits how deep the chain of smart contract results is followed when estimating the cost
+const maxScrHopsDepth = 10
+
 type transactionCostEstimator struct {
-	accounts         state.AccountsAdapter
-	shardCoordinator sharding.Coordinator
-	txTypeHandler    process.TxTypeHandler
-	feeHandler       process.FeeHandler
-	txSimulator      facade.TransactionSimulatorProcessor
-	mutExecution     sync.RWMutex
+	accounts          state.AccountsAdapter
+	shardCoordinator  sharding.Coordinator
+	txTypeHandler     process.TxTypeHandler
+	feeHandler        process.FeeHandler
+	txSimulator       facade.TransactionSimulatorProcessor
+	shardSimulators   map[uint32]facade.TransactionSimulatorProcessor
+	txSignMarshalizer marshal.Marshalizer
+	argsParser        process.ArgumentsParser
+	mutExecution      sync.RWMutex
 }
 
-// NewTransactionCostEstimator will create a new transaction cost estimator
+// NewTransactionCostEstimator will create a new transaction cost estimator. The shard simulators are optional and
+// work on read-only clones of the other shards' state. The execution steps taking place in a shard without a simulator
+// are accounted as explicit estimates, equal to the gas made available to them
 func NewTransactionCostEstimator(
 	txTypeHandler process.TxTypeHandler,
 	feeHandler process.FeeHandler,
 	txSimulator facade.TransactionSimulatorProcessor,
 	accounts state.AccountsAdapter,
 	shardCoordinator sharding.Coordinator,
+	txSignMarshalizer marshal.Marshalizer,
+	shardSimulators map[uint32]facade.TransactionSimulatorProcessor,
 ) (*transactionCostEstimator, error) {
 	if check.IfNil(txTypeHandler) {
 		return nil, process.ErrNilTxTypeHandler
@@ -52,13 +67,27 @@ func NewTransactionCostEstimator(
 	if check.IfNil(accounts) {
 		return nil, process.ErrNilAccountsAdapter
 	}
+	if check.IfNil(txSignMarshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	simulators := make(map[uint32]facade.TransactionSimulatorProcessor, len(shardSimulators))
+	for shardID, shardSimulator := range shardSimulators {
+		if check.IfNil(shardSimulator) {
+			return nil, fmt.Errorf("%w for shard %d", txsimulator.ErrNilTxSimulatorProcessor, shardID)
+		}
+		simulators[shardID] = shardSimulator
+	}
 
 	return &transactionCostEstimator{
-		txTypeHandler:    txTypeHandler,
-		feeHandler:       feeHandler,
-		txSimulator:      txSimulator,
-		accounts:         accounts,
-		shardCoordinator: shardCoordinator,
+		txTypeHandler:     txTypeHandler,
+		feeHandler:        feeHandler,
+		txSimulator:       txSimulator,
+		shardSimulators:   simulators,
+		txSignMarshalizer: txSignMarshalizer,
+		argsParser:        smartContract.NewArgumentParser(),
+		accounts:          accounts,
+		shardCoordinator:  shardCoordinator,
 	}, nil
 }
 
@@ -72,12 +101,8 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 	switch txType {
 	case process.SCDeployment, process.SCInvoking, process.BuiltInFunctionCall, process.MoveBalance:
 		return tce.simulateTransactionCost(tx, txType)
-	case process.RelayedTx, process.RelayedTxV2:
-		// TODO implement in the next PR
-		return &transaction.CostResponse{
-			GasUnits:      0,
-			ReturnMessage: "cannot compute cost of the relayed transaction",
-		}, nil
+	case process.RelayedTx, process.RelayedTxV2, process.RelayedTxV3:
+		return tce.simulateRelayedTransactionCost(tx, txType)
 	default:
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -87,12 +112,27 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 }
 
 func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
+	isGasLimitProvided := tx.GasLimit > 0
 	err := tce.addMissingFieldsIfNeeded(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := tce.txSimulator.ProcessTx(tx)
+	breakdown := &transaction.GasBreakdown{
+		MoveBalance: tce.feeHandler.ComputeGasLimit(tx),
+		ScrHops:     make([]*transaction.ScrHopGasCost, 0),
+	}
+
+	executionShardID := tce.shardCoordinator.SelfId()
+	if txType != process.MoveBalance {
+		executionShardID = tce.shardCoordinator.ComputeId(tx.RcvAddr)
+	}
+	simulator, ok := tce.getSimulatorForShard(executionShardID)
+	if !ok {
+		return tce.estimateExecutionInOtherShard(tx, breakdown, isGasLimitProvided)
+	}
+
+	res, err := simulator.ProcessTx(tx)
 	if err != nil {
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -102,13 +142,188 @@ func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Tra
 
 	isMoveBalanceOk := txType == process.MoveBalance && res.FailReason == ""
 	if isMoveBalanceOk {
+		setRefund(breakdown, tx, isGasLimitProvided)
 		return &transaction.CostResponse{
-			GasUnits:      tce.feeHandler.ComputeGasLimit(tx),
+			GasUnits:      breakdown.MoveBalance,
 			ReturnMessage: "",
+			GasBreakdown:  breakdown,
+		}, nil
+
+	}
+
+	return tce.createCostResponse(res, tx.GasLimit, breakdown.MoveBalance, executionShardID, breakdown, tx, isGasLimitProvided)
+}
+
+// estimateExecutionInOtherShard simulates the part of the transaction taking place in the sender shard, when that is
+// the self shard, and accounts the gas made available for the execution in the receiver shard as an explicit estimate
+func (tce *transactionCostEstimator) estimateExecutionInOtherShard(
+	tx *transaction.Transaction,
+	breakdown *transaction.GasBreakdown,
+	isGasLimitProvided bool,
+) (*transaction.CostResponse, error) {
+	if tce.shardCoordinator.ComputeId(tx.SndAddr) == tce.shardCoordinator.SelfId() {
+		res, err := tce.txSimulator.ProcessTx(tx)
+		if err != nil {
+			return &transaction.CostResponse{
+				GasUnits:      0,
+				ReturnMessage: err.Error(),
+			}, nil
+		}
+		if res.FailReason != "" {
+			return &transaction.CostResponse{
+				GasUnits:      0,
+				ReturnMessage: res.FailReason,
+			}, nil
+		}
+	}
+
+	breakdown.Processing = safeSub(tx.GasLimit, breakdown.MoveBalance)
+	breakdown.IsProcessingEstimated = true
+	setRefund(breakdown, tx, isGasLimitProvided)
+
+	return &transaction.CostResponse{
+		GasUnits:      computeTotalGasUnits(breakdown),
+		ReturnMessage: "",
+		GasBreakdown:  breakdown,
+	}, nil
+}
+
+// simulateRelayedTransactionCost estimates the inner user transaction and adds the gas consumed at the relayer. The
+// user transaction is executed as a smart contract result paid by the relayer, so it is simulated in the same way
+func (tce *transactionCostEstimator) simulateRelayedTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
+	isGasLimitProvided := tx.GasLimit > 0
+	err := tce.addMissingFieldsIfNeeded(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	userTx, err := tce.getUserTxFromRelayedTx(tx, txType)
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:      0,
+			ReturnMessage: err.Error(),
 		}, nil
+	}
 
+	breakdown := &transaction.GasBreakdown{
+		Relayer:     tce.feeHandler.ComputeGasLimit(tx),
+		MoveBalance: tce.feeHandler.ComputeGasLimit(userTx),
+		ScrHops:     make([]*transaction.ScrHopGasCost, 0),
 	}
+	userTx.GasLimit = safeSub(tx.GasLimit, breakdown.Relayer)
+
+	userTxType, _ := tce.txTypeHandler.ComputeTransactionType(userTx)
+	switch userTxType {
+	case process.MoveBalance:
+		setRefund(breakdown, tx, isGasLimitProvided)
+		return &transaction.CostResponse{
+			GasUnits:      computeTotalGasUnits(breakdown),
+			ReturnMessage: "",
+			GasBreakdown:  breakdown,
+		}, nil
+	case process.SCInvoking, process.BuiltInFunctionCall:
+	default:
+		return &transaction.CostResponse{
+			GasUnits:      0,
+			ReturnMessage: process.ErrRelayedTxCostEstimationNotSupported.Error(),
+		}, nil
+	}
+
+	relayerAddr := tx.SndAddr
+	if txType == process.RelayedTxV3 {
+		relayerAddr = tx.RelayerAddr
+	}
+	userScr := &smartContractResult.SmartContractResult{
+		Nonce:          userTx.Nonce,
+		Value:          userTx.Value,
+		RcvAddr:        userTx.RcvAddr,
+		SndAddr:        userTx.SndAddr,
+		OriginalSender: userTx.SndAddr,
+		RelayerAddr:    relayerAddr,
+		RelayedValue:   big.NewInt(0),
+		Data:           userTx.Data,
+		GasLimit:       safeSub(userTx.GasLimit, breakdown.MoveBalance),
+		GasPrice:       tx.GasPrice,
+		CallType:       vmcommon.DirectCall,
+	}
+
+	executionShardID := tce.shardCoordinator.ComputeId(userScr.RcvAddr)
+	simulator, ok := tce.getSimulatorForShard(executionShardID)
+	if !ok {
+		breakdown.Processing = userScr.GasLimit
+		breakdown.IsProcessingEstimated = true
+		setRefund(breakdown, tx, isGasLimitProvided)
+		return &transaction.CostResponse{
+			GasUnits:      computeTotalGasUnits(breakdown),
+			ReturnMessage: "",
+			GasBreakdown:  breakdown,
+		}, nil
+	}
+
+	res, err := simulator.ProcessSCR(userScr)
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:      0,
+			ReturnMessage: err.Error(),
+		}, nil
+	}
+
+	return tce.createCostResponse(res, userScr.GasLimit, 0, executionShardID, breakdown, tx, isGasLimitProvided)
+}
+
+func (tce *transactionCostEstimator) getUserTxFromRelayedTx(tx *transaction.Transaction, txType process.TransactionType) (*transaction.Transaction, error) {
+	if txType == process.RelayedTxV3 {
+		return makeUserTxFromRelayedTxV3(tx), nil
+	}
+
+	_, args, err := tce.argsParser.ParseCallData(string(tx.Data))
+	if err != nil {
+		return nil, err
+	}
+
+	if txType == process.RelayedTxV2 {
+		if len(args) != 4 {
+			return nil, process.ErrInvalidArguments
+		}
+
+		userTx := makeUserTxFromRelayedTxV2Args(args)
+		userTx.GasPrice = tx.GasPrice
+		userTx.SndAddr = tx.RcvAddr
 
+		return userTx, nil
+	}
+
+	if len(args) != 1 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	userTx := &transaction.Transaction{}
+	err = tce.txSignMarshalizer.Unmarshal(userTx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(userTx.SndAddr, tx.RcvAddr) {
+		return nil, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver
+	}
+	if userTx.Value == nil {
+		userTx.Value = big.NewInt(0)
+	}
+
+	return userTx, nil
+}
+
+// createCostResponse builds the response out of the simulation of the first execution step, following all the smart
+// contract results which carry gas. The gas used by the first step, without the already accounted gas and the gas
+// forwarded to the smart contract results, is accounted as processing
+func (tce *transactionCostEstimator) createCostResponse(
+	res *transaction.SimulationResults,
+	gasLimit uint64,
+	accountedGas uint64,
+	executionShardID uint32,
+	breakdown *transaction.GasBreakdown,
+	tx *transaction.Transaction,
+	isGasLimitProvided bool,
+) (*transaction.CostResponse, error) {
 	if res.FailReason != "" {
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -124,28 +339,168 @@ func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Tra
 		}, nil
 	}
 
-	if res.VMOutput.ReturnCode == vmcommon.Ok {
+	if res.VMOutput.ReturnCode != vmcommon.Ok {
 		return &transaction.CostResponse{
-			GasUnits:             computeGasUnitsBasedOnVMOutput(tx, res.VMOutput),
-			ReturnMessage:        "",
+			GasUnits:             0,
+			ReturnMessage:        fmt.Sprintf("%s %s", res.VMOutput.ReturnCode.String(), res.VMOutput.ReturnMessage),
 			SmartContractResults: res.ScResults,
 		}, nil
 	}
 
+	forwardedGas, err := tce.simulateScrHops(res, executionShardID, breakdown, 0)
+	if err != nil {
+		return &transaction.CostResponse{
+			GasUnits:             0,
+			ReturnMessage:        err.Error(),
+			SmartContractResults: res.ScResults,
+		}, nil
+	}
+
+	gasUsed := computeGasUnitsBasedOnVMOutput(gasLimit, res.VMOutput)
+	breakdown.Processing = safeSub(safeSub(gasUsed, accountedGas), forwardedGas)
+	setRefund(breakdown, tx, isGasLimitProvided)
+
 	return &transaction.CostResponse{
-		GasUnits:             0,
-		ReturnMessage:        fmt.Sprintf("%s %s", res.VMOutput.ReturnCode.String(), res.VMOutput.ReturnMessage),
+		GasUnits:             computeTotalGasUnits(breakdown),
+		ReturnMessage:        "",
 		SmartContractResults: res.ScResults,
+		GasBreakdown:         breakdown,
 	}, nil
 }
 
-func computeGasUnitsBasedOnVMOutput(tx *transaction.Transaction, vmOutput *vmcommon.VMOutput) uint64 {
+func (tce *transactionCostEstimator) getSimulatorForShard(shardID uint32) (facade.TransactionSimulatorProcessor, bool) {
+	if shardID == tce.shardCoordinator.SelfId() {
+		return tce.txSimulator, true
+	}
+
+	simulator, ok := tce.shardSimulators[shardID]
+	return simulator, ok
+}
+
+// simulateScrHops follows the smart contract results which carry gas and returns the total gas forwarded to them
+func (tce *transactionCostEstimator) simulateScrHops(
+	res *transaction.SimulationResults,
+	senderShardID uint32,
+	breakdown *transaction.GasBreakdown,
+	depth int,
+) (uint64, error) {
+	scrHashes := make([]string, 0, len(res.ScrsByHash))
+	for scrHash := range res.ScrsByHash {
+		scrHashes = append(scrHashes, scrHash)
+	}
+	sort.Strings(scrHashes)
+
+	forwardedGas := uint64(0)
+	for _, scrHash := range scrHashes {
+		scr, ok := res.ScrsByHash[scrHash].(*smartContractResult.SmartContractResult)
+		if !ok || scr.GasLimit == 0 {
+			continue
+		}
+
+		forwardedGas += scr.GasLimit
+		err := tce.simulateScrHop(scrHash, scr, senderShardID, breakdown, depth)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return forwardedGas, nil
+}
+
+// simulateScrHop executes the smart contract result in its destination shard. When there is no simulator for that
+// shard, or the chain of smart contract results is too deep, the gas forwarded to the smart contract result is
+// accounted as an explicit estimate of the hop
+func (tce *transactionCostEstimator) simulateScrHop(
+	scrHash string,
+	scr *smartContractResult.SmartContractResult,
+	senderShardID uint32,
+	breakdown *transaction.GasBreakdown,
+	depth int,
+) error {
+	receiverShardID := tce.shardCoordinator.ComputeId(scr.RcvAddr)
+	hop := &transaction.ScrHopGasCost{
+		Hash:          scrHash,
+		SenderShard:   senderShardID,
+		ReceiverShard: receiverShardID,
+	}
+
+	simulator, ok := tce.getSimulatorForShard(receiverShardID)
+	if !ok || depth >= maxScrHopsDepth {
+		hop.GasUnits = scr.GasLimit
+		hop.IsEstimated = true
+		addHopToBreakdown(breakdown, hop, scr)
+		return nil
+	}
+
+	res, err := simulator.ProcessSCR(scr)
+	if err != nil {
+		return err
+	}
+	if res.FailReason != "" {
+		return errors.New(res.FailReason)
+	}
+	if res.VMOutput == nil {
+		addHopToBreakdown(breakdown, hop, scr)
+		return nil
+	}
+	if res.VMOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%s %s", res.VMOutput.ReturnCode.String(), res.VMOutput.ReturnMessage)
+	}
+
+	forwardedGas, err := tce.simulateScrHops(res, receiverShardID, breakdown, depth+1)
+	if err != nil {
+		return err
+	}
+
+	gasUsed := computeGasUnitsBasedOnVMOutput(scr.GasLimit, res.VMOutput)
+	hop.GasUnits = safeSub(gasUsed, forwardedGas)
+	addHopToBreakdown(breakdown, hop, scr)
+
+	return nil
+}
+
+func addHopToBreakdown(breakdown *transaction.GasBreakdown, hop *transaction.ScrHopGasCost, scr *smartContractResult.SmartContractResult) {
+	if scr.CallType == vmcommon.AsynchronousCallBack {
+		breakdown.Callback += hop.GasUnits
+		breakdown.IsCallbackEstimated = breakdown.IsCallbackEstimated || hop.IsEstimated
+		return
+	}
+
+	breakdown.ScrHops = append(breakdown.ScrHops, hop)
+}
+
+func computeTotalGasUnits(breakdown *transaction.GasBreakdown) uint64 {
+	total := breakdown.Relayer + breakdown.MoveBalance + breakdown.Processing + breakdown.Callback
+	for _, hop := range breakdown.ScrHops {
+		total += hop.GasUnits
+	}
+
+	return total
+}
+
+func setRefund(breakdown *transaction.GasBreakdown, tx *transaction.Transaction, isGasLimitProvided bool) {
+	if !isGasLimitProvided {
+		return
+	}
+
+	breakdown.Refund = safeSub(tx.GasLimit, computeTotalGasUnits(breakdown))
+}
+
+func safeSub(a uint64, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+
+	return a - b
+}
+
+func computeGasUnitsBasedOnVMOutput(gasLimit uint64, vmOutput *vmcommon.VMOutput) uint64 {
 	isTooMuchGasProvided := strings.Contains(vmOutput.ReturnMessage, smartContract.TooMuchGasProvidedMessage)
 	if !isTooMuchGasProvided {
-		return tx.GasLimit - vmOutput.GasRemaining
+		return safeSub(gasLimit, vmOutput.GasRemaining)
 	}
 
-	return tx.GasLimit - extractGasRemainedFromMessage(vmOutput.ReturnMessage)
+	return safeSub(gasLimit, extractGasRemainedFromMessage(vmOutput.ReturnMessage))
 }
 
 func extractGasRemainedFromMessage(message string) uint64 {
@@ -185,12 +540,17 @@ func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction)
 	selfShardID := tce.shardCoordinator.SelfId()
 	maxGasLimitPerBlock := tce.feeHandler.MaxGasLimitPerBlock(selfShardID) - 1
 
-	senderShardID := tce.shardCoordinator.ComputeId(tx.SndAddr)
-	if tce.shardCoordinator.SelfId() != senderShardID {
+	feePayer := tx.SndAddr
+	if tx.IsRelayedV3() {
+		feePayer = tx.RelayerAddr
+	}
+
+	feePayerShardID := tce.shardCoordinator.ComputeId(feePayer)
+	if tce.shardCoordinator.SelfId() != feePayerShardID {
 		return maxGasLimitPerBlock, nil
 	}
 
-	accountHandler, err := tce.accounts.LoadAccount(tx.SndAddr)
+	accountHandler, err := tce.accounts.LoadAccount(feePayer)
 	if err != nil {
 		return 0, err
 	}
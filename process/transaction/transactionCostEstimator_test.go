@@ -1,15 +1,19 @@
 package transaction
 
 import (
+	"encoding/hex"
 	"errors"
 	"math"
 	"math/big"
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
@@ -21,7 +25,7 @@ import (
 func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 	t.Parallel()
 
-	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{})
+	tce, err := NewTransactionCostEstimator(nil, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilTxTypeHandler, err)
@@ -30,7 +34,7 @@ func TestTransactionCostEstimator_NilTxTypeHandler(t *testing.T) {
 func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, nil, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{})
+	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, nil, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	require.Nil(t, tce)
 	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
@@ -39,16 +43,37 @@ func TestTransactionCostEstimator_NilFeeHandlerShouldErr(t *testing.T) {
 func TestTransactionCostEstimator_NilTransactionSimulatorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{})
+	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, nil, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	require.Nil(t, tce)
 	require.Equal(t, txsimulator.ErrNilTxSimulatorProcessor, err)
 }
 
+func TestTransactionCostEstimator_NilTxSignMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, nil, nil)
+
+	require.Nil(t, tce)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestTransactionCostEstimator_NilShardSimulatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	shardSimulators := map[uint32]facade.TransactionSimulatorProcessor{
+		1: nil,
+	}
+	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, shardSimulators)
+
+	require.Nil(t, tce)
+	require.True(t, errors.Is(err, txsimulator.ErrNilTxSimulatorProcessor))
+}
+
 func TestTransactionCostEstimator_Ok(t *testing.T) {
 	t.Parallel()
 
-	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{})
+	tce, err := NewTransactionCostEstimator(&testscommon.TxTypeHandlerMock{}, &mock.FeeHandlerStub{}, &mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	require.Nil(t, err)
 	require.False(t, check.IfNil(tce))
@@ -77,7 +102,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 			return &mock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 		},
-	}, &mock.ShardCoordinatorStub{})
+	}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -109,7 +134,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 				return &mock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 			},
-		}, &mock.ShardCoordinatorStub{})
+		}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -136,7 +161,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 				return &mock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 			},
-		}, &mock.ShardCoordinatorStub{})
+		}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -162,7 +187,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 				return &mock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 			},
-		}, &mock.ShardCoordinatorStub{})
+		}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -192,7 +217,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 			LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
 				return &mock.UserAccountStub{Balance: big.NewInt(100000)}, nil
 			},
-		}, &mock.ShardCoordinatorStub{})
+		}, &mock.ShardCoordinatorStub{}, &mock.MarshalizerMock{}, nil)
 
 	tx := &transaction.Transaction{}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
@@ -200,24 +225,393 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 	require.True(t, strings.Contains(cost.ReturnMessage, vmcommon.UserError.String()))
 }
 
-func TestTransactionCostEstimator_RelayedTxShouldErr(t *testing.T) {
+func createAsyncCallEstimator(asyncCallReceiver []byte, withShardSimulator bool) *transactionCostEstimator {
+	asyncCallSCR := &smartContractResult.SmartContractResult{
+		RcvAddr:  asyncCallReceiver,
+		GasLimit: 60000,
+		CallType: vmcommon.AsynchronousCall,
+	}
+	callbackSCR := &smartContractResult.SmartContractResult{
+		RcvAddr:  []byte("scA0"),
+		GasLimit: 20000,
+		CallType: vmcommon.AsynchronousCallBack,
+	}
+	refundSCR := &smartContractResult.SmartContractResult{
+		RcvAddr: []byte("sender0"),
+		Value:   big.NewInt(10),
+	}
+
+	txSimulator := &mock.TransactionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+			return &transaction.SimulationResults{
+				VMOutput: &vmcommon.VMOutput{
+					ReturnCode:   vmcommon.Ok,
+					GasRemaining: 10000,
+				},
+				ScrsByHash: map[string]data.TransactionHandler{
+					"asyncCall": asyncCallSCR,
+					"refund":    refundSCR,
+				},
+			}, nil
+		},
+		ProcessSCRCalled: func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+			if scr == asyncCallSCR {
+				return &transaction.SimulationResults{
+					VMOutput: &vmcommon.VMOutput{
+						ReturnCode:   vmcommon.Ok,
+						GasRemaining: 0,
+					},
+					ScrsByHash: map[string]data.TransactionHandler{
+						"callback": callbackSCR,
+					},
+				}, nil
+			}
+
+			return &transaction.SimulationResults{
+				VMOutput: &vmcommon.VMOutput{
+					ReturnCode:   vmcommon.Ok,
+					GasRemaining: 15000,
+				},
+			}, nil
+		},
+	}
+
+	shardSimulators := make(map[uint32]facade.TransactionSimulatorProcessor)
+	if withShardSimulator {
+		shardSimulators[1] = txSimulator
+	}
+
+	tce, _ := NewTransactionCostEstimator(
+		&testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				return process.SCInvoking, process.SCInvoking
+			},
+		},
+		&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 1000
+			},
+		},
+		txSimulator,
+		&testscommon.AccountsStub{},
+		createTwoShardsCoordinator(),
+		&mock.MarshalizerMock{},
+		shardSimulators,
+	)
+
+	return tce
+}
+
+func createTwoShardsCoordinator() *mock.ShardCoordinatorStub {
+	return &mock.ShardCoordinatorStub{
+		ComputeIdCalled: func(address []byte) uint32 {
+			if address[len(address)-1] == '1' {
+				return 1
+			}
+			return 0
+		},
+		SelfIdCalled: func() uint32 {
+			return 0
+		},
+	}
+}
+
+func TestComputeTransactionGasLimit_IntraShardAsyncCallShouldFollowAllHops(t *testing.T) {
+	t.Parallel()
+
+	tce := createAsyncCallEstimator([]byte("scB0"), false)
+
+	tx := &transaction.Transaction{SndAddr: []byte("sender0"), RcvAddr: []byte("scA0"), GasLimit: 100000}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(75000), cost.GasUnits)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.MoveBalance)
+	require.Equal(t, uint64(29000), cost.GasBreakdown.Processing)
+	require.Equal(t, []*transaction.ScrHopGasCost{
+		{
+			Hash:          "asyncCall",
+			SenderShard:   0,
+			ReceiverShard: 0,
+			GasUnits:      40000,
+		},
+	}, cost.GasBreakdown.ScrHops)
+	require.Equal(t, uint64(5000), cost.GasBreakdown.Callback)
+	require.Equal(t, uint64(25000), cost.GasBreakdown.Refund)
+}
+
+func TestComputeTransactionGasLimit_CrossShardAsyncCallShouldEstimateTheHop(t *testing.T) {
+	t.Parallel()
+
+	tce := createAsyncCallEstimator([]byte("scB1"), false)
+
+	tx := &transaction.Transaction{SndAddr: []byte("sender0"), RcvAddr: []byte("scA0"), GasLimit: 100000}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(90000), cost.GasUnits)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.MoveBalance)
+	require.Equal(t, uint64(29000), cost.GasBreakdown.Processing)
+	require.False(t, cost.GasBreakdown.IsProcessingEstimated)
+	require.Equal(t, []*transaction.ScrHopGasCost{
+		{
+			Hash:          "asyncCall",
+			SenderShard:   0,
+			ReceiverShard: 1,
+			GasUnits:      60000,
+			IsEstimated:   true,
+		},
+	}, cost.GasBreakdown.ScrHops)
+	require.Equal(t, uint64(0), cost.GasBreakdown.Callback)
+	require.Equal(t, uint64(10000), cost.GasBreakdown.Refund)
+}
+
+func TestComputeTransactionGasLimit_CrossShardAsyncCallWithShardSimulatorShouldFollowAllHops(t *testing.T) {
+	t.Parallel()
+
+	tce := createAsyncCallEstimator([]byte("scB1"), true)
+
+	tx := &transaction.Transaction{SndAddr: []byte("sender0"), RcvAddr: []byte("scA0"), GasLimit: 100000}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(75000), cost.GasUnits)
+	require.Equal(t, []*transaction.ScrHopGasCost{
+		{
+			Hash:          "asyncCall",
+			SenderShard:   0,
+			ReceiverShard: 1,
+			GasUnits:      40000,
+		},
+	}, cost.GasBreakdown.ScrHops)
+	require.Equal(t, uint64(5000), cost.GasBreakdown.Callback)
+	require.False(t, cost.GasBreakdown.IsCallbackEstimated)
+	require.Equal(t, uint64(25000), cost.GasBreakdown.Refund)
+}
+
+func TestComputeTransactionGasLimit_ReceiverInOtherShardShouldEstimateTheProcessing(t *testing.T) {
+	t.Parallel()
+
+	tce := createAsyncCallEstimator([]byte("scB0"), false)
+
+	tx := &transaction.Transaction{SndAddr: []byte("sender0"), RcvAddr: []byte("scB1"), GasLimit: 100000}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(100000), cost.GasUnits)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.MoveBalance)
+	require.Equal(t, uint64(99000), cost.GasBreakdown.Processing)
+	require.True(t, cost.GasBreakdown.IsProcessingEstimated)
+	require.Equal(t, uint64(0), cost.GasBreakdown.Refund)
+}
+
+func TestComputeTransactionGasLimit_ReceiverInOtherShardShouldReturnTheSenderSideFailure(t *testing.T) {
 	t.Parallel()
 
 	tce, _ := NewTransactionCostEstimator(
 		&testscommon.TxTypeHandlerMock{
 			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
-				return process.RelayedTx, process.RelayedTx
+				return process.SCInvoking, process.SCInvoking
 			},
 		},
 		&mock.FeeHandlerStub{},
-		&mock.TransactionSimulatorStub{}, &testscommon.AccountsStub{}, &mock.ShardCoordinatorStub{},
+		&mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+				return &transaction.SimulationResults{FailReason: "insufficient funds"}, nil
+			},
+		},
+		&testscommon.AccountsStub{},
+		createTwoShardsCoordinator(),
+		&mock.MarshalizerMock{},
+		nil,
 	)
 
-	tx := &transaction.Transaction{}
+	tx := &transaction.Transaction{SndAddr: []byte("sender0"), RcvAddr: []byte("scB1"), GasLimit: 100000}
 	cost, err := tce.ComputeTransactionGasLimit(tx)
 	require.Nil(t, err)
-	require.Equal(t, "cannot compute cost of the relayed transaction", cost.ReturnMessage)
+	require.Equal(t, uint64(0), cost.GasUnits)
+	require.Equal(t, "insufficient funds", cost.ReturnMessage)
 }
+
+func createRelayedTxEstimator(
+	innerTxType process.TransactionType,
+	processSCRHandler func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error),
+) *transactionCostEstimator {
+	tce, _ := NewTransactionCostEstimator(
+		&testscommon.TxTypeHandlerMock{
+			ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+				txWithRelayer, ok := tx.(*transaction.Transaction)
+				switch {
+				case ok && txWithRelayer.IsRelayedV3():
+					return process.RelayedTxV3, process.RelayedTxV3
+				case strings.HasPrefix(string(tx.GetData()), core.RelayedTransactionV2):
+					return process.RelayedTxV2, process.RelayedTxV2
+				case strings.HasPrefix(string(tx.GetData()), core.RelayedTransaction):
+					return process.RelayedTx, process.RelayedTx
+				default:
+					return innerTxType, innerTxType
+				}
+			},
+		},
+		&mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx process.TransactionWithFeeHandler) uint64 {
+				return 1000
+			},
+		},
+		&mock.TransactionSimulatorStub{
+			ProcessSCRCalled: processSCRHandler,
+		},
+		&testscommon.AccountsStub{},
+		createTwoShardsCoordinator(),
+		&mock.MarshalizerMock{},
+		nil,
+	)
+
+	return tce
+}
+
+func createSCRSimulationHandler(processedSCRs *[]*smartContractResult.SmartContractResult) func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+	return func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+		*processedSCRs = append(*processedSCRs, scr)
+
+		return &transaction.SimulationResults{
+			VMOutput: &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: 48000,
+			},
+		}, nil
+	}
+}
+
+func TestComputeTransactionGasLimit_RelayedTxV2ShouldEstimateTheUserTx(t *testing.T) {
+	t.Parallel()
+
+	processedSCRs := make([]*smartContractResult.SmartContractResult, 0)
+	tce := createRelayedTxEstimator(process.SCInvoking, createSCRSimulationHandler(&processedSCRs))
+
+	txData := []byte(core.RelayedTransactionV2 + "@" + hex.EncodeToString([]byte("scA0")) + "@00@" + hex.EncodeToString([]byte("doSomething")) + "@" + hex.EncodeToString([]byte("sig")))
+	tx := &transaction.Transaction{SndAddr: []byte("relayer0"), RcvAddr: []byte("user0"), GasLimit: 100000, Data: txData}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(52000), cost.GasUnits)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.Relayer)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.MoveBalance)
+	require.Equal(t, uint64(50000), cost.GasBreakdown.Processing)
+	require.Equal(t, uint64(48000), cost.GasBreakdown.Refund)
+
+	require.Equal(t, 1, len(processedSCRs))
+	require.Equal(t, []byte("scA0"), processedSCRs[0].RcvAddr)
+	require.Equal(t, []byte("user0"), processedSCRs[0].SndAddr)
+	require.Equal(t, []byte("relayer0"), processedSCRs[0].RelayerAddr)
+	require.Equal(t, []byte("doSomething"), processedSCRs[0].Data)
+	require.Equal(t, uint64(98000), processedSCRs[0].GasLimit)
+}
+
+func TestComputeTransactionGasLimit_RelayedTxV3ShouldEstimateTheUserTx(t *testing.T) {
+	t.Parallel()
+
+	processedSCRs := make([]*smartContractResult.SmartContractResult, 0)
+	tce := createRelayedTxEstimator(process.SCInvoking, createSCRSimulationHandler(&processedSCRs))
+
+	tx := &transaction.Transaction{
+		SndAddr:     []byte("user0"),
+		RcvAddr:     []byte("scA0"),
+		RelayerAddr: []byte("relayer0"),
+		GasLimit:    100000,
+		Value:       big.NewInt(0),
+		Data:        []byte("doSomething"),
+	}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(52000), cost.GasUnits)
+	require.Equal(t, uint64(1000), cost.GasBreakdown.Relayer)
+
+	require.Equal(t, 1, len(processedSCRs))
+	require.Equal(t, []byte("user0"), processedSCRs[0].SndAddr)
+	require.Equal(t, []byte("relayer0"), processedSCRs[0].RelayerAddr)
+	require.Equal(t, uint64(98000), processedSCRs[0].GasLimit)
+}
+
+func TestComputeTransactionGasLimit_RelayedTxWithMoveBalanceShouldNotSimulate(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxEstimator(process.MoveBalance, func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+		require.Fail(t, "should have not simulated the user transaction")
+		return nil, nil
+	})
+
+	userTx := &transaction.Transaction{SndAddr: []byte("user0"), RcvAddr: []byte("receiver0"), Value: big.NewInt(10)}
+	userTxBytes, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+	txData := []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxBytes))
+	tx := &transaction.Transaction{SndAddr: []byte("relayer0"), RcvAddr: []byte("user0"), GasLimit: 100000, Data: txData}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(2000), cost.GasUnits)
+	require.Equal(t, uint64(98000), cost.GasBreakdown.Refund)
+}
+
+func TestComputeTransactionGasLimit_RelayedTxWithUserTxReceiverInOtherShardShouldEstimateTheProcessing(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxEstimator(process.SCInvoking, func(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+		require.Fail(t, "should have not simulated the user transaction")
+		return nil, nil
+	})
+
+	tx := &transaction.Transaction{
+		SndAddr:     []byte("user0"),
+		RcvAddr:     []byte("scB1"),
+		RelayerAddr: []byte("relayer0"),
+		GasLimit:    100000,
+		Value:       big.NewInt(0),
+		Data:        []byte("doSomething"),
+	}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, "", cost.ReturnMessage)
+	require.Equal(t, uint64(100000), cost.GasUnits)
+	require.Equal(t, uint64(98000), cost.GasBreakdown.Processing)
+	require.True(t, cost.GasBreakdown.IsProcessingEstimated)
+}
+
+func TestComputeTransactionGasLimit_RelayedTxWithUserTxBeneficiaryMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxEstimator(process.MoveBalance, nil)
+
+	userTx := &transaction.Transaction{SndAddr: []byte("other0"), RcvAddr: []byte("receiver0"), Value: big.NewInt(10)}
+	userTxBytes, _ := (&mock.MarshalizerMock{}).Marshal(userTx)
+	txData := []byte(core.RelayedTransaction + "@" + hex.EncodeToString(userTxBytes))
+	tx := &transaction.Transaction{SndAddr: []byte("relayer0"), RcvAddr: []byte("user0"), GasLimit: 100000, Data: txData}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), cost.GasUnits)
+	require.Equal(t, process.ErrRelayedTxBeneficiaryDoesNotMatchReceiver.Error(), cost.ReturnMessage)
+}
+
+func TestComputeTransactionGasLimit_RelayedSCDeploymentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tce := createRelayedTxEstimator(process.SCDeployment, nil)
+
+	tx := &transaction.Transaction{
+		SndAddr:     []byte("user0"),
+		RcvAddr:     make([]byte, 32),
+		RelayerAddr: []byte("relayer0"),
+		GasLimit:    100000,
+		Value:       big.NewInt(0),
+		Data:        []byte("code"),
+	}
+	cost, err := tce.ComputeTransactionGasLimit(tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), cost.GasUnits)
+	require.Equal(t, process.ErrRelayedTxCostEstimationNotSupported.Error(), cost.ReturnMessage)
+	require.Nil(t, cost.GasBreakdown)
+}
+
 func TestExtractGasNeededFromMessage(t *testing.T) {
 	t.Parallel()
 
@@ -5,6 +5,9 @@ import "errors"
 // ErrNilTxSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTxSimulatorProcessor = errors.New("nil transaction simulator processor")
 
+// ErrNilSCRProcessor signals that a nil smart contract results processor has been provided
+var ErrNilSCRProcessor = errors.New("nil smart contract results processor")
+
 // ErrNilIntermediateProcessorContainer signals that intermediate processors container is nil
 var ErrNilIntermediateProcessorContainer = errors.New("intermediate processor container is nil")
 
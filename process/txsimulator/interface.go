@@ -1,6 +1,7 @@
 package txsimulator
 
 import (
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -10,3 +11,9 @@ type TransactionProcessor interface {
 	ProcessTransaction(transaction *transaction.Transaction) (vmcommon.ReturnCode, error)
 	IsInterfaceNil() bool
 }
+
+// SCRProcessor defines the operations needed do be done by a smart contract results processor
+type SCRProcessor interface {
+	ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error)
+	IsInterfaceNil() bool
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/receipt"
 	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
@@ -20,6 +21,7 @@ import (
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
 type ArgsTxSimulator struct {
 	TransactionProcessor      TransactionProcessor
+	SCRProcessor              SCRProcessor
 	IntermediateProcContainer process.IntermediateProcessorContainer
 	AddressPubKeyConverter    core.PubkeyConverter
 	ShardCoordinator          sharding.Coordinator
@@ -30,6 +32,7 @@ type ArgsTxSimulator struct {
 
 type transactionSimulator struct {
 	txProcessor            TransactionProcessor
+	scrProcessor           SCRProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
@@ -43,6 +46,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.TransactionProcessor) {
 		return nil, ErrNilTxSimulatorProcessor
 	}
+	if check.IfNil(args.SCRProcessor) {
+		return nil, ErrNilSCRProcessor
+	}
 	if check.IfNil(args.IntermediateProcContainer) {
 		return nil, ErrNilIntermediateProcessorContainer
 	}
@@ -64,6 +70,7 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
+		scrProcessor:           args.SCRProcessor,
 		intermProcContainer:    args.IntermediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
@@ -75,10 +82,33 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
 func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
+	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+
+	results := ts.createSimulationResults(retCode, err)
+	results.GasPayer = ts.addressPubKeyConverter.Encode(tx.SndAddr)
+	results.ValuePayer = ts.addressPubKeyConverter.Encode(tx.SndAddr)
+	if tx.IsRelayedV3() {
+		results.GasPayer = ts.addressPubKeyConverter.Encode(tx.RelayerAddr)
+	}
+
+	return ts.finishSimulation(tx, results)
+}
+
+// ProcessSCR will process the smart contract result in a special environment, where state-writing is not allowed.
+// It is used to follow the execution of a transaction in the shards its smart contract results are sent to
+func (ts *transactionSimulator) ProcessSCR(scr *smartContractResult.SmartContractResult) (*transaction.SimulationResults, error) {
+	retCode, err := ts.scrProcessor.ProcessSmartContractResult(scr)
+
+	results := ts.createSimulationResults(retCode, err)
+	results.GasPayer = ts.addressPubKeyConverter.Encode(scr.OriginalSender)
+	results.ValuePayer = ts.addressPubKeyConverter.Encode(scr.SndAddr)
+
+	return ts.finishSimulation(scr, results)
+}
+
+func (ts *transactionSimulator) createSimulationResults(retCode vmcommon.ReturnCode, err error) *transaction.SimulationResults {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
-
-	retCode, err := ts.txProcessor.ProcessTransaction(tx)
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
@@ -88,17 +118,22 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transac
 		}
 	}
 
-	results := &transaction.SimulationResults{
+	return &transaction.SimulationResults{
 		Status:     txStatus,
 		FailReason: failReason,
 	}
+}
 
-	err = ts.addIntermediateTxsToResult(results)
+func (ts *transactionSimulator) finishSimulation(
+	txHandler data.TransactionHandler,
+	results *transaction.SimulationResults,
+) (*transaction.SimulationResults, error) {
+	err := ts.addIntermediateTxsToResult(results)
 	if err != nil {
 		return nil, err
 	}
 
-	vmOutput, ok := ts.getVMOutputOfTx(tx)
+	vmOutput, ok := ts.getVMOutputOfTx(txHandler)
 	if ok {
 		results.VMOutput = vmOutput
 	}
@@ -106,8 +141,8 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*transac
 	return results, nil
 }
 
-func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
-	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+func (ts *transactionSimulator) getVMOutputOfTx(txHandler data.TransactionHandler) (*vmcommon.VMOutput, bool) {
+	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, txHandler)
 	if err != nil {
 		return nil, false
 	}
@@ -146,14 +181,17 @@ func (ts *transactionSimulator) addIntermediateTxsToResult(result *transaction.S
 	}
 
 	scResults := make(map[string]*transaction.ApiSmartContractResult)
+	scrsByHash := make(map[string]data.TransactionHandler)
 	for hash, value := range scrForwarder.GetAllCurrentFinishedTxs() {
 		scr, ok := value.(*smartContractResult.SmartContractResult)
 		if !ok {
 			continue
 		}
 		scResults[hex.EncodeToString([]byte(hash))] = ts.adaptSmartContractResult(scr)
+		scrsByHash[hex.EncodeToString([]byte(hash))] = scr
 	}
 	result.ScResults = scResults
+	result.ScrsByHash = scrsByHash
 
 	if ts.shardCoordinator.SelfId() == core.MetachainShardId {
 		return nil
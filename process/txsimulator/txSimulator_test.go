@@ -44,6 +44,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilTxSimulatorProcessor,
 		},
+		{
+			name: "NilSCRProcessor",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SCRProcessor = nil
+				return args
+			},
+			exError: ErrNilSCRProcessor,
+		},
 		{
 			name: "NilIntermProcessorContainer",
 			argsFunc: func() ArgsTxSimulator {
@@ -125,6 +134,40 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
 
+func TestTransactionSimulator_ProcessSCRShouldReturnVMOutput(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+		Type:     storageUnit.LRUCache,
+		Capacity: 100,
+	})
+	scr := &smartContractResult.SmartContractResult{Nonce: 37, GasLimit: 1000}
+	scrHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, scr)
+	expectedVMOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 100}
+
+	processCalled := false
+	args.SCRProcessor = &testscommon.SCProcessorMock{
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			processCalled = true
+			args.VMOutputCacher.Put(scrHash, expectedVMOutput, 0)
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessSCR(scr)
+	require.NoError(t, err)
+	require.True(t, processCalled)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, expectedVMOutput, results.VMOutput)
+}
+
 func TestTransactionSimulator_getVMOutputComputeHashFails(t *testing.T) {
 	t.Parallel()
 
@@ -219,6 +262,7 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 func getTxSimulatorArgs() ArgsTxSimulator {
 	return ArgsTxSimulator{
 		TransactionProcessor:      &testscommon.TxProcessorStub{},
+		SCRProcessor:              &testscommon.SCProcessorMock{},
 		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
 		AddressPubKeyConverter:    &mock.PubkeyConverterMock{},
 		ShardCoordinator:          mock.NewMultiShardsCoordinatorMock(2),
@@ -0,0 +1,241 @@
+package compression
+
+import (
+	"bytes"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Persister = (*compressedPersister)(nil)
+
+var log = logger.GetOrCreate("storage/compression")
+
+// formatMarkerKey is written in the databases that were empty when opened through a compressed persister. Only the
+// values of these databases are prefixed by the header
+var formatMarkerKey = []byte("compressedPersisterFormatMarker")
+
+// ArgsCompressedPersister holds the arguments needed to create a compressed persister
+type ArgsCompressedPersister struct {
+	Persister       storage.Persister
+	CompressionType Type
+	Statistics      *Statistics
+	Path            string
+}
+
+// compressedPersister is a persister decorator that compresses the values before writing them in the wrapped
+// persister. Every written value is prefixed by a 2 bytes header containing the algorithm used. A database already
+// holding values written without the compression keeps them, and the new ones, uncompressed: the values of a
+// database are either all prefixed by the header or none of them is, as recorded by the format marker
+type compressedPersister struct {
+	storage.Persister
+	compressor        compressor
+	statistics        *Statistics
+	path              string
+	isFormatted       atomic.Flag
+	checkFormatMarker bool
+}
+
+// NewCompressedPersister creates a new compressed persister
+func NewCompressedPersister(args ArgsCompressedPersister) (*compressedPersister, error) {
+	if check.IfNil(args.Persister) {
+		return nil, ErrNilPersister
+	}
+	if check.IfNil(args.Statistics) {
+		return nil, ErrNilStatistics
+	}
+
+	comp, err := newCompressor(args.CompressionType)
+	if err != nil {
+		return nil, err
+	}
+
+	cp := &compressedPersister{
+		Persister:  args.Persister,
+		compressor: comp,
+		statistics: args.Statistics,
+		path:       args.Path,
+	}
+	err = cp.initFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return cp, nil
+}
+
+func (cp *compressedPersister) initFormat() error {
+	if cp.Persister.Has(formatMarkerKey) == nil {
+		cp.isFormatted.Set()
+		return nil
+	}
+	if !cp.isEmpty() {
+		log.Debug("compressedPersister: the database holds uncompressed values, the compression is not used", "path", cp.path)
+		return nil
+	}
+
+	err := cp.Persister.Put(formatMarkerKey, []byte{headerMarker})
+	if err == storage.ErrReadOnlyPersister {
+		// the writer of the database will add the marker when it writes the first values
+		cp.checkFormatMarker = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cp.isFormatted.Set()
+
+	return nil
+}
+
+func (cp *compressedPersister) isEmpty() bool {
+	isEmpty := true
+	cp.Persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		isEmpty = false
+		return false
+	})
+
+	return isEmpty
+}
+
+// hasFormat returns true if the values of the database are prefixed by the header
+func (cp *compressedPersister) hasFormat() bool {
+	if cp.isFormatted.IsSet() {
+		return true
+	}
+	if cp.checkFormatMarker && cp.Persister.Has(formatMarkerKey) == nil {
+		cp.isFormatted.Set()
+		return true
+	}
+
+	return false
+}
+
+// Put compresses the value and writes it in the wrapped persister. The value is stored uncompressed, still with the
+// header, if the compression does not reduce its size
+func (cp *compressedPersister) Put(key, val []byte) error {
+	if !cp.hasFormat() {
+		cp.statistics.add(len(val), len(val), false)
+		nodeStatistics.add(len(val), len(val), false)
+
+		return cp.Persister.Put(key, val)
+	}
+
+	compressed, err := cp.compressor.compress(val)
+	if err != nil {
+		return err
+	}
+
+	algorithmID := cp.compressor.algorithmID()
+	payload := compressed
+	if len(compressed) >= len(val) {
+		algorithmID = noneAlgorithmID
+		payload = val
+	}
+
+	stored := make([]byte, 0, headerLength+len(payload))
+	stored = append(stored, headerMarker, algorithmID)
+	stored = append(stored, payload...)
+	isCompressed := algorithmID != noneAlgorithmID
+	cp.statistics.add(len(val), len(stored), isCompressed)
+	nodeStatistics.add(len(val), len(stored), isCompressed)
+
+	return cp.Persister.Put(key, stored)
+}
+
+// Get returns the decompressed value associated to the key
+func (cp *compressedPersister) Get(key []byte) ([]byte, error) {
+	stored, err := cp.Persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if !cp.hasFormat() {
+		return stored, nil
+	}
+
+	return decode(stored)
+}
+
+// RangeKeys will call the handler function for each (key, decompressed value) pair. Values that can not be
+// decompressed are skipped
+func (cp *compressedPersister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	hasFormat := cp.hasFormat()
+	cp.Persister.RangeKeys(func(key []byte, stored []byte) bool {
+		if !hasFormat {
+			return handler(key, stored)
+		}
+		if bytes.Equal(key, formatMarkerKey) {
+			return true
+		}
+
+		val, err := decode(stored)
+		if err != nil {
+			log.Debug("compressedPersister.RangeKeys: decode", "path", cp.path, "key", key, "error", err.Error())
+			return true
+		}
+
+		return handler(key, val)
+	})
+}
+
+// Compact will compact the wrapped persister, if possible
+func (cp *compressedPersister) Compact() error {
+	compactor, ok := cp.Persister.(storage.Compactor)
+	if !ok {
+		return nil
+	}
+
+	return compactor.Compact()
+}
+
+// RefreshView refreshes the view of the wrapped persister, if it reads a database written by another process
+func (cp *compressedPersister) RefreshView() {
+	refresher, ok := cp.Persister.(storage.ViewRefresher)
+	if ok {
+		refresher.RefreshView()
+	}
+}
+
+// Close logs the compression statistics of the storage unit and closes the wrapped persister
+func (cp *compressedPersister) Close() error {
+	log.Debug("compression statistics",
+		"path", cp.path,
+		"num values", cp.statistics.NumValues(),
+		"num compressed values", cp.statistics.NumCompressedValues(),
+		"uncompressed bytes", cp.statistics.NumUncompressedBytes(),
+		"stored bytes", cp.statistics.NumStoredBytes(),
+		"ratio", cp.statistics.Ratio(),
+	)
+
+	return cp.Persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *compressedPersister) IsInterfaceNil() bool {
+	return cp == nil
+}
+
+func decode(stored []byte) ([]byte, error) {
+	if len(stored) < headerLength || stored[0] != headerMarker {
+		return nil, ErrMissingCompressionHeader
+	}
+
+	algorithmID := stored[1]
+	if algorithmID == noneAlgorithmID {
+		return stored[headerLength:], nil
+	}
+
+	comp, err := compressorForAlgorithmID(algorithmID)
+	if err != nil {
+		return nil, err
+	}
+
+	return comp.decompress(stored[headerLength:])
+}
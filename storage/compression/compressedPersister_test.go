@@ -0,0 +1,215 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs() ArgsCompressedPersister {
+	return ArgsCompressedPersister{
+		Persister:       memorydb.New(),
+		CompressionType: Snappy,
+		Statistics:      NewStatistics(),
+		Path:            "path",
+	}
+}
+
+func TestNewCompressedPersister(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	args.Persister = nil
+	cp, err := NewCompressedPersister(args)
+	assert.Nil(t, cp)
+	assert.Equal(t, ErrNilPersister, err)
+
+	args = createMockArgs()
+	args.Statistics = nil
+	cp, err = NewCompressedPersister(args)
+	assert.Nil(t, cp)
+	assert.Equal(t, ErrNilStatistics, err)
+
+	args = createMockArgs()
+	args.CompressionType = "Zip"
+	cp, err = NewCompressedPersister(args)
+	assert.Nil(t, cp)
+	assert.True(t, errors.Is(err, ErrUnknownCompressionType))
+
+	cp, err = NewCompressedPersister(createMockArgs())
+	assert.Nil(t, err)
+	assert.False(t, cp.IsInterfaceNil())
+}
+
+func TestCompressedPersister_PutGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, compressionType := range []Type{Snappy, Gzip} {
+		args := createMockArgs()
+		args.CompressionType = compressionType
+		cp, _ := NewCompressedPersister(args)
+
+		key := []byte("key")
+		val := bytes.Repeat([]byte("repetitive data field"), 100)
+		err := cp.Put(key, val)
+		require.Nil(t, err)
+
+		stored, _ := args.Persister.Get(key)
+		assert.True(t, len(stored) < len(val))
+		assert.Equal(t, headerMarker, stored[0])
+
+		recovered, err := cp.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, val, recovered)
+
+		assert.Equal(t, uint64(1), args.Statistics.NumCompressedValues())
+		assert.True(t, args.Statistics.Ratio() > 1)
+	}
+	// the other tests write in parallel through their own persisters
+	assert.True(t, NodeStatistics().NumCompressedValues() >= 2)
+}
+
+func TestCompressedPersister_PutIncompressibleValueShouldStoreItUncompressed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	cp, _ := NewCompressedPersister(args)
+
+	key := []byte("key")
+	val := []byte{0, 1}
+	_ = cp.Put(key, val)
+
+	stored, _ := args.Persister.Get(key)
+	assert.Equal(t, []byte{headerMarker, noneAlgorithmID, 0, 1}, stored)
+
+	recovered, err := cp.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.Equal(t, uint64(1), args.Statistics.NumValues())
+	assert.Equal(t, uint64(0), args.Statistics.NumCompressedValues())
+}
+
+func TestCompressedPersister_DatabaseWithLegacyValuesShouldNotBeCompressed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	legacyKey := []byte("legacy key")
+	// a legacy value which looks like a snappy compressed one
+	legacyVal := []byte{headerMarker, snappyAlgorithmID, 'a', 'b', 'c'}
+	_ = args.Persister.Put(legacyKey, legacyVal)
+
+	cp, err := NewCompressedPersister(args)
+	require.Nil(t, err)
+
+	recovered, err := cp.Get(legacyKey)
+	assert.Nil(t, err)
+	assert.Equal(t, legacyVal, recovered)
+
+	key := []byte("key")
+	val := bytes.Repeat([]byte("repetitive data field"), 100)
+	err = cp.Put(key, val)
+	require.Nil(t, err)
+
+	stored, _ := args.Persister.Get(key)
+	assert.Equal(t, val, stored)
+	recovered, err = cp.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+	assert.NotNil(t, args.Persister.Has(formatMarkerKey))
+
+	recoveredValues := make(map[string][]byte)
+	cp.RangeKeys(func(key []byte, val []byte) bool {
+		recoveredValues[string(key)] = val
+		return true
+	})
+	assert.Equal(t, map[string][]byte{string(legacyKey): legacyVal, string(key): val}, recoveredValues)
+
+	// reopening the database keeps the legacy format
+	cp, _ = NewCompressedPersister(args)
+	recovered, err = cp.Get(legacyKey)
+	assert.Nil(t, err)
+	assert.Equal(t, legacyVal, recovered)
+}
+
+func TestCompressedPersister_EmptyDatabaseShouldBeCompressedAfterReopening(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	cp, err := NewCompressedPersister(args)
+	require.Nil(t, err)
+	assert.Nil(t, args.Persister.Has(formatMarkerKey))
+
+	key := []byte("key")
+	val := []byte{headerMarker, snappyAlgorithmID, 'a', 'b', 'c'}
+	_ = cp.Put(key, val)
+
+	cp, err = NewCompressedPersister(args)
+	require.Nil(t, err)
+
+	recovered, err := cp.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+
+	_ = args.Persister.Put(key, []byte("not written by the compressed persister"))
+	recovered, err = cp.Get(key)
+	assert.Nil(t, recovered)
+	assert.Equal(t, ErrMissingCompressionHeader, err)
+}
+
+func TestCompressedPersister_ReadOnlyDatabaseShouldUseTheFormatOfTheWriter(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	args := createMockArgs()
+	args.Persister = &readOnlyPersisterStub{Persister: db}
+	readOnlyPersister, err := NewCompressedPersister(args)
+	require.Nil(t, err)
+
+	writerArgs := createMockArgs()
+	writerArgs.Persister = db
+	writer, err := NewCompressedPersister(writerArgs)
+	require.Nil(t, err)
+
+	key := []byte("key")
+	val := bytes.Repeat([]byte("repetitive data field"), 100)
+	_ = writer.Put(key, val)
+
+	recovered, err := readOnlyPersister.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+type readOnlyPersisterStub struct {
+	storage.Persister
+}
+
+func (rops *readOnlyPersisterStub) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+func TestCompressedPersister_RangeKeysShouldReturnDecompressedValues(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	cp, _ := NewCompressedPersister(args)
+
+	values := map[string][]byte{
+		"key1": bytes.Repeat([]byte("a"), 100),
+		"key2": []byte("b"),
+	}
+	for key, val := range values {
+		_ = cp.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	cp.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+	assert.Equal(t, values, recovered)
+}
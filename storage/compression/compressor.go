@@ -0,0 +1,118 @@
+package compression
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/golang/snappy"
+)
+
+// Type defines the compression algorithm used by a storage unit
+type Type string
+
+const (
+	// None means that the values are stored as they are
+	None Type = "None"
+	// Snappy will compress the values using the snappy algorithm
+	Snappy Type = "Snappy"
+	// Gzip will compress the values using the gzip algorithm
+	Gzip Type = "Gzip"
+)
+
+// headerMarker prefixes every value written through a compressed persister in a database holding the format marker
+const headerMarker = byte(0)
+
+const headerLength = 2
+
+const (
+	noneAlgorithmID   = byte(0)
+	snappyAlgorithmID = byte(1)
+	gzipAlgorithmID   = byte(2)
+)
+
+type compressor interface {
+	compress(data []byte) ([]byte, error)
+	decompress(data []byte) ([]byte, error)
+	algorithmID() byte
+}
+
+// IsCompressionEnabled returns true if the provided type requires the values to be compressed
+func IsCompressionEnabled(compressionType Type) bool {
+	return len(compressionType) > 0 && compressionType != None
+}
+
+func newCompressor(compressionType Type) (compressor, error) {
+	switch compressionType {
+	case Snappy:
+		return &snappyCompressor{}, nil
+	case Gzip:
+		return &gzipCompressor{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownCompressionType, compressionType)
+	}
+}
+
+func compressorForAlgorithmID(id byte) (compressor, error) {
+	switch id {
+	case snappyAlgorithmID:
+		return &snappyCompressor{}, nil
+	case gzipAlgorithmID:
+		return &gzipCompressor{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownAlgorithmID, id)
+	}
+}
+
+type snappyCompressor struct {
+}
+
+func (sc *snappyCompressor) compress(data []byte) ([]byte, error) {
+	return snappy.Encode(nil, data), nil
+}
+
+func (sc *snappyCompressor) decompress(data []byte) ([]byte, error) {
+	return snappy.Decode(nil, data)
+}
+
+func (sc *snappyCompressor) algorithmID() byte {
+	return snappyAlgorithmID
+}
+
+type gzipCompressor struct {
+}
+
+func (gc *gzipCompressor) compress(data []byte) ([]byte, error) {
+	buff := bytes.NewBuffer(make([]byte, 0, len(data)))
+	writer := gzip.NewWriter(buff)
+	_, err := writer.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+func (gc *gzipCompressor) decompress(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	decompressed, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return decompressed, reader.Close()
+}
+
+func (gc *gzipCompressor) algorithmID() byte {
+	return gzipAlgorithmID
+}
@@ -0,0 +1,18 @@
+package compression
+
+import "errors"
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilStatistics signals that a nil statistics instance has been provided
+var ErrNilStatistics = errors.New("nil compression statistics")
+
+// ErrUnknownCompressionType signals that an unknown compression type has been provided
+var ErrUnknownCompressionType = errors.New("unknown compression type")
+
+// ErrUnknownAlgorithmID signals that a stored value was compressed with an unknown algorithm
+var ErrUnknownAlgorithmID = errors.New("unknown compression algorithm identifier")
+
+// ErrMissingCompressionHeader signals that a stored value does not start with the compression header
+var ErrMissingCompressionHeader = errors.New("missing compression header")
@@ -0,0 +1,69 @@
+package compression
+
+import (
+	"sync/atomic"
+)
+
+var nodeStatistics = NewStatistics()
+
+// Statistics holds the counters of the values written through the compressed persisters of a storage unit
+type Statistics struct {
+	numValues            uint64
+	numCompressedValues  uint64
+	numUncompressedBytes uint64
+	numStoredBytes       uint64
+}
+
+// NewStatistics creates a new statistics instance
+func NewStatistics() *Statistics {
+	return &Statistics{}
+}
+
+func (s *Statistics) add(uncompressedSize int, storedSize int, isCompressed bool) {
+	atomic.AddUint64(&s.numValues, 1)
+	if isCompressed {
+		atomic.AddUint64(&s.numCompressedValues, 1)
+	}
+	atomic.AddUint64(&s.numUncompressedBytes, uint64(uncompressedSize))
+	atomic.AddUint64(&s.numStoredBytes, uint64(storedSize))
+}
+
+// NodeStatistics returns the statistics aggregated over all the compressed persisters of the node
+func NodeStatistics() *Statistics {
+	return nodeStatistics
+}
+
+// NumValues returns the number of values written
+func (s *Statistics) NumValues() uint64 {
+	return atomic.LoadUint64(&s.numValues)
+}
+
+// NumCompressedValues returns the number of values that were stored compressed
+func (s *Statistics) NumCompressedValues() uint64 {
+	return atomic.LoadUint64(&s.numCompressedValues)
+}
+
+// NumUncompressedBytes returns the total size of the written values, before compression
+func (s *Statistics) NumUncompressedBytes() uint64 {
+	return atomic.LoadUint64(&s.numUncompressedBytes)
+}
+
+// NumStoredBytes returns the total size of the values, as stored in the persister
+func (s *Statistics) NumStoredBytes() uint64 {
+	return atomic.LoadUint64(&s.numStoredBytes)
+}
+
+// Ratio returns the achieved compression ratio as uncompressed size / stored size. Returns 1 if nothing was written
+func (s *Statistics) Ratio() float64 {
+	stored := s.NumStoredBytes()
+	if stored == 0 {
+		return 1
+	}
+
+	return float64(s.NumUncompressedBytes()) / float64(stored)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *Statistics) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -94,6 +94,9 @@ var ErrInvalidNumberOfActivePersisters = errors.New("invalid number of active pe
 // ErrClosingPersisters signals that not all persisters were closed
 var ErrClosingPersisters = errors.New("cannot close all the persisters")
 
+// ErrPersisterIsClosed signals that the persister was closed before the operation could be done
+var ErrPersisterIsClosed = errors.New("persister is closed")
+
 // ErrCacheSizeIsLowerThanBatchSize signals that size of cache is lower than size of batch
 var ErrCacheSizeIsLowerThanBatchSize = errors.New("cache size is lower than batch size")
 
@@ -147,3 +150,18 @@ var ErrNilOldDataCleanerProvider = errors.New("nil old data cleaner provider")
 
 // ErrNilStoredDataFactory signals that a nil stored data factory has been provided
 var ErrNilStoredDataFactory = errors.New("nil stored data factory")
+
+// ErrEmptyColdStoragePath signals that an empty cold storage path has been provided
+var ErrEmptyColdStoragePath = errors.New("empty cold storage path")
+
+// ErrInvalidColdStorageNumEpochs signals that the number of epochs after which the data is moved to the cold storage is invalid
+var ErrInvalidColdStorageNumEpochs = errors.New("invalid number of epochs after which the data is moved to the cold storage")
+
+// ErrInvalidNumberOfColdPersisters signals that an invalid number of open cold persisters has been provided
+var ErrInvalidNumberOfColdPersisters = errors.New("invalid number of open cold persisters")
+
+// ErrCompactionNotSupported signals that the underlying storage does not support compaction
+var ErrCompactionNotSupported = errors.New("compaction not supported")
+
+// ErrReadOnlyPersister signals that a write operation was attempted on a read-only persister
+var ErrReadOnlyPersister = errors.New("read-only persister")
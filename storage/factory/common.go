@@ -2,6 +2,7 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
@@ -25,6 +26,7 @@ func GetDBFromConfig(cfg config.DBConfig) storageUnit.DBConfig {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       compression.Type(cfg.Compression),
 	}
 }
 
@@ -43,3 +45,9 @@ func GetBloomFromConfig(cfg config.BloomFilterConfig) storageUnit.BloomConfig {
 		HashFunc: hashFuncs,
 	}
 }
+
+// KeepsAllEpochs returns true if the node keeps the blocks data of all epochs. This is the case for both the full
+// archive and the light history nodes, the latter pruning the state tries as a regular observer
+func KeepsAllEpochs(prefs config.PreferencesConfig) bool {
+	return prefs.FullArchive || prefs.LightHistory
+}
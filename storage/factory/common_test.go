@@ -60,3 +60,11 @@ func TestGetBloomFromConfig(t *testing.T) {
 		Size:     cfg.Size,
 	}, storageBloomConfig)
 }
+
+func TestKeepsAllEpochs(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, KeepsAllEpochs(config.PreferencesConfig{}))
+	assert.True(t, KeepsAllEpochs(config.PreferencesConfig{FullArchive: true}))
+	assert.True(t, KeepsAllEpochs(config.PreferencesConfig{LightHistory: true}))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -16,6 +17,8 @@ type PersisterFactory struct {
 	batchDelaySeconds int
 	maxBatchSize      int
 	maxOpenFiles      int
+	compressionType   compression.Type
+	statistics        *compression.Statistics
 }
 
 // NewPersisterFactory will return a new instance of a PersisterFactory
@@ -25,6 +28,8 @@ func NewPersisterFactory(config config.DBConfig) *PersisterFactory {
 		batchDelaySeconds: config.BatchDelaySeconds,
 		maxBatchSize:      config.MaxBatchSize,
 		maxOpenFiles:      config.MaxOpenFiles,
+		compressionType:   compression.Type(config.Compression),
+		statistics:        compression.NewStatistics(),
 	}
 }
 
@@ -34,11 +39,30 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return nil, errors.New("invalid file path")
 	}
 
+	persister, err := pf.createPersister(path)
+	if err != nil {
+		return nil, err
+	}
+	if !compression.IsCompressionEnabled(pf.compressionType) {
+		return persister, nil
+	}
+
+	return compression.NewCompressedPersister(compression.ArgsCompressedPersister{
+		Persister:       persister,
+		CompressionType: pf.compressionType,
+		Statistics:      pf.statistics,
+		Path:            path,
+	})
+}
+
+func (pf *PersisterFactory) createPersister(path string) (storage.Persister, error) {
 	switch storageUnit.DBType(pf.dbType) {
 	case storageUnit.LvlDB:
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	case storageUnit.LvlDBSerial:
 		return leveldb.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+	case storageUnit.LvlDBReadOnly:
+		return leveldb.NewReadOnlyDB(path, pf.maxOpenFiles)
 	case storageUnit.MemoryDB:
 		return memorydb.New(), nil
 	default:
@@ -241,6 +241,13 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	}
 	successfullyCreatedStorers = append(successfullyCreatedStorers, receiptsUnit)
 
+	scheduledSCRsUnitArgs := psf.createPruningStorerArgs(psf.generalConfig.ScheduledSCRsStorage)
+	scheduledSCRsUnit, err := psf.createPruningPersister(scheduledSCRsUnitArgs)
+	if err != nil {
+		return nil, err
+	}
+	successfullyCreatedStorers = append(successfullyCreatedStorers, scheduledSCRsUnit)
+
 	store := dataRetriever.NewChainStorer()
 	store.AddStorer(dataRetriever.TransactionUnit, txUnit)
 	store.AddStorer(dataRetriever.MiniBlockUnit, miniBlockUnit)
@@ -258,6 +265,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	store.AddStorer(dataRetriever.TxLogsUnit, txLogsUnit)
 	store.AddStorer(dataRetriever.ReceiptsUnit, receiptsUnit)
 	store.AddStorer(dataRetriever.TrieEpochRootHashUnit, trieEpochRootHashStorageUnit)
+	store.AddStorer(dataRetriever.ScheduledSCRsUnit, scheduledSCRsUnit)
 
 	err = psf.setupDbLookupExtensions(store, &successfullyCreatedStorers)
 	if err != nil {
@@ -512,6 +520,38 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		// Create the eventsIndex (STATIC) storer
+		eventsIndexConfig := psf.generalConfig.DbLookupExtensions.EventsIndexStorageConfig
+		eventsIndexDbConfig := GetDBFromConfig(eventsIndexConfig.DB)
+		eventsIndexDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, eventsIndexConfig.DB.FilePath)
+		eventsIndexCacherConfig := GetCacherFromConfig(eventsIndexConfig.Cache)
+		eventsIndexBloomFilter := GetBloomFromConfig(eventsIndexConfig.Bloom)
+		eventsIndexUnit, errCreate := storageUnit.NewStorageUnitFromConf(eventsIndexCacherConfig, eventsIndexDbConfig, eventsIndexBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, eventsIndexUnit)
+		chainStorer.AddStorer(dataRetriever.EventsIndexUnit, eventsIndexUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.ESDTSuppliesEnabled {
+		// Create the esdtSupplies (STATIC) storer
+		esdtSuppliesConfig := psf.generalConfig.DbLookupExtensions.ESDTSuppliesStorageConfig
+		esdtSuppliesDbConfig := GetDBFromConfig(esdtSuppliesConfig.DB)
+		esdtSuppliesDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, esdtSuppliesConfig.DB.FilePath)
+		esdtSuppliesCacherConfig := GetCacherFromConfig(esdtSuppliesConfig.Cache)
+		esdtSuppliesBloomFilter := GetBloomFromConfig(esdtSuppliesConfig.Bloom)
+		esdtSuppliesUnit, errCreate := storageUnit.NewStorageUnitFromConf(esdtSuppliesCacherConfig, esdtSuppliesDbConfig, esdtSuppliesBloomFilter)
+		if errCreate != nil {
+			return errCreate
+		}
+
+		*createdStorers = append(*createdStorers, esdtSuppliesUnit)
+		chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
+	}
+
 	return nil
 }
 
@@ -563,7 +603,7 @@ func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (stora
 }
 
 func (psf *StorageServiceFactory) createPruningPersister(arg *pruning.StorerArgs) (storage.Storer, error) {
-	if !psf.prefsConfig.FullArchive {
+	if !KeepsAllEpochs(*psf.prefsConfig) {
 		return pruning.NewPruningStorer(arg)
 	}
 
@@ -571,13 +611,20 @@ func (psf *StorageServiceFactory) createPruningPersister(arg *pruning.StorerArgs
 		StorerArgs:               arg,
 		NumOfOldActivePersisters: psf.generalConfig.StoragePruning.FullArchiveNumActivePersisters,
 	}
+	pruningConfig := psf.generalConfig.StoragePruning
+	if pruningConfig.ColdStorageEnabled {
+		historyArgs.ColdStorage = &pruning.ColdStorageArgs{
+			Path:              pruningConfig.ColdStoragePath,
+			AfterNumEpochs:    pruningConfig.ColdStorageAfterNumEpochs,
+			NumOpenPersisters: pruningConfig.ColdStorageNumOpenPersisters,
+		}
+	}
 
 	return pruning.NewFullHistoryPruningStorer(historyArgs)
 }
 
 func (psf *StorageServiceFactory) initOldDatabasesCleaningIfNeeded(store dataRetriever.StorageService) error {
-	isFullArchive := psf.prefsConfig.FullArchive
-	if isFullArchive {
+	if KeepsAllEpochs(*psf.prefsConfig) {
 		return nil
 	}
 	_, err := clean.NewOldDatabaseCleaner(clean.ArgsOldDatabaseCleaner{
@@ -29,6 +29,17 @@ type Persister interface {
 	IsInterfaceNil() bool
 }
 
+// Compactor defines a persister or a storer that is able to compact its underlying storage
+type Compactor interface {
+	Compact() error
+}
+
+// ViewRefresher defines a persister or a storer that reads a database written by another process and is able to
+// refresh its view over it
+type ViewRefresher interface {
+	RefreshView()
+}
+
 // Batcher allows to batch the data first then write the batch to the persister in one go
 type Batcher interface {
 	// Put inserts one entry - key, value pair - into the batch
@@ -0,0 +1,207 @@
+package leveldb
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+var _ storage.Persister = (*ReadOnlyDB)(nil)
+
+// ReadOnlyDB is a persister able to read a leveldb database that is opened and written by another process, without
+// taking its lock. The view over the database is refreshed, by reopening it, when RefreshView is called or when a
+// read fails, e.g. because the owner compacted the files of the view
+type ReadOnlyDB struct {
+	path         string
+	maxOpenFiles int
+	mutRefresh   sync.Mutex
+	closed       bool
+	mutDB        sync.RWMutex
+	db           *leveldb.DB
+}
+
+// NewReadOnlyDB is a constructor for the read-only leveldb persister
+// It does not create the database if it is missing from the given location
+func NewReadOnlyDB(path string, maxOpenFiles int) (*ReadOnlyDB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	db, err := openReadOnlyLevelDB(path, maxOpenFiles)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	return &ReadOnlyDB{
+		path:         path,
+		maxOpenFiles: maxOpenFiles,
+		db:           db,
+	}, nil
+}
+
+func openReadOnlyLevelDB(path string, maxOpenFiles int) (*leveldb.DB, error) {
+	options := &opt.Options{
+		// disable internal cache
+		BlockCacheCapacity:     -1,
+		OpenFilesCacheCapacity: maxOpenFiles,
+		ReadOnly:               true,
+		ErrorIfMissing:         true,
+	}
+
+	return leveldb.Open(newReadOnlyStorage(path), options)
+}
+
+// RefreshView reopens the database, so the data written by its owner becomes visible
+func (rdb *ReadOnlyDB) RefreshView() {
+	rdb.mutDB.RLock()
+	currentDB := rdb.db
+	rdb.mutDB.RUnlock()
+
+	rdb.refresh(currentDB)
+}
+
+// refresh reopens the database if the provided view is still the current one, so concurrent readers failing on the
+// same view reopen the database only once
+func (rdb *ReadOnlyDB) refresh(view *leveldb.DB) {
+	rdb.mutRefresh.Lock()
+	defer rdb.mutRefresh.Unlock()
+
+	if rdb.closed {
+		return
+	}
+
+	rdb.mutDB.RLock()
+	isCurrentView := rdb.db == view
+	rdb.mutDB.RUnlock()
+	if !isCurrentView {
+		return
+	}
+
+	db, err := openReadOnlyLevelDB(rdb.path, rdb.maxOpenFiles)
+	if err != nil {
+		log.Debug("error refreshing read-only DB", "path", rdb.path, "error", err)
+		return
+	}
+
+	rdb.mutDB.Lock()
+	oldDB := rdb.db
+	rdb.db = db
+	rdb.mutDB.Unlock()
+
+	err = oldDB.Close()
+	if err != nil {
+		log.Debug("error closing the previous view of read-only DB", "path", rdb.path, "error", err)
+	}
+}
+
+// Put returns error as the database is written only by its owner
+func (rdb *ReadOnlyDB) Put(_, _ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Get returns the value associated to the key. A failed read, other than a missing key, is retried once on a
+// refreshed view
+func (rdb *ReadOnlyDB) Get(key []byte) ([]byte, error) {
+	data, view, err := rdb.get(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		rdb.refresh(view)
+		data, _, err = rdb.get(key)
+	}
+
+	return data, err
+}
+
+func (rdb *ReadOnlyDB) get(key []byte) ([]byte, *leveldb.DB, error) {
+	rdb.mutDB.RLock()
+	defer rdb.mutDB.RUnlock()
+
+	data, err := rdb.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, rdb.db, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, rdb.db, err
+	}
+
+	return data, rdb.db, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium. A failed read, other than a missing key,
+// is retried once on a refreshed view
+func (rdb *ReadOnlyDB) Has(key []byte) error {
+	view, err := rdb.has(key)
+	if err != nil && err != storage.ErrKeyNotFound {
+		rdb.refresh(view)
+		_, err = rdb.has(key)
+	}
+
+	return err
+}
+
+func (rdb *ReadOnlyDB) has(key []byte) (*leveldb.DB, error) {
+	rdb.mutDB.RLock()
+	defer rdb.mutDB.RUnlock()
+
+	has, err := rdb.db.Has(key, nil)
+	if err != nil {
+		return rdb.db, err
+	}
+	if !has {
+		return rdb.db, storage.ErrKeyNotFound
+	}
+
+	return rdb.db, nil
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (rdb *ReadOnlyDB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// Close closes the current view of the database. No refresh is done afterwards
+func (rdb *ReadOnlyDB) Close() error {
+	rdb.mutRefresh.Lock()
+	rdb.closed = true
+	rdb.mutRefresh.Unlock()
+
+	rdb.mutDB.Lock()
+	defer rdb.mutDB.Unlock()
+
+	return rdb.db.Close()
+}
+
+// Remove returns error as the database is written only by its owner
+func (rdb *ReadOnlyDB) Remove(_ []byte) error {
+	return storage.ErrReadOnlyPersister
+}
+
+// Destroy only closes the database as its files are owned by another process
+func (rdb *ReadOnlyDB) Destroy() error {
+	return rdb.Close()
+}
+
+// DestroyClosed does nothing as the files of the database are owned by another process
+func (rdb *ReadOnlyDB) DestroyClosed() error {
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (rdb *ReadOnlyDB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	rdb.mutDB.RLock()
+	defer rdb.mutDB.RUnlock()
+
+	bldb := &baseLevelDb{
+		db: rdb.db,
+	}
+	bldb.RangeKeys(handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rdb *ReadOnlyDB) IsInterfaceNil() bool {
+	return rdb == nil
+}
@@ -0,0 +1,155 @@
+package leveldb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadOnlyDB_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rdb, err := leveldb.NewReadOnlyDB("path", 0)
+	assert.Nil(t, rdb)
+	assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+}
+
+func TestNewReadOnlyDB_MissingDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_read_only")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	rdb, err := leveldb.NewReadOnlyDB(filepath.Join(dir, "missing"), 10)
+	assert.Nil(t, rdb)
+	assert.NotNil(t, err)
+
+	_, err = os.Stat(filepath.Join(dir, "missing"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestReadOnlyDB_ShouldReadTheDatabaseOfAnotherOwner(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_read_only")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	ownerDB, err := leveldb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = ownerDB.Close()
+	}()
+	err = ownerDB.Put([]byte("key1"), []byte("value1"))
+	require.Nil(t, err)
+
+	rdb, err := leveldb.NewReadOnlyDB(dir, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = rdb.Close()
+	}()
+
+	value, err := rdb.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, rdb.Has([]byte("key1")))
+
+	err = rdb.Put([]byte("key2"), []byte("value2"))
+	assert.Equal(t, storage.ErrReadOnlyPersister, err)
+	err = rdb.Remove([]byte("key1"))
+	assert.Equal(t, storage.ErrReadOnlyPersister, err)
+
+	// the data written by the owner is visible only after the view is refreshed
+	err = ownerDB.Put([]byte("key2"), []byte("value2"))
+	require.Nil(t, err)
+	_, err = rdb.Get([]byte("key2"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	rdb.RefreshView()
+	value, err = rdb.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	value, err = rdb.Get([]byte("key3"))
+	assert.Nil(t, value)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, rdb.Has([]byte("key3")))
+
+	numKeys := 0
+	rdb.RangeKeys(func(key []byte, value []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 2, numKeys)
+}
+
+func TestReadOnlyDB_ReadErrorShouldRetryOnARefreshedView(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_read_only")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	ownerDB, err := leveldb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = ownerDB.Close()
+	}()
+	err = ownerDB.Put([]byte("key"), []byte("old value"))
+	require.Nil(t, err)
+	err = ownerDB.Compact()
+	require.Nil(t, err)
+
+	rdb, err := leveldb.NewReadOnlyDB(dir, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = rdb.Close()
+	}()
+
+	// the compactions remove the files of the current view
+	for i := 0; i < 2; i++ {
+		err = ownerDB.Put([]byte("key"), []byte("new value"))
+		require.Nil(t, err)
+		err = ownerDB.Compact()
+		require.Nil(t, err)
+	}
+
+	value, err := rdb.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), value)
+}
+
+func TestReadOnlyDB_DestroyShouldNotRemoveTheFiles(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "leveldb_read_only")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	ownerDB, err := leveldb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = ownerDB.Close()
+	}()
+	_ = ownerDB.Put([]byte("key"), []byte("value"))
+
+	rdb, _ := leveldb.NewReadOnlyDB(dir, 10)
+	err = rdb.Destroy()
+	assert.Nil(t, err)
+	err = rdb.DestroyClosed()
+	assert.Nil(t, err)
+
+	value, err := ownerDB.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	_, err = os.Stat(dir)
+	assert.Nil(t, err)
+}
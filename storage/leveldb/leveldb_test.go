@@ -296,6 +296,26 @@ func TestDB_RangeKeys(t *testing.T) {
 	assert.Equal(t, keysVals, recovered)
 }
 
+func TestDB_CompactShouldKeepTheData(t *testing.T) {
+	ldb := createLevelDb(t, 10, 1, 10)
+	defer func() {
+		_ = ldb.Close()
+	}()
+
+	key := []byte("key")
+	val := []byte("val")
+	_ = ldb.Put(key, val)
+	_ = ldb.Put([]byte("removed"), val)
+	_ = ldb.Remove([]byte("removed"))
+
+	err := ldb.Compact()
+	assert.Nil(t, err)
+
+	recovered, err := ldb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
 func TestDB_PutGetLargeValue(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,147 @@
+package leveldb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+const currentFileName = "CURRENT"
+
+var _ storage.Storage = (*readOnlyStorage)(nil)
+
+type noLock struct{}
+
+// Unlock does nothing
+func (nl *noLock) Unlock() {}
+
+// readOnlyStorage is a leveldb file storage that does not take the file lock of the database directory, so it can
+// read a database that is opened and written by another process. The view it gives is the one existing at the time
+// the database was opened: it is the caller's responsibility to reopen the database in order to see newer data
+type readOnlyStorage struct {
+	path string
+}
+
+func newReadOnlyStorage(path string) *readOnlyStorage {
+	return &readOnlyStorage{
+		path: path,
+	}
+}
+
+// Lock returns a lock that does nothing as the database directory is owned by another process
+func (ros *readOnlyStorage) Lock() (storage.Locker, error) {
+	return &noLock{}, nil
+}
+
+// Log does nothing
+func (ros *readOnlyStorage) Log(_ string) {
+}
+
+// SetMeta returns error as the storage is read-only
+func (ros *readOnlyStorage) SetMeta(_ storage.FileDesc) error {
+	return storage.ErrInvalidFile
+}
+
+// GetMeta returns the manifest file pointed by the CURRENT file
+func (ros *readOnlyStorage) GetMeta() (storage.FileDesc, error) {
+	content, err := ioutil.ReadFile(filepath.Join(ros.path, currentFileName))
+	if err != nil {
+		return storage.FileDesc{}, err
+	}
+
+	fd := storage.FileDesc{Type: storage.TypeManifest}
+	_, err = fmt.Sscanf(strings.TrimSpace(string(content)), "MANIFEST-%d", &fd.Num)
+	if err != nil {
+		return storage.FileDesc{}, &storage.ErrCorrupted{Err: fmt.Errorf("%w while parsing the CURRENT file", err)}
+	}
+
+	return fd, nil
+}
+
+// List returns the files of the provided types existing in the database directory
+func (ros *readOnlyStorage) List(ft storage.FileType) ([]storage.FileDesc, error) {
+	entries, err := ioutil.ReadDir(ros.path)
+	if err != nil {
+		return nil, err
+	}
+
+	fds := make([]storage.FileDesc, 0, len(entries))
+	for _, entry := range entries {
+		fd, ok := parseFileName(entry.Name())
+		if ok && fd.Type&ft != 0 {
+			fds = append(fds, fd)
+		}
+	}
+
+	return fds, nil
+}
+
+// Open opens the file for reading
+func (ros *readOnlyStorage) Open(fd storage.FileDesc) (storage.Reader, error) {
+	if !storage.FileDescOk(fd) {
+		return nil, storage.ErrInvalidFile
+	}
+
+	file, err := os.Open(filepath.Join(ros.path, fd.String()))
+	if err != nil && os.IsNotExist(err) && fd.Type == storage.TypeTable {
+		// tables written by older leveldb versions have the .sst extension
+		file, err = os.Open(filepath.Join(ros.path, fmt.Sprintf("%06d.sst", fd.Num)))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Create returns error as the storage is read-only
+func (ros *readOnlyStorage) Create(_ storage.FileDesc) (storage.Writer, error) {
+	return nil, storage.ErrInvalidFile
+}
+
+// Remove returns error as the storage is read-only
+func (ros *readOnlyStorage) Remove(_ storage.FileDesc) error {
+	return storage.ErrInvalidFile
+}
+
+// Rename returns error as the storage is read-only
+func (ros *readOnlyStorage) Rename(_, _ storage.FileDesc) error {
+	return storage.ErrInvalidFile
+}
+
+// Close does nothing as the opened files are closed by their readers
+func (ros *readOnlyStorage) Close() error {
+	return nil
+}
+
+func parseFileName(name string) (storage.FileDesc, bool) {
+	fd := storage.FileDesc{}
+	var tail string
+	_, err := fmt.Sscanf(name, "%d.%s", &fd.Num, &tail)
+	if err == nil {
+		switch tail {
+		case "log":
+			fd.Type = storage.TypeJournal
+		case "ldb", "sst":
+			fd.Type = storage.TypeTable
+		case "tmp":
+			fd.Type = storage.TypeTemp
+		default:
+			return fd, false
+		}
+
+		return fd, true
+	}
+
+	n, _ := fmt.Sscanf(name, "MANIFEST-%d%s", &fd.Num, &tail)
+	if n == 1 {
+		fd.Type = storage.TypeManifest
+		return fd, true
+	}
+
+	return fd, false
+}
@@ -0,0 +1,180 @@
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// computeDiskUsage walks the databases directory, which has the layout defined by the path manager:
+// <database path>/Epoch_<epoch>/Shard_<shard>/<identifier> for the pruning storers and
+// <database path>/Static/Shard_<shard>/<identifier> for the static storers
+func computeDiskUsage(databasePath string) (*api.StorageUsage, error) {
+	usage := &api.StorageUsage{
+		DatabasePath: databasePath,
+		Units:        make([]*api.StorageUnitUsage, 0),
+		Epochs:       make([]*api.StorageEpochUsage, 0),
+		Timestamp:    time.Now().Unix(),
+	}
+
+	entries, err := ioutil.ReadDir(databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	unitsByKey := make(map[string]*api.StorageUnitUsage)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		epochUsage, ok := createEpochUsage(entry.Name())
+		if !ok {
+			continue
+		}
+
+		err = fillEpochUsage(epochUsage, filepath.Join(databasePath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Epochs = append(usage.Epochs, epochUsage)
+		usage.TotalSize += epochUsage.Size
+		for _, unit := range epochUsage.Units {
+			addToUnitsUsage(unitsByKey, unit, epochUsage.IsStatic)
+		}
+	}
+
+	for _, unit := range unitsByKey {
+		usage.Units = append(usage.Units, unit)
+	}
+
+	sort.Slice(usage.Epochs, func(i, j int) bool {
+		if usage.Epochs[i].IsStatic != usage.Epochs[j].IsStatic {
+			return usage.Epochs[i].IsStatic
+		}
+		return usage.Epochs[i].Epoch < usage.Epochs[j].Epoch
+	})
+	sortUnitsBySize(usage.Units)
+
+	return usage, nil
+}
+
+func createEpochUsage(directoryName string) (*api.StorageEpochUsage, bool) {
+	if directoryName == core.DefaultStaticDbString {
+		return &api.StorageEpochUsage{IsStatic: true}, true
+	}
+
+	epochPrefix := core.DefaultEpochString + "_"
+	if !strings.HasPrefix(directoryName, epochPrefix) {
+		return nil, false
+	}
+
+	epoch, err := strconv.ParseUint(strings.TrimPrefix(directoryName, epochPrefix), 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	return &api.StorageEpochUsage{Epoch: uint32(epoch)}, true
+}
+
+func fillEpochUsage(epochUsage *api.StorageEpochUsage, epochPath string) error {
+	epochUsage.Units = make([]*api.StorageUnitUsage, 0)
+
+	shardEntries, err := ioutil.ReadDir(epochPath)
+	if err != nil {
+		return err
+	}
+
+	shardPrefix := core.DefaultShardString + "_"
+	for _, shardEntry := range shardEntries {
+		if !shardEntry.IsDir() || !strings.HasPrefix(shardEntry.Name(), shardPrefix) {
+			continue
+		}
+
+		shard := strings.TrimPrefix(shardEntry.Name(), shardPrefix)
+		shardPath := filepath.Join(epochPath, shardEntry.Name())
+		unitEntries, errRead := ioutil.ReadDir(shardPath)
+		if errRead != nil {
+			return errRead
+		}
+
+		for _, unitEntry := range unitEntries {
+			size, errSize := directorySize(filepath.Join(shardPath, unitEntry.Name()))
+			if errSize != nil {
+				return errSize
+			}
+
+			epochUsage.Units = append(epochUsage.Units, &api.StorageUnitUsage{
+				Shard:      shard,
+				Identifier: unitEntry.Name(),
+				Size:       size,
+			})
+			epochUsage.Size += size
+		}
+	}
+
+	sortUnitsBySize(epochUsage.Units)
+
+	return nil
+}
+
+func addToUnitsUsage(unitsByKey map[string]*api.StorageUnitUsage, unit *api.StorageUnitUsage, isStatic bool) {
+	key := unit.Shard + "/" + unit.Identifier
+	if isStatic {
+		key = core.DefaultStaticDbString + "/" + key
+	}
+
+	existing, ok := unitsByKey[key]
+	if !ok {
+		existing = &api.StorageUnitUsage{
+			Shard:      unit.Shard,
+			Identifier: unit.Identifier,
+		}
+		unitsByKey[key] = existing
+	}
+
+	existing.Size += unit.Size
+	if !isStatic {
+		existing.NumEpochs++
+	}
+}
+
+func directorySize(path string) (uint64, error) {
+	size := uint64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files might be removed by the storers while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
+func sortUnitsBySize(units []*api.StorageUnitUsage) {
+	sort.Slice(units, func(i, j int) bool {
+		if units[i].Size != units[j].Size {
+			return units[i].Size > units[j].Size
+		}
+		if units[i].Identifier != units[j].Identifier {
+			return units[i].Identifier < units[j].Identifier
+		}
+		return units[i].Shard < units[j].Shard
+	})
+}
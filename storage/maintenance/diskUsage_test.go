@@ -0,0 +1,76 @@
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, path string, size int) {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	require.Nil(t, err)
+
+	err = ioutil.WriteFile(path, make([]byte, size), 0644)
+	require.Nil(t, err)
+}
+
+func TestComputeDiskUsage_MissingDirectoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	usage, err := computeDiskUsage(filepath.Join(os.TempDir(), "missing_database_directory"))
+	assert.NotNil(t, err)
+	assert.Nil(t, usage)
+}
+
+func TestComputeDiskUsage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	databasePath, err := ioutil.TempDir("", "diskUsage")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(databasePath)
+	}()
+
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_0", "Shard_0", "BlockHeaders", "000001.ldb"), 100)
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions", "000001.ldb"), 300)
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_1", "Shard_0", "BlockHeaders", "000001.ldb"), 50)
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_1", "Shard_0", "BlockHeaders", "000002.ldb"), 25)
+	writeTestFile(t, filepath.Join(databasePath, "Static", "Shard_0", "AccountsTrie", "000001.ldb"), 1000)
+	writeTestFile(t, filepath.Join(databasePath, "Static", "Shard_0", "BlockHeaders", "000001.ldb"), 10)
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_invalid", "Shard_0", "BlockHeaders", "000001.ldb"), 10000)
+	writeTestFile(t, filepath.Join(databasePath, "LOG"), 10000)
+
+	usage, err := computeDiskUsage(databasePath)
+	require.Nil(t, err)
+
+	assert.Equal(t, databasePath, usage.DatabasePath)
+	assert.Equal(t, uint64(1485), usage.TotalSize)
+
+	require.Equal(t, 3, len(usage.Epochs))
+	assert.True(t, usage.Epochs[0].IsStatic)
+	assert.Equal(t, uint64(1010), usage.Epochs[0].Size)
+	assert.Equal(t, uint32(0), usage.Epochs[1].Epoch)
+	assert.Equal(t, uint64(400), usage.Epochs[1].Size)
+	require.Equal(t, 2, len(usage.Epochs[1].Units))
+	assert.Equal(t, "Transactions", usage.Epochs[1].Units[0].Identifier)
+	assert.Equal(t, uint32(1), usage.Epochs[2].Epoch)
+	assert.Equal(t, uint64(75), usage.Epochs[2].Size)
+
+	require.Equal(t, 4, len(usage.Units))
+	assert.Equal(t, "AccountsTrie", usage.Units[0].Identifier)
+	assert.Equal(t, uint64(1000), usage.Units[0].Size)
+	assert.Equal(t, uint32(0), usage.Units[0].NumEpochs)
+	assert.Equal(t, "Transactions", usage.Units[1].Identifier)
+	assert.Equal(t, uint64(300), usage.Units[1].Size)
+	assert.Equal(t, uint32(1), usage.Units[1].NumEpochs)
+	assert.Equal(t, "BlockHeaders", usage.Units[2].Identifier)
+	assert.Equal(t, uint64(175), usage.Units[2].Size)
+	assert.Equal(t, uint32(2), usage.Units[2].NumEpochs)
+	assert.Equal(t, "BlockHeaders", usage.Units[3].Identifier)
+	assert.Equal(t, uint64(10), usage.Units[3].Size)
+	assert.Equal(t, "0", usage.Units[3].Shard)
+}
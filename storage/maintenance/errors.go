@@ -0,0 +1,24 @@
+package maintenance
+
+import "errors"
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilTrieStorageManagers signals that a nil trie storage managers map has been provided
+var ErrNilTrieStorageManagers = errors.New("nil trie storage managers")
+
+// ErrCompactionInProgress signals that a compaction is already in progress
+var ErrCompactionInProgress = errors.New("compaction already in progress")
+
+// ErrNoUnitsToCompact signals that no storage units to compact have been provided
+var ErrNoUnitsToCompact = errors.New("no storage units to compact")
+
+// ErrUnknownStorageUnit signals that an unknown or not compactable storage unit has been provided
+var ErrUnknownStorageUnit = errors.New("unknown or not compactable storage unit")
+
+// ErrStorageMaintainerClosed signals that the storage maintainer was closed
+var ErrStorageMaintainerClosed = errors.New("storage maintainer closed")
@@ -0,0 +1,243 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("storage/maintenance")
+
+const trieUnitSuffix = "Trie"
+
+// ArgsStorageMaintainer is the argument DTO used to create a storage maintainer
+type ArgsStorageMaintainer struct {
+	PathManager             storage.PathManagerHandler
+	StorageService          dataRetriever.StorageService
+	TrieStorageManagers     map[string]data.StorageManager
+	DelayBetweenCompactions time.Duration
+	DiskUsageCacheDuration  time.Duration
+}
+
+type storageMaintainer struct {
+	databasePath            string
+	compactors              map[string]storage.Compactor
+	compactableUnits        []string
+	delayBetweenCompactions time.Duration
+	diskUsageCacheDuration  time.Duration
+	mutDiskUsage            sync.Mutex
+	diskUsage               *api.StorageUsage
+	diskUsageComputedAt     time.Time
+	mutStatus               sync.RWMutex
+	status                  *api.StorageCompactionStatus
+	ctx                     context.Context
+	cancelFunc              context.CancelFunc
+}
+
+// NewStorageMaintainer creates a component able to report the disk usage of the storage units and to compact them
+// online. The compaction runs on a separate go routine, one storage unit at a time with a delay between units, so the
+// block processing is not stopped while it is in progress
+func NewStorageMaintainer(args ArgsStorageMaintainer) (*storageMaintainer, error) {
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if args.TrieStorageManagers == nil {
+		return nil, ErrNilTrieStorageManagers
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	sm := &storageMaintainer{
+		databasePath:            args.PathManager.DatabasePath(),
+		compactors:              make(map[string]storage.Compactor),
+		delayBetweenCompactions: args.DelayBetweenCompactions,
+		diskUsageCacheDuration:  args.DiskUsageCacheDuration,
+		status: &api.StorageCompactionStatus{
+			FailedUnits: make(map[string]string),
+		},
+		ctx:        ctx,
+		cancelFunc: cancelFunc,
+	}
+
+	for unitType, storer := range args.StorageService.GetAllStorers() {
+		compactor, ok := storer.(storage.Compactor)
+		if ok {
+			sm.compactors[unitType.String()] = compactor
+		}
+	}
+	for name, trieStorageManager := range args.TrieStorageManagers {
+		if check.IfNil(trieStorageManager) {
+			continue
+		}
+
+		compactor, ok := trieStorageManager.Database().(storage.Compactor)
+		if ok {
+			sm.compactors[name+trieUnitSuffix] = compactor
+		}
+	}
+
+	sm.compactableUnits = make([]string, 0, len(sm.compactors))
+	for name := range sm.compactors {
+		sm.compactableUnits = append(sm.compactableUnits, name)
+	}
+	sort.Strings(sm.compactableUnits)
+
+	return sm, nil
+}
+
+// DiskUsage returns the size on disk of the storage units, per unit and per epoch, together with the status of the
+// last compaction. Walking the databases directory is expensive, so the sizes are computed at most once for each
+// disk usage cache duration and the returned timestamp tells when they were computed
+func (sm *storageMaintainer) DiskUsage() (*api.StorageUsage, error) {
+	sm.mutDiskUsage.Lock()
+	defer sm.mutDiskUsage.Unlock()
+
+	if sm.diskUsage == nil || time.Since(sm.diskUsageComputedAt) >= sm.diskUsageCacheDuration {
+		usage, err := computeDiskUsage(sm.databasePath)
+		if err != nil {
+			return nil, err
+		}
+
+		sm.diskUsage = usage
+		sm.diskUsageComputedAt = time.Now()
+	}
+
+	usage := *sm.diskUsage
+	usage.Compaction = sm.CompactionStatus()
+
+	return &usage, nil
+}
+
+// StartCompaction starts the online compaction of the provided storage units. It returns an error if a compaction
+// is already in progress or if any of the provided units is not compactable
+func (sm *storageMaintainer) StartCompaction(units []string) error {
+	if len(units) == 0 {
+		return ErrNoUnitsToCompact
+	}
+	for _, unit := range units {
+		_, ok := sm.compactors[unit]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownStorageUnit, unit)
+		}
+	}
+
+	sm.mutStatus.Lock()
+	defer sm.mutStatus.Unlock()
+
+	if sm.ctx.Err() != nil {
+		return ErrStorageMaintainerClosed
+	}
+	if sm.status.InProgress {
+		return ErrCompactionInProgress
+	}
+
+	unitsToCompact := make([]string, len(units))
+	copy(unitsToCompact, units)
+	sm.status = &api.StorageCompactionStatus{
+		InProgress:     true,
+		Units:          unitsToCompact,
+		CompactedUnits: make([]string, 0, len(units)),
+		FailedUnits:    make(map[string]string),
+		StartTimestamp: time.Now().Unix(),
+	}
+
+	go sm.compact(unitsToCompact)
+
+	return nil
+}
+
+func (sm *storageMaintainer) compact(units []string) {
+	log.Info("storage compaction started", "units", units)
+
+	for i, unit := range units {
+		if i > 0 {
+			select {
+			case <-time.After(sm.delayBetweenCompactions):
+			case <-sm.ctx.Done():
+				sm.finishCompaction(units[i:], ErrStorageMaintainerClosed)
+				return
+			}
+		}
+
+		startTime := time.Now()
+		err := sm.compactors[unit].Compact()
+		log.Debug("storage unit compacted", "unit", unit, "duration", time.Since(startTime), "error", err)
+		sm.recordCompactedUnit(unit, err)
+	}
+
+	sm.finishCompaction(nil, nil)
+}
+
+func (sm *storageMaintainer) recordCompactedUnit(unit string, err error) {
+	sm.mutStatus.Lock()
+	defer sm.mutStatus.Unlock()
+
+	if err != nil {
+		sm.status.FailedUnits[unit] = err.Error()
+		return
+	}
+
+	sm.status.CompactedUnits = append(sm.status.CompactedUnits, unit)
+}
+
+func (sm *storageMaintainer) finishCompaction(remainingUnits []string, err error) {
+	sm.mutStatus.Lock()
+	defer sm.mutStatus.Unlock()
+
+	for _, unit := range remainingUnits {
+		sm.status.FailedUnits[unit] = err.Error()
+	}
+	sm.status.InProgress = false
+	sm.status.EndTimestamp = time.Now().Unix()
+
+	log.Info("storage compaction finished",
+		"num compacted", len(sm.status.CompactedUnits),
+		"num failed", len(sm.status.FailedUnits))
+}
+
+// CompactionStatus returns the status of the last compaction
+func (sm *storageMaintainer) CompactionStatus() *api.StorageCompactionStatus {
+	sm.mutStatus.RLock()
+	defer sm.mutStatus.RUnlock()
+
+	status := &api.StorageCompactionStatus{
+		InProgress:       sm.status.InProgress,
+		Units:            make([]string, len(sm.status.Units)),
+		CompactedUnits:   make([]string, len(sm.status.CompactedUnits)),
+		FailedUnits:      make(map[string]string, len(sm.status.FailedUnits)),
+		CompactableUnits: make([]string, len(sm.compactableUnits)),
+		StartTimestamp:   sm.status.StartTimestamp,
+		EndTimestamp:     sm.status.EndTimestamp,
+	}
+	copy(status.Units, sm.status.Units)
+	copy(status.CompactedUnits, sm.status.CompactedUnits)
+	copy(status.CompactableUnits, sm.compactableUnits)
+	for unit, errMessage := range sm.status.FailedUnits {
+		status.FailedUnits[unit] = errMessage
+	}
+
+	return status
+}
+
+// Close stops the compaction in progress, if any, after the unit currently being compacted
+func (sm *storageMaintainer) Close() error {
+	sm.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sm *storageMaintainer) IsInterfaceNil() bool {
+	return sm == nil
+}
@@ -0,0 +1,286 @@
+package maintenance
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type compactableStorerStub struct {
+	*testscommon.StorerStub
+	CompactCalled func() error
+}
+
+// Compact -
+func (css *compactableStorerStub) Compact() error {
+	if css.CompactCalled != nil {
+		return css.CompactCalled()
+	}
+
+	return nil
+}
+
+func createMockArgsStorageMaintainer() ArgsStorageMaintainer {
+	return ArgsStorageMaintainer{
+		PathManager:         &testscommon.PathManagerStub{},
+		StorageService:      dataRetriever.NewChainStorer(),
+		TrieStorageManagers: make(map[string]data.StorageManager),
+	}
+}
+
+func waitForCompactionToFinish(t *testing.T, sm *storageMaintainer) {
+	for i := 0; i < 100; i++ {
+		if !sm.CompactionStatus().InProgress {
+			return
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, "compaction did not finish in time")
+}
+
+func TestNewStorageMaintainer_NilPathManagerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageMaintainer()
+	args.PathManager = nil
+	sm, err := NewStorageMaintainer(args)
+
+	assert.True(t, check.IfNil(sm))
+	assert.Equal(t, ErrNilPathManager, err)
+}
+
+func TestNewStorageMaintainer_NilStorageServiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = nil
+	sm, err := NewStorageMaintainer(args)
+
+	assert.True(t, check.IfNil(sm))
+	assert.Equal(t, ErrNilStorageService, err)
+}
+
+func TestNewStorageMaintainer_NilTrieStorageManagersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStorageMaintainer()
+	args.TrieStorageManagers = nil
+	sm, err := NewStorageMaintainer(args)
+
+	assert.True(t, check.IfNil(sm))
+	assert.Equal(t, ErrNilTrieStorageManagers, err)
+}
+
+func TestNewStorageMaintainer_ShouldCollectCompactableUnits(t *testing.T) {
+	t.Parallel()
+
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.TransactionUnit, &compactableStorerStub{StorerStub: &testscommon.StorerStub{}})
+	storageService.AddStorer(dataRetriever.BlockHeaderUnit, &testscommon.StorerStub{})
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = storageService
+	args.TrieStorageManagers["userAccount"] = &testscommon.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return &compactableStorerStub{StorerStub: &testscommon.StorerStub{}}
+		},
+	}
+	args.TrieStorageManagers["peerAccount"] = &testscommon.StorageManagerStub{
+		DatabaseCalled: func() data.DBWriteCacher {
+			return &testscommon.StorerStub{}
+		},
+	}
+
+	sm, err := NewStorageMaintainer(args)
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(sm))
+	assert.Equal(t, []string{"TransactionUnit", "userAccountTrie"}, sm.CompactionStatus().CompactableUnits)
+}
+
+func TestStorageMaintainer_StartCompactionInvalidUnitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.TransactionUnit, &compactableStorerStub{StorerStub: &testscommon.StorerStub{}})
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = storageService
+	sm, _ := NewStorageMaintainer(args)
+
+	err := sm.StartCompaction(nil)
+	assert.Equal(t, ErrNoUnitsToCompact, err)
+
+	err = sm.StartCompaction([]string{"TransactionUnit", "BlockHeaderUnit"})
+	assert.True(t, errors.Is(err, ErrUnknownStorageUnit))
+	assert.False(t, sm.CompactionStatus().InProgress)
+}
+
+func TestStorageMaintainer_StartCompactionShouldCompactTheUnits(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	mutCompacted := sync.Mutex{}
+	compacted := make([]string, 0)
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.TransactionUnit, &compactableStorerStub{
+		StorerStub: &testscommon.StorerStub{},
+		CompactCalled: func() error {
+			mutCompacted.Lock()
+			compacted = append(compacted, "TransactionUnit")
+			mutCompacted.Unlock()
+			return nil
+		},
+	})
+	storageService.AddStorer(dataRetriever.BlockHeaderUnit, &compactableStorerStub{
+		StorerStub: &testscommon.StorerStub{},
+		CompactCalled: func() error {
+			return expectedErr
+		},
+	})
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = storageService
+	args.DelayBetweenCompactions = time.Millisecond
+	sm, _ := NewStorageMaintainer(args)
+
+	err := sm.StartCompaction([]string{"TransactionUnit", "BlockHeaderUnit"})
+	require.Nil(t, err)
+	waitForCompactionToFinish(t, sm)
+
+	status := sm.CompactionStatus()
+	assert.False(t, status.InProgress)
+	assert.Equal(t, []string{"TransactionUnit", "BlockHeaderUnit"}, status.Units)
+	assert.Equal(t, []string{"TransactionUnit"}, status.CompactedUnits)
+	assert.Equal(t, map[string]string{"BlockHeaderUnit": expectedErr.Error()}, status.FailedUnits)
+	assert.True(t, status.EndTimestamp >= status.StartTimestamp)
+
+	mutCompacted.Lock()
+	assert.Equal(t, []string{"TransactionUnit"}, compacted)
+	mutCompacted.Unlock()
+}
+
+func TestStorageMaintainer_StartCompactionWhileInProgressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.TransactionUnit, &compactableStorerStub{
+		StorerStub: &testscommon.StorerStub{},
+		CompactCalled: func() error {
+			<-chRelease
+			return nil
+		},
+	})
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = storageService
+	sm, _ := NewStorageMaintainer(args)
+
+	err := sm.StartCompaction([]string{"TransactionUnit"})
+	require.Nil(t, err)
+	assert.True(t, sm.CompactionStatus().InProgress)
+
+	err = sm.StartCompaction([]string{"TransactionUnit"})
+	assert.Equal(t, ErrCompactionInProgress, err)
+
+	close(chRelease)
+	waitForCompactionToFinish(t, sm)
+
+	err = sm.StartCompaction([]string{"TransactionUnit"})
+	assert.Nil(t, err)
+	waitForCompactionToFinish(t, sm)
+}
+
+func TestStorageMaintainer_CloseShouldStopTheCompaction(t *testing.T) {
+	t.Parallel()
+
+	storageService := dataRetriever.NewChainStorer()
+	storageService.AddStorer(dataRetriever.TransactionUnit, &compactableStorerStub{StorerStub: &testscommon.StorerStub{}})
+	storageService.AddStorer(dataRetriever.BlockHeaderUnit, &compactableStorerStub{StorerStub: &testscommon.StorerStub{}})
+	args := createMockArgsStorageMaintainer()
+	args.StorageService = storageService
+	args.DelayBetweenCompactions = time.Hour
+	sm, _ := NewStorageMaintainer(args)
+
+	err := sm.StartCompaction([]string{"TransactionUnit", "BlockHeaderUnit"})
+	require.Nil(t, err)
+
+	_ = sm.Close()
+	waitForCompactionToFinish(t, sm)
+
+	status := sm.CompactionStatus()
+	assert.Equal(t, []string{"TransactionUnit"}, status.CompactedUnits)
+	assert.Equal(t, map[string]string{"BlockHeaderUnit": ErrStorageMaintainerClosed.Error()}, status.FailedUnits)
+
+	err = sm.StartCompaction([]string{"TransactionUnit"})
+	assert.Equal(t, ErrStorageMaintainerClosed, err)
+}
+
+func TestStorageMaintainer_DiskUsageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	databasePath, err := ioutil.TempDir("", "storageMaintainer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(databasePath)
+	}()
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_3", "Shard_1", "Transactions", "000001.ldb"), 42)
+
+	args := createMockArgsStorageMaintainer()
+	args.PathManager = &testscommon.PathManagerStub{
+		DatabasePathCalled: func() string {
+			return databasePath
+		},
+	}
+	sm, _ := NewStorageMaintainer(args)
+
+	usage, err := sm.DiskUsage()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(42), usage.TotalSize)
+	require.Equal(t, 1, len(usage.Units))
+	assert.Equal(t, "1", usage.Units[0].Shard)
+	require.NotNil(t, usage.Compaction)
+	assert.False(t, usage.Compaction.InProgress)
+}
+
+func TestStorageMaintainer_DiskUsageShouldBeCachedForTheCacheDuration(t *testing.T) {
+	t.Parallel()
+
+	databasePath, err := ioutil.TempDir("", "storageMaintainer")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(databasePath)
+	}()
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_3", "Shard_1", "Transactions", "000001.ldb"), 42)
+
+	args := createMockArgsStorageMaintainer()
+	args.PathManager = &testscommon.PathManagerStub{
+		DatabasePathCalled: func() string {
+			return databasePath
+		},
+	}
+	args.DiskUsageCacheDuration = time.Millisecond * 200
+	sm, _ := NewStorageMaintainer(args)
+
+	usage, err := sm.DiskUsage()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(42), usage.TotalSize)
+
+	writeTestFile(t, filepath.Join(databasePath, "Epoch_3", "Shard_1", "Transactions", "000002.ldb"), 8)
+	usage, err = sm.DiskUsage()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(42), usage.TotalSize)
+
+	time.Sleep(args.DiskUsageCacheDuration)
+	usage, err = sm.DiskUsage()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(50), usage.TotalSize)
+}
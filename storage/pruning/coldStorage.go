@@ -0,0 +1,321 @@
+package pruning
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const tempDirectorySuffix = ".tmp"
+
+func (fhps *FullHistoryPruningStorer) initColdStorage(args *ColdStorageArgs) error {
+	if len(args.Path) == 0 {
+		return storage.ErrEmptyColdStoragePath
+	}
+	minNumEpochs := core.MaxUint32(fhps.args.NumOfEpochsToKeep, fhps.args.NumOfActivePersisters)
+	if args.AfterNumEpochs < minNumEpochs {
+		return fmt.Errorf("%w, provided %d, minimum %d", storage.ErrInvalidColdStorageNumEpochs, args.AfterNumEpochs, minNumEpochs)
+	}
+	if args.NumOpenPersisters < 1 || args.NumOpenPersisters > math.MaxInt32 {
+		return storage.ErrInvalidNumberOfColdPersisters
+	}
+
+	var err error
+	fhps.coldPersistersCache, err = lrucache.NewCacheWithEviction(int(args.NumOpenPersisters), fhps.onEvicted)
+	if err != nil {
+		return err
+	}
+
+	fhps.coldStorage = args
+	fhps.ctxOffload, fhps.cancelOffload = context.WithCancel(context.Background())
+
+	fhps.lock.Lock()
+	for epoch, pd := range fhps.persistersMapByEpoch {
+		if pd.getIsClosed() {
+			pd.setPath(fhps.persisterPathForEpoch(epoch))
+		}
+	}
+	fhps.lock.Unlock()
+
+	fhps.registerColdStorageHandler(fhps.args.Notifier)
+
+	return nil
+}
+
+func (fhps *FullHistoryPruningStorer) registerColdStorageHandler(handler EpochStartNotifier) {
+	subscribeHandler := notifier.NewHandlerForEpochStart(
+		func(hdr data.HeaderHandler) {
+			fhps.triggerOffload(hdr.GetEpoch())
+		},
+		func(_ data.HeaderHandler) {},
+		core.StorerOrder)
+
+	handler.RegisterHandler(subscribeHandler)
+}
+
+func (fhps *FullHistoryPruningStorer) triggerOffload(currentEpoch uint32) {
+	wasOffloading := fhps.isOffloading.Set()
+	if wasOffloading {
+		log.Debug("fhps - offload to cold storage already in progress", "identifier", fhps.identifier)
+		return
+	}
+
+	go func() {
+		fhps.offloadOldEpochs(currentEpoch)
+		fhps.isOffloading.Unset()
+	}()
+}
+
+// offloadOldEpochs will compact and move on the cold storage path all the epochs older than the configured
+// threshold that are still found on the main database path
+func (fhps *FullHistoryPruningStorer) offloadOldEpochs(currentEpoch uint32) {
+	if currentEpoch < fhps.coldStorage.AfterNumEpochs {
+		return
+	}
+
+	lastEpochToMove := currentEpoch - fhps.coldStorage.AfterNumEpochs
+	for epoch := uint32(0); epoch <= lastEpochToMove; epoch++ {
+		select {
+		case <-fhps.ctxOffload.Done():
+			log.Debug("fhps - offload to cold storage stopped", "identifier", fhps.identifier)
+			return
+		default:
+		}
+
+		if fhps.isEpochActive(epoch) {
+			continue
+		}
+
+		hotPath := createPersisterPathForEpoch(fhps.args, epoch, fhps.shardId)
+		if !pathExists(hotPath) {
+			continue
+		}
+
+		err := fhps.moveEpochToColdStorage(epoch, hotPath)
+		if err != nil {
+			log.Warn("fhps - cannot move epoch to cold storage",
+				"identifier", fhps.identifier,
+				"epoch", epoch,
+				"error", err.Error())
+			return
+		}
+	}
+}
+
+func (fhps *FullHistoryPruningStorer) moveEpochToColdStorage(epoch uint32, hotPath string) error {
+	fhps.mutColdStorage.Lock()
+	defer fhps.mutColdStorage.Unlock()
+
+	select {
+	case <-fhps.ctxOffload.Done():
+		return nil
+	default:
+	}
+
+	epochString := fmt.Sprintf("%d", epoch)
+	fhps.lock.Lock()
+	pd, exists := fhps.persistersMapByEpoch[epoch]
+	for _, cache := range fhps.oldPersistersCaches() {
+		cache.Remove([]byte(epochString))
+	}
+	if exists && !pd.getIsClosed() {
+		err := pd.Close()
+		if err != nil {
+			log.Debug("fhps - moveEpochToColdStorage: close persister", "epoch", epoch, "error", err.Error())
+		}
+	}
+	fhps.lock.Unlock()
+
+	err := fhps.compactPersister(hotPath)
+	if err != nil {
+		return err
+	}
+
+	coldPath := fhps.coldPath(hotPath)
+	err = moveDirectory(hotPath, coldPath)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		pd.setPath(coldPath)
+	}
+
+	log.Debug("fhps - moved epoch to cold storage",
+		"identifier", fhps.identifier,
+		"epoch", epoch,
+		"path", coldPath)
+
+	return nil
+}
+
+func (fhps *FullHistoryPruningStorer) compactPersister(path string) error {
+	persister, err := fhps.args.PersisterFactory.Create(path)
+	if err != nil {
+		return err
+	}
+
+	compactor, ok := persister.(storage.Compactor)
+	if ok {
+		err = fhps.compactUntilCancelled(compactor, persister)
+		if err != nil {
+			_ = persister.Close()
+			return err
+		}
+	}
+
+	return persister.Close()
+}
+
+// compactUntilCancelled closes the persister if the offload is cancelled, which interrupts the compaction in progress
+func (fhps *FullHistoryPruningStorer) compactUntilCancelled(compactor storage.Compactor, persister storage.Persister) error {
+	compactionDone := make(chan struct{})
+	defer close(compactionDone)
+
+	go func() {
+		select {
+		case <-fhps.ctxOffload.Done():
+			_ = persister.Close()
+		case <-compactionDone:
+		}
+	}()
+
+	err := compactor.Compact()
+	select {
+	case <-fhps.ctxOffload.Done():
+		return fmt.Errorf("%w, compaction interrupted", storage.ErrPersisterIsClosed)
+	default:
+		return err
+	}
+}
+
+// persisterPathForEpoch returns the path on the cold storage if the data of the provided epoch was already moved
+// there, the path on the main database otherwise
+func (fhps *FullHistoryPruningStorer) persisterPathForEpoch(epoch uint32) string {
+	hotPath := createPersisterPathForEpoch(fhps.args, epoch, fhps.shardId)
+	if fhps.coldStorage == nil || pathExists(hotPath) {
+		return hotPath
+	}
+
+	coldPath := fhps.coldPath(hotPath)
+	if pathExists(coldPath) {
+		return coldPath
+	}
+
+	return hotPath
+}
+
+// coldPath keeps on the cold storage the same directory structure the persister has under the main database path
+func (fhps *FullHistoryPruningStorer) coldPath(hotPath string) string {
+	relativePath, err := filepath.Rel(fhps.args.PathManager.DatabasePath(), hotPath)
+	if err != nil || strings.HasPrefix(relativePath, "..") {
+		relativePath = strings.TrimPrefix(filepath.Clean(hotPath), string(filepath.Separator))
+	}
+
+	return filepath.Join(fhps.coldStorage.Path, relativePath)
+}
+
+func (fhps *FullHistoryPruningStorer) cacheForPersister(pd *persisterData) storage.Cacher {
+	if fhps.coldPersistersCache != nil && strings.HasPrefix(pd.getPath(), fhps.coldStorage.Path) {
+		return fhps.coldPersistersCache
+	}
+
+	return fhps.oldEpochsActivePersistersCache
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// moveDirectory will try a rename first, falling back to a copy followed by a removal when the destination is on
+// another file system. The copy is done in a temporary directory so a partially copied persister is never used
+func moveDirectory(source string, destination string) error {
+	err := os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	tempDestination := destination + tempDirectorySuffix
+	err = os.RemoveAll(tempDestination)
+	if err != nil {
+		return err
+	}
+
+	err = copyDirectory(source, tempDestination)
+	if err != nil {
+		_ = os.RemoveAll(tempDestination)
+		return err
+	}
+
+	err = os.Rename(tempDestination, destination)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destination, relativePath)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(source string, destination string, mode os.FileMode) error {
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	err = destinationFile.Sync()
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
@@ -48,3 +48,13 @@ func (fhps *FullHistoryPruningStorer) GetOldEpochsActivePersisters() storage.Cac
 func (fhps *FullHistoryPruningStorer) IsEpochActive(epoch uint32) bool {
 	return fhps.isEpochActive(epoch)
 }
+
+// OffloadOldEpochs -
+func (fhps *FullHistoryPruningStorer) OffloadOldEpochs(currentEpoch uint32) {
+	fhps.offloadOldEpochs(currentEpoch)
+}
+
+// GetColdPersistersCache -
+func (fhps *FullHistoryPruningStorer) GetColdPersistersCache() storage.Cacher {
+	return fhps.coldPersistersCache
+}
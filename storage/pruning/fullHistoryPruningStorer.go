@@ -1,10 +1,13 @@
 package pruning
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
+	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
@@ -16,6 +19,12 @@ type FullHistoryPruningStorer struct {
 	args                           *StorerArgs
 	shardId                        string
 	oldEpochsActivePersistersCache storage.Cacher
+	coldStorage                    *ColdStorageArgs
+	coldPersistersCache            storage.Cacher
+	mutColdStorage                 sync.RWMutex
+	isOffloading                   atomic.Flag
+	cancelOffload                  func()
+	ctxOffload                     context.Context
 }
 
 // NewFullHistoryPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -52,6 +61,13 @@ func initFullHistoryPruningStorer(args *FullHistoryStorerArgs, shardId string) (
 		return nil, err
 	}
 
+	if args.ColdStorage != nil {
+		err = fhps.initColdStorage(args.ColdStorage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return fhps, nil
 }
 
@@ -88,6 +104,38 @@ func (fhps *FullHistoryPruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]
 	return fhps.searchInEpoch(key, epoch+1)
 }
 
+// SearchFirst will search a given key in all the active persisters and then in the already opened persisters of
+// the old epochs, including the ones re-opened from the cold storage
+func (fhps *FullHistoryPruningStorer) SearchFirst(key []byte) ([]byte, error) {
+	res, err := fhps.PruningStorer.SearchFirst(key)
+	if err == nil {
+		return res, nil
+	}
+
+	fhps.lock.RLock()
+	defer fhps.lock.RUnlock()
+
+	for _, cache := range fhps.oldPersistersCaches() {
+		for _, epochString := range cache.Keys() {
+			value, ok := cache.Peek(epochString)
+			if !ok {
+				continue
+			}
+			pd, ok := value.(*persisterData)
+			if !ok {
+				continue
+			}
+
+			res, errGet := pd.get(key)
+			if errGet == nil {
+				return res, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
 func (fhps *FullHistoryPruningStorer) searchInEpoch(key []byte, epoch uint32) ([]byte, error) {
 	if fhps.isEpochActive(epoch) {
 		return fhps.PruningStorer.SearchFirst(key)
@@ -111,12 +159,21 @@ func (fhps *FullHistoryPruningStorer) isEpochActive(epoch uint32) bool {
 }
 
 func (fhps *FullHistoryPruningStorer) getFromOldEpoch(key []byte, epoch uint32) ([]byte, error) {
-	persister, err := fhps.getOrOpenPersister(epoch)
+	pdata, err := fhps.getOrOpenPersister(epoch)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := persister.Get(key)
+	res, err := pdata.get(key)
+	if err == storage.ErrPersisterIsClosed {
+		// the persister was closed after it was opened, e.g. when the epoch was moved to the cold storage
+		pdata, err = fhps.getOrOpenPersister(epoch)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err = pdata.get(key)
+	}
 	if err == nil {
 		return res, nil
 	}
@@ -131,7 +188,7 @@ func (fhps *FullHistoryPruningStorer) getFromOldEpoch(key []byte, epoch uint32)
 		hex.EncodeToString(key), fhps.identifier)
 }
 
-func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.Persister, error) {
+func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (*persisterData, error) {
 	epochString := fmt.Sprintf("%d", epoch)
 
 	fhps.lock.RLock()
@@ -141,48 +198,75 @@ func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.
 	if exists {
 		isClosed := pdata.getIsClosed()
 		if !isClosed {
-			return pdata.getPersister(), nil
+			return pdata, nil
 		}
 	}
 
+	// an epoch can not be opened while it is moved to the cold storage
+	fhps.mutColdStorage.RLock()
+	defer fhps.mutColdStorage.RUnlock()
+
 	fhps.lock.Lock()
 	defer fhps.lock.Unlock()
 
 	pdata, exists = fhps.getPersisterData(epochString, epoch)
 	if !exists {
-		newPdata, errPersisterData := createPersisterDataForEpoch(fhps.args, epoch, fhps.shardId)
+		newPdata, errPersisterData := createPersisterDataForPath(fhps.args, epoch, fhps.persisterPathForEpoch(epoch))
 		if errPersisterData != nil {
 			return nil, errPersisterData
 		}
 
-		fhps.oldEpochsActivePersistersCache.Put([]byte(epochString), newPdata, 0)
+		fhps.cacheForPersister(newPdata).Put([]byte(epochString), newPdata, 0)
 		fhps.persistersMapByEpoch[epoch] = newPdata
 
-		return newPdata.getPersister(), nil
+		return newPdata, nil
 	}
-	persister, _, err := fhps.createAndInitPersisterIfClosed(pdata)
+	_, _, err := fhps.createAndInitPersisterIfClosed(pdata)
 	if err != nil {
 		return nil, err
 	}
 
-	_, ok := fhps.oldEpochsActivePersistersCache.Get([]byte(epochString))
+	cache := fhps.cacheForPersister(pdata)
+	_, ok := cache.Get([]byte(epochString))
 	if !ok {
 		log.Debug("fhps - getOrOpenPersister - put in cache", "epoch", epochString)
-		fhps.oldEpochsActivePersistersCache.Put([]byte(epochString), pdata, 0)
+		cache.Put([]byte(epochString), pdata, 0)
 	}
-	return persister, nil
+	return pdata, nil
 }
 
 func (fhps *FullHistoryPruningStorer) getPersisterData(epochString string, epoch uint32) (*persisterData, bool) {
-	pdata, exists := fhps.oldEpochsActivePersistersCache.Get([]byte(epochString))
-	if exists {
-		return pdata.(*persisterData), true
+	for _, cache := range fhps.oldPersistersCaches() {
+		pdata, exists := cache.Get([]byte(epochString))
+		if exists {
+			return pdata.(*persisterData), true
+		}
 	}
 
-	pdata, exists = fhps.persistersMapByEpoch[epoch]
+	pdata, exists := fhps.persistersMapByEpoch[epoch]
 	if exists {
-		return pdata.(*persisterData), true
+		return pdata, true
 	}
 
 	return nil, false
 }
+
+func (fhps *FullHistoryPruningStorer) oldPersistersCaches() []storage.Cacher {
+	if fhps.coldPersistersCache == nil {
+		return []storage.Cacher{fhps.oldEpochsActivePersistersCache}
+	}
+
+	return []storage.Cacher{fhps.oldEpochsActivePersistersCache, fhps.coldPersistersCache}
+}
+
+// Close will close the persisters and stop any move of old epochs towards the cold storage
+func (fhps *FullHistoryPruningStorer) Close() error {
+	if fhps.cancelOffload != nil {
+		fhps.cancelOffload()
+		// wait for the epoch that is currently moved, if any. The compaction in progress is interrupted by the cancel
+		fhps.mutColdStorage.Lock()
+		fhps.mutColdStorage.Unlock()
+	}
+
+	return fhps.PruningStorer.Close()
+}
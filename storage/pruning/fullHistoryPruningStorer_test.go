@@ -1,12 +1,22 @@
 package pruning_test
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"math"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
 	"github.com/ElrondNetwork/elrond-go/storage/pruning"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -198,3 +208,250 @@ func TestNewFullHistoryShardedPruningStorer_ShouldWork(t *testing.T) {
 	require.Nil(t, err)
 	require.NotNil(t, fhps)
 }
+
+func getColdStorageArgs(t *testing.T) (*pruning.FullHistoryStorerArgs, string, string) {
+	hotDir, err := ioutil.TempDir("", "fhps_hot")
+	require.Nil(t, err)
+	coldDir, err := ioutil.TempDir("", "fhps_cold")
+	require.Nil(t, err)
+
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewSerialDB(path, 1, 20, 10)
+		},
+	}
+	args.PathManager = &testscommon.PathManagerStub{
+		PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(hotDir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+		},
+		DatabasePathCalled: func() string {
+			return hotDir
+		},
+	}
+
+	fhArgs := &pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 2,
+		ColdStorage: &pruning.ColdStorageArgs{
+			Path:              coldDir,
+			AfterNumEpochs:    2,
+			NumOpenPersisters: 1,
+		},
+	}
+
+	return fhArgs, hotDir, coldDir
+}
+
+func TestNewFullHistoryPruningStorer_InvalidColdStorageArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fhArgs := &pruning.FullHistoryStorerArgs{
+		StorerArgs:               getDefaultArgs(),
+		NumOfOldActivePersisters: 2,
+		ColdStorage: &pruning.ColdStorageArgs{
+			Path:              "",
+			AfterNumEpochs:    2,
+			NumOpenPersisters: 1,
+		},
+	}
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	assert.Nil(t, fhps)
+	assert.Equal(t, storage.ErrEmptyColdStoragePath, err)
+
+	fhArgs.ColdStorage.Path = "cold"
+	fhArgs.ColdStorage.AfterNumEpochs = 1
+	fhps, err = pruning.NewFullHistoryPruningStorer(fhArgs)
+	assert.Nil(t, fhps)
+	assert.True(t, errors.Is(err, storage.ErrInvalidColdStorageNumEpochs))
+
+	fhArgs.ColdStorage.AfterNumEpochs = 2
+	fhArgs.ColdStorage.NumOpenPersisters = 0
+	fhps, err = pruning.NewFullHistoryPruningStorer(fhArgs)
+	assert.Nil(t, fhps)
+	assert.Equal(t, storage.ErrInvalidNumberOfColdPersisters, err)
+}
+
+func TestFullHistoryPruningStorer_OffloadOldEpochsShouldMoveAndReopenFromColdStorage(t *testing.T) {
+	t.Parallel()
+
+	fhArgs, hotDir, coldDir := getColdStorageArgs(t)
+	defer func() {
+		_ = os.RemoveAll(hotDir)
+		_ = os.RemoveAll(coldDir)
+	}()
+
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	err = fhps.PutInEpoch(testKey, testVal, 0)
+	require.Nil(t, err)
+
+	_ = fhps.ChangeEpochSimple(1)
+	_ = fhps.ChangeEpochSimple(2)
+	_ = fhps.ChangeEpochSimple(3)
+
+	fhps.OffloadOldEpochs(3)
+
+	relativePath := filepath.Join("Epoch_0", "Shard_0", "id")
+	_, err = os.Stat(filepath.Join(hotDir, relativePath))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(coldDir, relativePath))
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(coldDir, "Epoch_1", "Shard_0", "id"))
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(hotDir, "Epoch_2", "Shard_0", "id"))
+	assert.Nil(t, err)
+
+	res, err := fhps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Equal(t, 1, fhps.GetColdPersistersCache().Len())
+	assert.Equal(t, 0, fhps.GetOldEpochsActivePersisters().Len())
+
+	res, err = fhps.SearchFirst(testKey)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	_ = fhps.Close()
+}
+
+func TestFullHistoryPruningStorer_GetFromEpochWhileOffloadingShouldWork(t *testing.T) {
+	t.Parallel()
+
+	fhArgs, hotDir, coldDir := getColdStorageArgs(t)
+	defer func() {
+		_ = os.RemoveAll(hotDir)
+		_ = os.RemoveAll(coldDir)
+	}()
+
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	err = fhps.PutInEpoch(testKey, testVal, 0)
+	require.Nil(t, err)
+
+	_ = fhps.ChangeEpochSimple(1)
+	_ = fhps.ChangeEpochSimple(2)
+	_ = fhps.ChangeEpochSimple(3)
+
+	res, err := fhps.GetFromEpoch(testKey, 0)
+	require.Nil(t, err)
+	require.Equal(t, testVal, res)
+
+	offloadDone := make(chan struct{})
+	go func() {
+		fhps.OffloadOldEpochs(3)
+		close(offloadDone)
+	}()
+
+	numReaders := 5
+	wg := sync.WaitGroup{}
+	wg.Add(numReaders)
+	for i := 0; i < numReaders; i++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				resGet, errGet := fhps.GetFromEpoch(testKey, 0)
+				assert.Nil(t, errGet)
+				assert.Equal(t, testVal, resGet)
+
+				select {
+				case <-offloadDone:
+					return
+				default:
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, err = os.Stat(filepath.Join(coldDir, "Epoch_0", "Shard_0", "id"))
+	assert.Nil(t, err)
+
+	_ = fhps.Close()
+}
+
+type blockingCompactPersister struct {
+	storage.Persister
+	compactStarted chan struct{}
+	closed         chan struct{}
+	closeOnce      sync.Once
+}
+
+func (bcp *blockingCompactPersister) Compact() error {
+	close(bcp.compactStarted)
+	<-bcp.closed
+
+	return errors.New("closed")
+}
+
+func (bcp *blockingCompactPersister) Close() error {
+	bcp.closeOnce.Do(func() {
+		close(bcp.closed)
+	})
+
+	return bcp.Persister.Close()
+}
+
+func TestFullHistoryPruningStorer_CloseShouldInterruptTheCompaction(t *testing.T) {
+	t.Parallel()
+
+	fhArgs, hotDir, coldDir := getColdStorageArgs(t)
+	defer func() {
+		_ = os.RemoveAll(hotDir)
+		_ = os.RemoveAll(coldDir)
+	}()
+
+	compactStarted := make(chan struct{})
+	fhArgs.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			persister, err := leveldb.NewSerialDB(path, 1, 20, 10)
+			if err != nil {
+				return nil, err
+			}
+
+			return &blockingCompactPersister{
+				Persister:      persister,
+				compactStarted: compactStarted,
+				closed:         make(chan struct{}),
+			}, nil
+		},
+	}
+
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	err = fhps.PutInEpoch([]byte("key"), []byte("value"), 0)
+	require.Nil(t, err)
+
+	_ = fhps.ChangeEpochSimple(1)
+	_ = fhps.ChangeEpochSimple(2)
+	_ = fhps.ChangeEpochSimple(3)
+
+	go fhps.OffloadOldEpochs(3)
+
+	select {
+	case <-compactStarted:
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "the compaction did not start")
+	}
+
+	closeDone := make(chan struct{})
+	go func() {
+		_ = fhps.Close()
+		close(closeDone)
+	}()
+
+	select {
+	case <-closeDone:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "close is blocked by the compaction")
+	}
+
+	_, err = os.Stat(filepath.Join(hotDir, "Epoch_0", "Shard_0", "id"))
+	assert.Nil(t, err)
+}
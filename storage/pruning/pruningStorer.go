@@ -53,13 +53,41 @@ func (pd *persisterData) setIsClosed(closed bool) {
 	pd.Unlock()
 }
 
-// Close closes the underlying persister
+// Close closes the underlying persister, after the reads in progress are done
 func (pd *persisterData) Close() error {
-	pd.setIsClosed(true)
+	pd.Lock()
+	defer pd.Unlock()
+
+	pd.isClosed = true
 	err := pd.persister.Close()
 	return err
 }
 
+// get reads the key holding the persister, so the persister can not be closed during the read
+func (pd *persisterData) get(key []byte) ([]byte, error) {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	if pd.isClosed {
+		return nil, storage.ErrPersisterIsClosed
+	}
+
+	return pd.persister.Get(key)
+}
+
+func (pd *persisterData) getPath() string {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	return pd.path
+}
+
+func (pd *persisterData) setPath(path string) {
+	pd.Lock()
+	pd.path = path
+	pd.Unlock()
+}
+
 func (pd *persisterData) getPersister() storage.Persister {
 	pd.RLock()
 	defer pd.RUnlock()
@@ -328,7 +356,7 @@ func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Pers
 		return pd.getPersister(), noopClose, nil
 	}
 
-	persister, err := ps.persisterFactory.Create(pd.path)
+	persister, err := ps.persisterFactory.Create(pd.getPath())
 	if err != nil {
 		log.Warn("createAndInitPersister()", "error", err.Error())
 		return nil, nil, err
@@ -411,6 +439,52 @@ func (ps *PruningStorer) Close() error {
 	return storage.ErrClosingPersisters
 }
 
+// Compact will compact the active persisters, one at a time. The compaction is done without locking the storer, so
+// the storer remains usable while the compaction is in progress. Persisters closed in the meantime are skipped
+func (ps *PruningStorer) Compact() error {
+	ps.lock.RLock()
+	persisters := make([]*persisterData, len(ps.activePersisters))
+	copy(persisters, ps.activePersisters)
+	ps.lock.RUnlock()
+
+	for _, pd := range persisters {
+		if pd.getIsClosed() {
+			continue
+		}
+
+		compactor, ok := pd.getPersister().(storage.Compactor)
+		if !ok {
+			return storage.ErrCompactionNotSupported
+		}
+
+		err := compactor.Compact()
+		if err != nil && !pd.getIsClosed() {
+			return fmt.Errorf("%w while compacting epoch %d of %s", err, pd.epoch, ps.identifier)
+		}
+	}
+
+	return nil
+}
+
+// RefreshView refreshes the views of the active persisters which read databases written by another process
+func (ps *PruningStorer) RefreshView() {
+	ps.lock.RLock()
+	persisters := make([]*persisterData, len(ps.activePersisters))
+	copy(persisters, ps.activePersisters)
+	ps.lock.RUnlock()
+
+	for _, pd := range persisters {
+		if pd.getIsClosed() {
+			continue
+		}
+
+		refresher, ok := pd.getPersister().(storage.ViewRefresher)
+		if ok {
+			refresher.RefreshView()
+		}
+	}
+}
+
 // GetFromEpoch will search a key only in the persister for the given epoch
 func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	// TODO: this will be used when requesting from resolvers
@@ -924,6 +998,10 @@ func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shard string) (
 	// e.g. determined from directories in persister path or taken from boot storer
 	filePath := createPersisterPathForEpoch(args, epoch, shard)
 
+	return createPersisterDataForPath(args, epoch, filePath)
+}
+
+func createPersisterDataForPath(args *StorerArgs, epoch uint32, filePath string) (*persisterData, error) {
 	db, err := args.PersisterFactory.Create(filePath)
 	if err != nil {
 		log.Warn("persister create error", "error", err.Error())
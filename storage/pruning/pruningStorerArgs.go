@@ -29,4 +29,13 @@ type StorerArgs struct {
 type FullHistoryStorerArgs struct {
 	*StorerArgs
 	NumOfOldActivePersisters uint32
+	ColdStorage              *ColdStorageArgs
+}
+
+// ColdStorageArgs will hold the arguments needed for moving the old epochs of a full history PruningStorer
+// on a secondary path. A nil value disables the cold storage tier
+type ColdStorageArgs struct {
+	Path              string
+	AfterNumEpochs    uint32
+	NumOpenPersisters uint32
 }
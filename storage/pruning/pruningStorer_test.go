@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
@@ -809,3 +811,45 @@ func TestRegex(t *testing.T) {
 		assert.Equal(t, expectedRes, rg.ReplaceAllString(path, replacementEpoch))
 	}
 }
+
+func TestPruningStorer_CompactNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	ps, _ := pruning.NewPruningStorer(args)
+
+	err := ps.Compact()
+	assert.Equal(t, storage.ErrCompactionNotSupported, err)
+}
+
+func TestPruningStorer_CompactShouldCompactTheActivePersisters(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "pruningStorer_compact")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := getDefaultArgs()
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		CreateCalled: func(path string) (storage.Persister, error) {
+			return leveldb.NewDB(filepath.Join(dir, path), 1, 20, 10)
+		},
+	}
+	ps, _ := pruning.NewPruningStorer(args)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	for i := 0; i < 100; i++ {
+		_ = ps.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+
+	err = ps.Compact()
+	assert.Nil(t, err)
+
+	value, err := ps.Get([]byte("key37"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -46,9 +47,10 @@ var log = logger.GetOrCreate("storage/storageUnit")
 // LvlDB currently the only supported DBs
 // More to be added
 const (
-	LvlDB       DBType = "LvlDB"
-	LvlDBSerial DBType = "LvlDBSerial"
-	MemoryDB    DBType = "MemoryDB"
+	LvlDB         DBType = "LvlDB"
+	LvlDBSerial   DBType = "LvlDBSerial"
+	LvlDBReadOnly DBType = "LvlDBReadOnly"
+	MemoryDB      DBType = "MemoryDB"
 )
 
 const (
@@ -97,6 +99,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
 // BloomConfig holds the configurable elements of a bloom filter
@@ -270,6 +273,33 @@ func (u *Unit) DestroyUnit() error {
 	return u.persister.Destroy()
 }
 
+// Compact will compact the underlying persister, if it supports compaction. The compaction is done without locking
+// the unit, so the unit remains usable while the compaction is in progress
+func (u *Unit) Compact() error {
+	u.lock.RLock()
+	persister := u.persister
+	u.lock.RUnlock()
+
+	compactor, ok := persister.(storage.Compactor)
+	if !ok {
+		return storage.ErrCompactionNotSupported
+	}
+
+	return compactor.Compact()
+}
+
+// RefreshView refreshes the view of the persister, if it reads a database written by another process
+func (u *Unit) RefreshView() {
+	u.lock.RLock()
+	persister := u.persister
+	u.lock.RUnlock()
+
+	refresher, ok := persister.(storage.ViewRefresher)
+	if ok {
+		refresher.RefreshView()
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (u *Unit) IsInterfaceNil() bool {
 	return u == nil
@@ -354,6 +384,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
@@ -425,6 +456,7 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
 // NewDB creates a new database from database config
@@ -438,6 +470,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case LvlDBReadOnly:
+			db, err = leveldb.NewReadOnlyDB(argDB.Path, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
@@ -445,7 +479,7 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 		}
 
 		if err == nil {
-			return db, nil
+			return wrapWithCompression(db, argDB)
 		}
 
 		//TODO: extract this in a parameter and inject it
@@ -458,6 +492,19 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 	return db, nil
 }
 
+func wrapWithCompression(db storage.Persister, argDB ArgDB) (storage.Persister, error) {
+	if !compression.IsCompressionEnabled(argDB.Compression) {
+		return db, nil
+	}
+
+	return compression.NewCompressedPersister(compression.ArgsCompressedPersister{
+		Persister:       db,
+		CompressionType: argDB.Compression,
+		Statistics:      compression.NewStatistics(),
+		Path:            argDB.Path,
+	})
+}
+
 // NewBloomFilter creates a new bloom filter from bloom filter config
 func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 	var bf storage.BloomFilter
@@ -740,6 +740,40 @@ func initSUWithBloomFilter(cSize int, bfSize uint) *storageUnit.Unit {
 	return sUnit
 }
 
+func TestStorageUnit_CompactNotSupportedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s := initStorageUnitWithNilBloomFilter(t, 10)
+
+	err := s.Compact()
+	assert.Equal(t, storage.ErrCompactionNotSupported, err)
+}
+
+func TestStorageUnit_CompactShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "leveldb_compact")
+	assert.Nil(t, err)
+	ldb, err := leveldb.NewDB(filepath.Join(dir, "levelDB"), 10, 10, 10)
+	assert.Nil(t, err)
+	cache, _ := lrucache.NewCache(10)
+	s, _ := storageUnit.NewStorageUnit(cache, ldb)
+	defer func() {
+		_ = s.DestroyUnit()
+	}()
+
+	for i := 0; i < 100; i++ {
+		_ = s.Put([]byte(strconv.Itoa(i)), []byte(strconv.Itoa(i)))
+	}
+
+	err = s.Compact()
+	assert.Nil(t, err)
+
+	value, err := s.Get([]byte("37"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("37"), value)
+}
+
 func BenchmarkStorageUnit_PutWithNilBloomFilter(b *testing.B) {
 	b.StopTimer()
 	s := initSUWithNilBloomFilter(1)
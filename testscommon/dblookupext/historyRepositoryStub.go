@@ -9,11 +9,14 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, logs map[string]data.LogHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetEventPointersCalled             func(filter dblookupext.EventsFilter) ([]*dblookupext.EventPointer, error)
+	GetContractHistoryCalled           func(address []byte) ([]*dblookupext.EventPointer, error)
+	GetESDTSupplyCalled                func(token string) (*dblookupext.SupplyESDT, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -24,9 +27,10 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockBody data.BodyHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
+	logs map[string]data.LogHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, logs)
 	}
 	return nil
 }
@@ -67,6 +71,30 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetEventPointers -
+func (hp *HistoryRepositoryStub) GetEventPointers(filter dblookupext.EventsFilter) ([]*dblookupext.EventPointer, error) {
+	if hp.GetEventPointersCalled != nil {
+		return hp.GetEventPointersCalled(filter)
+	}
+	return nil, nil
+}
+
+// GetContractHistory -
+func (hp *HistoryRepositoryStub) GetContractHistory(address []byte) ([]*dblookupext.EventPointer, error) {
+	if hp.GetContractHistoryCalled != nil {
+		return hp.GetContractHistoryCalled(address)
+	}
+	return nil, nil
+}
+
+// GetESDTSupply -
+func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*dblookupext.SupplyESDT, error) {
+	if hp.GetESDTSupplyCalled != nil {
+		return hp.GetESDTSupplyCalled(token)
+	}
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
@@ -0,0 +1,95 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/scheduled"
+)
+
+// ScheduledTxsExecutionStub -
+type ScheduledTxsExecutionStub struct {
+	InitCalled                          func()
+	AddScheduledTxCalled                func(txHash []byte, tx data.TransactionHandler) bool
+	ExecuteCalled                       func(headerHash []byte) error
+	ApplyScheduledResultsCalled         func(headerHash []byte) error
+	GetScheduledSCRsCalled              func() []data.TransactionHandler
+	GetScheduledGasAndFeesCalled        func() scheduled.GasAndFees
+	GetScheduledRootHashCalled          func() []byte
+	GetScheduledRootHashForHeaderCalled func(headerHash []byte) ([]byte, error)
+	RollBackToBlockCalled               func(headerHash []byte) error
+}
+
+// Init -
+func (stes *ScheduledTxsExecutionStub) Init() {
+	if stes.InitCalled != nil {
+		stes.InitCalled()
+	}
+}
+
+// AddScheduledTx -
+func (stes *ScheduledTxsExecutionStub) AddScheduledTx(txHash []byte, tx data.TransactionHandler) bool {
+	if stes.AddScheduledTxCalled != nil {
+		return stes.AddScheduledTxCalled(txHash, tx)
+	}
+	return true
+}
+
+// Execute -
+func (stes *ScheduledTxsExecutionStub) Execute(headerHash []byte) error {
+	if stes.ExecuteCalled != nil {
+		return stes.ExecuteCalled(headerHash)
+	}
+	return nil
+}
+
+// ApplyScheduledResults -
+func (stes *ScheduledTxsExecutionStub) ApplyScheduledResults(headerHash []byte) error {
+	if stes.ApplyScheduledResultsCalled != nil {
+		return stes.ApplyScheduledResultsCalled(headerHash)
+	}
+	return nil
+}
+
+// GetScheduledSCRs -
+func (stes *ScheduledTxsExecutionStub) GetScheduledSCRs() []data.TransactionHandler {
+	if stes.GetScheduledSCRsCalled != nil {
+		return stes.GetScheduledSCRsCalled()
+	}
+	return make([]data.TransactionHandler, 0)
+}
+
+// GetScheduledGasAndFees -
+func (stes *ScheduledTxsExecutionStub) GetScheduledGasAndFees() scheduled.GasAndFees {
+	if stes.GetScheduledGasAndFeesCalled != nil {
+		return stes.GetScheduledGasAndFeesCalled()
+	}
+	return scheduled.NewEmptyGasAndFees()
+}
+
+// GetScheduledRootHash -
+func (stes *ScheduledTxsExecutionStub) GetScheduledRootHash() []byte {
+	if stes.GetScheduledRootHashCalled != nil {
+		return stes.GetScheduledRootHashCalled()
+	}
+	return nil
+}
+
+// GetScheduledRootHashForHeader -
+func (stes *ScheduledTxsExecutionStub) GetScheduledRootHashForHeader(headerHash []byte) ([]byte, error) {
+	if stes.GetScheduledRootHashForHeaderCalled != nil {
+		return stes.GetScheduledRootHashForHeaderCalled(headerHash)
+	}
+	return nil, nil
+}
+
+// RollBackToBlock -
+func (stes *ScheduledTxsExecutionStub) RollBackToBlock(headerHash []byte) error {
+	if stes.RollBackToBlockCalled != nil {
+		return stes.RollBackToBlockCalled(headerHash)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (stes *ScheduledTxsExecutionStub) IsInterfaceNil() bool {
+	return stes == nil
+}
@@ -62,9 +62,11 @@ type ArgsExporter struct {
 	RoundHandler              process.RoundHandler
 	InterceptorDebugConfig    config.InterceptorResolverDebugConfig
 	EnableSignTxWithHashEpoch uint32
+	EnableRelayedTxV3Epoch    uint32
 	MaxHardCapForMissingNodes int
 	NumConcurrentTrieSyncers  int
 	TrieSyncerVersion         int
+	ExportPortableFormat      bool
 }
 
 type exportHandlerFactory struct {
@@ -98,9 +100,11 @@ type exportHandlerFactory struct {
 	roundHandler              process.RoundHandler
 	interceptorDebugConfig    config.InterceptorResolverDebugConfig
 	enableSignTxWithHashEpoch uint32
+	enableRelayedTxV3Epoch    uint32
 	maxHardCapForMissingNodes int
 	numConcurrentTrieSyncers  int
 	trieSyncerVersion         int
+	exportPortableFormat      bool
 }
 
 // NewExportHandlerFactory creates an exporter factory
@@ -244,9 +248,11 @@ func NewExportHandlerFactory(args ArgsExporter) (*exportHandlerFactory, error) {
 		roundHandler:              args.RoundHandler,
 		interceptorDebugConfig:    args.InterceptorDebugConfig,
 		enableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		enableRelayedTxV3Epoch:    args.EnableRelayedTxV3Epoch,
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		numConcurrentTrieSyncers:  args.NumConcurrentTrieSyncers,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		exportPortableFormat:      args.ExportPortableFormat,
 	}
 	log.Debug("exportHandlerFactory: enable epoch for transaction signed with tx hash", "epoch", e.enableSignTxWithHashEpoch)
 
@@ -472,6 +478,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		ValidatorPubKeyConverter: e.CoreComponents.ValidatorPubKeyConverter(),
 		AddressPubKeyConverter:   e.CoreComponents.AddressPubKeyConverter(),
 		GenesisNodesSetupHandler: e.CoreComponents.GenesisNodesSetup(),
+		ExportPortableFormat:     e.exportPortableFormat,
 	}
 	exportHandler, err := genesis.NewStateExporter(argsExporter)
 	if err != nil {
@@ -528,6 +535,7 @@ func (e *exportHandlerFactory) createInterceptors() error {
 		InterceptorsContainer:     e.interceptorsContainer,
 		AntifloodHandler:          e.inputAntifloodHandler,
 		EnableSignTxWithHashEpoch: e.enableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    e.enableRelayedTxV3Epoch,
 	}
 	fullSyncInterceptors, err := NewFullSyncInterceptorsContainerFactory(argsInterceptors)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	syncIntrospectionDisabled "github.com/ElrondNetwork/elrond-go/process/sync/introspection/disabled"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/update"
 	"github.com/ElrondNetwork/elrond-go/update/disabled"
@@ -67,6 +68,7 @@ type ArgsNewFullSyncInterceptorsContainerFactory struct {
 	InterceptorsContainer     process.InterceptorsContainer
 	AntifloodHandler          process.P2PAntifloodHandler
 	EnableSignTxWithHashEpoch uint32
+	EnableRelayedTxV3Epoch    uint32
 }
 
 // NewFullSyncInterceptorsContainerFactory is responsible for creating a new interceptors factory object
@@ -134,6 +136,7 @@ func NewFullSyncInterceptorsContainerFactory(
 		WhiteListerVerifiedTxs:    args.WhiteListerVerifiedTxs,
 		ArgsParser:                smartContract.NewArgumentParser(),
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
+		EnableRelayedTxV3Epoch:    args.EnableRelayedTxV3Epoch,
 	}
 
 	icf := &fullSyncInterceptorsContainerFactory{
@@ -324,9 +327,10 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneShardHeaderIntercepto
 	}
 
 	argProcessor := &processor.ArgHdrInterceptorProcessor{
-		Headers:        ficf.dataPool.Headers(),
-		HdrValidator:   hdrValidator,
-		BlockBlackList: ficf.blockBlackList,
+		Headers:          ficf.dataPool.Headers(),
+		HdrValidator:     hdrValidator,
+		BlockBlackList:   ficf.blockBlackList,
+		SyncIntrospector: syncIntrospectionDisabled.NewSyncIntrospector(),
 	}
 	hdrProcessor, err := processor.NewHdrInterceptorProcessor(argProcessor)
 	if err != nil {
@@ -701,9 +705,10 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateMetachainHeaderInterce
 	}
 
 	argProcessor := &processor.ArgHdrInterceptorProcessor{
-		Headers:        ficf.dataPool.Headers(),
-		HdrValidator:   hdrValidator,
-		BlockBlackList: ficf.blockBlackList,
+		Headers:          ficf.dataPool.Headers(),
+		HdrValidator:     hdrValidator,
+		BlockBlackList:   ficf.blockBlackList,
+		SyncIntrospector: syncIntrospectionDisabled.NewSyncIntrospector(),
 	}
 	hdrProcessor, err := processor.NewHdrInterceptorProcessor(argProcessor)
 	if err != nil {
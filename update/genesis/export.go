@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/update/genesis/portable"
 )
 
 var _ update.ExportHandler = (*stateExport)(nil)
@@ -33,6 +34,17 @@ type ArgsNewStateExporter struct {
 	AddressPubKeyConverter   core.PubkeyConverter
 	ValidatorPubKeyConverter core.PubkeyConverter
 	GenesisNodesSetupHandler update.GenesisNodesSetupHandler
+	ExportPortableFormat     bool
+}
+
+type portableExporter interface {
+	Export(
+		metaBlock *block.MetaBlock,
+		tries []*portable.TrieToExport,
+		miniBlocks map[string]*block.MiniBlock,
+		transactions map[string]data.TransactionHandler,
+	) error
+	IsInterfaceNil() bool
 }
 
 type stateExport struct {
@@ -45,6 +57,7 @@ type stateExport struct {
 	addressPubKeyConverter   core.PubkeyConverter
 	validatorPubKeyConverter core.PubkeyConverter
 	genesisNodesSetupHandler update.GenesisNodesSetupHandler
+	portableExporter         portableExporter
 }
 
 var log = logger.GetOrCreate("update/genesis")
@@ -91,6 +104,18 @@ func NewStateExporter(args ArgsNewStateExporter) (*stateExport, error) {
 		genesisNodesSetupHandler: args.GenesisNodesSetupHandler,
 	}
 
+	if args.ExportPortableFormat {
+		var err error
+		se.portableExporter, err = portable.NewExporter(portable.ArgsExporter{
+			Folder:      filepath.Join(args.ExportFolder, portable.FolderName),
+			Marshalizer: args.Marshalizer,
+			Hasher:      args.Hasher,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return se, nil
 }
 
@@ -131,9 +156,81 @@ func (se *stateExport) ExportAll(epoch uint32) error {
 		return err
 	}
 
+	if !check.IfNil(se.portableExporter) {
+		// the portable format is only used for verifying the export, so an error here does not stop the hardfork
+		errPortable := se.exportPortableFormat()
+		if errPortable != nil {
+			log.Warn("hardfork portable export failed", "error", errPortable)
+		}
+	}
+
+	return nil
+}
+
+func (se *stateExport) exportPortableFormat() error {
+	metaBlock, err := se.stateSyncer.GetEpochStartMetaBlock()
+	if err != nil {
+		return err
+	}
+
+	tries, err := se.stateSyncer.GetAllTries()
+	if err != nil {
+		return err
+	}
+
+	triesToExport := make([]*portable.TrieToExport, 0, len(tries))
+	for key, tr := range tries {
+		accType, shId, errType := GetTrieTypeAndShId(TrieIdentifier + atSep + key)
+		if errType != nil {
+			return errType
+		}
+
+		trieType, errType := portableTrieType(accType)
+		if errType != nil {
+			return errType
+		}
+
+		triesToExport = append(triesToExport, &portable.TrieToExport{
+			ShardID: shId,
+			Type:    trieType,
+			Trie:    tr,
+		})
+	}
+
+	miniBlocks, err := se.stateSyncer.GetAllMiniBlocks()
+	if err != nil {
+		return err
+	}
+
+	transactions, err := se.stateSyncer.GetAllTransactions()
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Starting portable export", "num tries", len(triesToExport))
+	err = se.portableExporter.Export(metaBlock, triesToExport, miniBlocks, transactions)
+	if err != nil {
+		return err
+	}
+
+	log.Info("hardfork portable export written", "folder", filepath.Join(se.exportFolder, portable.FolderName))
+
 	return nil
 }
 
+func portableTrieType(accType Type) (portable.TrieType, error) {
+	switch accType {
+	case UserAccount:
+		return portable.UserAccountsTrie, nil
+	case ValidatorAccount:
+		return portable.ValidatorAccountsTrie, nil
+	case DataTrie:
+		return portable.DataTrie, nil
+	}
+
+	return "", update.ErrUnknownType
+}
+
 func (se *stateExport) exportAllTransactions() error {
 	toExportTransactions, err := se.stateSyncer.GetAllTransactions()
 	if err != nil {
@@ -0,0 +1,100 @@
+package portable
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// FormatVersion is the version of the portable export format
+const FormatVersion = 1
+
+// FolderName is the name of the folder, inside the hardfork export folder, that holds the portable export
+const FolderName = "portable"
+
+// ManifestFileName is the name of the file describing the portable export
+const ManifestFileName = "manifest.json"
+
+// EpochStartMetaBlockFileName is the name of the file holding the json encoded epoch start metablock
+const EpochStartMetaBlockFileName = "epochStartMetaBlock.json"
+
+// MiniBlocksFileName is the name of the json-lines file holding the pending miniblocks
+const MiniBlocksFileName = "miniBlocks.jsonl"
+
+// TransactionsFileName is the name of the json-lines file holding the pending transactions
+const TransactionsFileName = "transactions.jsonl"
+
+// ChecksumAlgorithm is the algorithm used for the files checksums, so they can be checked with common tools
+const ChecksumAlgorithm = "sha256"
+
+// TrieType defines the kind of an exported trie
+type TrieType string
+
+const (
+	// UserAccountsTrie is the main trie holding the user accounts of a shard
+	UserAccountsTrie TrieType = "userAccounts"
+	// ValidatorAccountsTrie is the trie holding the validator accounts, exported only by the metachain
+	ValidatorAccountsTrie TrieType = "validatorAccounts"
+	// DataTrie is the trie holding the key-value storage of a user account
+	DataTrie TrieType = "dataTrie"
+)
+
+// Manifest describes a portable export: the exported epoch start metablock, the root hash and number of leaves of each
+// trie and the checksum of each written file
+type Manifest struct {
+	Version                 uint32          `json:"version"`
+	Epoch                   uint32          `json:"epoch"`
+	EpochStartMetaBlockHash string          `json:"epochStartMetaBlockHash"`
+	EpochStartMetaBlockFile string          `json:"epochStartMetaBlockFile"`
+	ChecksumAlgorithm       string          `json:"checksumAlgorithm"`
+	Tries                   []*TrieManifest `json:"tries"`
+	Files                   []*FileManifest `json:"files"`
+}
+
+// TrieManifest describes an exported trie
+type TrieManifest struct {
+	ShardID   uint32   `json:"shardId"`
+	Type      TrieType `json:"type"`
+	RootHash  string   `json:"rootHash"`
+	NumLeaves uint64   `json:"numLeaves"`
+	FileName  string   `json:"fileName"`
+}
+
+// FileManifest describes a written file
+type FileManifest struct {
+	FileName   string `json:"fileName"`
+	NumRecords uint64 `json:"numRecords"`
+	Checksum   string `json:"checksum"`
+}
+
+// LeafRecord is a json-lines record of a tries file. All the fields are hex encoded. The value is the one stored in the
+// trie: the protobuf encoded account for the accounts tries, the value followed by the key and the account address for
+// the data tries
+type LeafRecord struct {
+	RootHash string `json:"rootHash"`
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+}
+
+// MiniBlockRecord is a json-lines record of the miniblocks file
+type MiniBlockRecord struct {
+	Hash      string      `json:"hash"`
+	MiniBlock interface{} `json:"miniBlock"`
+}
+
+// TransactionRecord is a json-lines record of the transactions file
+type TransactionRecord struct {
+	Hash        string      `json:"hash"`
+	Type        string      `json:"type"`
+	Transaction interface{} `json:"transaction"`
+}
+
+// TriesFileName returns the name of the json-lines file holding the tries of the given type from the given shard
+func TriesFileName(shardID uint32, trieType TrieType) string {
+	return fmt.Sprintf("shard_%s_%s.jsonl", core.GetShardIDString(shardID), trieType)
+}
+
+func encode(buff []byte) string {
+	return hex.EncodeToString(buff)
+}
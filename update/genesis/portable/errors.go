@@ -0,0 +1,21 @@
+package portable
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyFolder signals that an empty folder path was provided
+var ErrEmptyFolder = errors.New("empty folder path")
+
+// ErrNilEpochStartMetaBlock signals that a nil epoch start metablock was provided
+var ErrNilEpochStartMetaBlock = errors.New("nil epoch start metablock")
+
+// ErrUnsupportedVersion signals that the export was written with an unsupported format version
+var ErrUnsupportedVersion = errors.New("unsupported portable export version")
+
+// ErrFileNotInManifest signals that a file is not described in the manifest
+var ErrFileNotInManifest = errors.New("file not found in manifest")
@@ -0,0 +1,303 @@
+package portable
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+// TrieToExport holds a trie together with its shard and type
+type TrieToExport struct {
+	ShardID uint32
+	Type    TrieType
+	Trie    data.Trie
+}
+
+// ArgsExporter defines the arguments needed to create a new portable exporter
+type ArgsExporter struct {
+	Folder      string
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type exporter struct {
+	folder      string
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewExporter creates a new exporter that writes the hardfork data in the portable format
+func NewExporter(args ArgsExporter) (*exporter, error) {
+	if len(args.Folder) == 0 {
+		return nil, ErrEmptyFolder
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &exporter{
+		folder:      args.Folder,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Export writes the epoch start metablock, the tries, the miniblocks and the transactions. The manifest is written last,
+// so an export without a manifest is an incomplete one
+func (e *exporter) Export(
+	metaBlock *block.MetaBlock,
+	tries []*TrieToExport,
+	miniBlocks map[string]*block.MiniBlock,
+	transactions map[string]data.TransactionHandler,
+) error {
+	if metaBlock == nil {
+		return ErrNilEpochStartMetaBlock
+	}
+
+	err := os.MkdirAll(e.folder, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	manifest := &Manifest{
+		Version:                 FormatVersion,
+		Epoch:                   metaBlock.Epoch,
+		EpochStartMetaBlockFile: EpochStartMetaBlockFileName,
+		ChecksumAlgorithm:       ChecksumAlgorithm,
+		Tries:                   make([]*TrieManifest, 0, len(tries)),
+		Files:                   make([]*FileManifest, 0),
+	}
+
+	manifest.EpochStartMetaBlockHash, err = e.exportMetaBlock(metaBlock)
+	if err != nil {
+		return err
+	}
+
+	err = e.exportTries(tries, manifest)
+	if err != nil {
+		return err
+	}
+
+	miniBlocksFile, err := e.exportMiniBlocks(miniBlocks)
+	if err != nil {
+		return err
+	}
+
+	transactionsFile, err := e.exportTransactions(transactions)
+	if err != nil {
+		return err
+	}
+	manifest.Files = append(manifest.Files, miniBlocksFile, transactionsFile)
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(e.folder, ManifestFileName), manifestBytes, 0644)
+}
+
+func (e *exporter) exportMetaBlock(metaBlock *block.MetaBlock) (string, error) {
+	metaBytes, err := e.marshalizer.Marshal(metaBlock)
+	if err != nil {
+		return "", err
+	}
+
+	jsonData, err := json.MarshalIndent(metaBlock, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	err = ioutil.WriteFile(filepath.Join(e.folder, EpochStartMetaBlockFileName), jsonData, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	return encode(e.hasher.Compute(string(metaBytes))), nil
+}
+
+func (e *exporter) exportTries(tries []*TrieToExport, manifest *Manifest) error {
+	type trieWithRootHash struct {
+		*TrieToExport
+		rootHash []byte
+	}
+
+	sortedTries := make([]*trieWithRootHash, 0, len(tries))
+	for _, trieToExport := range tries {
+		rootHash, err := trieToExport.Trie.RootHash()
+		if err != nil {
+			return err
+		}
+
+		sortedTries = append(sortedTries, &trieWithRootHash{TrieToExport: trieToExport, rootHash: rootHash})
+	}
+	sort.Slice(sortedTries, func(i, j int) bool {
+		if sortedTries[i].ShardID != sortedTries[j].ShardID {
+			return sortedTries[i].ShardID < sortedTries[j].ShardID
+		}
+		if sortedTries[i].Type != sortedTries[j].Type {
+			return sortedTries[i].Type < sortedTries[j].Type
+		}
+
+		return bytes.Compare(sortedTries[i].rootHash, sortedTries[j].rootHash) < 0
+	})
+
+	var writer *jsonLinesWriter
+	closeWriter := func() error {
+		if writer == nil {
+			return nil
+		}
+
+		fileManifest, err := writer.close()
+		if err != nil {
+			return err
+		}
+
+		manifest.Files = append(manifest.Files, fileManifest)
+		writer = nil
+		return nil
+	}
+
+	for _, trieToExport := range sortedTries {
+		fileName := TriesFileName(trieToExport.ShardID, trieToExport.Type)
+		if writer == nil || writer.fileName != fileName {
+			err := closeWriter()
+			if err != nil {
+				return err
+			}
+
+			writer, err = newJsonLinesWriter(e.folder, fileName)
+			if err != nil {
+				return err
+			}
+		}
+
+		numLeaves, err := exportLeaves(writer, trieToExport.Trie, trieToExport.rootHash)
+		if err != nil {
+			_, _ = writer.close()
+			return err
+		}
+
+		manifest.Tries = append(manifest.Tries, &TrieManifest{
+			ShardID:   trieToExport.ShardID,
+			Type:      trieToExport.Type,
+			RootHash:  encode(trieToExport.rootHash),
+			NumLeaves: numLeaves,
+			FileName:  fileName,
+		})
+	}
+
+	return closeWriter()
+}
+
+func exportLeaves(writer *jsonLinesWriter, tr data.Trie, rootHash []byte) (uint64, error) {
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash)
+	if err != nil {
+		return 0, err
+	}
+
+	numLeaves := uint64(0)
+	encodedRootHash := encode(rootHash)
+	for leaf := range leavesChannel {
+		if err != nil {
+			// the channel has to be drained so the producer go routine ends
+			continue
+		}
+
+		err = writer.write(&LeafRecord{
+			RootHash: encodedRootHash,
+			Key:      encode(leaf.Key()),
+			Value:    encode(leaf.Value()),
+		})
+		numLeaves++
+	}
+
+	return numLeaves, err
+}
+
+func (e *exporter) exportMiniBlocks(miniBlocks map[string]*block.MiniBlock) (*FileManifest, error) {
+	writer, err := newJsonLinesWriter(e.folder, MiniBlocksFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(miniBlocks))
+	for hash := range miniBlocks {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	for _, hash := range hashes {
+		err = writer.write(&MiniBlockRecord{
+			Hash:      encode([]byte(hash)),
+			MiniBlock: miniBlocks[hash],
+		})
+		if err != nil {
+			_, _ = writer.close()
+			return nil, err
+		}
+	}
+
+	return writer.close()
+}
+
+func (e *exporter) exportTransactions(transactions map[string]data.TransactionHandler) (*FileManifest, error) {
+	writer, err := newJsonLinesWriter(e.folder, TransactionsFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(transactions))
+	for hash := range transactions {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	for _, hash := range hashes {
+		tx := transactions[hash]
+		err = writer.write(&TransactionRecord{
+			Hash:        encode([]byte(hash)),
+			Type:        transactionType(tx),
+			Transaction: tx,
+		})
+		if err != nil {
+			_, _ = writer.close()
+			return nil, err
+		}
+	}
+
+	return writer.close()
+}
+
+func transactionType(tx data.TransactionHandler) string {
+	switch tx.(type) {
+	case *transaction.Transaction:
+		return "normal"
+	case *smartContractResult.SmartContractResult:
+		return "smartContractResult"
+	case *rewardTx.RewardTx:
+		return "reward"
+	default:
+		return "unknown"
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
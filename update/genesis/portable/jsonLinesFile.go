@@ -0,0 +1,85 @@
+package portable
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/json"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// jsonLinesWriter writes one json encoded record per line while computing the checksum of the written file
+type jsonLinesWriter struct {
+	fileName   string
+	file       *os.File
+	buffer     *bufio.Writer
+	checksum   hash.Hash
+	encoder    *json.Encoder
+	numRecords uint64
+}
+
+func newJsonLinesWriter(folder string, fileName string) (*jsonLinesWriter, error) {
+	file, err := os.Create(filepath.Join(folder, fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.New()
+	buffer := bufio.NewWriter(file)
+
+	return &jsonLinesWriter{
+		fileName: fileName,
+		file:     file,
+		buffer:   buffer,
+		checksum: checksum,
+		encoder:  json.NewEncoder(io.MultiWriter(buffer, checksum)),
+	}, nil
+}
+
+func (w *jsonLinesWriter) write(record interface{}) error {
+	err := w.encoder.Encode(record)
+	if err != nil {
+		return err
+	}
+
+	w.numRecords++
+	return nil
+}
+
+// close flushes and closes the file, returning its description for the manifest
+func (w *jsonLinesWriter) close() (*FileManifest, error) {
+	err := w.buffer.Flush()
+	errClose := w.file.Close()
+	if err != nil {
+		return nil, err
+	}
+	if errClose != nil {
+		return nil, errClose
+	}
+
+	return &FileManifest{
+		FileName:   w.fileName,
+		NumRecords: w.numRecords,
+		Checksum:   encode(w.checksum.Sum(nil)),
+	}, nil
+}
+
+func computeFileChecksum(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	checksum := sha256.New()
+	_, err = io.Copy(checksum, file)
+	if err != nil {
+		return "", err
+	}
+
+	return encode(checksum.Sum(nil)), nil
+}
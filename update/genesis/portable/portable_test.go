@@ -0,0 +1,316 @@
+package portable
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/state/storagePruningManager/disabled"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = blake2b.NewBlake2b()
+
+type testExport struct {
+	folder       string
+	metaBlock    *block.MetaBlock
+	tries        []*TrieToExport
+	miniBlocks   map[string]*block.MiniBlock
+	transactions map[string]data.TransactionHandler
+}
+
+func createAccountsDB(t *testing.T, accountFactory state.AccountFactory) *state.AccountsDB {
+	tsm, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+	tr, err := trie.NewTrie(tsm, testMarshalizer, testHasher, maxTrieLevelInMemory)
+	require.Nil(t, err)
+	adb, err := state.NewAccountsDB(tr, testHasher, testMarshalizer, accountFactory, disabled.NewDisabledStoragePruningManager())
+	require.Nil(t, err)
+
+	return adb
+}
+
+func createUserTries(t *testing.T, shardID uint32, numAccounts int) ([]byte, []*TrieToExport) {
+	adb := createAccountsDB(t, stateFactory.NewAccountCreator())
+	for i := 0; i < numAccounts; i++ {
+		acc, err := adb.LoadAccount([]byte(fmt.Sprintf("address_%d_%022d", shardID, i)))
+		require.Nil(t, err)
+
+		userAccount := acc.(state.UserAccountHandler)
+		userAccount.IncreaseNonce(uint64(i + 1))
+		if i%2 == 0 {
+			err = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte(fmt.Sprintf("value%d", i)))
+			require.Nil(t, err)
+		}
+		require.Nil(t, adb.SaveAccount(userAccount))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+	allTries, err := adb.RecreateAllTries(rootHash)
+	require.Nil(t, err)
+
+	tries := make([]*TrieToExport, 0, len(allTries))
+	for hash, tr := range allTries {
+		trieType := DataTrie
+		if hash == string(rootHash) {
+			trieType = UserAccountsTrie
+		}
+		tries = append(tries, &TrieToExport{ShardID: shardID, Type: trieType, Trie: tr})
+	}
+
+	return rootHash, tries
+}
+
+func createValidatorsTrie(t *testing.T) ([]byte, *TrieToExport) {
+	adb := createAccountsDB(t, stateFactory.NewPeerAccountCreator())
+	for i := 0; i < 5; i++ {
+		acc, err := adb.LoadAccount([]byte(fmt.Sprintf("validator%023d", i)))
+		require.Nil(t, err)
+
+		acc.(state.PeerAccountHandler).SetTempRating(uint32(i))
+		require.Nil(t, adb.SaveAccount(acc))
+	}
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+	tr, err := adb.GetTrie(rootHash)
+	require.Nil(t, err)
+
+	return rootHash, &TrieToExport{ShardID: core.MetachainShardId, Type: ValidatorAccountsTrie, Trie: tr}
+}
+
+func createTestExport(t *testing.T) *testExport {
+	folder, err := ioutil.TempDir("", "portable")
+	require.Nil(t, err)
+
+	shardRootHash, shardTries := createUserTries(t, 0, 10)
+	metaRootHash, metaTries := createUserTries(t, core.MetachainShardId, 4)
+	validatorsRootHash, validatorsTrie := createValidatorsTrie(t)
+
+	tries := append(shardTries, metaTries...)
+	tries = append(tries, validatorsTrie)
+
+	return &testExport{
+		folder: folder,
+		metaBlock: &block.MetaBlock{
+			Epoch:                  7,
+			Nonce:                  70,
+			RootHash:               metaRootHash,
+			ValidatorStatsRootHash: validatorsRootHash,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0, RootHash: shardRootHash}},
+			},
+		},
+		tries:      tries,
+		miniBlocks: map[string]*block.MiniBlock{"mb1": {SenderShardID: 0, ReceiverShardID: 1}},
+		transactions: map[string]data.TransactionHandler{
+			"tx1": &transaction.Transaction{Nonce: 1},
+		},
+	}
+}
+
+func (te *testExport) export(t *testing.T) {
+	exp, err := NewExporter(ArgsExporter{
+		Folder:      te.folder,
+		Marshalizer: testMarshalizer,
+		Hasher:      testHasher,
+	})
+	require.Nil(t, err)
+
+	err = exp.Export(te.metaBlock, te.tries, te.miniBlocks, te.transactions)
+	require.Nil(t, err)
+}
+
+func verify(t *testing.T, folder string, expectedMetaHash []byte) *Report {
+	v, err := NewVerifier(ArgsVerifier{
+		Folder:                          folder,
+		Marshalizer:                     testMarshalizer,
+		Hasher:                          testHasher,
+		ExpectedEpochStartMetaBlockHash: expectedMetaHash,
+	})
+	require.Nil(t, err)
+
+	report, err := v.Verify()
+	require.Nil(t, err)
+
+	return report
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	exp, err := NewExporter(ArgsExporter{Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.Nil(t, exp)
+	assert.Equal(t, ErrEmptyFolder, err)
+
+	exp, err = NewExporter(ArgsExporter{Folder: "folder", Hasher: testHasher})
+	assert.Nil(t, exp)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	exp, err = NewExporter(ArgsExporter{Folder: "folder", Marshalizer: testMarshalizer})
+	assert.Nil(t, exp)
+	assert.Equal(t, ErrNilHasher, err)
+
+	exp, err = NewExporter(ArgsExporter{Folder: "folder", Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.Nil(t, err)
+	assert.False(t, exp.IsInterfaceNil())
+}
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	v, err := NewVerifier(ArgsVerifier{Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.Nil(t, v)
+	assert.Equal(t, ErrEmptyFolder, err)
+
+	v, err = NewVerifier(ArgsVerifier{Folder: "folder", Hasher: testHasher})
+	assert.Nil(t, v)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	v, err = NewVerifier(ArgsVerifier{Folder: "folder", Marshalizer: testMarshalizer})
+	assert.Nil(t, v)
+	assert.Equal(t, ErrNilHasher, err)
+
+	v, err = NewVerifier(ArgsVerifier{Folder: "folder", Marshalizer: testMarshalizer, Hasher: testHasher})
+	assert.Nil(t, err)
+	assert.False(t, v.IsInterfaceNil())
+}
+
+func TestExporter_ExportNilMetaBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	exp, _ := NewExporter(ArgsExporter{Folder: "folder", Marshalizer: testMarshalizer, Hasher: testHasher})
+	err := exp.Export(nil, nil, nil, nil)
+	assert.Equal(t, ErrNilEpochStartMetaBlock, err)
+}
+
+func TestExportAndVerify_ValidExport(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	defer func() {
+		_ = os.RemoveAll(te.folder)
+	}()
+	te.export(t)
+
+	metaBytes, _ := testMarshalizer.Marshal(te.metaBlock)
+	metaHash := testHasher.Compute(string(metaBytes))
+
+	report := verify(t, te.folder, metaHash)
+	assert.True(t, report.IsValid(), strings.Join(report.Problems, "\n"))
+	assert.Equal(t, uint32(7), report.Epoch)
+	assert.Equal(t, encode(metaHash), report.EpochStartMetaBlockHash)
+	assert.Equal(t, len(te.tries), report.NumTries)
+	assert.Equal(t, uint64(10+5+4+2+5), report.NumLeaves)
+
+	for _, fileName := range []string{
+		TriesFileName(0, UserAccountsTrie),
+		TriesFileName(0, DataTrie),
+		TriesFileName(core.MetachainShardId, ValidatorAccountsTrie),
+		MiniBlocksFileName,
+		TransactionsFileName,
+	} {
+		_, err := os.Stat(filepath.Join(te.folder, fileName))
+		assert.Nil(t, err, fileName)
+	}
+}
+
+func TestVerify_TamperedLeafShouldReportProblems(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	defer func() {
+		_ = os.RemoveAll(te.folder)
+	}()
+	te.export(t)
+
+	filePath := filepath.Join(te.folder, TriesFileName(0, UserAccountsTrie))
+	content, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	lines := strings.Split(string(content), "\n")
+	record := lines[0]
+	lastChar := record[len(record)-3]
+	newChar := "0"
+	if lastChar == '0' {
+		newChar = "1"
+	}
+	lines[0] = record[:len(record)-3] + newChar + record[len(record)-2:]
+	require.Nil(t, ioutil.WriteFile(filePath, []byte(strings.Join(lines, "\n")), 0644))
+
+	report := verify(t, te.folder, nil)
+	assert.False(t, report.IsValid())
+	assert.True(t, containsProblem(report, "checksum"))
+	assert.True(t, containsProblem(report, "rebuilt trie has root hash"))
+	assert.True(t, containsProblem(report, "from the epoch start metablock was not rebuilt"))
+}
+
+func TestVerify_WrongMetaBlockShouldReportProblems(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	defer func() {
+		_ = os.RemoveAll(te.folder)
+	}()
+	te.metaBlock.ValidatorStatsRootHash = []byte("another root hash")
+	te.export(t)
+
+	report := verify(t, te.folder, []byte("expected hash"))
+	assert.False(t, report.IsValid())
+	assert.True(t, containsProblem(report, "does not match the expected hash"))
+	assert.True(t, containsProblem(report, "the validatorAccounts trie of shard 4294967295"))
+}
+
+func TestVerify_MissingDataTrieShouldReportProblem(t *testing.T) {
+	t.Parallel()
+
+	te := createTestExport(t)
+	defer func() {
+		_ = os.RemoveAll(te.folder)
+	}()
+	for i, tr := range te.tries {
+		if tr.Type == DataTrie {
+			te.tries = append(te.tries[:i], te.tries[i+1:]...)
+			break
+		}
+	}
+	te.export(t)
+
+	report := verify(t, te.folder, nil)
+	require.Equal(t, 1, len(report.Problems))
+	assert.True(t, containsProblem(report, "referenced by an account"))
+}
+
+func TestVerify_MissingManifestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	v, _ := NewVerifier(ArgsVerifier{Folder: "missing folder", Marshalizer: testMarshalizer, Hasher: testHasher})
+	report, err := v.Verify()
+	assert.Nil(t, report)
+	assert.NotNil(t, err)
+}
+
+func containsProblem(report *Report, text string) bool {
+	for _, problem := range report.Problems {
+		if strings.Contains(problem, text) {
+			return true
+		}
+	}
+
+	return false
+}
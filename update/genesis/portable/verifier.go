@@ -0,0 +1,449 @@
+package portable
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+)
+
+const maxTrieLevelInMemory = 5
+const maxLineSize = 64 * 1024 * 1024
+
+// ArgsVerifier defines the arguments needed to create a new portable export verifier. The marshalizer and the hasher
+// have to be the ones used by the chain, as they define the trie nodes hashes and the metablock hash
+type ArgsVerifier struct {
+	Folder                          string
+	Marshalizer                     marshal.Marshalizer
+	Hasher                          hashing.Hasher
+	ExpectedEpochStartMetaBlockHash []byte
+}
+
+// Report holds the result of a portable export verification
+type Report struct {
+	Epoch                   uint32
+	EpochStartMetaBlockHash string
+	NumTries                int
+	NumLeaves               uint64
+	Problems                []string
+}
+
+// IsValid returns true if no problem was found
+func (r *Report) IsValid() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *Report) addProblem(format string, args ...interface{}) {
+	r.Problems = append(r.Problems, fmt.Sprintf(format, args...))
+}
+
+type trieKey struct {
+	shardID  uint32
+	trieType TrieType
+}
+
+type verifier struct {
+	folder              string
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	expectedMetaHash    []byte
+	report              *Report
+	computedRoots       map[trieKey]map[string]uint64
+	referencedDataTries map[uint32]map[string]struct{}
+}
+
+// NewVerifier creates a new verifier able to check a portable export offline
+func NewVerifier(args ArgsVerifier) (*verifier, error) {
+	if len(args.Folder) == 0 {
+		return nil, ErrEmptyFolder
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &verifier{
+		folder:           args.Folder,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		expectedMetaHash: args.ExpectedEpochStartMetaBlockHash,
+	}, nil
+}
+
+// Verify checks the files checksums, rebuilds every exported trie comparing the obtained root hashes with the ones from
+// the manifest and checks these root hashes against the exported epoch start metablock. The returned error signals
+// that the verification could not be done, while the problems found are listed in the report
+func (v *verifier) Verify() (*Report, error) {
+	v.report = &Report{Problems: make([]string, 0)}
+	v.computedRoots = make(map[trieKey]map[string]uint64)
+	v.referencedDataTries = make(map[uint32]map[string]struct{})
+
+	manifest, err := v.readManifest()
+	if err != nil {
+		return nil, err
+	}
+	v.report.Epoch = manifest.Epoch
+	v.report.NumTries = len(manifest.Tries)
+
+	metaBlock, err := v.verifyEpochStartMetaBlock(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	trieFiles := make(map[string]trieKey)
+	for _, trieManifest := range manifest.Tries {
+		trieFiles[trieManifest.FileName] = trieKey{shardID: trieManifest.ShardID, trieType: trieManifest.Type}
+	}
+	for _, fileManifest := range manifest.Files {
+		v.verifyFile(fileManifest, trieFiles)
+	}
+	for fileName := range trieFiles {
+		if !isFileInManifest(manifest, fileName) {
+			v.report.addProblem("%s: %v", fileName, ErrFileNotInManifest)
+		}
+	}
+
+	v.verifyManifestTries(manifest)
+	v.verifyRootHashesAgainstMetaBlock(metaBlock)
+	v.verifyDataTriesReferences()
+
+	return v.report, nil
+}
+
+func (v *verifier) readManifest() (*Manifest, error) {
+	manifestBytes, err := ioutil.ReadFile(filepath.Join(v.folder, ManifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
+
+func (v *verifier) verifyEpochStartMetaBlock(manifest *Manifest) (*block.MetaBlock, error) {
+	jsonData, err := ioutil.ReadFile(filepath.Join(v.folder, manifest.EpochStartMetaBlockFile))
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = json.Unmarshal(jsonData, metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBytes, err := v.marshalizer.Marshal(metaBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	metaHash := v.hasher.Compute(string(metaBytes))
+	v.report.EpochStartMetaBlockHash = encode(metaHash)
+	if v.report.EpochStartMetaBlockHash != manifest.EpochStartMetaBlockHash {
+		v.report.addProblem("epoch start metablock hash %s does not match the manifest hash %s",
+			v.report.EpochStartMetaBlockHash, manifest.EpochStartMetaBlockHash)
+	}
+	if len(v.expectedMetaHash) > 0 && !bytes.Equal(v.expectedMetaHash, metaHash) {
+		v.report.addProblem("epoch start metablock hash %s does not match the expected hash %s",
+			v.report.EpochStartMetaBlockHash, encode(v.expectedMetaHash))
+	}
+	if metaBlock.Epoch != manifest.Epoch {
+		v.report.addProblem("epoch start metablock epoch %d does not match the manifest epoch %d", metaBlock.Epoch, manifest.Epoch)
+	}
+
+	return metaBlock, nil
+}
+
+func (v *verifier) verifyFile(fileManifest *FileManifest, trieFiles map[string]trieKey) {
+	file, err := os.Open(filepath.Join(v.folder, fileManifest.FileName))
+	if err != nil {
+		v.report.addProblem("%s: %v", fileManifest.FileName, err)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	checksum := sha256.New()
+	scanner := bufio.NewScanner(io.TeeReader(file, checksum))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	key, isTrieFile := trieFiles[fileManifest.FileName]
+	builder := newTriesBuilder(v, fileManifest.FileName, key)
+	numRecords := uint64(0)
+	for scanner.Scan() {
+		numRecords++
+		if !isTrieFile {
+			continue
+		}
+
+		errRecord := builder.addRecord(scanner.Bytes())
+		if errRecord != nil {
+			v.report.addProblem("%s, record %d: %v", fileManifest.FileName, numRecords, errRecord)
+			return
+		}
+	}
+	if scanner.Err() != nil {
+		v.report.addProblem("%s: %v", fileManifest.FileName, scanner.Err())
+		return
+	}
+	builder.finish()
+
+	computedChecksum := encode(checksum.Sum(nil))
+	if computedChecksum != fileManifest.Checksum {
+		v.report.addProblem("%s: checksum %s does not match the manifest checksum %s",
+			fileManifest.FileName, computedChecksum, fileManifest.Checksum)
+	}
+	if numRecords != fileManifest.NumRecords {
+		v.report.addProblem("%s: has %d records, manifest has %d", fileManifest.FileName, numRecords, fileManifest.NumRecords)
+	}
+}
+
+func (v *verifier) verifyManifestTries(manifest *Manifest) {
+	emptyRootHash := encode(trie.EmptyTrieHash)
+	manifestRoots := make(map[trieKey]map[string]struct{})
+	for _, trieManifest := range manifest.Tries {
+		key := trieKey{shardID: trieManifest.ShardID, trieType: trieManifest.Type}
+		if manifestRoots[key] == nil {
+			manifestRoots[key] = make(map[string]struct{})
+		}
+		manifestRoots[key][trieManifest.RootHash] = struct{}{}
+
+		if trieManifest.NumLeaves == 0 && trieManifest.RootHash == emptyRootHash {
+			v.setComputedRoot(key, emptyRootHash, 0)
+			continue
+		}
+
+		numLeaves, ok := v.computedRoots[key][trieManifest.RootHash]
+		if !ok {
+			v.report.addProblem("trie %s of type %s from shard %d could not be rebuilt from %s",
+				trieManifest.RootHash, trieManifest.Type, trieManifest.ShardID, trieManifest.FileName)
+			continue
+		}
+		if numLeaves != trieManifest.NumLeaves {
+			v.report.addProblem("trie %s of type %s from shard %d has %d leaves, manifest has %d",
+				trieManifest.RootHash, trieManifest.Type, trieManifest.ShardID, numLeaves, trieManifest.NumLeaves)
+		}
+	}
+
+	for key, roots := range v.computedRoots {
+		for rootHash := range roots {
+			_, ok := manifestRoots[key][rootHash]
+			if !ok {
+				v.report.addProblem("trie %s of type %s from shard %d is not described in the manifest",
+					rootHash, key.trieType, key.shardID)
+			}
+		}
+	}
+}
+
+func (v *verifier) verifyRootHashesAgainstMetaBlock(metaBlock *block.MetaBlock) {
+	v.checkMetaBlockRootHash(core.MetachainShardId, UserAccountsTrie, metaBlock.RootHash)
+	v.checkMetaBlockRootHash(core.MetachainShardId, ValidatorAccountsTrie, metaBlock.ValidatorStatsRootHash)
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		v.checkMetaBlockRootHash(shardData.ShardID, UserAccountsTrie, shardData.RootHash)
+	}
+}
+
+func (v *verifier) checkMetaBlockRootHash(shardID uint32, trieType TrieType, rootHash []byte) {
+	key := trieKey{shardID: shardID, trieType: trieType}
+	encodedRootHash := encode(rootHash)
+	_, ok := v.computedRoots[key][encodedRootHash]
+	if ok {
+		if len(v.computedRoots[key]) > 1 {
+			v.report.addProblem("shard %d has more than one %s trie", shardID, trieType)
+		}
+		return
+	}
+
+	v.report.addProblem("the %s trie of shard %d with root hash %s from the epoch start metablock was not rebuilt",
+		trieType, shardID, encodedRootHash)
+}
+
+func (v *verifier) verifyDataTriesReferences() {
+	for shardID, referenced := range v.referencedDataTries {
+		computed := v.computedRoots[trieKey{shardID: shardID, trieType: DataTrie}]
+		for rootHash := range referenced {
+			_, ok := computed[rootHash]
+			if !ok {
+				v.report.addProblem("data trie %s referenced by an account from shard %d was not rebuilt", rootHash, shardID)
+			}
+		}
+	}
+
+	for key, computed := range v.computedRoots {
+		if key.trieType != DataTrie {
+			continue
+		}
+
+		for rootHash := range computed {
+			_, ok := v.referencedDataTries[key.shardID][rootHash]
+			if !ok {
+				v.report.addProblem("data trie %s from shard %d is not referenced by any account", rootHash, key.shardID)
+			}
+		}
+	}
+}
+
+func (v *verifier) setComputedRoot(key trieKey, rootHash string, numLeaves uint64) {
+	if v.computedRoots[key] == nil {
+		v.computedRoots[key] = make(map[string]uint64)
+	}
+	v.computedRoots[key][rootHash] = numLeaves
+}
+
+func (v *verifier) addDataTrieReference(shardID uint32, value []byte) {
+	account := &state.UserAccountData{}
+	err := v.marshalizer.Unmarshal(account, value)
+	if err != nil || len(account.RootHash) == 0 || bytes.Equal(account.RootHash, trie.EmptyTrieHash) {
+		// not all the leaves of the accounts trie are accounts
+		return
+	}
+
+	if v.referencedDataTries[shardID] == nil {
+		v.referencedDataTries[shardID] = make(map[string]struct{})
+	}
+	v.referencedDataTries[shardID][encode(account.RootHash)] = struct{}{}
+}
+
+func (v *verifier) newTrie() (data.Trie, error) {
+	tsm, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.NewTrie(tsm, v.marshalizer, v.hasher, maxTrieLevelInMemory)
+}
+
+func isFileInManifest(manifest *Manifest, fileName string) bool {
+	for _, fileManifest := range manifest.Files {
+		if fileManifest.FileName == fileName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (v *verifier) IsInterfaceNil() bool {
+	return v == nil
+}
+
+// triesBuilder rebuilds the tries of a json-lines file, whose records are grouped by trie
+type triesBuilder struct {
+	verifier  *verifier
+	fileName  string
+	key       trieKey
+	rootHash  string
+	tr        data.Trie
+	numLeaves uint64
+	seen      map[string]struct{}
+}
+
+func newTriesBuilder(v *verifier, fileName string, key trieKey) *triesBuilder {
+	return &triesBuilder{
+		verifier: v,
+		fileName: fileName,
+		key:      key,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (tb *triesBuilder) addRecord(line []byte) error {
+	record := &LeafRecord{}
+	err := json.Unmarshal(line, record)
+	if err != nil {
+		return err
+	}
+
+	if record.RootHash != tb.rootHash {
+		tb.finish()
+
+		_, alreadySeen := tb.seen[record.RootHash]
+		if alreadySeen {
+			return fmt.Errorf("the records of trie %s are not contiguous", record.RootHash)
+		}
+		tb.seen[record.RootHash] = struct{}{}
+
+		tb.tr, err = tb.verifier.newTrie()
+		if err != nil {
+			return err
+		}
+		tb.rootHash = record.RootHash
+		tb.numLeaves = 0
+	}
+
+	key, err := hex.DecodeString(record.Key)
+	if err != nil {
+		return err
+	}
+	value, err := hex.DecodeString(record.Value)
+	if err != nil {
+		return err
+	}
+
+	err = tb.tr.Update(key, value)
+	if err != nil {
+		return err
+	}
+	tb.numLeaves++
+
+	if tb.key.trieType == UserAccountsTrie {
+		tb.verifier.addDataTrieReference(tb.key.shardID, value)
+	}
+
+	return nil
+}
+
+func (tb *triesBuilder) finish() {
+	if check.IfNil(tb.tr) {
+		return
+	}
+
+	defer func() {
+		tb.tr = nil
+	}()
+
+	rootHash, err := tb.tr.RootHash()
+	if err != nil {
+		tb.verifier.report.addProblem("%s: trie %s: %v", tb.fileName, tb.rootHash, err)
+		return
+	}
+
+	computedRootHash := encode(rootHash)
+	if computedRootHash != tb.rootHash {
+		tb.verifier.report.addProblem("%s: rebuilt trie has root hash %s, exported root hash is %s",
+			tb.fileName, computedRootHash, tb.rootHash)
+		return
+	}
+
+	tb.verifier.setComputedRoot(tb.key, computedRootHash, tb.numLeaves)
+	tb.verifier.report.NumLeaves += tb.numLeaves
+}
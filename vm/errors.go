@@ -247,3 +247,15 @@ var ErrProposalNotFound = errors.New("proposal was not found in storage")
 
 // ErrInvalidNumOfInitialWhiteListedAddress signals that 0 initial whiteListed addresses were provided to the governance contract
 var ErrInvalidNumOfInitialWhiteListedAddress = errors.New("0 initial whiteListed addresses provided to the governance contract")
+
+// ErrCannotRedeemLiquidPosition signals that the burnt liquid staking tokens could not be converted in a delegation position
+var ErrCannotRedeemLiquidPosition = errors.New("cannot redeem liquid delegation position")
+
+// ErrInvalidLiquidStakingAmount signals that the amount of liquid staking tokens to redeem is zero or bigger than the supply
+var ErrInvalidLiquidStakingAmount = errors.New("invalid amount to redeem")
+
+// ErrLiquidStakingNotEnabled signals that liquid staking was not enabled for the delegation contract
+var ErrLiquidStakingNotEnabled = errors.New("liquid staking is not enabled")
+
+// ErrInvalidParamChange signals that the proposed network parameter change is not supported or has an invalid value
+var ErrInvalidParamChange = errors.New("invalid network parameter change")
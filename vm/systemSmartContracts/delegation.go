@@ -64,6 +64,13 @@ type delegation struct {
 	validatorToDelegationEnableEpoch   uint32
 	flagReDelegateBelowMinCheck        atomic.Flag
 	reDelegateBelowMinCheckEnableEpoch uint32
+	flagLiquidStaking                  atomic.Flag
+	liquidStakingEnableEpoch           uint32
+	flagUnDelegateQueue                atomic.Flag
+	unDelegateQueueEnableEpoch         uint32
+	flagAutoCompounding                atomic.Flag
+	autoCompoundingEnableEpoch         uint32
+	maxAutoCompoundingPerEpoch         uint64
 }
 
 // ArgsNewDelegation defines the arguments to create the delegation smart contract
@@ -135,11 +142,18 @@ func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
 		stakingV2Enabled:                   atomic.Flag{},
 		validatorToDelegationEnableEpoch:   args.EpochConfig.EnableEpochs.ValidatorToDelegationEnableEpoch,
 		reDelegateBelowMinCheckEnableEpoch: args.EpochConfig.EnableEpochs.ReDelegateBelowMinCheckEnableEpoch,
+		liquidStakingEnableEpoch:           args.EpochConfig.EnableEpochs.LiquidStakingEnableEpoch,
+		unDelegateQueueEnableEpoch:         args.EpochConfig.EnableEpochs.UnDelegateQueueEnableEpoch,
+		autoCompoundingEnableEpoch:         args.EpochConfig.EnableEpochs.DelegationAutoCompoundingEnableEpoch,
+		maxAutoCompoundingPerEpoch:         maxAutoCompoundingPerEpoch,
 	}
 	log.Debug("delegation: enable epoch for delegation smart contract", "epoch", d.enableDelegationEpoch)
 	log.Debug("delegation: enable epoch for staking v2", "epoch", d.stakingV2EnableEpoch)
 	log.Debug("delegation: enable epoch for validator to delegation", "epoch", d.validatorToDelegationEnableEpoch)
 	log.Debug("delegation: enable epoch for re-delegate below minimum check", "epoch", d.reDelegateBelowMinCheckEnableEpoch)
+	log.Debug("delegation: enable epoch for liquid staking", "epoch", d.liquidStakingEnableEpoch)
+	log.Debug("delegation: enable epoch for unDelegate queue", "epoch", d.unDelegateQueueEnableEpoch)
+	log.Debug("delegation: enable epoch for auto-compounding", "epoch", d.autoCompoundingEnableEpoch)
 
 	var okValue bool
 
@@ -265,6 +279,20 @@ func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCo
 		return d.setMetaData(args)
 	case "getMetaData":
 		return d.getMetaData(args)
+	case "enableLiquidStaking":
+		return d.enableLiquidStaking(args)
+	case redeemLiquidPositionFunction:
+		return d.redeemLiquidPosition(args)
+	case core.BuiltInFunctionESDTTransfer:
+		return d.executeWithLiquidToken(args)
+	case "getLiquidStakingData":
+		return d.getLiquidStakingData(args)
+	case "getUnDelegateQueuePosition":
+		return d.getUnDelegateQueuePosition(args)
+	case "setAutoCompounding":
+		return d.setAutoCompounding(args)
+	case "getAutoCompoundingStatus":
+		return d.getAutoCompoundingStatus(args)
 	}
 
 	d.eei.AddReturnMessage(args.Function + " is an unknown function")
@@ -1482,13 +1510,23 @@ func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return vmcommon.OutOfGas
 	}
 
+	matchedValue, returnCode := d.matchUnDelegateQueue(args.RecipientAddr, args.CallValue)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	valueToStake := big.NewInt(0).Sub(args.CallValue, matchedValue)
+
 	dStatus, err := d.getDelegationStatus()
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
 
-	return d.delegateUser(args.CallValue, args.CallValue, args.CallerAddr, args.RecipientAddr, dStatus)
+	if d.isLiquidStakingDelegation(args.CallerAddr) {
+		return d.delegateLiquid(args, valueToStake, dStatus)
+	}
+
+	return d.delegateUser(args.CallValue, valueToStake, args.CallerAddr, args.RecipientAddr, dStatus)
 }
 
 func (d *delegation) addValueToFund(key []byte, value *big.Int) error {
@@ -1645,12 +1683,21 @@ func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.Retur
 		return vmcommon.UserError
 	}
 
+	numUnStakedFunds := len(delegator.UnStakedFunds)
 	err = d.addNewUnStakedFund(args.CallerAddr, delegator, actualUserUnStake)
 	if err != nil {
 		d.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
 
+	if d.flagUnDelegateQueue.IsSet() && len(delegator.UnStakedFunds) > numUnStakedFunds {
+		err = d.addToUnDelegateQueue(delegator.UnStakedFunds[len(delegator.UnStakedFunds)-1])
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
 	globalFund.TotalActive.Sub(globalFund.TotalActive, actualUserUnStake)
 	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, actualUserUnStake)
 
@@ -1733,7 +1780,7 @@ func (d *delegation) updateRewards(args *vmcommon.ContractCallInput) vmcommon.Re
 		return vmcommon.UserError
 	}
 
-	return vmcommon.Ok
+	return d.compoundRewards(args.RecipientAddr)
 }
 
 func (d *delegation) getRewardData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
@@ -2842,6 +2889,15 @@ func (d *delegation) EpochConfirmed(epoch uint32, _ uint64) {
 
 	d.flagReDelegateBelowMinCheck.Toggle(epoch >= d.reDelegateBelowMinCheckEnableEpoch)
 	log.Debug("delegationSC: re-delegate below minimum check", "enabled", d.flagReDelegateBelowMinCheck.IsSet())
+
+	d.flagLiquidStaking.Toggle(epoch >= d.liquidStakingEnableEpoch)
+	log.Debug("delegationSC: liquid staking", "enabled", d.flagLiquidStaking.IsSet())
+
+	d.flagUnDelegateQueue.Toggle(epoch >= d.unDelegateQueueEnableEpoch)
+	log.Debug("delegationSC: unDelegate queue", "enabled", d.flagUnDelegateQueue.IsSet())
+
+	d.flagAutoCompounding.Toggle(epoch >= d.autoCompoundingEnableEpoch)
+	log.Debug("delegationSC: auto-compounding", "enabled", d.flagAutoCompounding.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
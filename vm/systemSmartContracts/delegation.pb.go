@@ -634,6 +634,147 @@ func (m *RewardComputationData) GetServiceFee() uint64 {
 	return 0
 }
 
+type LiquidStakingData struct {
+	Token       []byte        `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token"`
+	TotalShares *math_big.Int `protobuf:"bytes,2,opt,name=TotalShares,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalShares"`
+}
+
+func (m *LiquidStakingData) Reset()      { *m = LiquidStakingData{} }
+func (*LiquidStakingData) ProtoMessage() {}
+func (*LiquidStakingData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{10}
+}
+func (m *LiquidStakingData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidStakingData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LiquidStakingData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidStakingData.Merge(m, src)
+}
+func (m *LiquidStakingData) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidStakingData) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidStakingData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidStakingData proto.InternalMessageInfo
+
+func (m *LiquidStakingData) GetToken() []byte {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *LiquidStakingData) GetTotalShares() *math_big.Int {
+	if m != nil {
+		return m.TotalShares
+	}
+	return nil
+}
+
+type UnDelegateQueue struct {
+	Head uint64 `protobuf:"varint,1,opt,name=Head,proto3" json:"Head"`
+	Tail uint64 `protobuf:"varint,2,opt,name=Tail,proto3" json:"Tail"`
+}
+
+func (m *UnDelegateQueue) Reset()      { *m = UnDelegateQueue{} }
+func (*UnDelegateQueue) ProtoMessage() {}
+func (*UnDelegateQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{11}
+}
+func (m *UnDelegateQueue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnDelegateQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UnDelegateQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnDelegateQueue.Merge(m, src)
+}
+func (m *UnDelegateQueue) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnDelegateQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnDelegateQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnDelegateQueue proto.InternalMessageInfo
+
+func (m *UnDelegateQueue) GetHead() uint64 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *UnDelegateQueue) GetTail() uint64 {
+	if m != nil {
+		return m.Tail
+	}
+	return 0
+}
+
+type AutoCompoundingList struct {
+	Length uint64 `protobuf:"varint,1,opt,name=Length,proto3" json:"Length"`
+	Cursor uint64 `protobuf:"varint,2,opt,name=Cursor,proto3" json:"Cursor"`
+}
+
+func (m *AutoCompoundingList) Reset()      { *m = AutoCompoundingList{} }
+func (*AutoCompoundingList) ProtoMessage() {}
+func (*AutoCompoundingList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{12}
+}
+func (m *AutoCompoundingList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundingList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoCompoundingList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundingList.Merge(m, src)
+}
+func (m *AutoCompoundingList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundingList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundingList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundingList proto.InternalMessageInfo
+
+func (m *AutoCompoundingList) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *AutoCompoundingList) GetCursor() uint64 {
+	if m != nil {
+		return m.Cursor
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DelegationManagement)(nil), "proto.DelegationManagement")
 	proto.RegisterType((*DelegationContractList)(nil), "proto.DelegationContractList")
@@ -645,84 +786,94 @@ func init() {
 	proto.RegisterType((*GlobalFundData)(nil), "proto.GlobalFundData")
 	proto.RegisterType((*NodesData)(nil), "proto.NodesData")
 	proto.RegisterType((*RewardComputationData)(nil), "proto.RewardComputationData")
+	proto.RegisterType((*LiquidStakingData)(nil), "proto.LiquidStakingData")
+	proto.RegisterType((*UnDelegateQueue)(nil), "proto.UnDelegateQueue")
+	proto.RegisterType((*AutoCompoundingList)(nil), "proto.AutoCompoundingList")
 }
 
 func init() { proto.RegisterFile("delegation.proto", fileDescriptor_b823c7d67e95582e) }
 
 var fileDescriptor_b823c7d67e95582e = []byte{
-	// 1140 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0xe3, 0xc6,
-	0x17, 0xb7, 0x1c, 0x67, 0x37, 0xfb, 0x62, 0xe7, 0x9b, 0xcc, 0x26, 0xdf, 0x9a, 0xb6, 0x48, 0xc1,
-	0x50, 0x08, 0x94, 0x75, 0xe8, 0x0f, 0x28, 0xb4, 0x97, 0x46, 0x4e, 0x52, 0xcc, 0x26, 0x4e, 0x19,
-	0x27, 0x5b, 0xba, 0x2c, 0x85, 0xb1, 0x35, 0x51, 0x86, 0x58, 0x33, 0x46, 0x1a, 0x25, 0x1b, 0xe8,
-	0xa1, 0x97, 0x96, 0xf6, 0x52, 0x7a, 0xe8, 0xa5, 0x7f, 0x40, 0xa1, 0xf4, 0x2f, 0xe9, 0x31, 0xf4,
-	0x94, 0x43, 0x51, 0x1b, 0xe7, 0x52, 0x74, 0xda, 0x7f, 0xa0, 0x50, 0x34, 0x92, 0x6c, 0xc9, 0xd6,
-	0xee, 0xc9, 0xf4, 0x22, 0xbd, 0xf7, 0x79, 0xa3, 0xa7, 0x37, 0xf3, 0x79, 0xef, 0xcd, 0x83, 0x55,
-	0x8b, 0x0e, 0xa8, 0x4d, 0x24, 0x13, 0xbc, 0x39, 0x74, 0x85, 0x14, 0x68, 0x51, 0xbd, 0x5e, 0x7f,
-	0x64, 0x33, 0x79, 0xe6, 0xf7, 0x9a, 0x7d, 0xe1, 0x6c, 0xdb, 0xc2, 0x16, 0xdb, 0x0a, 0xee, 0xf9,
-	0xa7, 0x4a, 0x53, 0x8a, 0x92, 0xe2, 0xaf, 0x1a, 0xff, 0x2c, 0xc0, 0xfa, 0xee, 0xd8, 0xd5, 0x21,
-	0xe1, 0xc4, 0xa6, 0x0e, 0xe5, 0x12, 0x7d, 0x08, 0x2b, 0x1d, 0xdf, 0x39, 0x3a, 0x6d, 0x09, 0x2e,
-	0x5d, 0xd2, 0x97, 0x5e, 0x5d, 0xdb, 0xd4, 0xb6, 0x6a, 0x26, 0x0a, 0x03, 0x63, 0xca, 0x82, 0xa7,
-	0x74, 0xf4, 0x0e, 0x2c, 0x1f, 0x10, 0x4f, 0xee, 0x58, 0x96, 0x4b, 0x3d, 0xaf, 0x5e, 0xde, 0xd4,
-	0xb6, 0xaa, 0xe6, 0xff, 0xc2, 0xc0, 0xc8, 0xc2, 0x38, 0xab, 0xa0, 0x0f, 0xa0, 0x76, 0xc8, 0x78,
-	0x97, 0xba, 0x17, 0xac, 0x4f, 0xf7, 0x29, 0xad, 0x2f, 0x6c, 0x6a, 0x5b, 0x15, 0x73, 0x2d, 0x0c,
-	0x8c, 0xbc, 0x01, 0xe7, 0x55, 0xf5, 0x21, 0x79, 0x9e, 0xf9, 0xb0, 0x92, 0xf9, 0x30, 0x6b, 0xc0,
-	0x79, 0x15, 0x79, 0x00, 0x87, 0x8c, 0xef, 0xd2, 0xa1, 0xf0, 0x98, 0xac, 0x2f, 0xaa, 0x18, 0xbb,
-	0x61, 0x60, 0x64, 0xd0, 0x5f, 0xff, 0x34, 0x76, 0x1c, 0x22, 0xcf, 0xb6, 0x7b, 0xcc, 0x6e, 0xb6,
-	0xb9, 0xfc, 0x28, 0x73, 0xb6, 0x7b, 0x03, 0x57, 0x70, 0xab, 0x43, 0xe5, 0xa5, 0x70, 0xcf, 0xb7,
-	0xa9, 0xd2, 0x1e, 0xd9, 0x62, 0xdb, 0x22, 0x92, 0x34, 0x4d, 0x66, 0xb7, 0xb9, 0x6c, 0x11, 0x4f,
-	0x52, 0x17, 0x67, 0x1c, 0xa2, 0xef, 0x35, 0x78, 0xa8, 0xd4, 0xf4, 0xc4, 0x77, 0x1c, 0xe1, 0x73,
-	0x59, 0xbf, 0xa7, 0x7e, 0xff, 0x2c, 0x0c, 0x8c, 0x22, 0xf3, 0x7c, 0xe2, 0x28, 0xf2, 0xdc, 0xd8,
-	0x83, 0xff, 0x4f, 0xb0, 0x94, 0xc1, 0x03, 0xe6, 0x49, 0xf4, 0x36, 0x3c, 0x48, 0xc8, 0xa1, 0x11,
-	0xf7, 0x0b, 0x5b, 0x55, 0xb3, 0x16, 0x06, 0xc6, 0x04, 0xc4, 0x13, 0xb1, 0xf1, 0xf3, 0x22, 0xac,
-	0xe6, 0xfc, 0x9c, 0x32, 0x1b, 0x7d, 0xad, 0xc1, 0xea, 0x21, 0x79, 0x9e, 0xc1, 0xc9, 0x50, 0x65,
-	0x51, 0xd5, 0xfc, 0x3c, 0x0c, 0x8c, 0x19, 0xdb, 0x7c, 0xb6, 0x39, 0xe3, 0x16, 0x7d, 0xab, 0xc1,
-	0x5a, 0x9b, 0x33, 0xc9, 0xc8, 0xe0, 0xe8, 0x92, 0x53, 0x77, 0xdf, 0xe7, 0x56, 0x9a, 0x95, 0x4f,
-	0xc3, 0xc0, 0x98, 0x35, 0xce, 0x27, 0x92, 0x59, 0xbf, 0xa8, 0x0d, 0x0f, 0x77, 0x7c, 0x29, 0x1c,
-	0x22, 0x59, 0x7f, 0xa7, 0x2f, 0xd9, 0x85, 0x0a, 0x52, 0x25, 0xfb, 0x92, 0xf9, 0x5a, 0x44, 0x7f,
-	0x81, 0x19, 0x17, 0x81, 0xe8, 0x00, 0xd6, 0x5b, 0x67, 0x84, 0xdb, 0x94, 0xf4, 0x06, 0x74, 0x2a,
-	0xff, 0x97, 0xcc, 0x7a, 0x18, 0x18, 0x85, 0x76, 0x5c, 0x88, 0xa2, 0xf7, 0xa1, 0xda, 0x72, 0x29,
-	0x91, 0xd4, 0xea, 0x08, 0xde, 0xa7, 0xaa, 0x1e, 0x2a, 0xe6, 0x6a, 0x18, 0x18, 0x39, 0x1c, 0xe7,
-	0xb4, 0x28, 0x86, 0x13, 0x6e, 0x0a, 0x6e, 0x7d, 0x4a, 0x5d, 0x26, 0xac, 0x36, 0xdf, 0x1b, 0x8a,
-	0xfe, 0x99, 0xa7, 0xd2, 0xb9, 0x16, 0xc7, 0x50, 0x64, 0xc7, 0x85, 0x28, 0x22, 0xf0, 0x46, 0xeb,
-	0x8c, 0xf6, 0xcf, 0x5b, 0x64, 0x78, 0xc4, 0x31, 0x4d, 0x48, 0xa4, 0x98, 0x5e, 0x12, 0xd7, 0xf2,
-	0xea, 0xf7, 0xd5, 0xc6, 0x8c, 0x30, 0x30, 0x5e, 0xb5, 0x0c, 0xbf, 0xca, 0xd8, 0xf8, 0x4e, 0x03,
-	0x94, 0x69, 0x77, 0x54, 0x92, 0x5d, 0x22, 0x09, 0x7a, 0x13, 0x2a, 0x1d, 0xe2, 0xd0, 0x24, 0x39,
-	0x97, 0xc2, 0xc0, 0x50, 0x3a, 0x56, 0x4f, 0xf4, 0x16, 0xdc, 0xff, 0x8c, 0xf6, 0x3c, 0x26, 0x69,
-	0x92, 0x34, 0xcb, 0x61, 0x60, 0xa4, 0x10, 0x4e, 0x05, 0xd4, 0x04, 0x68, 0x5b, 0x94, 0x4b, 0x76,
-	0xca, 0xa8, 0xab, 0x28, 0xad, 0x9a, 0x2b, 0x51, 0x43, 0x99, 0xa0, 0x38, 0x23, 0x37, 0x7e, 0x2a,
-	0x43, 0x7d, 0xb6, 0xf6, 0xba, 0x92, 0x48, 0xdf, 0x43, 0x1f, 0x03, 0x74, 0x25, 0x39, 0xa7, 0xd6,
-	0x63, 0x7a, 0x15, 0x97, 0xdf, 0xf2, 0xbb, 0xab, 0x71, 0xcf, 0x6e, 0x76, 0x84, 0x45, 0xbd, 0x28,
-	0xee, 0xd8, 0xfd, 0x64, 0x1d, 0xce, 0xc8, 0xa8, 0x0d, 0xb5, 0x8e, 0x90, 0x19, 0x27, 0xe5, 0x97,
-	0x38, 0x51, 0xad, 0x32, 0xb7, 0x14, 0xe7, 0x55, 0xb4, 0x0f, 0xd5, 0x13, 0x9e, 0xf1, 0xb4, 0xf0,
-	0x12, 0x4f, 0x2a, 0x5d, 0xb2, 0x2b, 0x71, 0x4e, 0x43, 0x5b, 0xb0, 0xd4, 0xf1, 0x9d, 0x13, 0x8f,
-	0xba, 0x5e, 0xd2, 0xa6, 0xab, 0x61, 0x60, 0x8c, 0x31, 0x3c, 0x96, 0x1a, 0xbf, 0x6b, 0x50, 0x89,
-	0x2a, 0x06, 0x59, 0xb0, 0xf8, 0x84, 0x0c, 0xfc, 0x94, 0x9a, 0x4e, 0x18, 0x18, 0x31, 0x30, 0x9f,
-	0x12, 0x8d, 0x7d, 0x45, 0x0c, 0xe7, 0x2f, 0x2b, 0xc5, 0x70, 0x7a, 0x51, 0xa5, 0x02, 0x32, 0x60,
-	0x51, 0xa5, 0xaa, 0x22, 0xb7, 0x66, 0x3e, 0x88, 0x82, 0x51, 0x00, 0x8e, 0x5f, 0x51, 0x1e, 0x1d,
-	0x5f, 0x0d, 0xe3, 0x1a, 0xac, 0xc5, 0x79, 0x14, 0xe9, 0x58, 0x3d, 0x1b, 0x7f, 0x2c, 0x40, 0x2d,
-	0x21, 0x5c, 0xb8, 0x2a, 0xef, 0x9a, 0x00, 0xaa, 0xa2, 0x69, 0xb4, 0xd7, 0x64, 0x8b, 0x8a, 0xd3,
-	0x09, 0x8a, 0x33, 0x72, 0x74, 0xd9, 0xa5, 0x07, 0x9a, 0x36, 0xb1, 0xa8, 0x2f, 0x2b, 0x06, 0x73,
-	0x06, 0x9c, 0x57, 0x51, 0x0b, 0xd6, 0x92, 0x12, 0x50, 0xd5, 0x31, 0x14, 0x8c, 0xcb, 0x64, 0x17,
-	0x1b, 0x51, 0x07, 0x9c, 0x31, 0xe2, 0x59, 0x48, 0xf5, 0xf3, 0x13, 0xde, 0x1a, 0x10, 0xe6, 0x50,
-	0x2b, 0xad, 0xca, 0xca, 0xa4, 0x9f, 0x4f, 0xdb, 0xe6, 0xd4, 0xcf, 0xa7, 0xdd, 0xa2, 0x1f, 0x35,
-	0xd8, 0x38, 0x16, 0x92, 0x0c, 0x5a, 0xbe, 0xe3, 0x0f, 0xa2, 0x6e, 0x94, 0x06, 0x13, 0xdf, 0xe2,
-	0x5f, 0x84, 0x81, 0x51, 0xbc, 0x60, 0x3e, 0x11, 0x15, 0xfb, 0x6e, 0x7c, 0x53, 0x86, 0x95, 0x4f,
-	0x06, 0xa2, 0x47, 0x06, 0xd1, 0x99, 0x2b, 0x7e, 0x2f, 0x60, 0x59, 0xad, 0x8d, 0x29, 0x4c, 0x08,
-	0x3e, 0x8e, 0x06, 0xa1, 0x0c, 0x3c, 0x9f, 0xa0, 0xb2, 0x1e, 0xd1, 0x97, 0x50, 0x53, 0x6a, 0x9a,
-	0x04, 0x49, 0x56, 0x3f, 0x89, 0xf2, 0x24, 0x67, 0x98, 0xcf, 0xbf, 0xf3, 0x3e, 0x1b, 0xcf, 0xe0,
-	0xc1, 0xb8, 0x27, 0xa0, 0x06, 0xdc, 0x33, 0x0f, 0xba, 0x8f, 0xe9, 0x55, 0xb2, 0x7b, 0x08, 0x03,
-	0x23, 0x41, 0x70, 0xf2, 0x8e, 0x46, 0x8d, 0x2e, 0xb3, 0x39, 0xb5, 0x0e, 0x3d, 0x3b, 0x09, 0x55,
-	0x8d, 0x1a, 0x63, 0x10, 0x4f, 0xc4, 0xc6, 0x75, 0x19, 0x36, 0xe2, 0x23, 0x6f, 0x09, 0x67, 0xe8,
-	0x4b, 0xd5, 0x3d, 0xd5, 0xaf, 0xa2, 0xe1, 0x2a, 0x21, 0xe3, 0x58, 0xec, 0x32, 0x4f, 0xba, 0xac,
-	0xe7, 0xcb, 0xf4, 0xd8, 0xd5, 0x70, 0x55, 0x60, 0x9e, 0xd3, 0x70, 0x55, 0xe0, 0x79, 0x9a, 0xfe,
-	0xf2, 0x7f, 0x45, 0x7f, 0x13, 0x60, 0x66, 0x92, 0x8e, 0xaf, 0x8a, 0xc9, 0x18, 0x90, 0x91, 0xcd,
-	0xce, 0xf5, 0xad, 0x5e, 0xba, 0xb9, 0xd5, 0x4b, 0x2f, 0x6e, 0x75, 0xed, 0xab, 0x91, 0xae, 0xfd,
-	0x32, 0xd2, 0xb5, 0xdf, 0x46, 0xba, 0x76, 0x3d, 0xd2, 0xb5, 0x9b, 0x91, 0xae, 0xfd, 0x35, 0xd2,
-	0xb5, 0xbf, 0x47, 0x7a, 0xe9, 0xc5, 0x48, 0xd7, 0x7e, 0xb8, 0xd3, 0x4b, 0xd7, 0x77, 0x7a, 0xe9,
-	0xe6, 0x4e, 0x2f, 0x3d, 0x5d, 0xf7, 0xae, 0x3c, 0x49, 0x9d, 0xae, 0x43, 0x5c, 0x39, 0x9e, 0xff,
-	0x7b, 0xf7, 0xd4, 0xc5, 0xf0, 0xde, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xec, 0x59, 0xd4,
-	0xa5, 0x0c, 0x00, 0x00,
+	// 1262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x5f, 0x67, 0xb3, 0xdb, 0xed, 0xdb, 0xa4, 0xdd, 0x9d, 0xb6, 0xdf, 0x6f, 0x04, 0xc8, 0xae,
+	0x2c, 0x21, 0xad, 0x84, 0x9a, 0x15, 0x3f, 0x24, 0x24, 0xb8, 0xb0, 0xce, 0xb6, 0x10, 0x75, 0x37,
+	0x85, 0xc9, 0x6e, 0x11, 0x55, 0x41, 0x9a, 0xc4, 0xb3, 0xce, 0x68, 0xe3, 0x99, 0x60, 0x8f, 0xdb,
+	0xae, 0xc4, 0x81, 0x0b, 0x08, 0x2e, 0x88, 0x03, 0x17, 0xfe, 0x00, 0x24, 0x84, 0xf8, 0x43, 0x38,
+	0xae, 0x38, 0xf5, 0x80, 0x0c, 0x4d, 0x2f, 0xc8, 0xa7, 0xfe, 0x03, 0x48, 0x68, 0xc6, 0x76, 0x62,
+	0x27, 0x69, 0x4f, 0x11, 0x17, 0xcf, 0x7b, 0x9f, 0x37, 0x7e, 0x79, 0x33, 0x9f, 0xf7, 0x9e, 0x5f,
+	0x60, 0xcb, 0xa5, 0x43, 0xea, 0x11, 0xc9, 0x04, 0x6f, 0x8e, 0x02, 0x21, 0x05, 0x5a, 0xd3, 0xcb,
+	0x4b, 0x37, 0x3c, 0x26, 0x07, 0x51, 0xaf, 0xd9, 0x17, 0xfe, 0xae, 0x27, 0x3c, 0xb1, 0xab, 0xe1,
+	0x5e, 0x74, 0xa2, 0x35, 0xad, 0x68, 0x29, 0x7d, 0xcb, 0xfe, 0x67, 0x15, 0xae, 0xee, 0x4f, 0x5c,
+	0x1d, 0x12, 0x4e, 0x3c, 0xea, 0x53, 0x2e, 0xd1, 0x3b, 0x70, 0xa9, 0x13, 0xf9, 0x77, 0x4e, 0x5a,
+	0x82, 0xcb, 0x80, 0xf4, 0x65, 0xd8, 0x30, 0xae, 0x1b, 0x3b, 0x75, 0x07, 0x25, 0xb1, 0x35, 0x63,
+	0xc1, 0x33, 0x3a, 0x7a, 0x1d, 0x36, 0x0f, 0x48, 0x28, 0xf7, 0x5c, 0x37, 0xa0, 0x61, 0xd8, 0xa8,
+	0x5c, 0x37, 0x76, 0x6a, 0xce, 0xe5, 0x24, 0xb6, 0x8a, 0x30, 0x2e, 0x2a, 0xe8, 0x6d, 0xa8, 0x1f,
+	0x32, 0xde, 0xa5, 0xc1, 0x03, 0xd6, 0xa7, 0xb7, 0x28, 0x6d, 0xac, 0x5e, 0x37, 0x76, 0xaa, 0xce,
+	0x76, 0x12, 0x5b, 0x65, 0x03, 0x2e, 0xab, 0xfa, 0x45, 0xf2, 0xa8, 0xf0, 0x62, 0xb5, 0xf0, 0x62,
+	0xd1, 0x80, 0xcb, 0x2a, 0x0a, 0x01, 0x0e, 0x19, 0xdf, 0xa7, 0x23, 0x11, 0x32, 0xd9, 0x58, 0xd3,
+	0x31, 0x76, 0x93, 0xd8, 0x2a, 0xa0, 0xbf, 0xfc, 0x69, 0xed, 0xf9, 0x44, 0x0e, 0x76, 0x7b, 0xcc,
+	0x6b, 0xb6, 0xb9, 0x7c, 0xb7, 0x70, 0xb7, 0x37, 0x87, 0x81, 0xe0, 0x6e, 0x87, 0xca, 0x87, 0x22,
+	0x38, 0xdd, 0xa5, 0x5a, 0xbb, 0xe1, 0x89, 0x5d, 0x97, 0x48, 0xd2, 0x74, 0x98, 0xd7, 0xe6, 0xb2,
+	0x45, 0x42, 0x49, 0x03, 0x5c, 0x70, 0x88, 0xbe, 0x33, 0xe0, 0x8a, 0x56, 0xf3, 0x1b, 0xdf, 0xf3,
+	0x45, 0xc4, 0x65, 0x63, 0x5d, 0xff, 0xfc, 0xfd, 0x24, 0xb6, 0x16, 0x99, 0x97, 0x13, 0xc7, 0x22,
+	0xcf, 0xf6, 0x4d, 0xf8, 0xdf, 0x14, 0xcb, 0x19, 0x3c, 0x60, 0xa1, 0x44, 0xaf, 0xc1, 0xc5, 0x8c,
+	0x1c, 0xaa, 0xb8, 0x5f, 0xdd, 0xa9, 0x39, 0xf5, 0x24, 0xb6, 0xa6, 0x20, 0x9e, 0x8a, 0xf6, 0x4f,
+	0x6b, 0xb0, 0x55, 0xf2, 0x73, 0xc2, 0x3c, 0xf4, 0x95, 0x01, 0x5b, 0x87, 0xe4, 0x51, 0x01, 0x27,
+	0x23, 0x9d, 0x45, 0x35, 0xe7, 0x93, 0x24, 0xb6, 0xe6, 0x6c, 0xcb, 0x39, 0xe6, 0x9c, 0x5b, 0xf4,
+	0x8d, 0x01, 0xdb, 0x6d, 0xce, 0x24, 0x23, 0xc3, 0x3b, 0x0f, 0x39, 0x0d, 0x6e, 0x45, 0xdc, 0xcd,
+	0xb3, 0xf2, 0x5e, 0x12, 0x5b, 0xf3, 0xc6, 0xe5, 0x44, 0x32, 0xef, 0x17, 0xb5, 0xe1, 0xca, 0x5e,
+	0x24, 0x85, 0x4f, 0x24, 0xeb, 0xef, 0xf5, 0x25, 0x7b, 0xa0, 0x83, 0xd4, 0xc9, 0xbe, 0xe1, 0xfc,
+	0x5f, 0xd1, 0xbf, 0xc0, 0x8c, 0x17, 0x81, 0xe8, 0x00, 0xae, 0xb6, 0x06, 0x84, 0x7b, 0x94, 0xf4,
+	0x86, 0x74, 0x26, 0xff, 0x37, 0x9c, 0x46, 0x12, 0x5b, 0x0b, 0xed, 0x78, 0x21, 0x8a, 0xde, 0x82,
+	0x5a, 0x2b, 0xa0, 0x44, 0x52, 0xb7, 0x23, 0x78, 0x9f, 0xea, 0x7a, 0xa8, 0x3a, 0x5b, 0x49, 0x6c,
+	0x95, 0x70, 0x5c, 0xd2, 0x54, 0x0c, 0xc7, 0xdc, 0x11, 0xdc, 0xfd, 0x90, 0x06, 0x4c, 0xb8, 0x6d,
+	0x7e, 0x73, 0x24, 0xfa, 0x83, 0x50, 0xa7, 0x73, 0x3d, 0x8d, 0x61, 0x91, 0x1d, 0x2f, 0x44, 0x11,
+	0x81, 0x97, 0x5b, 0x03, 0xda, 0x3f, 0x6d, 0x91, 0xd1, 0x1d, 0x8e, 0x69, 0x46, 0x22, 0xc5, 0xf4,
+	0x21, 0x09, 0xdc, 0xb0, 0x71, 0x41, 0x1f, 0xcc, 0x4a, 0x62, 0xeb, 0x45, 0xdb, 0xf0, 0x8b, 0x8c,
+	0xf6, 0xb7, 0x06, 0xa0, 0x42, 0xbb, 0xa3, 0x92, 0xec, 0x13, 0x49, 0xd0, 0x2b, 0x50, 0xed, 0x10,
+	0x9f, 0x66, 0xc9, 0xb9, 0x91, 0xc4, 0x96, 0xd6, 0xb1, 0x7e, 0xa2, 0x57, 0xe1, 0xc2, 0xc7, 0xb4,
+	0x17, 0x32, 0x49, 0xb3, 0xa4, 0xd9, 0x4c, 0x62, 0x2b, 0x87, 0x70, 0x2e, 0xa0, 0x26, 0x40, 0xdb,
+	0xa5, 0x5c, 0xb2, 0x13, 0x46, 0x03, 0x4d, 0x69, 0xcd, 0xb9, 0xa4, 0x1a, 0xca, 0x14, 0xc5, 0x05,
+	0xd9, 0xfe, 0xb1, 0x02, 0x8d, 0xf9, 0xda, 0xeb, 0x4a, 0x22, 0xa3, 0x10, 0xbd, 0x07, 0xd0, 0x95,
+	0xe4, 0x94, 0xba, 0xb7, 0xe9, 0x59, 0x5a, 0x7e, 0x9b, 0x6f, 0x6c, 0xa5, 0x3d, 0xbb, 0xd9, 0x11,
+	0x2e, 0x0d, 0x55, 0xdc, 0xa9, 0xfb, 0xe9, 0x3e, 0x5c, 0x90, 0x51, 0x1b, 0xea, 0x1d, 0x21, 0x0b,
+	0x4e, 0x2a, 0xcf, 0x71, 0xa2, 0x5b, 0x65, 0x69, 0x2b, 0x2e, 0xab, 0xe8, 0x16, 0xd4, 0x8e, 0x79,
+	0xc1, 0xd3, 0xea, 0x73, 0x3c, 0xe9, 0x74, 0x29, 0xee, 0xc4, 0x25, 0x0d, 0xed, 0xc0, 0x46, 0x27,
+	0xf2, 0x8f, 0x43, 0x1a, 0x84, 0x59, 0x9b, 0xae, 0x25, 0xb1, 0x35, 0xc1, 0xf0, 0x44, 0xb2, 0x7f,
+	0x37, 0xa0, 0xaa, 0x2a, 0x06, 0xb9, 0xb0, 0x76, 0x97, 0x0c, 0xa3, 0x9c, 0x9a, 0x4e, 0x12, 0x5b,
+	0x29, 0xb0, 0x9c, 0x12, 0x4d, 0x7d, 0x29, 0x86, 0xcb, 0x1f, 0x2b, 0xcd, 0x70, 0x06, 0xe1, 0x5c,
+	0x40, 0x16, 0xac, 0xe9, 0x54, 0xd5, 0xe4, 0xd6, 0x9d, 0x8b, 0x2a, 0x18, 0x0d, 0xe0, 0x74, 0x51,
+	0x79, 0x74, 0x74, 0x36, 0x4a, 0x6b, 0xb0, 0x9e, 0xe6, 0x91, 0xd2, 0xb1, 0x7e, 0xda, 0x7f, 0xac,
+	0x42, 0x3d, 0x23, 0x5c, 0x04, 0x3a, 0xef, 0x9a, 0x00, 0xba, 0xa2, 0xa9, 0x3a, 0x6b, 0x76, 0x44,
+	0xcd, 0xe9, 0x14, 0xc5, 0x05, 0x59, 0x7d, 0xec, 0xf2, 0x0b, 0xcd, 0x9b, 0x98, 0xea, 0xcb, 0x9a,
+	0xc1, 0x92, 0x01, 0x97, 0x55, 0xd4, 0x82, 0xed, 0xac, 0x04, 0x74, 0x75, 0x8c, 0x04, 0xe3, 0x32,
+	0x3b, 0xc5, 0x35, 0xd5, 0x01, 0xe7, 0x8c, 0x78, 0x1e, 0xd2, 0xfd, 0xfc, 0x98, 0xb7, 0x86, 0x84,
+	0xf9, 0xd4, 0xcd, 0xab, 0xb2, 0x3a, 0xed, 0xe7, 0xb3, 0xb6, 0x25, 0xf5, 0xf3, 0x59, 0xb7, 0xe8,
+	0x07, 0x03, 0xae, 0x1d, 0x09, 0x49, 0x86, 0xad, 0xc8, 0x8f, 0x86, 0x44, 0x4e, 0x2c, 0xd9, 0x57,
+	0xfc, 0xb3, 0x24, 0xb6, 0x16, 0x6f, 0x58, 0x4e, 0x44, 0x8b, 0x7d, 0xdb, 0x5f, 0x57, 0xe0, 0xd2,
+	0xfb, 0x43, 0xd1, 0x23, 0x43, 0x75, 0xe7, 0x9a, 0xdf, 0x07, 0xb0, 0xa9, 0xf7, 0xa6, 0x14, 0x66,
+	0x04, 0x1f, 0xa9, 0x41, 0xa8, 0x00, 0x2f, 0x27, 0xa8, 0xa2, 0x47, 0xf4, 0x05, 0xd4, 0xb5, 0x9a,
+	0x27, 0x41, 0x96, 0xd5, 0x77, 0x55, 0x9e, 0x94, 0x0c, 0xcb, 0xf9, 0xed, 0xb2, 0x4f, 0xfb, 0x3e,
+	0x5c, 0x9c, 0xf4, 0x04, 0x64, 0xc3, 0xba, 0x73, 0xd0, 0xbd, 0x4d, 0xcf, 0xb2, 0xd3, 0x43, 0x12,
+	0x5b, 0x19, 0x82, 0xb3, 0x55, 0x8d, 0x1a, 0x5d, 0xe6, 0x71, 0xea, 0x1e, 0x86, 0x5e, 0x16, 0xaa,
+	0x1e, 0x35, 0x26, 0x20, 0x9e, 0x8a, 0xf6, 0x79, 0x05, 0xae, 0xa5, 0x57, 0xde, 0x12, 0xfe, 0x28,
+	0x92, 0xba, 0x7b, 0xea, 0x9f, 0x52, 0xc3, 0x55, 0x46, 0xc6, 0x91, 0xd8, 0x67, 0xa1, 0x0c, 0x58,
+	0x2f, 0x92, 0xf9, 0xb5, 0xeb, 0xe1, 0x6a, 0x81, 0x79, 0x49, 0xc3, 0xd5, 0x02, 0xcf, 0xb3, 0xf4,
+	0x57, 0xfe, 0x2b, 0xfa, 0x9b, 0x00, 0x73, 0x93, 0x74, 0xfa, 0xa9, 0x98, 0xa0, 0xb8, 0x20, 0xdb,
+	0xbf, 0x1a, 0xb0, 0x7d, 0xc0, 0x3e, 0x8f, 0x98, 0xab, 0x18, 0x64, 0xdc, 0xd3, 0xd7, 0x69, 0xc1,
+	0xda, 0x91, 0x38, 0xa5, 0x3c, 0xbb, 0x3f, 0xdd, 0xed, 0x34, 0x80, 0xd3, 0x65, 0x72, 0xbc, 0xee,
+	0x80, 0x04, 0x34, 0x9c, 0x3b, 0x5e, 0x0a, 0x2f, 0xf3, 0x78, 0xa9, 0x47, 0xfb, 0x10, 0x2e, 0x1f,
+	0xe7, 0x93, 0x2c, 0xfd, 0x28, 0xa2, 0x11, 0x55, 0x8d, 0xf7, 0x03, 0x4a, 0xd2, 0x16, 0x5a, 0x4d,
+	0x1b, 0xaf, 0xd2, 0xb1, 0x7e, 0xea, 0xb6, 0x4c, 0xd8, 0xb0, 0x51, 0x99, 0x5a, 0x95, 0x8e, 0xf5,
+	0xd3, 0xfe, 0x34, 0x9d, 0xc9, 0x54, 0x36, 0x89, 0x88, 0xbb, 0x8c, 0x7b, 0x7a, 0xfe, 0xb5, 0x61,
+	0xfd, 0x80, 0x72, 0x4f, 0x0e, 0x32, 0xa7, 0x3a, 0x71, 0x53, 0x04, 0x67, 0xab, 0xda, 0xd3, 0x8a,
+	0x82, 0x50, 0x04, 0x8d, 0xca, 0x74, 0x4f, 0x8a, 0xe0, 0x6c, 0x75, 0x3a, 0xe7, 0x4f, 0xcc, 0x95,
+	0xc7, 0x4f, 0xcc, 0x95, 0x67, 0x4f, 0x4c, 0xe3, 0xcb, 0xb1, 0x69, 0xfc, 0x3c, 0x36, 0x8d, 0xdf,
+	0xc6, 0xa6, 0x71, 0x3e, 0x36, 0x8d, 0xc7, 0x63, 0xd3, 0xf8, 0x6b, 0x6c, 0x1a, 0x7f, 0x8f, 0xcd,
+	0x95, 0x67, 0x63, 0xd3, 0xf8, 0xfe, 0xa9, 0xb9, 0x72, 0xfe, 0xd4, 0x5c, 0x79, 0xfc, 0xd4, 0x5c,
+	0xb9, 0x77, 0x35, 0x3c, 0x0b, 0x25, 0xf5, 0xbb, 0x3e, 0x09, 0xe4, 0xe4, 0xcf, 0x55, 0x6f, 0x5d,
+	0x7f, 0x75, 0xdf, 0xfc, 0x77, 0x00, 0x8b, 0xd5, 0x92, 0x53, 0x02, 0x0e, 0x00, 0x00,
 }
 
 func (this *DelegationManagement) Equal(that interface{}) bool {
@@ -1104,6 +1255,90 @@ func (this *RewardComputationData) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LiquidStakingData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidStakingData)
+	if !ok {
+		that2, ok := that.(LiquidStakingData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Token, that1.Token) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalShares, that1.TotalShares) {
+			return false
+		}
+	}
+	return true
+}
+func (this *UnDelegateQueue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnDelegateQueue)
+	if !ok {
+		that2, ok := that.(UnDelegateQueue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Head != that1.Head {
+		return false
+	}
+	if this.Tail != that1.Tail {
+		return false
+	}
+	return true
+}
+func (this *AutoCompoundingList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoCompoundingList)
+	if !ok {
+		that2, ok := that.(AutoCompoundingList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	if this.Cursor != that1.Cursor {
+		return false
+	}
+	return true
+}
 func (this *DelegationManagement) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1237,6 +1472,39 @@ func (this *RewardComputationData) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *LiquidStakingData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.LiquidStakingData{")
+	s = append(s, "Token: "+fmt.Sprintf("%#v", this.Token)+",\n")
+	s = append(s, "TotalShares: "+fmt.Sprintf("%#v", this.TotalShares)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnDelegateQueue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.UnDelegateQueue{")
+	s = append(s, "Head: "+fmt.Sprintf("%#v", this.Head)+",\n")
+	s = append(s, "Tail: "+fmt.Sprintf("%#v", this.Tail)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AutoCompoundingList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.AutoCompoundingList{")
+	s = append(s, "Length: "+fmt.Sprintf("%#v", this.Length)+",\n")
+	s = append(s, "Cursor: "+fmt.Sprintf("%#v", this.Cursor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringDelegation(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1792,6 +2060,113 @@ func (m *RewardComputationData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LiquidStakingData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidStakingData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidStakingData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalShares)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalShares, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnDelegateQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnDelegateQueue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnDelegateQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tail != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Tail))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Head != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Head))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundingList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundingList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cursor != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Cursor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Length != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDelegation(v)
 	base := offset
@@ -2049,6 +2424,54 @@ func (m *RewardComputationData) Size() (n int) {
 	return n
 }
 
+func (m *LiquidStakingData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalShares)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *UnDelegateQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Head != 0 {
+		n += 1 + sovDelegation(uint64(m.Head))
+	}
+	if m.Tail != 0 {
+		n += 1 + sovDelegation(uint64(m.Tail))
+	}
+	return n
+}
+
+func (m *AutoCompoundingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Length != 0 {
+		n += 1 + sovDelegation(uint64(m.Length))
+	}
+	if m.Cursor != 0 {
+		n += 1 + sovDelegation(uint64(m.Cursor))
+	}
+	return n
+}
+
 func sovDelegation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2197,6 +2620,39 @@ func (this *RewardComputationData) String() string {
 	}, "")
 	return s
 }
+func (this *LiquidStakingData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LiquidStakingData{`,
+		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
+		`TotalShares:` + fmt.Sprintf("%v", this.TotalShares) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnDelegateQueue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnDelegateQueue{`,
+		`Head:` + fmt.Sprintf("%v", this.Head) + `,`,
+		`Tail:` + fmt.Sprintf("%v", this.Tail) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AutoCompoundingList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutoCompoundingList{`,
+		`Length:` + fmt.Sprintf("%v", this.Length) + `,`,
+		`Cursor:` + fmt.Sprintf("%v", this.Cursor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringDelegation(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3841,6 +4297,313 @@ func (m *RewardComputationData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LiquidStakingData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidStakingData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidStakingData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = append(m.Token[:0], dAtA[iNdEx:postIndex]...)
+			if m.Token == nil {
+				m.Token = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalShares = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnDelegateQueue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnDelegateQueue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnDelegateQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			m.Head = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Head |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundingList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundingList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundingList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			m.Cursor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cursor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDelegation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
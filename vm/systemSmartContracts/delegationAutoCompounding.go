@@ -0,0 +1,340 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const autoCompoundingKey = "autoCompounding"
+
+// autoCompoundingEntryPrefix prefixes the keys of the opted-in addresses, each one being saved under its own index
+const autoCompoundingEntryPrefix = "autoCompoundingEntry"
+
+// autoCompoundingIndexPrefix prefixes the keys holding the index of each opted-in address, incremented by one
+const autoCompoundingIndexPrefix = "autoCompoundingIndex"
+
+// maxAutoCompoundingPerEpoch bounds the number of opted-in delegators compounded when the rewards of an epoch are
+// received. The following epochs continue from where the previous one stopped
+const maxAutoCompoundingPerEpoch = 100
+
+type compoundingDelegator struct {
+	address   []byte
+	delegator *DelegatorData
+	value     *big.Int
+}
+
+// setAutoCompounding opts the caller in or out of the automatic re-delegation of its claimable rewards, which is done
+// each time the contract receives the rewards of an epoch. The expected argument is "true" or "false"
+func (d *delegation) setAutoCompounding(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagAutoCompounding.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.ErrCallValueMustBeZero.Error())
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage(vm.ErrInvalidNumOfArguments.Error())
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.OutOfGas
+	}
+
+	var enable bool
+	switch string(args.Arguments[0]) {
+	case "true":
+		enable = true
+	case "false":
+		enable = false
+	default:
+		d.eei.AddReturnMessage("invalid argument")
+		return vmcommon.UserError
+	}
+
+	isNew, _, err := d.getOrCreateDelegatorData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if isNew {
+		d.eei.AddReturnMessage("caller is not a delegator")
+		return vmcommon.UserError
+	}
+
+	list, err := d.getAutoCompoundingList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	isOptedIn := d.isAutoCompounding(args.CallerAddr)
+	if enable && !isOptedIn {
+		d.addToAutoCompounding(list, args.CallerAddr)
+	}
+	if !enable && isOptedIn {
+		d.removeFromAutoCompounding(list, args.CallerAddr)
+	}
+
+	err = d.saveAutoCompoundingList(list)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getAutoCompoundingStatus returns "true" if the provided delegator opted in for auto-compounding, "false" otherwise
+func (d *delegation) getAutoCompoundingStatus(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagAutoCompounding.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	_, returnCode := d.checkArgumentsForUserViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	if d.isAutoCompounding(args.Arguments[0]) {
+		d.eei.Finish([]byte("true"))
+	} else {
+		d.eei.Finish([]byte("false"))
+	}
+
+	return vmcommon.Ok
+}
+
+// compoundRewards re-delegates the claimable rewards of the delegators who opted in for auto-compounding. It is called
+// from updateRewards, so a delegator which cannot compound - total delegation cap reached while the cap is checked on
+// re-delegated rewards, active stake below the minimum - keeps its rewards claimable instead of failing the call. At
+// most maxAutoCompoundingPerEpoch delegators are compounded at once, starting from the cursor saved by the previous call
+func (d *delegation) compoundRewards(scAddress []byte) vmcommon.ReturnCode {
+	if !d.flagAutoCompounding.IsSet() {
+		return vmcommon.Ok
+	}
+
+	list, err := d.getAutoCompoundingList()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if list.Length == 0 {
+		return vmcommon.Ok
+	}
+
+	dConfig, err := d.getDelegationContractConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if dConfig.InitialOwnerFunds.Cmp(zero) == 0 {
+		// rewards can not be re-delegated before the owner provides the initial funds
+		return vmcommon.Ok
+	}
+
+	minDelegationAmount := big.NewInt(0)
+	if d.flagReDelegateBelowMinCheck.IsSet() {
+		delegationManagement, errGet := getDelegationManagement(d.eei, d.marshalizer, d.delegationMgrSCAddress)
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		minDelegationAmount = delegationManagement.MinDelegationAmount
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	checkCap := dConfig.CheckCapOnReDelegateRewards && dConfig.MaxDelegationCap.Cmp(zero) != 0
+	totalActive := big.NewInt(0).Set(globalFund.TotalActive)
+	totalCompounded := big.NewInt(0)
+	compounding := make([]*compoundingDelegator, 0)
+	index := list.Cursor
+	numToProcess := list.Length
+	if numToProcess > d.maxAutoCompoundingPerEpoch {
+		numToProcess = d.maxAutoCompoundingPerEpoch
+	}
+	for i := uint64(0); i < numToProcess && list.Length > 0; i++ {
+		if index >= list.Length {
+			index = 0
+		}
+		address := d.eei.GetStorage(createAutoCompoundingEntryKey(index))
+		isNew, delegator, errGet := d.getOrCreateDelegatorData(address)
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		if isNew {
+			// the delegator withdrew everything and was removed, the last address was moved in its place
+			d.removeFromAutoCompounding(list, address)
+			continue
+		}
+		index++
+		if len(delegator.ActiveFund) == 0 {
+			continue
+		}
+
+		errGet = d.computeAndUpdateRewards(address, delegator)
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		if delegator.UnClaimedRewards.Cmp(zero) <= 0 {
+			continue
+		}
+
+		activeFund, errGet := d.getFund(delegator.ActiveFund)
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		newActiveValue := big.NewInt(0).Add(activeFund.Value, delegator.UnClaimedRewards)
+		if newActiveValue.Cmp(minDelegationAmount) < 0 {
+			continue
+		}
+
+		newTotalActive := big.NewInt(0).Add(totalActive, delegator.UnClaimedRewards)
+		if checkCap && newTotalActive.Cmp(dConfig.MaxDelegationCap) > 0 {
+			continue
+		}
+
+		totalActive = newTotalActive
+		totalCompounded.Add(totalCompounded, delegator.UnClaimedRewards)
+		compounding = append(compounding, &compoundingDelegator{
+			address:   address,
+			delegator: delegator,
+			value:     big.NewInt(0).Set(delegator.UnClaimedRewards),
+		})
+	}
+
+	list.Cursor = index
+	err = d.saveAutoCompoundingList(list)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if totalCompounded.Cmp(zero) == 0 {
+		return vmcommon.Ok
+	}
+
+	vmOutput, err := d.executeOnValidatorSC(scAddress, "stake", nil, totalCompounded)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		// the transferred value is moved back so the rewards remain claimable
+		_ = d.eei.Transfer(scAddress, d.validatorSCAddr, totalCompounded, nil, 0)
+		log.Debug("delegation: could not compound rewards",
+			"contract", scAddress,
+			"value", totalCompounded.String(),
+			"return code", vmOutput.ReturnCode,
+			"return message", vmOutput.ReturnMessage,
+		)
+		return vmcommon.Ok
+	}
+
+	for _, compounded := range compounding {
+		delegator := compounded.delegator
+		err = d.addValueToFund(delegator.ActiveFund, compounded.value)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		delegator.TotalCumulatedRewards.Add(delegator.TotalCumulatedRewards, compounded.value)
+		delegator.UnClaimedRewards.SetUint64(0)
+		err = d.saveDelegatorData(compounded.address, delegator)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+	}
+
+	globalFund.TotalActive.Add(globalFund.TotalActive, totalCompounded)
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getAutoCompoundingList() (*AutoCompoundingList, error) {
+	list := &AutoCompoundingList{}
+	marshaledData := d.eei.GetStorage([]byte(autoCompoundingKey))
+	if len(marshaledData) == 0 {
+		return list, nil
+	}
+
+	err := d.marshalizer.Unmarshal(list, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *delegation) saveAutoCompoundingList(list *AutoCompoundingList) error {
+	if list.Length == 0 {
+		d.eei.SetStorage([]byte(autoCompoundingKey), nil)
+		return nil
+	}
+
+	marshaledData, err := d.marshalizer.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(autoCompoundingKey), marshaledData)
+	return nil
+}
+
+func (d *delegation) isAutoCompounding(address []byte) bool {
+	return len(d.eei.GetStorage(createAutoCompoundingIndexKey(address))) > 0
+}
+
+func (d *delegation) addToAutoCompounding(list *AutoCompoundingList, address []byte) {
+	d.eei.SetStorage(createAutoCompoundingEntryKey(list.Length), address)
+	d.eei.SetStorage(createAutoCompoundingIndexKey(address), big.NewInt(0).SetUint64(list.Length+1).Bytes())
+	list.Length++
+}
+
+// removeFromAutoCompounding moves the last opted-in address in the place of the removed one
+func (d *delegation) removeFromAutoCompounding(list *AutoCompoundingList, address []byte) {
+	indexKey := createAutoCompoundingIndexKey(address)
+	storedIndex := big.NewInt(0).SetBytes(d.eei.GetStorage(indexKey)).Uint64()
+	d.eei.SetStorage(indexKey, nil)
+	if storedIndex == 0 || list.Length == 0 {
+		return
+	}
+
+	index := storedIndex - 1
+	lastIndex := list.Length - 1
+	lastEntryKey := createAutoCompoundingEntryKey(lastIndex)
+	if index != lastIndex {
+		lastAddress := d.eei.GetStorage(lastEntryKey)
+		d.eei.SetStorage(createAutoCompoundingEntryKey(index), lastAddress)
+		d.eei.SetStorage(createAutoCompoundingIndexKey(lastAddress), big.NewInt(0).SetUint64(storedIndex).Bytes())
+	}
+	d.eei.SetStorage(lastEntryKey, nil)
+	list.Length--
+}
+
+func createAutoCompoundingEntryKey(index uint64) []byte {
+	return append([]byte(autoCompoundingEntryPrefix), big.NewInt(0).SetUint64(index).Bytes()...)
+}
+
+func createAutoCompoundingIndexKey(address []byte) []byte {
+	return append([]byte(autoCompoundingIndexPrefix), address...)
+}
@@ -0,0 +1,245 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func executeUpdateRewards(t *testing.T, d *delegation, rewards int64) {
+	vmInput := getDefaultVmInputForFunc("updateRewards", [][]byte{})
+	vmInput.CallerAddr = vm.EndOfEpochAddress
+	vmInput.CallValue = big.NewInt(rewards)
+	require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+}
+
+func getDelegatorActiveValue(t *testing.T, d *delegation, address []byte) *big.Int {
+	_, delegator, err := d.getOrCreateDelegatorData(address)
+	require.Nil(t, err)
+	fund, err := d.getFund(delegator.ActiveFund)
+	require.Nil(t, err)
+
+	return fund.Value
+}
+
+func TestDelegationSystemSC_AutoCompoundingDisabled(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	d.flagAutoCompounding.Unset()
+	executeDelegationFunction(t, d, "delegate", []byte("delegatorA"), 100)
+
+	vmInput := getDefaultVmInputForFunc("setAutoCompounding", [][]byte{[]byte("true")})
+	vmInput.CallerAddr = []byte("delegatorA")
+	retCode := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "setAutoCompounding is an unknown function", eei.returnMessage)
+}
+
+func TestDelegationSystemSC_SetAutoCompoundingAndStatus(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+
+	vmInput := getDefaultVmInputForFunc("setAutoCompounding", [][]byte{[]byte("true")})
+	vmInput.CallerAddr = delegatorA
+	retCode := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "caller is not a delegator"))
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+
+	eei.returnMessage = ""
+	vmInput.Arguments = [][]byte{[]byte("yes")}
+	retCode = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid argument", eei.returnMessage)
+
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("true"))
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("true"))
+	list, _ := d.getAutoCompoundingList()
+	assert.Equal(t, uint64(1), list.Length)
+	assert.Equal(t, delegatorA, eei.GetStorage(createAutoCompoundingEntryKey(0)))
+
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getAutoCompoundingStatus", delegatorA, 0, delegatorA)
+	assert.Equal(t, [][]byte{[]byte("true")}, eei.output)
+
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("false"))
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getAutoCompoundingStatus", delegatorA, 0, delegatorA)
+	assert.Equal(t, [][]byte{[]byte("false")}, eei.output)
+	assert.Equal(t, 0, len(eei.GetStorage([]byte(autoCompoundingKey))))
+	assert.Equal(t, 0, len(eei.GetStorage(createAutoCompoundingEntryKey(0))))
+}
+
+func TestDelegationSystemSC_SetAutoCompoundingFalseShouldMoveTheLastAddressInPlace(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	delegators := [][]byte{[]byte("delegatorA"), []byte("delegatorB"), []byte("delegatorC")}
+	for _, delegator := range delegators {
+		executeDelegationFunction(t, d, "delegate", delegator, 100)
+		executeDelegationFunction(t, d, "setAutoCompounding", delegator, 0, []byte("true"))
+	}
+
+	executeDelegationFunction(t, d, "setAutoCompounding", delegators[0], 0, []byte("false"))
+
+	list, _ := d.getAutoCompoundingList()
+	assert.Equal(t, uint64(2), list.Length)
+	assert.Equal(t, delegators[2], eei.GetStorage(createAutoCompoundingEntryKey(0)))
+	assert.Equal(t, delegators[1], eei.GetStorage(createAutoCompoundingEntryKey(1)))
+	assert.Equal(t, 0, len(eei.GetStorage(createAutoCompoundingEntryKey(2))))
+	assert.False(t, d.isAutoCompounding(delegators[0]))
+	assert.True(t, d.isAutoCompounding(delegators[1]))
+	assert.True(t, d.isAutoCompounding(delegators[2]))
+
+	executeDelegationFunction(t, d, "setAutoCompounding", delegators[2], 0, []byte("false"))
+	assert.Equal(t, delegators[1], eei.GetStorage(createAutoCompoundingEntryKey(0)))
+	assert.True(t, d.isAutoCompounding(delegators[1]))
+}
+
+func TestDelegationSystemSC_UpdateRewardsShouldCompoundABoundedNumberOfDelegatorsPerEpoch(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, _, validatorCalls := createUnDelegateQueueDelegation(t, &epoch)
+	d.maxAutoCompoundingPerEpoch = 2
+	delegators := [][]byte{[]byte("delegatorA"), []byte("delegatorB"), []byte("delegatorC")}
+	for _, delegator := range delegators {
+		executeDelegationFunction(t, d, "delegate", delegator, 100)
+		executeDelegationFunction(t, d, "setAutoCompounding", delegator, 0, []byte("true"))
+	}
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	executeUpdateRewards(t, d, 300)
+
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, big.NewInt(200), (*validatorCalls)[0].CallValue)
+	assert.Equal(t, big.NewInt(200), getDelegatorActiveValue(t, d, delegators[0]))
+	assert.Equal(t, big.NewInt(200), getDelegatorActiveValue(t, d, delegators[1]))
+	assert.Equal(t, big.NewInt(100), getDelegatorActiveValue(t, d, delegators[2]))
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	executeUpdateRewards(t, d, 0)
+
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, big.NewInt(100), (*validatorCalls)[0].CallValue)
+	assert.Equal(t, big.NewInt(200), getDelegatorActiveValue(t, d, delegators[2]))
+
+	list, _ := d.getAutoCompoundingList()
+	assert.Equal(t, uint64(1), list.Cursor)
+}
+
+func TestDelegationSystemSC_UpdateRewardsShouldCompoundTheRewardsOfOptedInDelegators(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, _, validatorCalls := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+	delegatorB := []byte("delegatorB")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "delegate", delegatorB, 100)
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("true"))
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	executeUpdateRewards(t, d, 100)
+
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, "stake", (*validatorCalls)[0].Function)
+	assert.Equal(t, big.NewInt(50), (*validatorCalls)[0].CallValue)
+
+	assert.Equal(t, big.NewInt(150), getDelegatorActiveValue(t, d, delegatorA))
+	_, dataA, _ := d.getOrCreateDelegatorData(delegatorA)
+	assert.Equal(t, big.NewInt(0), dataA.UnClaimedRewards)
+	assert.Equal(t, big.NewInt(50), dataA.TotalCumulatedRewards)
+	assert.Equal(t, epoch+1, dataA.RewardsCheckpoint)
+
+	assert.Equal(t, big.NewInt(100), getDelegatorActiveValue(t, d, delegatorB))
+	_, dataB, _ := d.getOrCreateDelegatorData(delegatorB)
+	require.Nil(t, d.computeAndUpdateRewards(delegatorB, dataB))
+	assert.Equal(t, big.NewInt(50), dataB.UnClaimedRewards)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(250), globalFund.TotalActive)
+}
+
+func TestDelegationSystemSC_UpdateRewardsShouldRespectTheDelegationCapWhenCompounding(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, _, validatorCalls := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+	delegatorB := []byte("delegatorB")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "delegate", delegatorB, 100)
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("true"))
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorB, 0, []byte("true"))
+
+	dConfig, _ := d.getDelegationContractConfig()
+	dConfig.MaxDelegationCap = big.NewInt(260)
+	dConfig.CheckCapOnReDelegateRewards = true
+	_ = d.saveDelegationContractConfig(dConfig)
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	executeUpdateRewards(t, d, 100)
+
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, big.NewInt(50), (*validatorCalls)[0].CallValue)
+	assert.Equal(t, big.NewInt(150), getDelegatorActiveValue(t, d, delegatorA))
+	assert.Equal(t, big.NewInt(100), getDelegatorActiveValue(t, d, delegatorB))
+
+	dConfig.CheckCapOnReDelegateRewards = false
+	_ = d.saveDelegationContractConfig(dConfig)
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	executeUpdateRewards(t, d, 0)
+
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, big.NewInt(50), (*validatorCalls)[0].CallValue)
+	assert.Equal(t, big.NewInt(150), getDelegatorActiveValue(t, d, delegatorB))
+}
+
+func TestDelegationSystemSC_UpdateRewardsShouldKeepRewardsClaimableIfStakeFails(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "setAutoCompounding", delegatorA, 0, []byte("true"))
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			return vmcommon.UserError
+		}}, nil
+	}})
+
+	epoch++
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	executeUpdateRewards(t, d, 100)
+
+	assert.Equal(t, 0, eei.outputAccounts["addr"].BalanceDelta.Sign())
+	assert.Equal(t, big.NewInt(100), getDelegatorActiveValue(t, d, delegatorA))
+	_, dataA, _ := d.getOrCreateDelegatorData(delegatorA)
+	require.Nil(t, d.computeAndUpdateRewards(delegatorA, dataA))
+	assert.Equal(t, big.NewInt(100), dataA.UnClaimedRewards)
+}
@@ -0,0 +1,424 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const liquidStakingKey = "liquidStaking"
+
+// liquidStakingPoolKey is the key under which the delegator data of all the liquid positions is kept. It is shorter
+// than an address, so it can not collide with the data of a real delegator
+const liquidStakingPoolKey = "liquidStakingPool"
+
+// enableLiquidStaking makes the delegation contract represent all the following delegations through the provided
+// token. The owner of the contract has to transfer the ownership of the token to the delegation contract before
+func (d *delegation) enableLiquidStaking(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	returnCode := d.checkOwnerCallValueGasAndDuplicates(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	liquidData, err := d.getLiquidData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidData != nil {
+		d.eei.AddReturnMessage("liquid staking already enabled")
+		return vmcommon.UserError
+	}
+
+	setTokenData := "setDelegationPositionToken@" + hex.EncodeToString(args.Arguments[0])
+	vmOutput, err := d.eei.ExecuteOnDestContext(vm.ESDTSCAddress, args.RecipientAddr, big.NewInt(0), []byte(setTokenData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	err = d.saveLiquidData(&LiquidStakingData{
+		Token:       args.Arguments[0],
+		TotalShares: big.NewInt(0),
+	})
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) isLiquidStakingDelegation(caller []byte) bool {
+	if !d.flagLiquidStaking.IsSet() || d.isOwner(caller) {
+		return false
+	}
+
+	return len(d.eei.GetStorage([]byte(liquidStakingKey))) > 0
+}
+
+// delegateLiquid adds the delegated value to the liquid positions pool and mints to the caller the number of tokens
+// matching its share of the pool, which contains both the active stake and the rewards not yet redeemed
+func (d *delegation) delegateLiquid(
+	args *vmcommon.ContractCallInput,
+	valueToStake *big.Int,
+	dStatus *DelegationContractStatus,
+) vmcommon.ReturnCode {
+	liquidData, err := d.getLiquidData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	poolValue, err := d.computeLiquidPoolValue()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	shares := big.NewInt(0).Set(args.CallValue)
+	if liquidData.TotalShares.Cmp(zero) > 0 && poolValue.Cmp(zero) > 0 {
+		shares.Mul(shares, liquidData.TotalShares)
+		shares.Div(shares, poolValue)
+	}
+	if shares.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("delegated value is too small to be represented in liquid tokens")
+		return vmcommon.UserError
+	}
+
+	returnCode := d.delegateUser(args.CallValue, valueToStake, []byte(liquidStakingPoolKey), args.RecipientAddr, dStatus)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	mintData := "mint@" + hex.EncodeToString(liquidData.Token) + "@" + hex.EncodeToString(shares.Bytes()) + "@" + hex.EncodeToString(args.CallerAddr)
+	vmOutput, err := d.eei.ExecuteOnDestContext(vm.ESDTSCAddress, args.RecipientAddr, big.NewInt(0), []byte(mintData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	liquidData.TotalShares.Add(liquidData.TotalShares, shares)
+	err = d.saveLiquidData(liquidData)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) computeLiquidPoolValue() (*big.Int, error) {
+	isNew, pool, err := d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return big.NewInt(0), nil
+	}
+
+	err = d.computeAndUpdateRewards([]byte(liquidStakingPoolKey), pool)
+	if err != nil {
+		return nil, err
+	}
+
+	poolValue := big.NewInt(0).Set(pool.UnClaimedRewards)
+	if len(pool.ActiveFund) == 0 {
+		return poolValue, nil
+	}
+
+	activeFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	return poolValue.Add(poolValue, activeFund.Value), nil
+}
+
+// redeemLiquidPosition is called by the ESDT system SC when liquid staking tokens are burnt. The stake and the
+// rewards matching the burnt tokens are moved from the pool to the holder, who can then unDelegate or claim them
+func (d *delegation) redeemLiquidPosition(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, vm.ESDTSCAddress) {
+		d.eei.AddReturnMessage("can be called by the ESDT system SC only")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	liquidData, err := d.getLiquidData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidData == nil {
+		d.eei.AddReturnMessage(vm.ErrLiquidStakingNotEnabled.Error())
+		return vmcommon.UserError
+	}
+
+	_, err = d.redeemLiquidShares(liquidData, args.Arguments[0], big.NewInt(0).SetBytes(args.Arguments[1]))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// executeWithLiquidToken is called when liquid staking tokens are sent to the contract through ESDTTransfer, as
+// ESDTTransfer@token@amount@unDelegate or ESDTTransfer@token@amount@claimRewards. The tokens left the shard of the
+// sender, so they are redeemed in a delegation position of the sender and recorded as burnt by the ESDT system SC.
+// Then the redeemed stake is undelegated, or the rewards of the sender are claimed. Any error sends the tokens back
+func (d *delegation) executeWithLiquidToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !d.flagLiquidStaking.IsSet() {
+		d.eei.AddReturnMessage(args.Function + " is an unknown function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("invalid number of arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	liquidData, err := d.getLiquidData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidData == nil {
+		d.eei.AddReturnMessage(vm.ErrLiquidStakingNotEnabled.Error())
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.Arguments[0], liquidData.Token) {
+		d.eei.AddReturnMessage("only the liquid staking token is accepted")
+		return vmcommon.UserError
+	}
+
+	function := string(args.Arguments[2])
+	if function != "unDelegate" && function != "claimRewards" {
+		d.eei.AddReturnMessage("liquid staking tokens can only be used to unDelegate or claimRewards")
+		return vmcommon.UserError
+	}
+
+	amount := big.NewInt(0).SetBytes(args.Arguments[1])
+	stake, err := d.redeemLiquidShares(liquidData, args.CallerAddr, amount)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	burnData := core.BuiltInFunctionESDTBurn + "@" + hex.EncodeToString(liquidData.Token) + "@" + hex.EncodeToString(amount.Bytes())
+	vmOutput, err := d.eei.ExecuteOnDestContext(vm.ESDTSCAddress, args.RecipientAddr, big.NewInt(0), []byte(burnData))
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vmOutput.ReturnCode
+	}
+
+	holderInput := &vmcommon.ContractCallInput{
+		VMInput:       args.VMInput,
+		RecipientAddr: args.RecipientAddr,
+		Function:      function,
+	}
+	holderInput.CallValue = big.NewInt(0)
+	holderInput.Arguments = make([][]byte, 0)
+	if function == "claimRewards" {
+		return d.claimRewards(holderInput)
+	}
+
+	if stake.Cmp(zero) == 0 {
+		d.eei.AddReturnMessage("the liquid staking tokens hold no stake to undelegate")
+		return vmcommon.UserError
+	}
+	holderInput.Arguments = append(holderInput.Arguments, stake.Bytes())
+
+	return d.unDelegate(holderInput)
+}
+
+// redeemLiquidShares moves the stake and the rewards matching the provided amount of liquid staking tokens from the pool
+// to the holder and returns the redeemed stake. The rewards of the pool are updated first, so the share of each token
+// includes the rewards accumulated up to the current epoch
+func (d *delegation) redeemLiquidShares(liquidData *LiquidStakingData, holder []byte, amount *big.Int) (*big.Int, error) {
+	if amount.Cmp(zero) <= 0 || amount.Cmp(liquidData.TotalShares) > 0 {
+		return nil, vm.ErrInvalidLiquidStakingAmount
+	}
+
+	_, pool, err := d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	if err != nil {
+		return nil, err
+	}
+	err = d.computeAndUpdateRewards([]byte(liquidStakingPoolKey), pool)
+	if err != nil {
+		return nil, err
+	}
+	poolFund, err := d.getFund(pool.ActiveFund)
+	if err != nil {
+		return nil, err
+	}
+
+	stake := computeLiquidShareOfValue(poolFund.Value, amount, liquidData.TotalShares)
+	rewards := computeLiquidShareOfValue(pool.UnClaimedRewards, amount, liquidData.TotalShares)
+
+	err = d.moveLiquidStakeToHolder(holder, stake, rewards)
+	if err != nil {
+		return nil, err
+	}
+
+	poolFund.Value.Sub(poolFund.Value, stake)
+	err = d.saveFund(pool.ActiveFund, poolFund)
+	if err != nil {
+		return nil, err
+	}
+	if poolFund.Value.Cmp(zero) == 0 {
+		pool.ActiveFund = nil
+	}
+	pool.UnClaimedRewards.Sub(pool.UnClaimedRewards, rewards)
+	err = d.saveDelegatorData([]byte(liquidStakingPoolKey), pool)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidData.TotalShares.Sub(liquidData.TotalShares, amount)
+	err = d.saveLiquidData(liquidData)
+	if err != nil {
+		return nil, err
+	}
+
+	return stake, nil
+}
+
+func (d *delegation) moveLiquidStakeToHolder(holder []byte, stake *big.Int, rewards *big.Int) error {
+	isNew, delegator, err := d.getOrCreateDelegatorData(holder)
+	if err != nil {
+		return err
+	}
+
+	if isNew {
+		delegator.RewardsCheckpoint = d.eei.BlockChainHook().CurrentEpoch() + 1
+	} else {
+		err = d.computeAndUpdateRewards(holder, delegator)
+		if err != nil {
+			return err
+		}
+	}
+	delegator.UnClaimedRewards.Add(delegator.UnClaimedRewards, rewards)
+
+	if stake.Cmp(zero) > 0 {
+		if len(delegator.ActiveFund) == 0 {
+			fundKey, errCreate := d.createAndSaveNextKeyFund(holder, stake, active)
+			if errCreate != nil {
+				return errCreate
+			}
+			delegator.ActiveFund = fundKey
+		} else {
+			err = d.addValueToFund(delegator.ActiveFund, stake)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if isNew {
+		dStatus, errGet := d.getDelegationStatus()
+		if errGet != nil {
+			return errGet
+		}
+		dStatus.NumUsers++
+		err = d.saveDelegationStatus(dStatus)
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.saveDelegatorData(holder, delegator)
+}
+
+func computeLiquidShareOfValue(value *big.Int, amount *big.Int, totalShares *big.Int) *big.Int {
+	if amount.Cmp(totalShares) == 0 {
+		return big.NewInt(0).Set(value)
+	}
+
+	share := big.NewInt(0).Mul(value, amount)
+	return share.Div(share, totalShares)
+}
+
+func (d *delegation) getLiquidData() (*LiquidStakingData, error) {
+	marshaledData := d.eei.GetStorage([]byte(liquidStakingKey))
+	if len(marshaledData) == 0 {
+		return nil, nil
+	}
+
+	liquidData := &LiquidStakingData{}
+	err := d.marshalizer.Unmarshal(liquidData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	if liquidData.TotalShares == nil {
+		liquidData.TotalShares = big.NewInt(0)
+	}
+
+	return liquidData, nil
+}
+
+func (d *delegation) saveLiquidData(liquidData *LiquidStakingData) error {
+	marshaledData, err := d.marshalizer.Marshal(liquidData)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(liquidStakingKey), marshaledData)
+	return nil
+}
+
+func (d *delegation) getLiquidStakingData(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	returnCode := d.checkArgumentsForGeneralViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	liquidData, err := d.getLiquidData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if liquidData == nil {
+		d.eei.AddReturnMessage(vm.ErrLiquidStakingNotEnabled.Error())
+		return vmcommon.UserError
+	}
+
+	poolValue, err := d.computeLiquidPoolValue()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(liquidData.Token)
+	d.eei.Finish(liquidData.TotalShares.Bytes())
+	d.eei.Finish(poolValue.Bytes())
+
+	return vmcommon.Ok
+}
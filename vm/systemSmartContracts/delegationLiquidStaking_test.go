@@ -0,0 +1,271 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLiquidStakingDelegation(t *testing.T) (*delegation, *vmContext, *[]*vmcommon.ContractCallInput) {
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	eei.inputParser = parsers.NewCallArgsParser()
+	esdtCalls := make([]*vmcommon.ContractCallInput, 0)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			if bytes.Equal(input.RecipientAddr, vm.ESDTSCAddress) {
+				esdtCalls = append(esdtCalls, input)
+			}
+			return vmcommon.Ok
+		}}, nil
+	}})
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+	args.Eei = eei
+
+	d, err := NewDelegationSystemSC(args)
+	require.Nil(t, err)
+
+	eei.SetSCAddress([]byte("addr"))
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:  big.NewInt(0),
+		InitialOwnerFunds: big.NewInt(100),
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	return d, eei, &esdtCalls
+}
+
+func TestDelegationSystemSC_EnableLiquidStaking(t *testing.T) {
+	t.Parallel()
+
+	d, eei, esdtCalls := createLiquidStakingDelegation(t)
+
+	vmInput := getDefaultVmInputForFunc("enableLiquidStaking", [][]byte{[]byte("LIQ-abcdef")})
+	vmInput.CallerAddr = []byte("delegator")
+	output := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only owner can call this method"))
+
+	vmInput.CallerAddr = []byte("owner")
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(*esdtCalls))
+	assert.Equal(t, "setDelegationPositionToken", (*esdtCalls)[0].Function)
+	assert.Equal(t, [][]byte{[]byte("LIQ-abcdef")}, (*esdtCalls)[0].Arguments)
+
+	liquidData, _ := d.getLiquidData()
+	assert.Equal(t, []byte("LIQ-abcdef"), liquidData.Token)
+	assert.Equal(t, big.NewInt(0), liquidData.TotalShares)
+
+	eei.returnMessage = ""
+	output = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "liquid staking already enabled"))
+}
+
+func TestDelegationSystemSC_DelegateLiquidShouldMintShares(t *testing.T) {
+	t.Parallel()
+
+	d, _, esdtCalls := createLiquidStakingDelegation(t)
+	_ = d.saveLiquidData(&LiquidStakingData{Token: []byte("LIQ-abcdef"), TotalShares: big.NewInt(0)})
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallValue = big.NewInt(100)
+	vmInput.CallerAddr = []byte("delegator1")
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 1, len(*esdtCalls))
+	assert.Equal(t, "mint", (*esdtCalls)[0].Function)
+	assert.Equal(t, [][]byte{[]byte("LIQ-abcdef"), big.NewInt(100).Bytes(), []byte("delegator1")}, (*esdtCalls)[0].Arguments)
+
+	_, delegator, _ := d.getOrCreateDelegatorData([]byte("delegator1"))
+	assert.Equal(t, 0, len(delegator.ActiveFund))
+
+	// rewards accumulated by the pool increase the value of each share
+	_, pool, _ := d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	pool.UnClaimedRewards = big.NewInt(100)
+	_ = d.saveDelegatorData([]byte(liquidStakingPoolKey), pool)
+
+	vmInput.CallerAddr = []byte("delegator2")
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 2, len(*esdtCalls))
+	assert.Equal(t, big.NewInt(50).Bytes(), (*esdtCalls)[1].Arguments[1])
+
+	liquidData, _ := d.getLiquidData()
+	assert.Equal(t, big.NewInt(150), liquidData.TotalShares)
+	poolValue, _ := d.computeLiquidPoolValue()
+	assert.Equal(t, big.NewInt(300), poolValue)
+}
+
+func TestDelegationSystemSC_RedeemLiquidPosition(t *testing.T) {
+	t.Parallel()
+
+	d, eei, _ := createLiquidStakingDelegation(t)
+	_ = d.saveLiquidData(&LiquidStakingData{Token: []byte("LIQ-abcdef"), TotalShares: big.NewInt(0)})
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallValue = big.NewInt(300)
+	vmInput.CallerAddr = []byte("delegator1")
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	pool.UnClaimedRewards = big.NewInt(60)
+	_ = d.saveDelegatorData([]byte(liquidStakingPoolKey), pool)
+
+	holder := []byte("holder")
+	redeemInput := getDefaultVmInputForFunc(redeemLiquidPositionFunction, [][]byte{holder, big.NewInt(100).Bytes()})
+	output = d.Execute(redeemInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by the ESDT system SC only"))
+
+	redeemInput.CallerAddr = vm.ESDTSCAddress
+	redeemInput.Arguments[1] = big.NewInt(301).Bytes()
+	output = d.Execute(redeemInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	redeemInput.Arguments[1] = big.NewInt(100).Bytes()
+	output = d.Execute(redeemInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, delegator, _ := d.getOrCreateDelegatorData(holder)
+	holderFund, _ := d.getFund(delegator.ActiveFund)
+	assert.Equal(t, big.NewInt(100), holderFund.Value)
+	assert.Equal(t, holder, holderFund.Address)
+	assert.Equal(t, big.NewInt(20), delegator.UnClaimedRewards)
+
+	_, pool, _ = d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	poolFund, _ := d.getFund(pool.ActiveFund)
+	assert.Equal(t, big.NewInt(200), poolFund.Value)
+	assert.Equal(t, big.NewInt(40), pool.UnClaimedRewards)
+
+	liquidData, _ := d.getLiquidData()
+	assert.Equal(t, big.NewInt(200), liquidData.TotalShares)
+
+	dStatus, _ := d.getDelegationStatus()
+	assert.Equal(t, uint64(2), dStatus.NumUsers)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(300), globalFund.TotalActive)
+
+	redeemInput.Arguments[1] = big.NewInt(200).Bytes()
+	output = d.Execute(redeemInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, pool, _ = d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	assert.Equal(t, 0, len(pool.ActiveFund))
+	_, delegator, _ = d.getOrCreateDelegatorData(holder)
+	holderFund, _ = d.getFund(delegator.ActiveFund)
+	assert.Equal(t, big.NewInt(300), holderFund.Value)
+	assert.Equal(t, big.NewInt(60), delegator.UnClaimedRewards)
+}
+
+func TestDelegationSystemSC_ExecuteWithLiquidTokenAfterRewardsAndPartialRedeem(t *testing.T) {
+	t.Parallel()
+
+	d, eei, esdtCalls := createLiquidStakingDelegation(t)
+	currentEpoch := uint32(0)
+	eei.blockChainHook = &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	_ = d.saveLiquidData(&LiquidStakingData{Token: []byte("LIQ-abcdef"), TotalShares: big.NewInt(0)})
+
+	vmInput := getDefaultVmInputForFunc("delegate", [][]byte{})
+	vmInput.CallValue = big.NewInt(300)
+	vmInput.CallerAddr = []byte("delegator1")
+	output := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	// the pool holds all the active stake and collects all the rewards of epochs 1 and 2
+	_ = d.saveRewardData(1, &RewardComputationData{RewardsToDistribute: big.NewInt(30), TotalActive: big.NewInt(300)})
+	_ = d.saveRewardData(2, &RewardComputationData{RewardsToDistribute: big.NewInt(30), TotalActive: big.NewInt(300)})
+	currentEpoch = 2
+
+	// 120 delegated over a pool worth 360 for 300 shares
+	vmInput.CallValue = big.NewInt(120)
+	vmInput.CallerAddr = []byte("delegator2")
+	output = d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, big.NewInt(100).Bytes(), (*esdtCalls)[1].Arguments[1])
+
+	_ = d.saveRewardData(3, &RewardComputationData{RewardsToDistribute: big.NewInt(42), TotalActive: big.NewInt(420)})
+	currentEpoch = 3
+
+	transferInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTTransfer, [][]byte{[]byte("OTHER-abcdef"), big.NewInt(100).Bytes(), []byte("claimRewards")})
+	transferInput.CallerAddr = []byte("holder1")
+	output = d.Execute(transferInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "only the liquid staking token is accepted"))
+
+	transferInput.Arguments[0] = []byte("LIQ-abcdef")
+	transferInput.Arguments[2] = []byte("delegate")
+	output = d.Execute(transferInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	// partial redeem of a quarter of the pool: stake 420 * 100 / 400 and rewards 102 * 100 / 400
+	transferInput.Arguments[2] = []byte("claimRewards")
+	output = d.Execute(transferInput)
+	require.Equal(t, vmcommon.Ok, output)
+	require.Equal(t, 3, len(*esdtCalls))
+	assert.Equal(t, core.BuiltInFunctionESDTBurn, (*esdtCalls)[2].Function)
+	assert.Equal(t, [][]byte{[]byte("LIQ-abcdef"), big.NewInt(100).Bytes()}, (*esdtCalls)[2].Arguments)
+
+	holderAccount := eei.outputAccounts["holder1"]
+	require.NotNil(t, holderAccount)
+	assert.Equal(t, big.NewInt(25), holderAccount.OutputTransfers[0].Value)
+
+	_, holder1, _ := d.getOrCreateDelegatorData([]byte("holder1"))
+	holder1Fund, _ := d.getFund(holder1.ActiveFund)
+	assert.Equal(t, big.NewInt(105), holder1Fund.Value)
+	assert.Equal(t, big.NewInt(0), holder1.UnClaimedRewards)
+
+	liquidData, _ := d.getLiquidData()
+	assert.Equal(t, big.NewInt(300), liquidData.TotalShares)
+	poolValue, _ := d.computeLiquidPoolValue()
+	assert.Equal(t, big.NewInt(315+77), poolValue)
+
+	// the remaining shares take the whole pool, including the rounding left by the partial redeem
+	transferInput.CallerAddr = []byte("holder2")
+	transferInput.Arguments[1] = big.NewInt(300).Bytes()
+	transferInput.Arguments[2] = []byte("unDelegate")
+	output = d.Execute(transferInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	_, holder2, _ := d.getOrCreateDelegatorData([]byte("holder2"))
+	assert.Equal(t, 0, len(holder2.ActiveFund))
+	require.Equal(t, 1, len(holder2.UnStakedFunds))
+	unStakedFund, _ := d.getFund(holder2.UnStakedFunds[0])
+	assert.Equal(t, big.NewInt(315), unStakedFund.Value)
+	assert.Equal(t, big.NewInt(77), holder2.UnClaimedRewards)
+
+	_, pool, _ := d.getOrCreateDelegatorData([]byte(liquidStakingPoolKey))
+	assert.Equal(t, 0, len(pool.ActiveFund))
+	assert.Equal(t, big.NewInt(0), pool.UnClaimedRewards)
+	liquidData, _ = d.getLiquidData()
+	assert.Equal(t, big.NewInt(0), liquidData.TotalShares)
+}
@@ -0,0 +1,238 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const unDelegateQueueKey = "unDelegateQueue"
+
+// unDelegateQueueEntryPrefix prefixes the keys of the queued funds, each entry being saved under its own index
+const unDelegateQueueEntryPrefix = "unDelegateQueueEntry"
+
+// maxUnDelegateQueueMatches bounds the number of queue entries a single delegate call can go through
+const maxUnDelegateQueueMatches = 50
+
+// addToUnDelegateQueue appends the newly created unStaked fund at the tail of the queue. A delegator unDelegating
+// several times in the same epoch keeps the position of its first request, as all the values are added in the same
+// fund, which is queued only once
+func (d *delegation) addToUnDelegateQueue(fundKey []byte) error {
+	queue, err := d.getUnDelegateQueue()
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage(createUnDelegateQueueEntryKey(queue.Tail), fundKey)
+	queue.Tail++
+
+	return d.saveUnDelegateQueue(queue)
+}
+
+// matchUnDelegateQueue pays the unDelegate requests of the current epoch from the delegated value, in the order in
+// which they were made. The paid value is moved back from unStaked to staked in the validator SC, so the returned
+// matched value must not be staked again by the delegate call. The entries of the previous epochs and the ones of
+// the already withdrawn funds are removed from the head of the queue on the way
+func (d *delegation) matchUnDelegateQueue(scAddress []byte, delegateValue *big.Int) (*big.Int, vmcommon.ReturnCode) {
+	matched := big.NewInt(0)
+	if !d.flagUnDelegateQueue.IsSet() {
+		return matched, vmcommon.Ok
+	}
+
+	queue, err := d.getUnDelegateQueue()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	currentEpoch := d.eei.BlockChainHook().CurrentEpoch()
+	numSteps := 0
+	for queue.Head < queue.Tail && numSteps < maxUnDelegateQueueMatches && matched.Cmp(delegateValue) < 0 {
+		numSteps++
+		entryKey := createUnDelegateQueueEntryKey(queue.Head)
+		fundKey := d.eei.GetStorage(entryKey)
+		fund, errGet := d.getQueuedFund(fundKey)
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return nil, vmcommon.UserError
+		}
+		if fund == nil || fund.Epoch != currentEpoch {
+			d.eei.SetStorage(entryKey, nil)
+			queue.Head++
+			continue
+		}
+
+		remaining := big.NewInt(0).Sub(delegateValue, matched)
+		paidValue := big.NewInt(0).Set(fund.Value)
+		if paidValue.Cmp(remaining) > 0 {
+			paidValue.Set(remaining)
+		}
+
+		err = d.payUnDelegateRequest(scAddress, fundKey, fund, paidValue)
+		if err != nil {
+			d.eei.AddReturnMessage(err.Error())
+			return nil, vmcommon.UserError
+		}
+		if fund.Value.Cmp(zero) == 0 {
+			d.eei.SetStorage(entryKey, nil)
+			queue.Head++
+		}
+
+		matched.Add(matched, paidValue)
+	}
+
+	err = d.saveUnDelegateQueue(queue)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if matched.Cmp(zero) == 0 {
+		return matched, vmcommon.Ok
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	globalFund.TotalUnStaked.Sub(globalFund.TotalUnStaked, matched)
+	err = d.saveGlobalFundData(globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	_, returnCode := d.executeOnValidatorSCWithValueInArgs(scAddress, "reStakeUnStakedTokens", matched)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+
+	return matched, vmcommon.Ok
+}
+
+// getQueuedFund returns the queued fund or nil if it was already withdrawn. Any other error is returned
+func (d *delegation) getQueuedFund(fundKey []byte) (*Fund, error) {
+	if len(fundKey) == 0 {
+		return nil, nil
+	}
+
+	fund, err := d.getFund(fundKey)
+	if errors.Is(err, vm.ErrDataNotFoundUnderKey) {
+		return nil, nil
+	}
+
+	return fund, err
+}
+
+func (d *delegation) payUnDelegateRequest(scAddress []byte, fundKey []byte, fund *Fund, paidValue *big.Int) error {
+	fund.Value.Sub(fund.Value, paidValue)
+	err := d.saveFund(fundKey, fund)
+	if err != nil {
+		return err
+	}
+
+	err = d.eei.Transfer(fund.Address, scAddress, paidValue, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	if fund.Value.Cmp(zero) > 0 {
+		return nil
+	}
+
+	_, delegator, err := d.getOrCreateDelegatorData(fund.Address)
+	if err != nil {
+		return err
+	}
+
+	remainingFunds := make([][]byte, 0, len(delegator.UnStakedFunds))
+	for _, unStakedFundKey := range delegator.UnStakedFunds {
+		if !bytes.Equal(unStakedFundKey, fundKey) {
+			remainingFunds = append(remainingFunds, unStakedFundKey)
+		}
+	}
+	delegator.UnStakedFunds = remainingFunds
+
+	err = d.saveDelegatorData(fund.Address, delegator)
+	if err != nil {
+		return err
+	}
+
+	return d.deleteDelegatorIfNeeded(fund.Address, delegator)
+}
+
+func (d *delegation) getUnDelegateQueue() (*UnDelegateQueue, error) {
+	queue := &UnDelegateQueue{}
+	marshaledData := d.eei.GetStorage([]byte(unDelegateQueueKey))
+	if len(marshaledData) == 0 {
+		return queue, nil
+	}
+
+	err := d.marshalizer.Unmarshal(queue, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+func (d *delegation) saveUnDelegateQueue(queue *UnDelegateQueue) error {
+	marshaledData, err := d.marshalizer.Marshal(queue)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(unDelegateQueueKey), marshaledData)
+	return nil
+}
+
+func createUnDelegateQueueEntryKey(index uint64) []byte {
+	return append([]byte(unDelegateQueueEntryPrefix), big.NewInt(0).SetUint64(index).Bytes()...)
+}
+
+// getUnDelegateQueuePosition returns the 1-based position of the delegator in the unDelegate queue of the current
+// epoch (0 if it is not queued), the value queued in front of it and its own queued value
+func (d *delegation) getUnDelegateQueuePosition(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkArgumentsForUserViewFunc(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	queue, err := d.getUnDelegateQueue()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	currentEpoch := d.eei.BlockChainHook().CurrentEpoch()
+	valueInFront := big.NewInt(0)
+	position := 0
+	for index := queue.Head; index < queue.Tail; index++ {
+		fund, errGet := d.getQueuedFund(d.eei.GetStorage(createUnDelegateQueueEntryKey(index)))
+		if errGet != nil {
+			d.eei.AddReturnMessage(errGet.Error())
+			return vmcommon.UserError
+		}
+		if fund == nil || fund.Epoch != currentEpoch {
+			continue
+		}
+
+		position++
+		if bytes.Equal(fund.Address, args.Arguments[0]) {
+			d.eei.Finish(big.NewInt(int64(position)).Bytes())
+			d.eei.Finish(valueInFront.Bytes())
+			d.eei.Finish(fund.Value.Bytes())
+			return vmcommon.Ok
+		}
+		valueInFront.Add(valueInFront, fund.Value)
+	}
+
+	d.eei.Finish(zero.Bytes())
+	d.eei.Finish(valueInFront.Bytes())
+	d.eei.Finish(zero.Bytes())
+
+	return vmcommon.Ok
+}
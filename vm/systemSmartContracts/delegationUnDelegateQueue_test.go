@@ -0,0 +1,185 @@
+package systemSmartContracts
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUnDelegateQueueDelegation(t *testing.T, epoch *uint32) (*delegation, *vmContext, *[]*vmcommon.ContractCallInput) {
+	args := createMockArgumentsForDelegation()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return *epoch
+			},
+		},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	eei.inputParser = parsers.NewCallArgsParser()
+	validatorCalls := make([]*vmcommon.ContractCallInput, 0)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			validatorCalls = append(validatorCalls, input)
+			return vmcommon.Ok
+		}}, nil
+	}})
+	createDelegationManagerConfig(eei, args.Marshalizer, big.NewInt(10))
+	args.Eei = eei
+	args.StakingSCConfig.UnBondPeriodInEpochs = 10
+
+	d, err := NewDelegationSystemSC(args)
+	require.Nil(t, err)
+
+	eei.SetSCAddress([]byte("addr"))
+	eei.SetStorage([]byte(ownerKey), []byte("owner"))
+	_ = d.saveDelegationStatus(&DelegationContractStatus{})
+	_ = d.saveDelegationContractConfig(&DelegationConfig{
+		MaxDelegationCap:     big.NewInt(0),
+		InitialOwnerFunds:    big.NewInt(100),
+		UnBondPeriodInEpochs: 10,
+	})
+	_ = d.saveGlobalFundData(&GlobalFundData{
+		TotalActive:   big.NewInt(0),
+		TotalUnStaked: big.NewInt(0),
+	})
+
+	return d, eei, &validatorCalls
+}
+
+func executeDelegationFunction(t *testing.T, d *delegation, function string, caller []byte, value int64, arguments ...[]byte) {
+	vmInput := getDefaultVmInputForFunc(function, arguments)
+	vmInput.CallerAddr = caller
+	vmInput.CallValue = big.NewInt(value)
+	require.Equal(t, vmcommon.Ok, d.Execute(vmInput))
+}
+
+func TestDelegationSystemSC_DelegateShouldPayUnDelegateQueueInOrder(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, validatorCalls := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+	delegatorB := []byte("delegatorB")
+	delegatorC := []byte("delegatorC")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "delegate", delegatorB, 100)
+	executeDelegationFunction(t, d, "unDelegate", delegatorA, 0, big.NewInt(60).Bytes())
+	executeDelegationFunction(t, d, "unDelegate", delegatorB, 0, big.NewInt(40).Bytes())
+
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getUnDelegateQueuePosition", delegatorB, 0, delegatorB)
+	assert.Equal(t, [][]byte{{2}, {60}, {40}}, eei.output)
+
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	executeDelegationFunction(t, d, "delegate", delegatorC, 80)
+
+	assert.Equal(t, big.NewInt(60), eei.outputAccounts[string(delegatorA)].BalanceDelta)
+	assert.Equal(t, big.NewInt(20), eei.outputAccounts[string(delegatorB)].BalanceDelta)
+	require.Equal(t, 2, len(*validatorCalls))
+	assert.Equal(t, "reStakeUnStakedTokens", (*validatorCalls)[0].Function)
+	assert.Equal(t, [][]byte{big.NewInt(80).Bytes()}, (*validatorCalls)[0].Arguments)
+	assert.Equal(t, "stake", (*validatorCalls)[1].Function)
+	assert.Equal(t, 0, (*validatorCalls)[1].CallValue.Sign())
+
+	_, dataA, _ := d.getOrCreateDelegatorData(delegatorA)
+	assert.Equal(t, 0, len(dataA.UnStakedFunds))
+	_, dataB, _ := d.getOrCreateDelegatorData(delegatorB)
+	require.Equal(t, 1, len(dataB.UnStakedFunds))
+	fundB, _ := d.getFund(dataB.UnStakedFunds[0])
+	assert.Equal(t, big.NewInt(20), fundB.Value)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(180), globalFund.TotalActive)
+	assert.Equal(t, big.NewInt(20), globalFund.TotalUnStaked)
+
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getUnDelegateQueuePosition", delegatorB, 0, delegatorB)
+	assert.Equal(t, [][]byte{{1}, {}, {20}}, eei.output)
+
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getUnDelegateQueuePosition", delegatorA, 0, delegatorA)
+	assert.Equal(t, [][]byte{{}, {20}, {}}, eei.output)
+}
+
+func TestDelegationSystemSC_DelegateShouldNotMatchUnDelegateRequestsOfPreviousEpochs(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, validatorCalls := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "unDelegate", delegatorA, 0, big.NewInt(60).Bytes())
+
+	epoch++
+	*validatorCalls = make([]*vmcommon.ContractCallInput, 0)
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	executeDelegationFunction(t, d, "delegate", []byte("delegatorB"), 50)
+
+	assert.Nil(t, eei.outputAccounts[string(delegatorA)])
+	require.Equal(t, 1, len(*validatorCalls))
+	assert.Equal(t, "stake", (*validatorCalls)[0].Function)
+	assert.Equal(t, big.NewInt(50), (*validatorCalls)[0].CallValue)
+
+	globalFund, _ := d.getGlobalFundData()
+	assert.Equal(t, big.NewInt(60), globalFund.TotalUnStaked)
+
+	queue, _ := d.getUnDelegateQueue()
+	assert.Equal(t, &UnDelegateQueue{Head: 1, Tail: 1}, queue)
+	assert.Equal(t, 0, len(eei.GetStorage(createUnDelegateQueueEntryKey(0))))
+}
+
+func TestDelegationSystemSC_UnDelegateSameEpochShouldQueueTheFundOnce(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "unDelegate", delegatorA, 0, big.NewInt(30).Bytes())
+	executeDelegationFunction(t, d, "unDelegate", delegatorA, 0, big.NewInt(30).Bytes())
+
+	queue, _ := d.getUnDelegateQueue()
+	assert.Equal(t, &UnDelegateQueue{Head: 0, Tail: 1}, queue)
+
+	eei.output = make([][]byte, 0)
+	executeDelegationFunction(t, d, "getUnDelegateQueuePosition", delegatorA, 0, delegatorA)
+	assert.Equal(t, [][]byte{{1}, {}, {60}}, eei.output)
+}
+
+func TestDelegationSystemSC_DelegateWithUnreadableQueuedFundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(5)
+	d, eei, _ := createUnDelegateQueueDelegation(t, &epoch)
+	delegatorA := []byte("delegatorA")
+
+	executeDelegationFunction(t, d, "delegate", delegatorA, 100)
+	executeDelegationFunction(t, d, "unDelegate", delegatorA, 0, big.NewInt(60).Bytes())
+
+	fundKey := eei.GetStorage(createUnDelegateQueueEntryKey(0))
+	eei.SetStorage(fundKey, []byte("invalid fund"))
+
+	vmInput := getDefaultVmInputForFunc("delegate", nil)
+	vmInput.CallerAddr = []byte("delegatorB")
+	vmInput.CallValue = big.NewInt(50)
+	assert.Equal(t, vmcommon.UserError, d.Execute(vmInput))
+
+	queue, _ := d.getUnDelegateQueue()
+	assert.Equal(t, &UnDelegateQueue{Head: 0, Tail: 1}, queue)
+}
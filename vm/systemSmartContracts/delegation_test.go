@@ -2031,6 +2031,7 @@ func TestDelegationSystemSC_ExecuteUnDelegateAllFundsAsOwner(t *testing.T) {
 	addValidatorAndStakingScToVmContext(eei)
 	minDelegationAmount := big.NewInt(10)
 	createDelegationManagerConfig(eei, args.Marshalizer, minDelegationAmount)
+	args.EpochConfig.EnableEpochs.UnDelegateQueueEnableEpoch = 1
 
 	vmInput := getDefaultVmInputForFunc("unDelegate", [][]byte{{100}})
 	d, _ := NewDelegationSystemSC(args)
@@ -41,6 +41,9 @@ const canTransferNFTCreateRole = "canTransferNFTCreateRole"
 const upgradable = "canUpgrade"
 
 const conversionBase = 10
+const redeemLiquidPositionFunction = "redeemLiquidPosition"
+
+var metachainShardIdentifier = []byte{255}
 
 type esdt struct {
 	eei                    vm.SystemEI
@@ -53,6 +56,10 @@ type esdt struct {
 	hasher                 hashing.Hasher
 	enabledEpoch           uint32
 	flagEnabled            atomic.Flag
+	metaESDTEnableEpoch    uint32
+	flagMetaESDT           atomic.Flag
+	liquidStakingEpoch     uint32
+	flagLiquidStaking      atomic.Flag
 	mutExecution           sync.RWMutex
 	addressPubKeyConverter core.PubkeyConverter
 }
@@ -108,10 +115,14 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 		hasher:                 args.Hasher,
 		marshalizer:            args.Marshalizer,
 		enabledEpoch:           args.EpochConfig.EnableEpochs.ESDTEnableEpoch,
+		metaESDTEnableEpoch:    args.EpochConfig.EnableEpochs.MetaESDTEnableEpoch,
+		liquidStakingEpoch:     args.EpochConfig.EnableEpochs.LiquidStakingEnableEpoch,
 		endOfEpochSCAddress:    args.EndOfEpochSCAddress,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 	}
 	log.Debug("esdt: enable epoch for esdt", "epoch", e.enabledEpoch)
+	log.Debug("esdt: enable epoch for meta esdt", "epoch", e.metaESDTEnableEpoch)
+	log.Debug("esdt: enable epoch for liquid staking", "epoch", e.liquidStakingEpoch)
 
 	args.EpochNotifier.RegisterNotifyHandler(e)
 
@@ -143,6 +154,8 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.registerSemiFungible(args)
 	case "issueNonFungible":
 		return e.registerNonFungible(args)
+	case "registerMetaESDT":
+		return e.registerMetaESDT(args)
 	case core.BuiltInFunctionESDTBurn:
 		return e.burn(args)
 	case "mint":
@@ -187,6 +200,8 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.getAllAddressesAndRoles(args)
 	case "getContractConfig":
 		return e.getContractConfig(args)
+	case "setDelegationPositionToken":
+		return e.setDelegationPositionToken(args)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -335,6 +350,63 @@ func (e *esdt) registerSemiFungible(args *vmcommon.ContractCallInput) vmcommon.R
 	return vmcommon.Ok
 }
 
+// format: registerMetaESDT@tokenName@ticker@numOfDecimals@optional-list-of-properties
+// The number of decimals only tells how the amounts are displayed, as for fungible tokens: ESDTNFTCreate,
+// ESDTNFTAddQuantity and ESDTNFTBurn work with amounts in the smallest denomination, so the number of decimals is
+// kept only in the token properties returned by getTokenProperties and it is not sent to the shards
+func (e *esdt) registerMetaESDT(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagMetaESDT.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	returnCode := e.checkBasicCreateArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	numOfDecimals, err := getNumOfDecimals(args.Arguments[2])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	tokenIdentifier, err := e.createNewToken(
+		args.CallerAddr,
+		args.Arguments[0],
+		args.Arguments[1],
+		big.NewInt(0),
+		numOfDecimals,
+		args.Arguments[3:],
+		[]byte(core.MetaESDT))
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.eei.Finish(tokenIdentifier)
+
+	return vmcommon.Ok
+}
+
+func getNumOfDecimals(argument []byte) (uint32, error) {
+	numOfDecimalsAsBig := big.NewInt(0).SetBytes(argument)
+	if !numOfDecimalsAsBig.IsUint64() || numOfDecimalsAsBig.Uint64() > maxNumberOfDecimals {
+		return 0, fmt.Errorf("%w, minimum: %d, maximum: %d, provided: %s",
+			vm.ErrInvalidNumberOfDecimals,
+			minNumberOfDecimals,
+			maxNumberOfDecimals,
+			numOfDecimalsAsBig.String(),
+		)
+	}
+
+	return uint32(numOfDecimalsAsBig.Uint64()), nil
+}
+
 func (e *esdt) createNewToken(
 	owner []byte,
 	tokenName []byte,
@@ -376,9 +448,21 @@ func (e *esdt) createNewToken(
 		return nil, err
 	}
 
+	if e.flagMetaESDT.IsSet() {
+		e.sendTokenTypeToAll(tokenIdentifier, newESDTToken)
+	}
+
 	return tokenIdentifier, nil
 }
 
+// sendTokenTypeToAll sends the type of a new token to the system account of each shard, where the NFT built-in
+// functions check it
+func (e *esdt) sendTokenTypeToAll(tokenIdentifier []byte, token *ESDTData) {
+	esdtTransferData := core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenIdentifier) +
+		"@" + hex.EncodeToString(token.TokenType)
+	e.eei.SendGlobalSettingToAll(e.eSDTSCAddress, []byte(esdtTransferData))
+}
+
 func isTickerValid(tickerName []byte) bool {
 	if len(tickerName) < minLengthForTickerName || len(tickerName) > maxLengthForTickerName {
 		return false
@@ -519,16 +603,17 @@ func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage(err.Error())
 		return vmcommon.UserError
 	}
-	if !token.Burnable {
-		esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
-		err = e.eei.Transfer(args.CallerAddr, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
-		if err != nil {
-			e.eei.AddReturnMessage(err.Error())
-			return vmcommon.UserError
+	isDelegationPosition := e.flagLiquidStaking.IsSet() && len(token.DelegationContract) > 0
+	// the delegation contract records the burn of the tokens it received and already redeemed
+	isRedeemedByDelegation := isDelegationPosition && bytes.Equal(args.CallerAddr, token.DelegationContract)
+	if !token.Burnable && !isRedeemedByDelegation {
+		return e.returnBurntTokens(args, "token is not burnable")
+	}
+	if isDelegationPosition && !isRedeemedByDelegation {
+		errRedeem := e.redeemDelegationPosition(args, token.DelegationContract)
+		if errRedeem != nil {
+			return e.returnBurntTokens(args, errRedeem.Error())
 		}
-
-		e.eei.AddReturnMessage("token is not burnable")
-		return vmcommon.Ok
 	}
 
 	token.BurntValue.Add(token.BurntValue, burntValue)
@@ -548,6 +633,97 @@ func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+func (e *esdt) returnBurntTokens(args *vmcommon.ContractCallInput, message string) vmcommon.ReturnCode {
+	esdtTransferData := core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
+	err := e.eei.Transfer(args.CallerAddr, e.eSDTSCAddress, big.NewInt(0), []byte(esdtTransferData), 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	e.eei.AddReturnMessage(message)
+	return vmcommon.Ok
+}
+
+// redeemDelegationPosition notifies the delegation contract that the caller burnt the provided quantity of the token
+// representing the liquid delegation positions, so it can be converted back in a delegation position of the caller
+func (e *esdt) redeemDelegationPosition(args *vmcommon.ContractCallInput, delegationContract []byte) error {
+	redeemData := redeemLiquidPositionFunction + "@" + hex.EncodeToString(args.CallerAddr) + "@" + hex.EncodeToString(args.Arguments[1])
+	vmOutput, err := e.eei.ExecuteOnDestContext(delegationContract, e.eSDTSCAddress, big.NewInt(0), []byte(redeemData))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return fmt.Errorf("%w: %s", vm.ErrCannotRedeemLiquidPosition, vmOutput.ReturnMessage)
+	}
+
+	return nil
+}
+
+// setDelegationPositionToken marks the token as the representation of the liquid positions of the calling delegation
+// contract. The caller must own the fungible token and must be able to mint and burn it
+func (e *esdt) setDelegationPositionToken(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagLiquidStaking.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) != 1 {
+		e.eei.AddReturnMessage("expected num of arguments 1")
+		return vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if !core.IsSmartContractOnMetachain(metachainShardIdentifier, args.CallerAddr) {
+		e.eei.AddReturnMessage("can be called by delegation contracts only")
+		return vmcommon.UserError
+	}
+	if string(token.TokenType) != core.FungibleESDT {
+		e.eei.AddReturnMessage("only fungible tokens can represent delegation positions")
+		return vmcommon.UserError
+	}
+	if !token.Mintable || !token.Burnable {
+		e.eei.AddReturnMessage("token must be mintable and burnable")
+		return vmcommon.UserError
+	}
+	if len(token.DelegationContract) > 0 {
+		e.eei.AddReturnMessage("token already represents delegation positions")
+		return vmcommon.UserError
+	}
+	if len(token.SpecialRoles) > 0 {
+		e.eei.AddReturnMessage("token must not have special roles")
+		return vmcommon.UserError
+	}
+	if getCirculatingSupply(token).Sign() != 0 {
+		e.eei.AddReturnMessage("token must not have circulating supply")
+		return vmcommon.UserError
+	}
+
+	token.DelegationContract = args.CallerAddr
+	err := e.saveToken(args.Arguments[0], token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// getCirculatingSupply returns the quantity minted through the esdt system smart contract which was not burnt yet. The
+// quantities minted and burnt locally are not accounted here, which is why tokens having special roles are rejected
+func getCirculatingSupply(token *ESDTData) *big.Int {
+	circulatingSupply := big.NewInt(0)
+	if token.MintedValue != nil {
+		circulatingSupply.Set(token.MintedValue)
+	}
+	if token.BurntValue != nil {
+		circulatingSupply.Sub(circulatingSupply, token.BurntValue)
+	}
+
+	return circulatingSupply
+}
+
 func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 2 || len(args.Arguments) > 3 {
 		e.eei.AddReturnMessage("accepted arguments number 2/3")
@@ -1104,7 +1280,7 @@ func checkSpecialRolesAccordingToTokenType(args [][]byte, token *ESDTData) error
 		return validateRoles(args, isSpecialRoleValidForFungible)
 	case core.NonFungibleESDT:
 		return validateRoles(args, isSpecialRoleValidForNonFungible)
-	case core.SemiFungibleESDT:
+	case core.SemiFungibleESDT, core.MetaESDT:
 		return validateRoles(args, isSpecialRoleValidForSemiFungible)
 	}
 	return nil
@@ -1496,6 +1672,12 @@ func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
 func (e *esdt) EpochConfirmed(epoch uint32, _ uint64) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("ESDT contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagMetaESDT.Toggle(epoch >= e.metaESDTEnableEpoch)
+	log.Debug("ESDT contract: meta ESDT", "enabled", e.flagMetaESDT.IsSet())
+
+	e.flagLiquidStaking.Toggle(epoch >= e.liquidStakingEpoch)
+	log.Debug("ESDT contract: liquid staking", "enabled", e.flagLiquidStaking.IsSet())
 }
 
 // SetNewGasCost is called whenever a gas cost was changed
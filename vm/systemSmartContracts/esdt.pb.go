@@ -49,6 +49,7 @@ type ESDTData struct {
 	CanTransferNFTCreateRole bool          `protobuf:"varint,18,opt,name=CanTransferNFTCreateRole,proto3" json:"CanTransferNFTCreateRole"`
 	SpecialRoles             []*ESDTRoles  `protobuf:"bytes,19,rep,name=SpecialRoles,proto3" json:"SpecialRoles"`
 	NumWiped                 uint32        `protobuf:"varint,20,opt,name=NumWiped,proto3" json:"NumWiped"`
+	DelegationContract       []byte        `protobuf:"bytes,21,opt,name=DelegationContract,proto3" json:"DelegationContract"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -219,6 +220,13 @@ func (m *ESDTData) GetNumWiped() uint32 {
 	return 0
 }
 
+func (m *ESDTData) GetDelegationContract() []byte {
+	if m != nil {
+		return m.DelegationContract
+	}
+	return nil
+}
+
 type ESDTRoles struct {
 	Address []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
 	Roles   [][]byte `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles"`
@@ -338,57 +346,58 @@ func init() {
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xf3, 0x44,
-	0x14, 0x8e, 0x7b, 0x4d, 0x26, 0x49, 0x1b, 0x86, 0x0a, 0x8d, 0x10, 0xb2, 0xa3, 0x4a, 0x48, 0x91,
-	0x50, 0x13, 0x71, 0x59, 0xc1, 0x86, 0xda, 0x6d, 0xa4, 0x48, 0x34, 0xa0, 0x49, 0x80, 0x8a, 0xdd,
-	0x24, 0x9e, 0x3a, 0x56, 0xe3, 0x71, 0xe4, 0x19, 0x53, 0xca, 0x0a, 0xf1, 0x04, 0x3c, 0x46, 0xc5,
-	0x93, 0xb0, 0xec, 0x8e, 0xae, 0x0c, 0x75, 0x37, 0xbf, 0xbc, 0xea, 0x23, 0xfc, 0x9a, 0xf1, 0xef,
-	0x4b, 0x2e, 0xdd, 0xfc, 0xea, 0xca, 0xdf, 0xf9, 0xce, 0xe7, 0xcf, 0x73, 0xce, 0x1c, 0x1f, 0x00,
-	0x28, 0xb7, 0x45, 0x77, 0x11, 0xf8, 0xc2, 0x87, 0xbb, 0xea, 0xf1, 0xf1, 0x89, 0xe3, 0x8a, 0x59,
-	0x38, 0xe9, 0x4e, 0x7d, 0xaf, 0xe7, 0xf8, 0x8e, 0xdf, 0x53, 0xf4, 0x24, 0xbc, 0x52, 0x91, 0x0a,
-	0x14, 0x4a, 0xdf, 0x3a, 0xbe, 0xab, 0x81, 0xea, 0xf9, 0xe8, 0x6c, 0x7c, 0x46, 0x04, 0x81, 0x5f,
-	0x81, 0xc6, 0xf7, 0x37, 0x8c, 0x06, 0xa7, 0xb6, 0x1d, 0x50, 0xce, 0x91, 0xd6, 0xd6, 0x3a, 0x0d,
-	0xb3, 0x95, 0x44, 0xc6, 0x12, 0x8f, 0x97, 0x22, 0xf8, 0x19, 0xa8, 0x8d, 0xfd, 0x6b, 0xca, 0x86,
-	0xc4, 0xa3, 0x68, 0x4b, 0xbd, 0xd2, 0x4c, 0x22, 0xa3, 0x20, 0x71, 0x01, 0x61, 0x17, 0x80, 0xb1,
-	0x3b, 0xbd, 0xa6, 0x81, 0x52, 0x6f, 0x2b, 0xf5, 0x41, 0x12, 0x19, 0x25, 0x16, 0x97, 0x70, 0x6e,
-	0x3e, 0xbe, 0x5d, 0x50, 0xb4, 0xb3, 0x62, 0x2e, 0x49, 0x5c, 0x40, 0xd8, 0x01, 0xd5, 0x0b, 0x97,
-	0x09, 0x32, 0x99, 0x53, 0xb4, 0xdb, 0xd6, 0x3a, 0x55, 0xb3, 0x91, 0x44, 0x46, 0xce, 0xe1, 0x1c,
-	0x49, 0xa5, 0x19, 0x06, 0x4c, 0x29, 0xf7, 0x0a, 0x65, 0xc6, 0xe1, 0x1c, 0x49, 0xa5, 0x45, 0xd8,
-	0x0f, 0x24, 0xe4, 0x14, 0xed, 0x17, 0xca, 0x8c, 0xc3, 0x39, 0x92, 0x47, 0xb5, 0x08, 0xeb, 0x07,
-	0x94, 0xfe, 0x4e, 0x51, 0x55, 0x49, 0xd5, 0x51, 0x73, 0x12, 0x17, 0x10, 0x7e, 0x0a, 0xf6, 0x2d,
-	0xc2, 0x7e, 0x76, 0x17, 0x14, 0xd5, 0x94, 0xb4, 0x9e, 0x44, 0x46, 0x46, 0xe1, 0x0c, 0xc8, 0x76,
-	0xfd, 0xb8, 0x70, 0x02, 0x62, 0xab, 0x93, 0x02, 0xa5, 0x54, 0xed, 0xb2, 0x08, 0x4b, 0x13, 0x14,
-	0x97, 0x14, 0xf0, 0x6b, 0x70, 0x60, 0x11, 0x66, 0xcd, 0x08, 0x73, 0xa8, 0xba, 0x24, 0x54, 0x57,
-	0xef, 0xc0, 0x24, 0x32, 0x56, 0x32, 0x78, 0x25, 0x96, 0x95, 0x0e, 0xb8, 0x2a, 0xc5, 0x46, 0x8d,
-	0xa2, 0xd2, 0x8c, 0xc3, 0x39, 0x82, 0xbf, 0x82, 0xba, 0xec, 0x24, 0xb5, 0x7f, 0x22, 0xf3, 0x90,
-	0xa2, 0xa6, 0xba, 0x96, 0x71, 0x12, 0x19, 0x65, 0xfa, 0xef, 0xff, 0x8c, 0x53, 0x8f, 0x88, 0x59,
-	0x6f, 0xe2, 0x3a, 0xdd, 0x01, 0x13, 0xdf, 0x94, 0x06, 0xf3, 0x7c, 0x1e, 0xf8, 0xcc, 0x1e, 0x52,
-	0x71, 0xe3, 0x07, 0xd7, 0x3d, 0xaa, 0xa2, 0x13, 0xc7, 0xef, 0xd9, 0x44, 0x90, 0xae, 0xe9, 0x3a,
-	0x03, 0x26, 0x2c, 0xc2, 0x05, 0x0d, 0x70, 0xd9, 0x11, 0x72, 0x00, 0xe4, 0xbd, 0x88, 0xf4, 0xb3,
-	0x07, 0xea, 0xb3, 0x23, 0xd9, 0x8d, 0x82, 0x7d, 0x9d, 0xaf, 0x96, 0x0c, 0xe1, 0xe7, 0xa0, 0x3e,
-	0x0c, 0xbd, 0x33, 0x3a, 0x75, 0x3d, 0x32, 0xe7, 0xe8, 0xb0, 0xad, 0x75, 0x9a, 0xe6, 0xa1, 0x2c,
-	0xb6, 0x44, 0xe3, 0x72, 0x00, 0xfb, 0x00, 0x5a, 0x84, 0x9d, 0xda, 0xf6, 0x68, 0x41, 0xa7, 0x2e,
-	0x99, 0x63, 0x7f, 0x4e, 0x39, 0x6a, 0xa9, 0x9e, 0x7e, 0x94, 0x44, 0xc6, 0x86, 0x2c, 0xde, 0xc0,
-	0xc1, 0x6f, 0x41, 0x6b, 0xd8, 0x1f, 0x5b, 0x01, 0x25, 0x82, 0x8e, 0x84, 0xbf, 0x58, 0x50, 0x1b,
-	0x7d, 0xa0, 0x5c, 0x8e, 0x92, 0xc8, 0x58, 0xcb, 0xe1, 0x35, 0x06, 0x5e, 0x02, 0x64, 0x11, 0x36,
-	0x0e, 0x08, 0xe3, 0x57, 0x34, 0xc8, 0xd3, 0xd2, 0x1e, 0x41, 0xe5, 0xf4, 0x49, 0x12, 0x19, 0x2f,
-	0x6a, 0xf0, 0x8b, 0x19, 0xd8, 0x07, 0x8d, 0xa5, 0xea, 0x3e, 0x6c, 0x6f, 0x77, 0xea, 0x5f, 0xb4,
-	0xd2, 0xb5, 0xd2, 0x95, 0x2b, 0x45, 0xf1, 0xe9, 0xf6, 0x58, 0xaa, 0x74, 0x29, 0x92, 0x53, 0x37,
-	0x0c, 0x3d, 0x39, 0xec, 0x36, 0x3a, 0x52, 0xbd, 0x55, 0x53, 0x97, 0x71, 0x38, 0x47, 0xc7, 0x23,
-	0x50, 0xcb, 0x6d, 0xe5, 0xff, 0xb3, 0xbc, 0xa5, 0xd4, 0xff, 0x93, 0x2d, 0xa8, 0x0c, 0x40, 0x03,
-	0xec, 0xa6, 0xc7, 0xdb, 0x6a, 0x6f, 0x77, 0x1a, 0x66, 0x2d, 0x89, 0x8c, 0x94, 0xc0, 0xe9, 0xe3,
-	0xf8, 0xdf, 0x2d, 0x00, 0xa4, 0xab, 0xe5, 0xb3, 0x2b, 0xd7, 0x79, 0xcf, 0x0d, 0xf8, 0xa7, 0x06,
-	0x0e, 0x4d, 0xc2, 0xe9, 0x80, 0xf3, 0xd0, 0x65, 0x8e, 0xe5, 0x73, 0xf1, 0x6e, 0x11, 0x5e, 0x26,
-	0x91, 0xb1, 0x9a, 0x7a, 0x9d, 0x11, 0x5d, 0x75, 0x95, 0x43, 0x77, 0xe1, 0xb2, 0x7c, 0xd3, 0x7e,
-	0x47, 0x99, 0x23, 0x66, 0x6a, 0xc3, 0x36, 0xd3, 0xa1, 0x5b, 0xcf, 0xe2, 0x0d, 0x9c, 0xf2, 0x21,
-	0xbf, 0xad, 0xfa, 0xec, 0x94, 0x7c, 0xd6, 0xb2, 0x78, 0x03, 0x67, 0x0e, 0xef, 0x1f, 0xf5, 0xca,
-	0xc3, 0xa3, 0x5e, 0x79, 0x7e, 0xd4, 0xb5, 0x3f, 0x62, 0x5d, 0xbb, 0x8b, 0x75, 0xed, 0x9f, 0x58,
-	0xd7, 0xee, 0x63, 0x5d, 0x7b, 0x88, 0x75, 0xed, 0xff, 0x58, 0xd7, 0xde, 0xc4, 0x7a, 0xe5, 0x39,
-	0xd6, 0xb5, 0xbf, 0x9e, 0xf4, 0xca, 0xfd, 0x93, 0x5e, 0x79, 0x78, 0xd2, 0x2b, 0xbf, 0x1c, 0xf1,
-	0x5b, 0x2e, 0xa8, 0x37, 0xf2, 0x48, 0x20, 0x2c, 0x9f, 0x89, 0x80, 0x4c, 0x05, 0x9f, 0xec, 0xa9,
-	0xc9, 0xfa, 0xf2, 0x6d, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe1, 0xbf, 0xbc, 0xec, 0xf4, 0x06, 0x00,
-	0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x1e, 0x27, 0x9b, 0xdd, 0x4c, 0xcf, 0xe4, 0x87, 0x26, 0xa0, 0x16, 0x42, 0xf6, 0x28, 0x12,
+	0xd2, 0x48, 0x68, 0x67, 0xc4, 0xcf, 0x09, 0x2e, 0x64, 0x9c, 0x8d, 0x14, 0x89, 0x1d, 0x50, 0xcf,
+	0x00, 0x2b, 0x6e, 0x3d, 0xe3, 0x8a, 0x63, 0x65, 0xa6, 0x3d, 0x72, 0xb7, 0x59, 0x96, 0x13, 0xe2,
+	0x09, 0x78, 0x0c, 0xc4, 0x93, 0x70, 0xcc, 0x8d, 0x9c, 0x0c, 0x71, 0x2e, 0xc8, 0xa7, 0x3c, 0x02,
+	0xea, 0xf2, 0xfa, 0x67, 0x7e, 0xf6, 0xb2, 0xca, 0xc9, 0x5f, 0x7d, 0xf5, 0xf9, 0x73, 0x57, 0x75,
+	0xb9, 0x08, 0x01, 0xe5, 0xe9, 0xde, 0x22, 0x0a, 0x75, 0x48, 0x77, 0xf0, 0xf1, 0xc1, 0x53, 0x3f,
+	0xd0, 0x97, 0xf1, 0xa4, 0x37, 0x0d, 0xe7, 0x7d, 0x3f, 0xf4, 0xc3, 0x3e, 0xd2, 0x93, 0xf8, 0x02,
+	0x23, 0x0c, 0x10, 0xe5, 0x6f, 0x1d, 0xdf, 0x37, 0xc9, 0xee, 0xb3, 0xd1, 0xe9, 0xf8, 0x54, 0x68,
+	0x41, 0x3f, 0x27, 0xed, 0x6f, 0x5e, 0x4a, 0x88, 0x4e, 0x3c, 0x2f, 0x02, 0xa5, 0x98, 0xd5, 0xb1,
+	0xba, 0xed, 0xc1, 0x61, 0x96, 0x38, 0x4b, 0x3c, 0x5f, 0x8a, 0xe8, 0xc7, 0xa4, 0x39, 0x0e, 0xaf,
+	0x40, 0x0e, 0xc5, 0x1c, 0xd8, 0x16, 0xbe, 0xb2, 0x97, 0x25, 0x4e, 0x45, 0xf2, 0x0a, 0xd2, 0x1e,
+	0x21, 0xe3, 0x60, 0x7a, 0x05, 0x11, 0xaa, 0xb7, 0x51, 0xbd, 0x9f, 0x25, 0x4e, 0x8d, 0xe5, 0x35,
+	0x5c, 0x9a, 0x8f, 0x5f, 0x2d, 0x80, 0x3d, 0x5a, 0x31, 0x37, 0x24, 0xaf, 0x20, 0xed, 0x92, 0xdd,
+	0xe7, 0x81, 0xd4, 0x62, 0x32, 0x03, 0xb6, 0xd3, 0xb1, 0xba, 0xbb, 0x83, 0x76, 0x96, 0x38, 0x25,
+	0xc7, 0x4b, 0x64, 0x94, 0x83, 0x38, 0x92, 0xa8, 0x7c, 0x5c, 0x29, 0x0b, 0x8e, 0x97, 0xc8, 0x28,
+	0x5d, 0x21, 0xbf, 0x15, 0xb1, 0x02, 0xf6, 0xa4, 0x52, 0x16, 0x1c, 0x2f, 0x91, 0x39, 0xaa, 0x2b,
+	0xe4, 0x59, 0x04, 0xf0, 0x0b, 0xb0, 0x5d, 0x94, 0xe2, 0x51, 0x4b, 0x92, 0x57, 0x90, 0x7e, 0x44,
+	0x9e, 0xb8, 0x42, 0xfe, 0x10, 0x2c, 0x80, 0x35, 0x51, 0xda, 0xca, 0x12, 0xa7, 0xa0, 0x78, 0x01,
+	0x4c, 0xbb, 0xbe, 0x5b, 0xf8, 0x91, 0xf0, 0xf0, 0xa4, 0x04, 0x95, 0xd8, 0x2e, 0x57, 0xc8, 0x3c,
+	0x01, 0xbc, 0xa6, 0xa0, 0x5f, 0x90, 0x7d, 0x57, 0x48, 0xf7, 0x52, 0x48, 0x1f, 0xf0, 0x92, 0x58,
+	0x0b, 0xdf, 0xa1, 0x59, 0xe2, 0xac, 0x64, 0xf8, 0x4a, 0x6c, 0x2a, 0x3d, 0x57, 0x58, 0x8a, 0xc7,
+	0xda, 0x55, 0xa5, 0x05, 0xc7, 0x4b, 0x44, 0x7f, 0x22, 0x2d, 0xd3, 0x49, 0xf0, 0xbe, 0x17, 0xb3,
+	0x18, 0xd8, 0x1e, 0x5e, 0xcb, 0x38, 0x4b, 0x9c, 0x3a, 0xfd, 0xe7, 0x3f, 0xce, 0xc9, 0x5c, 0xe8,
+	0xcb, 0xfe, 0x24, 0xf0, 0x7b, 0xe7, 0x52, 0x7f, 0x59, 0x1b, 0xcc, 0x67, 0xb3, 0x28, 0x94, 0xde,
+	0x10, 0xf4, 0xcb, 0x30, 0xba, 0xea, 0x03, 0x46, 0x4f, 0xfd, 0xb0, 0xef, 0x09, 0x2d, 0x7a, 0x83,
+	0xc0, 0x3f, 0x97, 0xda, 0x15, 0x4a, 0x43, 0xc4, 0xeb, 0x8e, 0x54, 0x11, 0x62, 0xee, 0x45, 0xe7,
+	0x9f, 0xdd, 0xc7, 0xcf, 0x8e, 0x4c, 0x37, 0x2a, 0xf6, 0x61, 0xbe, 0x5a, 0x33, 0xa4, 0x9f, 0x90,
+	0xd6, 0x30, 0x9e, 0x9f, 0xc2, 0x34, 0x98, 0x8b, 0x99, 0x62, 0x07, 0x1d, 0xab, 0xbb, 0x37, 0x38,
+	0x30, 0xc5, 0xd6, 0x68, 0x5e, 0x0f, 0xe8, 0x19, 0xa1, 0xae, 0x90, 0x27, 0x9e, 0x37, 0x5a, 0xc0,
+	0x34, 0x10, 0x33, 0x1e, 0xce, 0x40, 0xb1, 0x43, 0xec, 0xe9, 0xfb, 0x59, 0xe2, 0x6c, 0xc8, 0xf2,
+	0x0d, 0x1c, 0xfd, 0x8a, 0x1c, 0x0e, 0xcf, 0xc6, 0x6e, 0x04, 0x42, 0xc3, 0x48, 0x87, 0x8b, 0x05,
+	0x78, 0xec, 0x1d, 0x74, 0x39, 0xca, 0x12, 0x67, 0x2d, 0xc7, 0xd7, 0x18, 0xfa, 0x82, 0x30, 0x57,
+	0xc8, 0x71, 0x24, 0xa4, 0xba, 0x80, 0xa8, 0x4c, 0x1b, 0x7b, 0x46, 0xd1, 0xe9, 0xc3, 0x2c, 0x71,
+	0xde, 0xa8, 0xe1, 0x6f, 0xcc, 0xd0, 0x33, 0xd2, 0x5e, 0xaa, 0xee, 0xdd, 0xce, 0x76, 0xb7, 0xf5,
+	0xe9, 0x61, 0xbe, 0x56, 0x7a, 0x66, 0xa5, 0x20, 0x9f, 0x6f, 0x8f, 0xa5, 0x4a, 0x97, 0x22, 0x33,
+	0x75, 0xc3, 0x78, 0x6e, 0x86, 0xdd, 0x63, 0x47, 0xd8, 0x5b, 0x9c, 0xba, 0x82, 0xe3, 0x25, 0x32,
+	0x5d, 0x3d, 0x85, 0x19, 0xf8, 0x42, 0x07, 0xa1, 0x74, 0x43, 0xa9, 0x23, 0x31, 0xd5, 0xec, 0x3d,
+	0x9c, 0x02, 0xec, 0xea, 0x7a, 0x96, 0x6f, 0xe0, 0x8e, 0x47, 0xa4, 0x59, 0x1e, 0xcf, 0xfc, 0x87,
+	0xcb, 0xdb, 0x0e, 0xff, 0xc3, 0xd7, 0x14, 0x2f, 0x00, 0x75, 0xc8, 0x4e, 0x5e, 0xe6, 0x56, 0x67,
+	0xbb, 0xdb, 0x1e, 0x34, 0xb3, 0xc4, 0xc9, 0x09, 0x9e, 0x3f, 0x8e, 0xff, 0xde, 0x22, 0xc4, 0xb8,
+	0xba, 0xa1, 0xbc, 0x08, 0xfc, 0xb7, 0xdc, 0xa4, 0xbf, 0x59, 0xe4, 0x60, 0x20, 0x14, 0x9c, 0x2b,
+	0x15, 0x07, 0xd2, 0x77, 0x43, 0xa5, 0x5f, 0x2f, 0xd4, 0x17, 0x59, 0xe2, 0xac, 0xa6, 0x1e, 0x66,
+	0xd4, 0x57, 0x5d, 0x4d, 0x9b, 0x9f, 0x07, 0xb2, 0xdc, 0xd8, 0x5f, 0x83, 0xf4, 0xf5, 0x25, 0x6e,
+	0xea, 0xbd, 0xbc, 0xcd, 0xeb, 0x59, 0xbe, 0x81, 0x43, 0x1f, 0xf1, 0xf3, 0xaa, 0xcf, 0xa3, 0x9a,
+	0xcf, 0x5a, 0x96, 0x6f, 0xe0, 0x06, 0xc3, 0xeb, 0x5b, 0xbb, 0x71, 0x73, 0x6b, 0x37, 0xee, 0x6f,
+	0x6d, 0xeb, 0xd7, 0xd4, 0xb6, 0xfe, 0x48, 0x6d, 0xeb, 0xaf, 0xd4, 0xb6, 0xae, 0x53, 0xdb, 0xba,
+	0x49, 0x6d, 0xeb, 0xdf, 0xd4, 0xb6, 0xfe, 0x4b, 0xed, 0xc6, 0x7d, 0x6a, 0x5b, 0xbf, 0xdf, 0xd9,
+	0x8d, 0xeb, 0x3b, 0xbb, 0x71, 0x73, 0x67, 0x37, 0x7e, 0x3c, 0x52, 0xaf, 0x94, 0x86, 0xf9, 0x68,
+	0x2e, 0x22, 0x5d, 0xdc, 0xbe, 0x9a, 0x3c, 0xc6, 0x09, 0xfd, 0xec, 0xff, 0x01, 0x00, 0x31, 0xd0,
+	0xe7, 0x34, 0x3c, 0x07, 0x00, 0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -481,6 +490,9 @@ func (this *ESDTData) Equal(that interface{}) bool {
 	if this.NumWiped != that1.NumWiped {
 		return false
 	}
+	if !bytes.Equal(this.DelegationContract, that1.DelegationContract) {
+		return false
+	}
 	return true
 }
 func (this *ESDTRoles) Equal(that interface{}) bool {
@@ -555,7 +567,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 24)
+	s := make([]string, 0, 25)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -579,6 +591,7 @@ func (this *ESDTData) GoString() string {
 		s = append(s, "SpecialRoles: "+fmt.Sprintf("%#v", this.SpecialRoles)+",\n")
 	}
 	s = append(s, "NumWiped: "+fmt.Sprintf("%#v", this.NumWiped)+",\n")
+	s = append(s, "DelegationContract: "+fmt.Sprintf("%#v", this.DelegationContract)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -634,6 +647,15 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegationContract) > 0 {
+		i -= len(m.DelegationContract)
+		copy(dAtA[i:], m.DelegationContract)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.DelegationContract)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if m.NumWiped != 0 {
 		i = encodeVarintEsdt(dAtA, i, uint64(m.NumWiped))
 		i--
@@ -1009,6 +1031,10 @@ func (m *ESDTData) Size() (n int) {
 	if m.NumWiped != 0 {
 		n += 2 + sovEsdt(uint64(m.NumWiped))
 	}
+	l = len(m.DelegationContract)
+	if l > 0 {
+		n += 2 + l + sovEsdt(uint64(l))
+	}
 	return n
 }
 
@@ -1091,6 +1117,7 @@ func (this *ESDTData) String() string {
 		`CanTransferNFTCreateRole:` + fmt.Sprintf("%v", this.CanTransferNFTCreateRole) + `,`,
 		`SpecialRoles:` + repeatedStringForSpecialRoles + `,`,
 		`NumWiped:` + fmt.Sprintf("%v", this.NumWiped) + `,`,
+		`DelegationContract:` + fmt.Sprintf("%v", this.DelegationContract) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1660,6 +1687,40 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationContract", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationContract = append(m.DelegationContract[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegationContract == nil {
+				m.DelegationContract = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -3892,3 +3893,285 @@ func TestEsdt_CanUseContract(t *testing.T) {
 	e, _ := NewESDTSmartContract(args)
 	require.True(t, e.CanUseContract())
 }
+
+func TestEsdt_RegisterMetaESDT(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("registerMetaESDT", [][]byte{[]byte("name"), []byte("TICKER")})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput.Arguments = append(vmInput.Arguments, big.NewInt(19).Bytes())
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrInvalidNumberOfDecimals.Error()))
+
+	vmInput.Arguments[2] = big.NewInt(6).Bytes()
+	vmInput.Arguments = append(vmInput.Arguments, []byte(canFreeze), []byte("true"))
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	tokenIdentifier := eei.output[len(eei.output)-1]
+	token, err := e.getExistingToken(tokenIdentifier)
+	require.Nil(t, err)
+	assert.Equal(t, []byte(core.MetaESDT), token.TokenType)
+	assert.Equal(t, uint32(6), token.NumDecimals)
+	assert.True(t, token.CanFreeze)
+	assert.False(t, token.Mintable)
+	assert.False(t, token.Burnable)
+	assert.Equal(t, big.NewInt(0), token.MintedValue)
+
+	systemAddress := make([]byte, len(core.SystemAccountAddress))
+	copy(systemAddress, core.SystemAccountAddress)
+	systemAddress[len(systemAddress)-1] = 0
+	outputTransfers := eei.outputAccounts[string(systemAddress)].OutputTransfers
+	require.Len(t, outputTransfers, 1)
+	expectedData := core.BuiltInFunctionESDTSetTokenType + "@" + hex.EncodeToString(tokenIdentifier) +
+		"@" + hex.EncodeToString([]byte(core.MetaESDT))
+	assert.Equal(t, []byte(expectedData), outputTransfers[0].Data)
+}
+
+func TestEsdt_RegisterMetaESDTNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	args.EpochConfig.EnableEpochs.MetaESDTEnableEpoch = 10
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("registerMetaESDT", [][]byte{[]byte("name"), []byte("TICKER"), {6}})
+	vmInput.CallValue, _ = big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput.GasProvided = args.GasCost.MetaChainSystemSCsCost.ESDTIssue
+	eei.gasRemaining = vmInput.GasProvided
+
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	e.EpochConfirmed(10, 0)
+	eei.gasRemaining = vmInput.GasProvided
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_SetSpecialRoleMetaESDT(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForESDT()
+	eei := &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			token := &ESDTData{
+				OwnerAddress:       []byte("caller123"),
+				TokenType:          []byte(core.MetaESDT),
+				CanAddSpecialRoles: true,
+			}
+			tokenBytes, _ := args.Marshalizer.Marshal(token)
+			return tokenBytes
+		},
+	}
+	args.Eei = eei
+
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := getDefaultVmInputForFunc("setSpecialRole", [][]byte{})
+	vmInput.Arguments = [][]byte{[]byte("myToken"), []byte("myAddress"), []byte(core.ESDTRoleLocalMint)}
+	vmInput.CallerAddr = []byte("caller123")
+	vmInput.CallValue = big.NewInt(0)
+	vmInput.GasProvided = 50000000
+
+	retCode := e.Execute(vmInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	for _, role := range []string{core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity, core.ESDTRoleNFTBurn} {
+		vmInput.Arguments[2] = []byte(role)
+		retCode = e.Execute(vmInput)
+		require.Equal(t, vmcommon.Ok, retCode)
+	}
+}
+
+func TestEsdt_SetDelegationPositionToken(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("LIQ-abcdef")
+	delegationSC := make([]byte, 32)
+	delegationSC[len(delegationSC)-1] = 255
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	token := &ESDTData{
+		OwnerAddress: []byte("owner"),
+		TokenType:    []byte(core.FungibleESDT),
+		Mintable:     true,
+		Burnable:     true,
+	}
+	_ = e.saveToken(tokenName, token)
+
+	vmInput := getDefaultVmInputForFunc("setDelegationPositionToken", [][]byte{tokenName})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by delegation contracts only"))
+
+	token.OwnerAddress = delegationSC
+	token.Mintable = false
+	_ = e.saveToken(tokenName, token)
+	eei.returnMessage = ""
+	vmInput.CallerAddr = delegationSC
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "token must be mintable and burnable"))
+
+	token.Mintable = true
+	_ = e.saveToken(tokenName, token)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	savedToken, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, delegationSC, savedToken.DelegationContract)
+
+	eei.returnMessage = ""
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "token already represents delegation positions"))
+}
+
+func TestEsdt_SetDelegationPositionTokenWithCirculatingSupplyOrSpecialRolesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("LIQ-abcdef")
+	delegationSC := make([]byte, 32)
+	delegationSC[len(delegationSC)-1] = 255
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	// the owner mints before transferring the token to the delegation contract, so the pre-minted tokens would
+	// redeem the stake of the delegators
+	token := &ESDTData{
+		OwnerAddress: delegationSC,
+		TokenType:    []byte(core.FungibleESDT),
+		Mintable:     true,
+		Burnable:     true,
+		MintedValue:  big.NewInt(1000),
+		BurntValue:   big.NewInt(0),
+	}
+	_ = e.saveToken(tokenName, token)
+
+	vmInput := getDefaultVmInputForFunc("setDelegationPositionToken", [][]byte{tokenName})
+	vmInput.CallerAddr = delegationSC
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "token must not have circulating supply"))
+
+	token.BurntValue = big.NewInt(1000)
+	token.SpecialRoles = []*ESDTRoles{{Address: []byte("owner"), Roles: [][]byte{[]byte(core.ESDTRoleLocalMint)}}}
+	_ = e.saveToken(tokenName, token)
+	eei.returnMessage = ""
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.returnMessage, "token must not have special roles"))
+
+	token.SpecialRoles = nil
+	_ = e.saveToken(tokenName, token)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	savedToken, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, delegationSC, savedToken.DelegationContract)
+}
+
+func TestEsdt_ExecuteBurnDelegationPositionToken(t *testing.T) {
+	t.Parallel()
+
+	tokenName := []byte("LIQ-abcdef")
+	delegationSC := []byte("delegationSC")
+	args := createMockArgumentsForESDT()
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{})
+	eei.inputParser = parsers.NewCallArgsParser()
+	redeemReturnCode := vmcommon.UserError
+	var redeemInput *vmcommon.ContractCallInput
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		return &mock.SystemSCStub{ExecuteCalled: func(input *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			redeemInput = input
+			return redeemReturnCode
+		}}, nil
+	}})
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	_ = e.saveToken(tokenName, &ESDTData{
+		TokenType:          []byte(core.FungibleESDT),
+		Burnable:           true,
+		BurntValue:         big.NewInt(0),
+		DelegationContract: delegationSC,
+	})
+
+	burnValue := []byte{100}
+	vmInput := getDefaultVmInputForFunc(core.BuiltInFunctionESDTBurn, [][]byte{tokenName, burnValue})
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, delegationSC, redeemInput.RecipientAddr)
+	assert.Equal(t, redeemLiquidPositionFunction, redeemInput.Function)
+	assert.Equal(t, [][]byte{vmInput.CallerAddr, burnValue}, redeemInput.Arguments)
+
+	outputTransfer := eei.outputAccounts["owner"].OutputTransfers[0]
+	expectedReturnData := []byte(core.BuiltInFunctionESDTTransfer + "@" + hex.EncodeToString(tokenName) + "@" + hex.EncodeToString(burnValue))
+	assert.Equal(t, expectedReturnData, outputTransfer.Data)
+	savedToken, _ := e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(0), savedToken.BurntValue)
+
+	redeemReturnCode = vmcommon.Ok
+	eei.outputAccounts = make(map[string]*vmcommon.OutputAccount)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Nil(t, eei.outputAccounts["owner"])
+	savedToken, _ = e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(100), savedToken.BurntValue)
+
+	// the tokens received and redeemed by the delegation contract are only recorded as burnt
+	redeemInput = nil
+	vmInput.CallerAddr = delegationSC
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+	assert.Nil(t, redeemInput)
+	savedToken, _ = e.getExistingToken(tokenName)
+	assert.Equal(t, big.NewInt(200), savedToken.BurntValue)
+}
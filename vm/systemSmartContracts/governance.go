@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -59,6 +60,10 @@ type governanceContract struct {
 	initialWhiteListedAddresses [][]byte
 	enabledEpoch                uint32
 	flagEnabled                 atomic.Flag
+	paramChangesEnableEpoch     uint32
+	flagParamChanges            atomic.Flag
+	viewsEnableEpoch            uint32
+	flagViews                   atomic.Flag
 	mutExecution                sync.RWMutex
 }
 
@@ -94,19 +99,23 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	}
 
 	g := &governanceContract{
-		eei:                    args.Eei,
-		gasCost:                args.GasCost,
-		baseProposalCost:       baseProposalCost,
-		ownerAddress:           nil,
-		governanceSCAddress:    args.GovernanceSCAddress,
-		delegationMgrSCAddress: args.DelegationMgrSCAddress,
-		validatorSCAddress:     args.ValidatorSCAddress,
-		marshalizer:            args.Marshalizer,
-		hasher:                 args.Hasher,
-		governanceConfig:       args.GovernanceConfig,
-		enabledEpoch:           args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		eei:                     args.Eei,
+		gasCost:                 args.GasCost,
+		baseProposalCost:        baseProposalCost,
+		ownerAddress:            nil,
+		governanceSCAddress:     args.GovernanceSCAddress,
+		delegationMgrSCAddress:  args.DelegationMgrSCAddress,
+		validatorSCAddress:      args.ValidatorSCAddress,
+		marshalizer:             args.Marshalizer,
+		hasher:                  args.Hasher,
+		governanceConfig:        args.GovernanceConfig,
+		enabledEpoch:            args.EpochConfig.EnableEpochs.GovernanceEnableEpoch,
+		paramChangesEnableEpoch: args.EpochConfig.EnableEpochs.GovernanceParamChangesEnableEpoch,
+		viewsEnableEpoch:        args.EpochConfig.EnableEpochs.GovernanceViewsEnableEpoch,
 	}
 	log.Debug("governance: enable epoch for governance", "epoch", g.enabledEpoch)
+	log.Debug("governance: enable epoch for param changes", "epoch", g.paramChangesEnableEpoch)
+	log.Debug("governance: enable epoch for views", "epoch", g.viewsEnableEpoch)
 
 	err := g.validateInitialWhiteListedAddresses(args.InitialWhiteListedAddresses)
 	if err != nil {
@@ -157,10 +166,20 @@ func (g *governanceContract) Execute(args *vmcommon.ContractCallInput) vmcommon.
 		return g.changeConfig(args)
 	case "closeProposal":
 		return g.closeProposal(args)
+	case "paramChange":
+		return g.paramChangeProposal(args)
+	case applyParamChangesFunction:
+		return g.applyParamChanges(args)
 	case "getValidatorVotingPower":
 		return g.getValidatorVotingPower(args)
 	case "getBalanceVotingPower":
 		return g.getBalanceVotingPower(args)
+	case "viewProposal":
+		return g.viewProposal(args)
+	case "viewProposalTally":
+		return g.viewProposalTally(args)
+	case "viewUserVoteSet":
+		return g.viewUserVoteSet(args)
 	}
 
 	g.eei.AddReturnMessage("invalid method to call")
@@ -945,6 +964,153 @@ func (g *governanceContract) getBalanceVotingPower(args *vmcommon.ContractCallIn
 	return vmcommon.Ok
 }
 
+// viewProposal returns the general information of a proposal. Accepts a single argument:
+//  args.Arguments[0] - proposal reference (github commit)
+//  The returned values are the issuer address, the commit hash, the start and end vote nonces, the yes, no and veto
+//  voting power, the closed and passed flags and the number of voters
+func (g *governanceContract) viewProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	proposal, returnCode := g.checkArgumentsForProposalViewFunc(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	g.eei.Finish(proposal.IssuerAddress)
+	g.eei.Finish(proposal.CommitHash)
+	g.eei.Finish(big.NewInt(0).SetUint64(proposal.StartVoteNonce).Bytes())
+	g.eei.Finish(big.NewInt(0).SetUint64(proposal.EndVoteNonce).Bytes())
+	g.eei.Finish(bigIntBytes(proposal.Yes))
+	g.eei.Finish(bigIntBytes(proposal.No))
+	g.eei.Finish(bigIntBytes(proposal.Veto))
+	g.eei.Finish([]byte(strconv.FormatBool(proposal.Closed)))
+	g.eei.Finish([]byte(strconv.FormatBool(proposal.Passed)))
+	g.eei.Finish(big.NewInt(int64(len(proposal.Votes))).Bytes())
+
+	return vmcommon.Ok
+}
+
+// viewProposalTally returns the voting power cast on a proposal, split between the validators and the funds votes.
+//  Accepts a single argument:
+//  args.Arguments[0] - proposal reference (github commit)
+//  The returned values are the validators yes, no and veto voting power followed by the funds yes, no and veto
+//  voting power
+func (g *governanceContract) viewProposalTally(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	proposal, returnCode := g.checkArgumentsForProposalViewFunc(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	validatorsYes, validatorsNo, validatorsVeto := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	for _, voter := range proposal.Votes {
+		voteSet, err := g.getOrCreateVoteSet(getVoteItemKey(proposal.CommitHash, voter))
+		if err != nil {
+			g.eei.AddReturnMessage(err.Error())
+			return vmcommon.UserError
+		}
+
+		for _, voteItem := range voteSet.VoteItems {
+			if voteItem.Balance != nil && voteItem.Balance.Cmp(zero) > 0 {
+				continue
+			}
+
+			switch voteItem.Value {
+			case Yes:
+				validatorsYes.Add(validatorsYes, voteItem.Power)
+			case No:
+				validatorsNo.Add(validatorsNo, voteItem.Power)
+			case Veto:
+				validatorsVeto.Add(validatorsVeto, voteItem.Power)
+			}
+		}
+	}
+
+	// the votes with funds are not recorded in the proposal's voters list, they are what remains from the totals
+	g.eei.Finish(validatorsYes.Bytes())
+	g.eei.Finish(validatorsNo.Bytes())
+	g.eei.Finish(validatorsVeto.Bytes())
+	g.eei.Finish(remainingVotingPower(proposal.Yes, validatorsYes).Bytes())
+	g.eei.Finish(remainingVotingPower(proposal.No, validatorsNo).Bytes())
+	g.eei.Finish(remainingVotingPower(proposal.Veto, validatorsVeto).Bytes())
+
+	return vmcommon.Ok
+}
+
+// viewUserVoteSet returns the votes cast by an address on a proposal. Accepts 2 arguments:
+//  args.Arguments[0] - proposal reference (github commit)
+//  args.Arguments[1] - voter address
+//  The returned values are the used voting power, the used balance and the yes, no and veto voting power
+func (g *governanceContract) viewUserVoteSet(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	proposal, returnCode := g.checkArgumentsForProposalViewFunc(args, 2)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	voteSet, err := g.getOrCreateVoteSet(getVoteItemKey(proposal.CommitHash, args.Arguments[1]))
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if len(voteSet.VoteItems) == 0 {
+		g.eei.AddReturnMessage("address did not vote on this proposal")
+		return vmcommon.UserError
+	}
+
+	g.eei.Finish(bigIntBytes(voteSet.UsedPower))
+	g.eei.Finish(bigIntBytes(voteSet.UsedBalance))
+	g.eei.Finish(bigIntBytes(voteSet.TotalYes))
+	g.eei.Finish(bigIntBytes(voteSet.TotalNo))
+	g.eei.Finish(bigIntBytes(voteSet.TotalVeto))
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) checkArgumentsForProposalViewFunc(args *vmcommon.ContractCallInput, numArguments int) (*GeneralProposal, vmcommon.ReturnCode) {
+	if !g.flagViews.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return nil, vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return nil, vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Vote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return nil, vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != numArguments {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments, expected %d", numArguments))
+		return nil, vmcommon.FunctionWrongSignature
+	}
+
+	proposal, err := g.getGeneralProposal(args.Arguments[0])
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if len(proposal.CommitHash) == 0 {
+		g.eei.AddReturnMessage("reference is not a proposal")
+		return nil, vmcommon.UserError
+	}
+
+	return proposal, vmcommon.Ok
+}
+
+func remainingVotingPower(total *big.Int, used *big.Int) *big.Int {
+	if total == nil || total.Cmp(used) <= 0 {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Sub(total, used)
+}
+
+func bigIntBytes(value *big.Int) []byte {
+	if value == nil {
+		return make([]byte, 0)
+	}
+
+	return value.Bytes()
+}
+
 // saveGeneralProposal saves a proposal into the storage
 func (g *governanceContract) saveGeneralProposal(reference []byte, generalProposal *GeneralProposal) error {
 	marshaledData, err := g.marshalizer.Marshal(generalProposal)
@@ -1436,6 +1602,12 @@ func (g *governanceContract) convertV2Config(config config.GovernanceSystemSCCon
 func (g *governanceContract) EpochConfirmed(epoch uint32, _ uint64) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagParamChanges.Toggle(epoch >= g.paramChangesEnableEpoch)
+	log.Debug("governance contract: param changes", "enabled", g.flagParamChanges.IsSet())
+
+	g.flagViews.Toggle(epoch >= g.viewsEnableEpoch)
+	log.Debug("governance contract: views", "enabled", g.flagViews.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
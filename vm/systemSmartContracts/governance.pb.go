@@ -274,6 +274,124 @@ func (m *HardForkProposal) GetProposalStatus() []byte {
 	return nil
 }
 
+type ParamChangeProposal struct {
+	CommitHash      []byte `protobuf:"bytes,1,opt,name=CommitHash,proto3" json:"CommitHash"`
+	Type            []byte `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type"`
+	Name            []byte `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name"`
+	Value           []byte `protobuf:"bytes,4,opt,name=Value,proto3" json:"Value"`
+	ActivationEpoch uint32 `protobuf:"varint,5,opt,name=ActivationEpoch,proto3" json:"ActivationEpoch"`
+	Applied         bool   `protobuf:"varint,6,opt,name=Applied,proto3" json:"Applied"`
+}
+
+func (m *ParamChangeProposal) Reset()      { *m = ParamChangeProposal{} }
+func (*ParamChangeProposal) ProtoMessage() {}
+func (*ParamChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{3}
+}
+func (m *ParamChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParamChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeProposal.Merge(m, src)
+}
+func (m *ParamChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeProposal proto.InternalMessageInfo
+
+func (m *ParamChangeProposal) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
+func (m *ParamChangeProposal) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *ParamChangeProposal) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *ParamChangeProposal) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ParamChangeProposal) GetActivationEpoch() uint32 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *ParamChangeProposal) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+type ParamChangesList struct {
+	CommitHashes [][]byte `protobuf:"bytes,1,rep,name=CommitHashes,proto3" json:"CommitHashes"`
+}
+
+func (m *ParamChangesList) Reset()      { *m = ParamChangesList{} }
+func (*ParamChangesList) ProtoMessage() {}
+func (*ParamChangesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{4}
+}
+func (m *ParamChangesList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ParamChangesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangesList.Merge(m, src)
+}
+func (m *ParamChangesList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangesList proto.InternalMessageInfo
+
+func (m *ParamChangesList) GetCommitHashes() [][]byte {
+	if m != nil {
+		return m.CommitHashes
+	}
+	return nil
+}
+
 type GovernanceConfig struct {
 	NumNodes         int64         `protobuf:"varint,1,opt,name=NumNodes,proto3" json:"NumNodes"`
 	MinQuorum        int32         `protobuf:"varint,2,opt,name=MinQuorum,proto3" json:"MinQuorum"`
@@ -285,7 +403,7 @@ type GovernanceConfig struct {
 func (m *GovernanceConfig) Reset()      { *m = GovernanceConfig{} }
 func (*GovernanceConfig) ProtoMessage() {}
 func (*GovernanceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{3}
+	return fileDescriptor_e18a03da5266c714, []int{5}
 }
 func (m *GovernanceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +473,7 @@ type GovernanceConfigV2 struct {
 func (m *GovernanceConfigV2) Reset()      { *m = GovernanceConfigV2{} }
 func (*GovernanceConfigV2) ProtoMessage() {}
 func (*GovernanceConfigV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{4}
+	return fileDescriptor_e18a03da5266c714, []int{6}
 }
 func (m *GovernanceConfigV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +536,7 @@ type VoteDetails struct {
 func (m *VoteDetails) Reset()      { *m = VoteDetails{} }
 func (*VoteDetails) ProtoMessage() {}
 func (*VoteDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{5}
+	return fileDescriptor_e18a03da5266c714, []int{7}
 }
 func (m *VoteDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -483,7 +601,7 @@ type VoteSet struct {
 func (m *VoteSet) Reset()      { *m = VoteSet{} }
 func (*VoteSet) ProtoMessage() {}
 func (*VoteSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e18a03da5266c714, []int{6}
+	return fileDescriptor_e18a03da5266c714, []int{8}
 }
 func (m *VoteSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,6 +673,8 @@ func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
 	proto.RegisterType((*HardForkProposal)(nil), "proto.HardForkProposal")
+	proto.RegisterType((*ParamChangeProposal)(nil), "proto.ParamChangeProposal")
+	proto.RegisterType((*ParamChangesList)(nil), "proto.ParamChangesList")
 	proto.RegisterType((*GovernanceConfig)(nil), "proto.GovernanceConfig")
 	proto.RegisterType((*GovernanceConfigV2)(nil), "proto.GovernanceConfigV2")
 	proto.RegisterType((*VoteDetails)(nil), "proto.VoteDetails")
@@ -564,71 +684,78 @@ func init() {
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x8f, 0xe3, 0xb4,
-	0x1b, 0x6e, 0xda, 0x66, 0xfe, 0x78, 0xfe, 0x75, 0xfd, 0x1b, 0xfd, 0x54, 0x71, 0x48, 0xaa, 0x9e,
-	0xaa, 0x45, 0xdb, 0x8a, 0x01, 0x84, 0x04, 0x42, 0xb0, 0xe9, 0xce, 0xec, 0x8e, 0xd8, 0x89, 0x66,
-	0xdd, 0x52, 0xb4, 0x20, 0x21, 0x79, 0x1a, 0x4f, 0x1b, 0x36, 0x89, 0x2b, 0xdb, 0xdd, 0xd1, 0xde,
-	0xe0, 0xc0, 0x15, 0xc1, 0xb7, 0x40, 0xf0, 0x45, 0xe0, 0x36, 0x17, 0xa4, 0x39, 0x05, 0xa6, 0x23,
-	0x24, 0x94, 0xd3, 0x7e, 0x04, 0x64, 0x27, 0x4d, 0x93, 0xb6, 0x42, 0x1c, 0x22, 0x4e, 0xf6, 0xfb,
-	0xbc, 0xc9, 0xe3, 0x37, 0xef, 0xf3, 0xd8, 0x31, 0xa8, 0x8d, 0xe8, 0x4b, 0xc2, 0x02, 0x1c, 0x0c,
-	0x49, 0x7b, 0xc2, 0xa8, 0xa0, 0x50, 0x57, 0xc3, 0x1b, 0x0f, 0x46, 0xae, 0x18, 0x4f, 0x2f, 0xda,
-	0x43, 0xea, 0x77, 0x46, 0x74, 0x44, 0x3b, 0x0a, 0xbe, 0x98, 0x5e, 0xaa, 0x48, 0x05, 0x6a, 0x16,
-	0xbf, 0xd5, 0xfc, 0x59, 0x07, 0x07, 0x8f, 0x49, 0x40, 0x18, 0xf6, 0xce, 0x19, 0x9d, 0x50, 0x8e,
-	0x3d, 0xf8, 0x1e, 0xd8, 0x3b, 0xe5, 0x7c, 0x4a, 0xd8, 0x43, 0xc7, 0x61, 0x84, 0xf3, 0xba, 0xd6,
-	0xd0, 0x5a, 0xbb, 0xd6, 0xbd, 0x28, 0x34, 0xf3, 0x09, 0x94, 0x0f, 0x61, 0x1b, 0x80, 0x2e, 0xf5,
-	0x7d, 0x57, 0x3c, 0xc1, 0x7c, 0x5c, 0x2f, 0xab, 0xb7, 0xf6, 0xa3, 0xd0, 0xcc, 0xa0, 0x28, 0x33,
-	0x87, 0xef, 0x83, 0xfd, 0x9e, 0xc0, 0x4c, 0x0c, 0xa8, 0x20, 0x36, 0x0d, 0x86, 0xa4, 0x5e, 0x69,
-	0x68, 0xad, 0xaa, 0x05, 0xa3, 0xd0, 0x5c, 0xca, 0xa0, 0xa5, 0x18, 0xbe, 0x03, 0x76, 0x8f, 0x03,
-	0x67, 0xf1, 0x66, 0x55, 0xbd, 0x59, 0x8b, 0x42, 0x33, 0x87, 0xa3, 0x5c, 0x04, 0xbf, 0x04, 0x95,
-	0xe7, 0x84, 0xd7, 0x75, 0x55, 0xda, 0xd3, 0x28, 0x34, 0x65, 0xf8, 0xd3, 0xef, 0xe6, 0x43, 0x1f,
-	0x8b, 0x71, 0xe7, 0xc2, 0x1d, 0xb5, 0x4f, 0x03, 0xf1, 0x41, 0xa6, 0x85, 0xc7, 0x1e, 0xa3, 0x81,
-	0x63, 0x13, 0x71, 0x45, 0xd9, 0x8b, 0x0e, 0x51, 0xd1, 0x83, 0x11, 0xed, 0x38, 0x58, 0xe0, 0xb6,
-	0xe5, 0x8e, 0x4e, 0x03, 0xd1, 0xc5, 0x5c, 0x10, 0x86, 0x24, 0x13, 0xfc, 0x02, 0x94, 0x6d, 0x5a,
-	0xdf, 0x50, 0xf4, 0x9f, 0x44, 0xa1, 0x59, 0xb6, 0x69, 0x31, 0xec, 0x65, 0x9b, 0x42, 0x0c, 0xaa,
-	0x03, 0x22, 0x68, 0x7d, 0x53, 0xd1, 0x9f, 0x45, 0xa1, 0xa9, 0xe2, 0x62, 0x16, 0x50, 0x54, 0xb0,
-	0x09, 0x36, 0xce, 0x31, 0xe7, 0xc4, 0xa9, 0x6f, 0x35, 0xb4, 0xd6, 0x96, 0x05, 0xa2, 0xd0, 0x4c,
-	0x10, 0x94, 0x8c, 0xd0, 0x04, 0xba, 0x6c, 0x28, 0xaf, 0x6f, 0x37, 0x2a, 0xad, 0x5d, 0x6b, 0x3b,
-	0x0a, 0xcd, 0x18, 0x40, 0xf1, 0x20, 0xa5, 0xe9, 0xd3, 0x09, 0x22, 0x97, 0x84, 0x11, 0x29, 0x0d,
-	0x50, 0xf5, 0x2a, 0x69, 0xb2, 0x38, 0xca, 0x45, 0x72, 0xe9, 0xae, 0x47, 0xe5, 0xd2, 0x3b, 0x8b,
-	0xa5, 0x63, 0x04, 0x25, 0x63, 0xf3, 0x07, 0x0d, 0xdc, 0xfb, 0x6c, 0xec, 0x0a, 0xf2, 0xd4, 0xe5,
-	0x22, 0xf5, 0xeb, 0xc7, 0xa0, 0x96, 0x82, 0x79, 0xcb, 0x1e, 0x46, 0xa1, 0xb9, 0x92, 0x43, 0x2b,
-	0x88, 0x34, 0xe2, 0x9c, 0xad, 0x27, 0xb0, 0x98, 0xf2, 0xc4, 0xbc, 0xca, 0x88, 0xf9, 0x0c, 0x5a,
-	0x8a, 0x9b, 0xbf, 0x69, 0xa0, 0xf6, 0x04, 0x33, 0xe7, 0x84, 0xb2, 0x17, 0x69, 0x49, 0x1f, 0x82,
-	0x83, 0xe3, 0x09, 0x1d, 0x8e, 0xfb, 0x74, 0x9e, 0x52, 0x15, 0xed, 0x59, 0xff, 0x8b, 0x42, 0x73,
-	0x39, 0x85, 0x96, 0x01, 0x78, 0x02, 0xa0, 0x4d, 0xae, 0x7a, 0xf4, 0x52, 0x5c, 0x61, 0x46, 0x06,
-	0x84, 0x71, 0x97, 0x06, 0x49, 0x4d, 0xff, 0x8f, 0x42, 0x73, 0x4d, 0x16, 0xad, 0xc1, 0xd6, 0x7c,
-	0x57, 0xe5, 0x5f, 0x7f, 0xd7, 0x9f, 0x65, 0x50, 0x7b, 0x9c, 0x1e, 0x32, 0x5d, 0x1a, 0x5c, 0xba,
-	0x23, 0xd8, 0x02, 0x5b, 0xf6, 0xd4, 0xb7, 0xa9, 0x43, 0xe2, 0x16, 0x57, 0xac, 0xdd, 0x28, 0x34,
-	0x53, 0x0c, 0xa5, 0x33, 0xf8, 0x26, 0xd8, 0x3e, 0x73, 0x83, 0x67, 0x53, 0xca, 0xa6, 0xbe, 0xaa,
-	0x5c, 0xb7, 0xf6, 0xa2, 0xd0, 0x5c, 0x80, 0x68, 0x31, 0x95, 0x0a, 0x9e, 0xb9, 0x81, 0xf4, 0x57,
-	0x7f, 0xcc, 0x08, 0x1f, 0x53, 0xcf, 0x51, 0x95, 0xea, 0xb1, 0x82, 0xcb, 0x39, 0xb4, 0x82, 0x24,
-	0x0c, 0xd2, 0xc3, 0x0b, 0x86, 0x6a, 0x8e, 0x21, 0x97, 0x43, 0x2b, 0x08, 0x7c, 0x09, 0x76, 0xe6,
-	0x1d, 0x38, 0x21, 0x24, 0x39, 0x22, 0xfa, 0x51, 0x68, 0x66, 0xe1, 0x62, 0xf6, 0x5a, 0x96, 0xb1,
-	0xf9, 0x5d, 0x15, 0xc0, 0xe5, 0x3e, 0x0f, 0x8e, 0xe0, 0x24, 0xdb, 0xbf, 0xd8, 0xcd, 0x28, 0xd7,
-	0xbf, 0x62, 0x4a, 0xc9, 0x88, 0xf0, 0xad, 0xb6, 0x46, 0x85, 0xd8, 0x73, 0xcf, 0xd7, 0xa9, 0x50,
-	0x4c, 0x01, 0xab, 0x52, 0x26, 0x75, 0xe4, 0xb5, 0xac, 0xe4, 0xea, 0xc8, 0xe5, 0x8a, 0xab, 0xe3,
-	0x1f, 0x0d, 0x51, 0xfd, 0xaf, 0x0c, 0xf1, 0x6b, 0x19, 0xec, 0xc8, 0x83, 0xf4, 0x11, 0x11, 0xd8,
-	0xf5, 0x38, 0x7c, 0x17, 0xe8, 0x03, 0xec, 0x4d, 0x89, 0x72, 0xc1, 0xfe, 0xd1, 0x61, 0xfc, 0xe7,
-	0x6e, 0xcb, 0x47, 0x14, 0xde, 0x7f, 0x35, 0x21, 0xc9, 0x29, 0x2c, 0x43, 0x14, 0x0f, 0xd0, 0x01,
-	0xfa, 0x39, 0xbd, 0x22, 0x2c, 0x91, 0xd0, 0x96, 0x0f, 0x28, 0xa0, 0x98, 0x92, 0x63, 0x2e, 0xf8,
-	0x15, 0xd8, 0xb4, 0xb0, 0x87, 0xe7, 0xff, 0xee, 0x5d, 0xeb, 0x3c, 0x0a, 0xcd, 0x39, 0x54, 0xcc,
-	0x4a, 0x73, 0x36, 0xf8, 0x16, 0xd8, 0x79, 0x44, 0x3c, 0x32, 0xc2, 0x82, 0x38, 0x7d, 0x9a, 0x08,
-	0x72, 0x20, 0x05, 0xc9, 0xc0, 0x28, 0x1b, 0x34, 0xbf, 0xd1, 0xc1, 0xa6, 0x6c, 0x54, 0x8f, 0x08,
-	0xb9, 0xa3, 0x3e, 0xe5, 0xc4, 0x89, 0x9b, 0x92, 0xd9, 0x51, 0x29, 0x58, 0xd0, 0x8e, 0x4a, 0xf9,
-	0xa4, 0x83, 0x64, 0x30, 0x6f, 0x50, 0x79, 0xe1, 0xa0, 0x0c, 0x5c, 0x90, 0x83, 0x32, 0x8c, 0xd0,
-	0x07, 0x5b, 0x7d, 0x2a, 0xb0, 0x27, 0xaf, 0x3a, 0xb1, 0x2a, 0xcf, 0xe4, 0x29, 0x3d, 0xc7, 0x8a,
-	0x59, 0x31, 0xa5, 0x93, 0x1e, 0x50, 0x73, 0x7b, 0xae, 0x89, 0xf2, 0x40, 0x02, 0x15, 0xe4, 0x81,
-	0x84, 0x4d, 0x8a, 0xa8, 0xa6, 0xea, 0x22, 0xa4, 0x2f, 0x44, 0x4c, 0xc1, 0x82, 0x44, 0x4c, 0xf9,
-	0xe0, 0x47, 0x60, 0x5b, 0x3a, 0xe8, 0x54, 0x10, 0x9f, 0xd7, 0x37, 0x1a, 0x95, 0xd6, 0xce, 0x11,
-	0xcc, 0x6c, 0xc1, 0x64, 0x97, 0xc6, 0x3f, 0xb7, 0xf4, 0x41, 0xb4, 0x98, 0xde, 0xbf, 0x0f, 0xf6,
-	0x72, 0x7b, 0x15, 0x6e, 0xaa, 0x4b, 0x68, 0xad, 0x04, 0x37, 0xe4, 0x6d, 0xb1, 0xa6, 0xc1, 0xad,
-	0xf8, 0x62, 0x57, 0x2b, 0x5b, 0xf6, 0xf5, 0xad, 0x51, 0xba, 0xb9, 0x35, 0x4a, 0xaf, 0x6f, 0x0d,
-	0xed, 0xeb, 0x99, 0xa1, 0xfd, 0x38, 0x33, 0xb4, 0x5f, 0x66, 0x86, 0x76, 0x3d, 0x33, 0xb4, 0x9b,
-	0x99, 0xa1, 0xfd, 0x31, 0x33, 0xb4, 0xbf, 0x66, 0x46, 0xe9, 0xf5, 0xcc, 0xd0, 0xbe, 0xbf, 0x33,
-	0x4a, 0xd7, 0x77, 0x46, 0xe9, 0xe6, 0xce, 0x28, 0x7d, 0x7e, 0xc8, 0x5f, 0x71, 0x41, 0xfc, 0x9e,
-	0x8f, 0x99, 0xe8, 0xd2, 0x40, 0x30, 0x3c, 0x14, 0xfc, 0x62, 0x43, 0x15, 0xfa, 0xf6, 0xdf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x1d, 0x96, 0x26, 0x2f, 0x0c, 0x00, 0x00,
+	// 1133 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6b, 0x24, 0x45,
+	0x14, 0x9f, 0x9e, 0x3f, 0xc9, 0xa4, 0x26, 0x7f, 0x66, 0x6b, 0x83, 0x34, 0x22, 0xdd, 0x61, 0x40,
+	0x18, 0x56, 0x76, 0x82, 0x51, 0x11, 0x14, 0xd1, 0xf4, 0x6c, 0xb2, 0x09, 0x6e, 0x9a, 0x6c, 0x65,
+	0x8c, 0xac, 0x82, 0x50, 0x99, 0xae, 0xcc, 0xb4, 0xdb, 0xdd, 0x35, 0x74, 0xd5, 0x24, 0xec, 0x4d,
+	0x0f, 0x82, 0x27, 0xd1, 0x6f, 0x21, 0xfa, 0x45, 0xf4, 0x96, 0x8b, 0x90, 0x53, 0x6b, 0x26, 0x08,
+	0xd2, 0xa7, 0xfd, 0x08, 0x52, 0xd5, 0xff, 0x27, 0x83, 0x7a, 0x68, 0xbc, 0x4c, 0xd5, 0xfb, 0xbd,
+	0xee, 0x5f, 0xbd, 0x7e, 0xef, 0xf7, 0xde, 0x14, 0x68, 0x8f, 0xe8, 0x05, 0xf1, 0x3d, 0xec, 0x0d,
+	0x49, 0x6f, 0xe2, 0x53, 0x4e, 0x61, 0x43, 0x2e, 0xaf, 0x3e, 0x1c, 0xd9, 0x7c, 0x3c, 0x3d, 0xeb,
+	0x0d, 0xa9, 0xbb, 0x3d, 0xa2, 0x23, 0xba, 0x2d, 0xe1, 0xb3, 0xe9, 0xb9, 0xb4, 0xa4, 0x21, 0x77,
+	0xd1, 0x5b, 0x9d, 0x9f, 0x1b, 0x60, 0xe3, 0x31, 0xf1, 0x88, 0x8f, 0x9d, 0x63, 0x9f, 0x4e, 0x28,
+	0xc3, 0x0e, 0x7c, 0x17, 0xac, 0x1d, 0x32, 0x36, 0x25, 0xfe, 0xae, 0x65, 0xf9, 0x84, 0x31, 0x55,
+	0xd9, 0x52, 0xba, 0xab, 0xc6, 0xbd, 0x30, 0xd0, 0x8b, 0x0e, 0x54, 0x34, 0x61, 0x0f, 0x80, 0x3e,
+	0x75, 0x5d, 0x9b, 0x1f, 0x60, 0x36, 0x56, 0xab, 0xf2, 0xad, 0xf5, 0x30, 0xd0, 0x73, 0x28, 0xca,
+	0xed, 0xe1, 0x7b, 0x60, 0xfd, 0x84, 0x63, 0x9f, 0x9f, 0x52, 0x4e, 0x4c, 0xea, 0x0d, 0x89, 0x5a,
+	0xdb, 0x52, 0xba, 0x75, 0x03, 0x86, 0x81, 0x3e, 0xe7, 0x41, 0x73, 0x36, 0x7c, 0x1b, 0xac, 0xee,
+	0x79, 0x56, 0xf6, 0x66, 0x5d, 0xbe, 0xd9, 0x0e, 0x03, 0xbd, 0x80, 0xa3, 0x82, 0x05, 0xbf, 0x00,
+	0xb5, 0x67, 0x84, 0xa9, 0x0d, 0x19, 0xda, 0x93, 0x30, 0xd0, 0x85, 0xf9, 0xd3, 0xef, 0xfa, 0xae,
+	0x8b, 0xf9, 0x78, 0xfb, 0xcc, 0x1e, 0xf5, 0x0e, 0x3d, 0xfe, 0x7e, 0x2e, 0x85, 0x7b, 0x8e, 0x4f,
+	0x3d, 0xcb, 0x24, 0xfc, 0x92, 0xfa, 0xcf, 0xb7, 0x89, 0xb4, 0x1e, 0x8e, 0xe8, 0xb6, 0x85, 0x39,
+	0xee, 0x19, 0xf6, 0xe8, 0xd0, 0xe3, 0x7d, 0xcc, 0x38, 0xf1, 0x91, 0x60, 0x82, 0x9f, 0x83, 0xaa,
+	0x49, 0xd5, 0x25, 0x49, 0xff, 0x71, 0x18, 0xe8, 0x55, 0x93, 0x96, 0xc3, 0x5e, 0x35, 0x29, 0xc4,
+	0xa0, 0x7e, 0x4a, 0x38, 0x55, 0x97, 0x25, 0xfd, 0x51, 0x18, 0xe8, 0xd2, 0x2e, 0xe7, 0x00, 0x49,
+	0x05, 0x3b, 0x60, 0xe9, 0x18, 0x33, 0x46, 0x2c, 0xb5, 0xb9, 0xa5, 0x74, 0x9b, 0x06, 0x08, 0x03,
+	0x3d, 0x46, 0x50, 0xbc, 0x42, 0x1d, 0x34, 0x44, 0x42, 0x99, 0xba, 0xb2, 0x55, 0xeb, 0xae, 0x1a,
+	0x2b, 0x61, 0xa0, 0x47, 0x00, 0x8a, 0x16, 0x51, 0x9a, 0x01, 0x9d, 0x20, 0x72, 0x4e, 0x7c, 0x22,
+	0x4a, 0x03, 0x64, 0xbc, 0xb2, 0x34, 0x79, 0x1c, 0x15, 0x2c, 0x71, 0x74, 0xdf, 0xa1, 0xe2, 0xe8,
+	0x56, 0x76, 0x74, 0x84, 0xa0, 0x78, 0xed, 0xfc, 0xa0, 0x80, 0x7b, 0x9f, 0x8e, 0x6d, 0x4e, 0x9e,
+	0xd8, 0x8c, 0xa7, 0x7a, 0xfd, 0x08, 0xb4, 0x53, 0xb0, 0x28, 0xd9, 0xcd, 0x30, 0xd0, 0xef, 0xf8,
+	0xd0, 0x1d, 0x44, 0x08, 0x31, 0x61, 0x3b, 0xe1, 0x98, 0x4f, 0x59, 0x2c, 0x5e, 0x29, 0xc4, 0xa2,
+	0x07, 0xcd, 0xd9, 0x9d, 0xdf, 0x14, 0xd0, 0x3e, 0xc0, 0xbe, 0xb5, 0x4f, 0xfd, 0xe7, 0x69, 0x48,
+	0x1f, 0x80, 0x8d, 0xbd, 0x09, 0x1d, 0x8e, 0x07, 0x34, 0x71, 0xc9, 0x88, 0xd6, 0x8c, 0xfb, 0x61,
+	0xa0, 0xcf, 0xbb, 0xd0, 0x3c, 0x00, 0xf7, 0x01, 0x34, 0xc9, 0xe5, 0x09, 0x3d, 0xe7, 0x97, 0xd8,
+	0x27, 0xa7, 0xc4, 0x67, 0x36, 0xf5, 0xe2, 0x98, 0x5e, 0x09, 0x03, 0x7d, 0x81, 0x17, 0x2d, 0xc0,
+	0x16, 0x7c, 0x57, 0xed, 0x3f, 0x7f, 0xd7, 0xb7, 0x55, 0x70, 0xff, 0x18, 0xfb, 0xd8, 0xed, 0x8f,
+	0xb1, 0x37, 0x22, 0xe9, 0xa7, 0x15, 0x9b, 0x5c, 0xf9, 0xd7, 0x26, 0x7f, 0x0d, 0xd4, 0x07, 0x2f,
+	0x26, 0x24, 0x8e, 0xbe, 0x29, 0x54, 0x2b, 0x6c, 0x24, 0x7f, 0x85, 0xd7, 0xc4, 0x2e, 0x51, 0x6b,
+	0x99, 0x57, 0xd8, 0x48, 0xfe, 0x4a, 0xa9, 0x61, 0x67, 0x1a, 0x75, 0x77, 0x22, 0x35, 0x01, 0xa0,
+	0x68, 0x11, 0x79, 0xde, 0x1d, 0x72, 0xfb, 0x02, 0x73, 0x9b, 0x7a, 0x32, 0x8b, 0x6a, 0x23, 0xcb,
+	0xf3, 0x9c, 0x0b, 0xcd, 0x03, 0xf0, 0x75, 0xb0, 0xbc, 0x3b, 0x99, 0x38, 0x36, 0xb1, 0x64, 0xcf,
+	0x36, 0x8d, 0x56, 0x18, 0xe8, 0x09, 0x84, 0x92, 0x4d, 0xe7, 0x00, 0xb4, 0x73, 0x99, 0x60, 0x42,
+	0x39, 0x42, 0xe4, 0xd9, 0x47, 0x12, 0x21, 0xb8, 0x5a, 0x22, 0xf2, 0x3c, 0x8e, 0x0a, 0x56, 0xe7,
+	0xcf, 0x2a, 0x68, 0x3f, 0x4e, 0x27, 0x77, 0x9f, 0x7a, 0xe7, 0xf6, 0x08, 0x76, 0x41, 0xd3, 0x9c,
+	0xba, 0x26, 0xb5, 0x48, 0xa4, 0xdb, 0x9a, 0xb1, 0x1a, 0x06, 0x7a, 0x8a, 0xa1, 0x74, 0x07, 0xdf,
+	0x00, 0x2b, 0x47, 0xb6, 0xf7, 0x74, 0x4a, 0xfd, 0xa9, 0x2b, 0x13, 0xda, 0x30, 0xd6, 0xc2, 0x40,
+	0xcf, 0x40, 0x94, 0x6d, 0x45, 0x5b, 0x1c, 0xd9, 0x9e, 0x68, 0xda, 0xc1, 0xd8, 0x27, 0x6c, 0x4c,
+	0x1d, 0x4b, 0xa6, 0xb9, 0x11, 0xb5, 0xc5, 0xbc, 0x0f, 0xdd, 0x41, 0x62, 0x06, 0x31, 0x18, 0x32,
+	0x86, 0x7a, 0x81, 0xa1, 0xe0, 0x43, 0x77, 0x10, 0x78, 0x01, 0x5a, 0x89, 0x70, 0xf6, 0x09, 0x89,
+	0xe7, 0xee, 0x20, 0x0c, 0xf4, 0x3c, 0x5c, 0xce, 0x00, 0xcb, 0x33, 0x76, 0xbe, 0xab, 0x03, 0x38,
+	0x9f, 0xe7, 0xd3, 0x1d, 0x38, 0xc9, 0xe7, 0x2f, 0x92, 0x2e, 0x2a, 0xe4, 0xaf, 0x9c, 0x50, 0x72,
+	0x45, 0xf8, 0x46, 0x59, 0x50, 0x85, 0xa8, 0x15, 0x9e, 0x2d, 0xaa, 0x42, 0x39, 0x01, 0xdc, 0x2d,
+	0x65, 0x1c, 0x47, 0xb1, 0x96, 0xb5, 0x42, 0x1c, 0x05, 0x5f, 0x79, 0x71, 0xfc, 0xa3, 0x20, 0xea,
+	0xff, 0x97, 0x20, 0x7e, 0xad, 0x82, 0x96, 0xf8, 0x77, 0x7a, 0x44, 0x38, 0xb6, 0x1d, 0x06, 0xdf,
+	0x49, 0x26, 0x8b, 0x50, 0xc1, 0xfa, 0xce, 0x66, 0x74, 0x1d, 0xea, 0x89, 0x47, 0x24, 0x2e, 0x86,
+	0xd3, 0x82, 0x79, 0x63, 0x81, 0xc6, 0x31, 0xbd, 0x24, 0x7e, 0x5c, 0x42, 0x53, 0x3c, 0x20, 0x81,
+	0x72, 0x42, 0x8e, 0xb8, 0xe0, 0x97, 0x60, 0xd9, 0xc0, 0x0e, 0x4e, 0x2e, 0x44, 0xab, 0xc6, 0xb1,
+	0x18, 0x4b, 0x31, 0x54, 0xce, 0x49, 0x09, 0x1b, 0x7c, 0x13, 0xb4, 0x1e, 0x11, 0x87, 0x8c, 0x30,
+	0x27, 0xd6, 0x80, 0xc6, 0x05, 0xd9, 0x10, 0x05, 0xc9, 0xc1, 0x28, 0x6f, 0x74, 0xbe, 0x6e, 0x80,
+	0x65, 0x91, 0xa8, 0x13, 0xc2, 0x45, 0x47, 0x7d, 0xc2, 0x88, 0x15, 0x25, 0x25, 0xd7, 0x51, 0x29,
+	0x58, 0x52, 0x47, 0xa5, 0x7c, 0x42, 0x41, 0xc2, 0x48, 0x12, 0x54, 0xcd, 0x14, 0x94, 0x83, 0x4b,
+	0x52, 0x50, 0x8e, 0x11, 0xba, 0xa0, 0x39, 0xa0, 0x1c, 0x3b, 0xe2, 0xfe, 0x18, 0x55, 0xe5, 0xa9,
+	0x98, 0xd2, 0x09, 0x56, 0xce, 0x89, 0x29, 0x9d, 0xd0, 0x80, 0xdc, 0x9b, 0x49, 0x4d, 0xa4, 0x06,
+	0x62, 0xa8, 0x24, 0x0d, 0xc4, 0x6c, 0xa2, 0x88, 0x72, 0x2b, 0x6f, 0x97, 0x8d, 0xac, 0x88, 0x29,
+	0x58, 0x52, 0x11, 0x53, 0x3e, 0xf8, 0x21, 0x58, 0x11, 0x0a, 0x3a, 0xe4, 0xc4, 0x65, 0xea, 0xd2,
+	0x56, 0xad, 0xdb, 0xda, 0x81, 0xb9, 0x16, 0x8c, 0xbb, 0x34, 0xfa, 0x73, 0x4b, 0x1f, 0x44, 0xd9,
+	0xf6, 0xc1, 0x03, 0xb0, 0x56, 0xe8, 0x55, 0xb8, 0x2c, 0x6f, 0xf6, 0xed, 0x0a, 0x5c, 0x12, 0x57,
+	0xf0, 0xb6, 0x02, 0x9b, 0xd1, 0x6d, 0xb9, 0x5d, 0x35, 0xcc, 0xab, 0x1b, 0xad, 0x72, 0x7d, 0xa3,
+	0x55, 0x5e, 0xde, 0x68, 0xca, 0x57, 0x33, 0x4d, 0xf9, 0x71, 0xa6, 0x29, 0xbf, 0xcc, 0x34, 0xe5,
+	0x6a, 0xa6, 0x29, 0xd7, 0x33, 0x4d, 0xf9, 0x63, 0xa6, 0x29, 0x7f, 0xcd, 0xb4, 0xca, 0xcb, 0x99,
+	0xa6, 0x7c, 0x7f, 0xab, 0x55, 0xae, 0x6e, 0xb5, 0xca, 0xf5, 0xad, 0x56, 0xf9, 0x6c, 0x93, 0xbd,
+	0x60, 0x9c, 0xb8, 0x27, 0x2e, 0xf6, 0x79, 0x9f, 0x7a, 0xdc, 0xc7, 0x43, 0xce, 0xce, 0x96, 0x64,
+	0xa0, 0x6f, 0xfd, 0x3d, 0x00, 0x18, 0x9c, 0x9b, 0x05, 0x84, 0x0d, 0x00, 0x00,
 }
 
 func (x VoteValueType) String() string {
@@ -763,6 +890,74 @@ func (this *HardForkProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ParamChangeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamChangeProposal)
+	if !ok {
+		that2, ok := that.(ParamChangeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.CommitHash, that1.CommitHash) {
+		return false
+	}
+	if !bytes.Equal(this.Type, that1.Type) {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	if this.ActivationEpoch != that1.ActivationEpoch {
+		return false
+	}
+	if this.Applied != that1.Applied {
+		return false
+	}
+	return true
+}
+func (this *ParamChangesList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamChangesList)
+	if !ok {
+		that2, ok := that.(ParamChangesList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CommitHashes) != len(that1.CommitHashes) {
+		return false
+	}
+	for i := range this.CommitHashes {
+		if !bytes.Equal(this.CommitHashes[i], that1.CommitHashes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GovernanceConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -988,6 +1183,31 @@ func (this *HardForkProposal) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ParamChangeProposal) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&systemSmartContracts.ParamChangeProposal{")
+	s = append(s, "CommitHash: "+fmt.Sprintf("%#v", this.CommitHash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "ActivationEpoch: "+fmt.Sprintf("%#v", this.ActivationEpoch)+",\n")
+	s = append(s, "Applied: "+fmt.Sprintf("%#v", this.Applied)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ParamChangesList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.ParamChangesList{")
+	s = append(s, "CommitHashes: "+fmt.Sprintf("%#v", this.CommitHashes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GovernanceConfig) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1248,6 +1468,104 @@ func (m *HardForkProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivationEpoch != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.ActivationEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CommitHash) > 0 {
+		i -= len(m.CommitHash)
+		copy(dAtA[i:], m.CommitHash)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.CommitHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangesList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangesList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangesList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitHashes) > 0 {
+		for iNdEx := len(m.CommitHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CommitHashes[iNdEx])
+			copy(dAtA[i:], m.CommitHashes[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.CommitHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GovernanceConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1620,6 +1938,52 @@ func (m *HardForkProposal) Size() (n int) {
 	return n
 }
 
+func (m *ParamChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CommitHash)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovGovernance(uint64(m.ActivationEpoch))
+	}
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *ParamChangesList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommitHashes) > 0 {
+		for _, b := range m.CommitHashes {
+			l = len(b)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GovernanceConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1790,11 +2154,36 @@ func (this *HardForkProposal) String() string {
 	}, "")
 	return s
 }
-func (this *GovernanceConfig) String() string {
+func (this *ParamChangeProposal) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&GovernanceConfig{`,
+	s := strings.Join([]string{`&ParamChangeProposal{`,
+		`CommitHash:` + fmt.Sprintf("%v", this.CommitHash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ActivationEpoch:` + fmt.Sprintf("%v", this.ActivationEpoch) + `,`,
+		`Applied:` + fmt.Sprintf("%v", this.Applied) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ParamChangesList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ParamChangesList{`,
+		`CommitHashes:` + fmt.Sprintf("%v", this.CommitHashes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GovernanceConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GovernanceConfig{`,
 		`NumNodes:` + fmt.Sprintf("%v", this.NumNodes) + `,`,
 		`MinQuorum:` + fmt.Sprintf("%v", this.MinQuorum) + `,`,
 		`MinPassThreshold:` + fmt.Sprintf("%v", this.MinPassThreshold) + `,`,
@@ -2498,6 +2887,319 @@ func (m *HardForkProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHash = append(m.CommitHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommitHash == nil {
+				m.CommitHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = append(m.Type[:0], dAtA[iNdEx:postIndex]...)
+			if m.Type == nil {
+				m.Type = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			}
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChangesList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangesList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangesList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitHashes = append(m.CommitHashes, make([]byte, postIndex-iNdEx))
+			copy(m.CommitHashes[len(m.CommitHashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GovernanceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
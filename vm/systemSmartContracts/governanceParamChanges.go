@@ -0,0 +1,331 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/forking"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const paramChangePrefix = "paramChange_"
+const paramChangesListKey = "paramChangesList"
+const appliedParamChangesListKey = "appliedParamChangesList"
+const applyParamChangesFunction = "applyParamChanges"
+
+var feeSettingsParamNames = map[string]struct{}{
+	"MinGasPrice":             {},
+	"MinGasLimit":             {},
+	"GasPerDataByte":          {},
+	"MaxGasLimitPerBlock":     {},
+	"MaxGasLimitPerMetaBlock": {},
+	"GasPriceModifier":        {},
+}
+
+// paramChangeProposal creates a proposal which changes a network parameter starting with the activation epoch,
+// if accepted. The expected arguments are:
+//  args.Arguments[0] - proposal reference (github commit)
+//  args.Arguments[1] - parameter type (gasSchedule, feeSettings, enableEpochs)
+//  args.Arguments[2] - parameter name (Section.Entry for gas schedule entries, the setting or the enable epoch name)
+//  args.Arguments[3] - new value
+//  args.Arguments[4] - activation epoch
+//  args.Arguments[5] - start vote nonce
+//  args.Arguments[6] - end vote nonce
+func (g *governanceContract) paramChangeProposal(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagParamChanges.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallValue.Cmp(g.baseProposalCost) != 0 {
+		g.eei.AddReturnMessage("invalid proposal cost, expected " + g.baseProposalCost.String())
+		return vmcommon.OutOfFunds
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.Proposal)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 7 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 7")
+		return vmcommon.FunctionWrongSignature
+	}
+	if !g.isWhiteListed(args.CallerAddr) {
+		g.eei.AddReturnMessage("called address is not whiteListed")
+		return vmcommon.UserError
+	}
+	commitHash := args.Arguments[0]
+	if len(commitHash) != commitHashLength {
+		g.eei.AddReturnMessage(fmt.Sprintf("invalid github commit length, wanted exactly %d", commitHashLength))
+		return vmcommon.UserError
+	}
+	if g.proposalExists(commitHash) {
+		g.eei.AddReturnMessage("proposal already exists")
+		return vmcommon.UserError
+	}
+
+	activationEpoch, okConvert := big.NewInt(0).SetString(string(args.Arguments[4]), conversionBase)
+	if !okConvert || !activationEpoch.IsUint64() || activationEpoch.Uint64() > uint64(^uint32(0)) {
+		g.eei.AddReturnMessage("invalid argument for activation epoch")
+		return vmcommon.UserError
+	}
+	if uint32(activationEpoch.Uint64()) <= g.eei.BlockChainHook().CurrentEpoch() {
+		g.eei.AddReturnMessage("activation epoch must be in the future")
+		return vmcommon.UserError
+	}
+
+	err = checkParamChange(string(args.Arguments[1]), string(args.Arguments[2]), string(args.Arguments[3]), activationEpoch.Uint64())
+	if err != nil {
+		g.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	startVoteNonce, endVoteNonce, err := g.startEndNonceFromArguments(args.Arguments[5], args.Arguments[6])
+	if err != nil {
+		g.eei.AddReturnMessage("invalid start/end vote nonce " + err.Error())
+		return vmcommon.UserError
+	}
+
+	paramChange := &ParamChangeProposal{
+		CommitHash:      commitHash,
+		Type:            args.Arguments[1],
+		Name:            args.Arguments[2],
+		Value:           args.Arguments[3],
+		ActivationEpoch: uint32(activationEpoch.Uint64()),
+	}
+	err = g.saveParamChange(paramChange)
+	if err != nil {
+		g.eei.AddReturnMessage("saveParamChange " + err.Error())
+		return vmcommon.UserError
+	}
+
+	paramChanges, err := g.getParamChangesList(paramChangesListKey)
+	if err != nil {
+		g.eei.AddReturnMessage("getParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+	paramChanges.CommitHashes = append(paramChanges.CommitHashes, commitHash)
+	err = g.saveParamChangesList(paramChangesListKey, paramChanges)
+	if err != nil {
+		g.eei.AddReturnMessage("saveParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+
+	generalProposal := &GeneralProposal{
+		IssuerAddress:  args.CallerAddr,
+		CommitHash:     commitHash,
+		StartVoteNonce: startVoteNonce,
+		EndVoteNonce:   endVoteNonce,
+		Yes:            big.NewInt(0),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Passed:         false,
+		Votes:          make([][]byte, 0),
+	}
+	err = g.saveGeneralProposal(commitHash, generalProposal)
+	if err != nil {
+		log.Warn("save general proposal", "error", err)
+		g.eei.AddReturnMessage("saveGeneralProposal " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func checkParamChange(paramType string, name string, value string, activationEpoch uint64) error {
+	switch paramType {
+	case core.GovernanceGasScheduleParam:
+		nameParts := strings.Split(name, ".")
+		if len(nameParts) != 2 || len(nameParts[0]) == 0 || len(nameParts[1]) == 0 {
+			return fmt.Errorf("%w, gas schedule entries are named Section.Entry", vm.ErrInvalidParamChange)
+		}
+		_, err := strconv.ParseUint(value, conversionBase, 64)
+		if err != nil {
+			return fmt.Errorf("%w, invalid gas cost %s", vm.ErrInvalidParamChange, value)
+		}
+	case core.GovernanceFeeSettingsParam:
+		_, exists := feeSettingsParamNames[name]
+		if !exists {
+			return fmt.Errorf("%w, unknown fee setting %s", vm.ErrInvalidParamChange, name)
+		}
+		if name == "GasPriceModifier" {
+			modifier, err := strconv.ParseFloat(value, 64)
+			if err != nil || modifier <= 0 || modifier > 1 {
+				return fmt.Errorf("%w, invalid gas price modifier %s", vm.ErrInvalidParamChange, value)
+			}
+			return nil
+		}
+		_, err := strconv.ParseUint(value, conversionBase, 64)
+		if err != nil {
+			return fmt.Errorf("%w, invalid value %s for %s", vm.ErrInvalidParamChange, value, name)
+		}
+	case core.GovernanceEnableEpochsParam:
+		if !forking.IsEnableEpochName(name) {
+			return fmt.Errorf("%w, unknown enable epoch %s", vm.ErrInvalidParamChange, name)
+		}
+		// the nodes restart at a random moment of the activation epoch in order to apply the change, so the new enable
+		// epoch must come after the epoch following the activation epoch
+		epoch, err := strconv.ParseUint(value, conversionBase, 32)
+		if err != nil || epoch <= activationEpoch+1 {
+			return fmt.Errorf("%w, the enable epoch %s must be at least two epochs after the activation epoch", vm.ErrInvalidParamChange, value)
+		}
+	default:
+		return fmt.Errorf("%w, unknown parameter type %s", vm.ErrInvalidParamChange, paramType)
+	}
+
+	return nil
+}
+
+// applyParamChanges is called at the start of each epoch and returns all the accepted parameter changes activated up to
+// the provided epoch, in the order of their activation. The list is added in each epoch start block, so a node starting
+// in any epoch finds in it the parameters of the network. The rejected proposals and the activated ones are removed from
+// the pending list
+//  args.Arguments[0] - the epoch which starts
+func (g *governanceContract) applyParamChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagParamChanges.IsSet() {
+		g.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if !bytes.Equal(args.CallerAddr, vm.EndOfEpochAddress) {
+		g.eei.AddReturnMessage(applyParamChangesFunction + " can be called by the end of epoch address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	epoch := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
+	paramChanges, err := g.getParamChangesList(paramChangesListKey)
+	if err != nil {
+		g.eei.AddReturnMessage("getParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+	appliedParamChanges, err := g.getParamChangesList(appliedParamChangesListKey)
+	if err != nil {
+		g.eei.AddReturnMessage("getParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, commitHash := range appliedParamChanges.CommitHashes {
+		marshaledData := g.eei.GetStorage(append([]byte(paramChangePrefix), commitHash...))
+		if len(marshaledData) == 0 {
+			g.eei.AddReturnMessage("getParamChange " + vm.ErrProposalNotFound.Error())
+			return vmcommon.UserError
+		}
+		g.eei.Finish(marshaledData)
+	}
+
+	pendingCommitHashes := make([][]byte, 0, len(paramChanges.CommitHashes))
+	for _, commitHash := range paramChanges.CommitHashes {
+		generalProposal, errGet := g.getGeneralProposal(commitHash)
+		if errGet != nil {
+			g.eei.AddReturnMessage("getGeneralProposal " + errGet.Error())
+			return vmcommon.UserError
+		}
+		if !generalProposal.Closed {
+			pendingCommitHashes = append(pendingCommitHashes, commitHash)
+			continue
+		}
+		if !generalProposal.Passed {
+			continue
+		}
+
+		paramChange, errGet := g.getParamChange(commitHash)
+		if errGet != nil {
+			g.eei.AddReturnMessage("getParamChange " + errGet.Error())
+			return vmcommon.UserError
+		}
+		if uint64(paramChange.ActivationEpoch) > epoch {
+			pendingCommitHashes = append(pendingCommitHashes, commitHash)
+			continue
+		}
+
+		paramChange.Applied = true
+		marshaledData, errMarshal := g.marshalizer.Marshal(paramChange)
+		if errMarshal != nil {
+			g.eei.AddReturnMessage("marshal param change " + errMarshal.Error())
+			return vmcommon.UserError
+		}
+		g.eei.SetStorage(append([]byte(paramChangePrefix), commitHash...), marshaledData)
+		g.eei.Finish(marshaledData)
+		appliedParamChanges.CommitHashes = append(appliedParamChanges.CommitHashes, commitHash)
+	}
+
+	paramChanges.CommitHashes = pendingCommitHashes
+	err = g.saveParamChangesList(paramChangesListKey, paramChanges)
+	if err != nil {
+		g.eei.AddReturnMessage("saveParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+	err = g.saveParamChangesList(appliedParamChangesListKey, appliedParamChanges)
+	if err != nil {
+		g.eei.AddReturnMessage("saveParamChangesList " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) getParamChange(commitHash []byte) (*ParamChangeProposal, error) {
+	marshaledData := g.eei.GetStorage(append([]byte(paramChangePrefix), commitHash...))
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrProposalNotFound
+	}
+
+	paramChange := &ParamChangeProposal{}
+	err := g.marshalizer.Unmarshal(paramChange, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return paramChange, nil
+}
+
+func (g *governanceContract) saveParamChange(paramChange *ParamChangeProposal) error {
+	marshaledData, err := g.marshalizer.Marshal(paramChange)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(append([]byte(paramChangePrefix), paramChange.CommitHash...), marshaledData)
+	return nil
+}
+
+func (g *governanceContract) getParamChangesList(key string) (*ParamChangesList, error) {
+	paramChanges := &ParamChangesList{CommitHashes: make([][]byte, 0)}
+	marshaledData := g.eei.GetStorage([]byte(key))
+	if len(marshaledData) == 0 {
+		return paramChanges, nil
+	}
+
+	err := g.marshalizer.Unmarshal(paramChanges, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return paramChanges, nil
+}
+
+func (g *governanceContract) saveParamChangesList(key string, paramChanges *ParamChangesList) error {
+	if len(paramChanges.CommitHashes) == 0 {
+		g.eei.SetStorage([]byte(key), nil)
+		return nil
+	}
+
+	marshaledData, err := g.marshalizer.Marshal(paramChanges)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage([]byte(key), marshaledData)
+	return nil
+}
@@ -0,0 +1,200 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createParamChangesGovernance(t *testing.T, epoch *uint32) (*governanceContract, *vmContext) {
+	eei, _ := NewVMContext(
+		&mock.BlockChainHookStub{
+			CurrentEpochCalled: func() uint32 {
+				return *epoch
+			},
+		},
+		hooks.NewVMCryptoHook(),
+		&mock.ArgumentParserMock{},
+		&testscommon.AccountsStub{},
+		&mock.RaterMock{},
+	)
+	eei.SetSCAddress(vm.GovernanceSCAddress)
+
+	args := createMockGovernanceArgs()
+	args.Eei = eei
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, err)
+
+	whiteList, _ := args.Marshalizer.Marshal(&WhiteListProposal{
+		WhiteListAddress: vm.GovernanceSCAddress,
+		ProposalStatus:   append([]byte(proposalPrefix), vm.GovernanceSCAddress...),
+	})
+	eei.SetStorage(append([]byte(whiteListPrefix), vm.GovernanceSCAddress...), whiteList)
+	_ = gsc.saveGeneralProposal(vm.GovernanceSCAddress, &GeneralProposal{Passed: true})
+
+	return gsc, eei
+}
+
+func createParamChangeInput(commitHash []byte, paramType string, name string, value string, activationEpoch string) *vmcommon.ContractCallInput {
+	callInputArgs := [][]byte{
+		commitHash,
+		[]byte(paramType),
+		[]byte(name),
+		[]byte(value),
+		[]byte(activationEpoch),
+		[]byte("1"),
+		[]byte("10"),
+	}
+
+	return createVMInput(big.NewInt(500), "paramChange", vm.GovernanceSCAddress, vm.GovernanceSCAddress, callInputArgs)
+}
+
+func TestGovernanceContract_ParamChangeDisabled(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.EpochConfig.EnableEpochs.GovernanceParamChangesEnableEpoch = 1
+	gsc, _ := NewGovernanceContract(args)
+
+	callInput := createParamChangeInput(bytes.Repeat([]byte("a"), commitHashLength), core.GovernanceGasScheduleParam, "BuiltInCost.ClaimDeveloperRewards", "100", "5")
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.FunctionNotFound, retCode)
+}
+
+func TestGovernanceContract_ParamChangeInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+
+	callInput := createParamChangeInput(commitHash, "enableEpoch", "ESDTEnableEpoch", "10", "5")
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "unknown parameter type"))
+
+	callInput = createParamChangeInput(commitHash, core.GovernanceGasScheduleParam, "ClaimDeveloperRewards", "100", "5")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	callInput = createParamChangeInput(commitHash, core.GovernanceFeeSettingsParam, "GasPriceModifier", "1.5", "5")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	callInput = createParamChangeInput(commitHash, core.GovernanceFeeSettingsParam, "MinGasPrice", "1000000000", "2")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "activation epoch must be in the future"))
+
+	callInput = createParamChangeInput(commitHash, core.GovernanceFeeSettingsParam, "MinGasPrice", "1000000000", "3")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "proposal already exists"))
+}
+
+func TestGovernanceContract_ParamChangeEnableEpochs(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+
+	callInput := createParamChangeInput(commitHash, core.GovernanceEnableEpochsParam, "UnknownEnableEpoch", "10", "5")
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "unknown enable epoch"))
+
+	eei.returnMessage = ""
+	callInput = createParamChangeInput(commitHash, core.GovernanceEnableEpochsParam, "ESDTEnableEpoch", "5", "5")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "must be at least two epochs after the activation epoch"))
+
+	eei.returnMessage = ""
+	callInput = createParamChangeInput(commitHash, core.GovernanceEnableEpochsParam, "ESDTEnableEpoch", "6", "5")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "must be at least two epochs after the activation epoch"))
+
+	callInput = createParamChangeInput(commitHash, core.GovernanceEnableEpochsParam, "ESDTEnableEpoch", "7", "5")
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestGovernanceContract_ApplyParamChangesOnlyEndOfEpochAddress(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+
+	callInput := createVMInput(big.NewInt(0), applyParamChangesFunction, vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{{5}})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "can be called by the end of epoch address only"))
+}
+
+func TestGovernanceContract_ApplyParamChangesShouldReturnAllTheActivatedChanges(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(2)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+
+	passedHash := bytes.Repeat([]byte("a"), commitHashLength)
+	rejectedHash := bytes.Repeat([]byte("b"), commitHashLength)
+	laterHash := bytes.Repeat([]byte("c"), commitHashLength)
+	openHash := bytes.Repeat([]byte("d"), commitHashLength)
+
+	require.Equal(t, vmcommon.Ok, gsc.Execute(createParamChangeInput(passedHash, core.GovernanceGasScheduleParam, "BuiltInCost.ClaimDeveloperRewards", "100", "4")))
+	require.Equal(t, vmcommon.Ok, gsc.Execute(createParamChangeInput(rejectedHash, core.GovernanceFeeSettingsParam, "MinGasLimit", "70000", "4")))
+	require.Equal(t, vmcommon.Ok, gsc.Execute(createParamChangeInput(laterHash, core.GovernanceFeeSettingsParam, "GasPriceModifier", "0.02", "6")))
+	require.Equal(t, vmcommon.Ok, gsc.Execute(createParamChangeInput(openHash, core.GovernanceFeeSettingsParam, "GasPerDataByte", "2000", "4")))
+
+	_ = gsc.saveGeneralProposal(passedHash, &GeneralProposal{CommitHash: passedHash, Closed: true, Passed: true})
+	_ = gsc.saveGeneralProposal(rejectedHash, &GeneralProposal{CommitHash: rejectedHash, Closed: true, Passed: false})
+	_ = gsc.saveGeneralProposal(laterHash, &GeneralProposal{CommitHash: laterHash, Closed: true, Passed: true})
+
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), applyParamChangesFunction, vm.EndOfEpochAddress, vm.GovernanceSCAddress, [][]byte{{4}})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	require.Equal(t, 1, len(eei.output))
+	paramChange := &ParamChangeProposal{}
+	_ = gsc.marshalizer.Unmarshal(paramChange, eei.output[0])
+	assert.Equal(t, passedHash, paramChange.CommitHash)
+	assert.Equal(t, []byte("BuiltInCost.ClaimDeveloperRewards"), paramChange.Name)
+	assert.Equal(t, []byte("100"), paramChange.Value)
+	assert.True(t, paramChange.Applied)
+
+	paramChanges, _ := gsc.getParamChangesList(paramChangesListKey)
+	assert.Equal(t, [][]byte{laterHash, openHash}, paramChanges.CommitHashes)
+
+	// the changes activated in the previous epochs are returned again, before the ones activated now
+	eei.output = make([][]byte, 0)
+	callInput.Arguments = [][]byte{{6}}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 2, len(eei.output))
+	_ = gsc.marshalizer.Unmarshal(paramChange, eei.output[0])
+	assert.Equal(t, passedHash, paramChange.CommitHash)
+	_ = gsc.marshalizer.Unmarshal(paramChange, eei.output[1])
+	assert.Equal(t, laterHash, paramChange.CommitHash)
+
+	paramChanges, _ = gsc.getParamChangesList(paramChangesListKey)
+	assert.Equal(t, [][]byte{openHash}, paramChanges.CommitHashes)
+	appliedParamChanges, _ := gsc.getParamChangesList(appliedParamChangesListKey)
+	assert.Equal(t, [][]byte{passedHash, laterHash}, appliedParamChanges.CommitHashes)
+}
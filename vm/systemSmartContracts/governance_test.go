@@ -3315,3 +3315,106 @@ func createMockStorer(callerAddress []byte, proposalIdentifier []byte, proposal
 		},
 	}
 }
+
+func TestGovernanceContract_ViewFunctionsDisabled(t *testing.T) {
+	t.Parallel()
+
+	args := createMockGovernanceArgs()
+	args.EpochConfig.EnableEpochs.GovernanceViewsEnableEpoch = 1
+	gsc, _ := NewGovernanceContract(args)
+
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+	for _, function := range []string{"viewProposal", "viewProposalTally", "viewUserVoteSet"} {
+		callInput := createVMInput(big.NewInt(0), function, vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{commitHash})
+		retCode := gsc.Execute(callInput)
+		require.Equal(t, vmcommon.FunctionNotFound, retCode)
+	}
+}
+
+func TestGovernanceContract_ViewProposalNotFound(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+
+	callInput := createVMInput(big.NewInt(0), "viewProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{[]byte("missing")})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, vm.ErrProposalNotFound.Error(), eei.returnMessage)
+
+	callInput.Arguments = make([][]byte, 0)
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+}
+
+func TestGovernanceContract_ViewFunctionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(0)
+	gsc, eei := createParamChangesGovernance(t, &epoch)
+
+	commitHash := bytes.Repeat([]byte("a"), commitHashLength)
+	validator := bytes.Repeat([]byte("v"), 32)
+	funder := bytes.Repeat([]byte("f"), 32)
+	proposal := &GeneralProposal{
+		IssuerAddress:  vm.GovernanceSCAddress,
+		CommitHash:     commitHash,
+		StartVoteNonce: 10,
+		EndVoteNonce:   20,
+		Yes:            big.NewInt(0),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Votes:          make([][]byte, 0),
+	}
+	err := gsc.addNewVote(validator, &VoteDetails{Value: Yes, Power: big.NewInt(100), Balance: big.NewInt(0)}, gsc.getEmptyVoteSet(), proposal)
+	require.Nil(t, err)
+
+	voteSet, _, err := gsc.applyVote(&VoteDetails{Value: No, Power: big.NewInt(30), Balance: big.NewInt(900)}, gsc.getEmptyVoteSet(), proposal)
+	require.Nil(t, err)
+	require.Nil(t, gsc.saveVoteSet(funder, voteSet, proposal))
+	require.Nil(t, gsc.saveGeneralProposal(commitHash, proposal))
+
+	eei.output = make([][]byte, 0)
+	callInput := createVMInput(big.NewInt(0), "viewProposal", vm.GovernanceSCAddress, vm.GovernanceSCAddress, [][]byte{commitHash})
+	retCode := gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 10, len(eei.output))
+	require.Equal(t, vm.GovernanceSCAddress, eei.output[0])
+	require.Equal(t, commitHash, eei.output[1])
+	require.Equal(t, big.NewInt(10).Bytes(), eei.output[2])
+	require.Equal(t, big.NewInt(20).Bytes(), eei.output[3])
+	require.Equal(t, big.NewInt(100).Bytes(), eei.output[4])
+	require.Equal(t, big.NewInt(30).Bytes(), eei.output[5])
+	require.Equal(t, []byte("false"), eei.output[7])
+	require.Equal(t, big.NewInt(1).Bytes(), eei.output[9])
+
+	eei.output = make([][]byte, 0)
+	callInput.Function = "viewProposalTally"
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{
+		big.NewInt(100).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(30).Bytes(),
+		big.NewInt(0).Bytes(),
+	}, eei.output)
+
+	eei.output = make([][]byte, 0)
+	callInput.Function = "viewUserVoteSet"
+	callInput.Arguments = [][]byte{commitHash, funder}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, [][]byte{
+		big.NewInt(30).Bytes(),
+		big.NewInt(900).Bytes(),
+		big.NewInt(0).Bytes(),
+		big.NewInt(30).Bytes(),
+		big.NewInt(0).Bytes(),
+	}, eei.output)
+
+	callInput.Arguments = [][]byte{commitHash, bytes.Repeat([]byte("x"), 32)}
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
@@ -0,0 +1,296 @@
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const keyRotationsKey = "keyRotations"
+const executeKeyRotationsFunction = "executeKeyRotations"
+
+// rotateValidatorKey schedules the replacement of one of the caller's staked BLS keys. The new key takes the place of
+// the old one at the start of the next epoch, keeping its stake, rating, list and shard. The expected arguments are:
+//  args.Arguments[0] - the BLS key to be replaced
+//  args.Arguments[1] - the new BLS key
+//  args.Arguments[2] - the caller address signed with the new BLS key
+func (v *validatorSC) rotateValidatorKey(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagKeyRotation.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		v.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected exactly %d, got %d", 3, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.ChangeValidatorKeys)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	oldKey := args.Arguments[0]
+	newKey := args.Arguments[1]
+	if len(newKey) != len(oldKey) || bytes.Equal(oldKey, newKey) {
+		v.eei.AddReturnMessage("invalid new BLS key")
+		return vmcommon.UserError
+	}
+
+	registrationData, err := v.getOrCreateRegistrationData(args.CallerAddr)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+	if !isBLSKeyInList(oldKey, registrationData.BlsPubKeys) {
+		v.eei.AddReturnMessage("the BLS key to be replaced is not registered by the caller")
+		return vmcommon.UserError
+	}
+
+	err = v.sigVerifier.Verify(args.CallerAddr, args.Arguments[2], newKey)
+	if err != nil {
+		v.eei.AddReturnMessage("invalid signature for the new BLS key: " + err.Error())
+		return vmcommon.UserError
+	}
+
+	buff := v.eei.GetStorageFromAddress(v.stakingSCAddress, newKey)
+	if len(buff) > 0 {
+		v.eei.AddReturnMessage(vm.ErrKeyAlreadyRegistered.Error())
+		return vmcommon.UserError
+	}
+
+	vmOutput, err := v.executeOnStakingSC([]byte("scheduleKeyRotation@" +
+		hex.EncodeToString(oldKey) + "@" +
+		hex.EncodeToString(newKey) + "@" +
+		hex.EncodeToString(args.CallerAddr),
+	))
+	if err != nil {
+		v.eei.AddReturnMessage("cannot schedule key rotation: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		v.eei.AddReturnMessage(vmOutput.ReturnMessage)
+		return vmOutput.ReturnCode
+	}
+
+	return vmcommon.Ok
+}
+
+// executeKeyRotations is called at the end of the epoch and replaces the rotated BLS keys in the staking data and in
+// the owners registration data. The old and the new key of each executed rotation are returned in pairs
+func (v *validatorSC) executeKeyRotations(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagKeyRotation.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, v.endOfEpochAddress) {
+		v.eei.AddReturnMessage(executeKeyRotationsFunction + " can be called by the end of epoch address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		v.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+
+	vmOutput, err := v.executeOnStakingSC([]byte(executeKeyRotationsFunction))
+	if err != nil {
+		v.eei.AddReturnMessage("cannot execute key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		v.eei.AddReturnMessage(vmOutput.ReturnMessage)
+		return vmOutput.ReturnCode
+	}
+	if len(vmOutput.ReturnData)%3 != 0 {
+		v.eei.AddReturnMessage("invalid number of key rotations returned by the staking SC")
+		return vmcommon.UserError
+	}
+
+	for i := 0; i < len(vmOutput.ReturnData); i += 3 {
+		oldKey := vmOutput.ReturnData[i]
+		newKey := vmOutput.ReturnData[i+1]
+		owner := vmOutput.ReturnData[i+2]
+
+		registrationData, errGet := v.getOrCreateRegistrationData(owner)
+		if errGet != nil {
+			v.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + errGet.Error())
+			return vmcommon.UserError
+		}
+		for index, blsKey := range registrationData.BlsPubKeys {
+			if bytes.Equal(blsKey, oldKey) {
+				registrationData.BlsPubKeys[index] = newKey
+				break
+			}
+		}
+		errSave := v.saveRegistrationData(owner, registrationData)
+		if errSave != nil {
+			v.eei.AddReturnMessage("cannot save registration data: error " + errSave.Error())
+			return vmcommon.UserError
+		}
+
+		v.eei.Finish(oldKey)
+		v.eei.Finish(newKey)
+	}
+
+	return vmcommon.Ok
+}
+
+func isBLSKeyInList(blsKey []byte, blsKeys [][]byte) bool {
+	for _, key := range blsKeys {
+		if bytes.Equal(key, blsKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// scheduleKeyRotation records the rotation of a staked BLS key, to be executed at the end of the epoch
+//  args.Arguments[0] - the BLS key to be replaced
+//  args.Arguments[1] - the new BLS key
+//  args.Arguments[2] - the owner of the BLS key
+func (s *stakingSC) scheduleKeyRotation(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagKeyRotation.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.stakeAccessAddr) {
+		s.eei.AddReturnMessage("scheduleKeyRotation function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		s.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments: expected exactly %d, got %d", 3, len(args.Arguments)))
+		return vmcommon.UserError
+	}
+
+	oldKey := args.Arguments[0]
+	newKey := args.Arguments[1]
+	owner := args.Arguments[2]
+	stakedData, err := s.getOrCreateRegisteredData(oldKey)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get or create registered data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(stakedData.RewardAddress) == 0 || !bytes.Equal(stakedData.OwnerAddress, owner) {
+		s.eei.AddReturnMessage("the BLS key to be replaced is not registered by the owner")
+		return vmcommon.UserError
+	}
+	if !stakedData.Staked || stakedData.Jailed || s.eei.CanUnJail(oldKey) {
+		s.eei.AddReturnMessage("only staked and not jailed nodes can rotate their keys")
+		return vmcommon.UserError
+	}
+	if len(s.eei.GetStorage(newKey)) > 0 {
+		s.eei.AddReturnMessage(vm.ErrKeyAlreadyRegistered.Error())
+		return vmcommon.UserError
+	}
+
+	keyRotations, err := s.getKeyRotations()
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+	for _, keyRotation := range keyRotations.Rotations {
+		if isBLSKeyInList(oldKey, [][]byte{keyRotation.OldKey, keyRotation.NewKey}) ||
+			isBLSKeyInList(newKey, [][]byte{keyRotation.OldKey, keyRotation.NewKey}) {
+			s.eei.AddReturnMessage("a key rotation is already scheduled for the provided keys")
+			return vmcommon.UserError
+		}
+	}
+
+	keyRotations.Rotations = append(keyRotations.Rotations, &KeyRotation{
+		OldKey:       oldKey,
+		NewKey:       newKey,
+		OwnerAddress: owner,
+	})
+	err = s.saveKeyRotations(keyRotations)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// executeKeyRotations moves the staking data of the scheduled rotations under the new keys. The rotations of the keys
+// which were unStaked or jailed in the meantime, or whose new key got registered by someone else, are dropped.
+// The old key, the new key and the owner of each executed rotation are returned
+func (s *stakingSC) executeKeyRotations(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagKeyRotation.IsSet() {
+		s.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, s.stakeAccessAddr) {
+		s.eei.AddReturnMessage("executeKeyRotations function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+
+	keyRotations, err := s.getKeyRotations()
+	if err != nil {
+		s.eei.AddReturnMessage("cannot get key rotations: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, keyRotation := range keyRotations.Rotations {
+		stakedData, errGet := s.getOrCreateRegisteredData(keyRotation.OldKey)
+		if errGet != nil {
+			s.eei.AddReturnMessage("cannot get or create registered data: error " + errGet.Error())
+			return vmcommon.UserError
+		}
+
+		canRotate := stakedData.Staked && !stakedData.Jailed && !s.eei.CanUnJail(keyRotation.OldKey) &&
+			len(s.eei.GetStorage(keyRotation.NewKey)) == 0
+		if !canRotate {
+			log.Debug("dropped key rotation",
+				"old key", keyRotation.OldKey,
+				"new key", keyRotation.NewKey,
+			)
+			continue
+		}
+
+		errSave := s.saveStakingData(keyRotation.NewKey, stakedData)
+		if errSave != nil {
+			s.eei.AddReturnMessage("cannot save staking data: error " + errSave.Error())
+			return vmcommon.UserError
+		}
+		s.eei.SetStorage(keyRotation.OldKey, nil)
+
+		s.eei.Finish(keyRotation.OldKey)
+		s.eei.Finish(keyRotation.NewKey)
+		s.eei.Finish(keyRotation.OwnerAddress)
+	}
+
+	s.eei.SetStorage([]byte(keyRotationsKey), nil)
+
+	return vmcommon.Ok
+}
+
+func (s *stakingSC) getKeyRotations() (*KeyRotationsList, error) {
+	keyRotations := &KeyRotationsList{Rotations: make([]*KeyRotation, 0)}
+	marshaledData := s.eei.GetStorage([]byte(keyRotationsKey))
+	if len(marshaledData) == 0 {
+		return keyRotations, nil
+	}
+
+	err := s.marshalizer.Unmarshal(keyRotations, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyRotations, nil
+}
+
+func (s *stakingSC) saveKeyRotations(keyRotations *KeyRotationsList) error {
+	marshaledData, err := s.marshalizer.Marshal(keyRotations)
+	if err != nil {
+		return err
+	}
+
+	s.eei.SetStorage([]byte(keyRotationsKey), marshaledData)
+	return nil
+}
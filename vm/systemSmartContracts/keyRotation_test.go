@@ -0,0 +1,175 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	rotationOwner   = []byte("owner")
+	rotationOldKey  = []byte("oldBLSKey1")
+	rotationKey2    = []byte("oldBLSKey2")
+	rotationNewKey  = []byte("newBLSKey1")
+	rotationSignMsg = []byte("signature")
+)
+
+func createKeyRotationContracts(t *testing.T) (*validatorSC, *stakingSC, *vmContext) {
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &testscommon.AccountsStub{}, &mock.RaterMock{})
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = "10000000"
+	argsStaking.EpochConfig.EnableEpochs.StakingV2EnableEpoch = 0
+	argsStaking.Eei = eei
+	stakingSc, err := NewStakingSmartContract(argsStaking)
+	require.Nil(t, err)
+
+	eei.SetSCAddress([]byte("validator"))
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (contract vm.SystemSmartContract, err error) {
+		return stakingSc, nil
+	}})
+
+	args := createMockArgumentsForValidatorSC()
+	args.Eei = eei
+	args.StakingSCConfig = argsStaking.StakingSCConfig
+	args.EpochConfig.EnableEpochs.StakingV2EnableEpoch = 0
+	validatorSc, err := NewValidatorSmartContract(args)
+	require.Nil(t, err)
+
+	stakeInput := CreateVmContractCallInput()
+	stakeInput.CallerAddr = rotationOwner
+	stakeInput.Function = "stake"
+	stakeInput.CallValue = big.NewInt(20000000)
+	stakeInput.Arguments = [][]byte{big.NewInt(2).Bytes(), rotationOldKey, []byte("msg1"), rotationKey2, []byte("msg2")}
+	require.Equal(t, vmcommon.Ok, validatorSc.Execute(stakeInput))
+
+	return validatorSc, stakingSc, eei
+}
+
+func createRotateValidatorKeyInput(oldKey []byte, newKey []byte) *vmcommon.ContractCallInput {
+	callInput := CreateVmContractCallInput()
+	callInput.CallerAddr = rotationOwner
+	callInput.Function = "rotateValidatorKey"
+	callInput.Arguments = [][]byte{oldKey, newKey, rotationSignMsg}
+
+	return callInput
+}
+
+func createExecuteKeyRotationsInput(caller []byte) *vmcommon.ContractCallInput {
+	callInput := CreateVmContractCallInput()
+	callInput.CallerAddr = caller
+	callInput.Function = executeKeyRotationsFunction
+
+	return callInput
+}
+
+func TestValidatorSC_RotateValidatorKeyDisabled(t *testing.T) {
+	t.Parallel()
+
+	validatorSc, _, eei := createKeyRotationContracts(t)
+	validatorSc.flagKeyRotation.Unset()
+
+	retCode := validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationNewKey))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Equal(t, "invalid method to call", eei.returnMessage)
+}
+
+func TestValidatorSC_RotateValidatorKeyInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	validatorSc, _, eei := createKeyRotationContracts(t)
+
+	retCode := validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationOldKey))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid new BLS key"))
+
+	eei.returnMessage = ""
+	retCode = validatorSc.Execute(createRotateValidatorKeyInput([]byte("otherKey01"), rotationNewKey))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "not registered by the caller"))
+
+	eei.returnMessage = ""
+	retCode = validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationKey2))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, vm.ErrKeyAlreadyRegistered.Error()))
+
+	eei.returnMessage = ""
+	validatorSc.sigVerifier = &mock.MessageSignVerifierMock{
+		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
+			return errors.New("invalid signature")
+		},
+	}
+	retCode = validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationNewKey))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "invalid signature for the new BLS key"))
+}
+
+func TestValidatorSC_RotateValidatorKeyShouldSwapTheKeysAtEndOfEpoch(t *testing.T) {
+	t.Parallel()
+
+	validatorSc, _, eei := createKeyRotationContracts(t)
+	oldStakedData := eei.GetStorageFromAddress([]byte("staking"), rotationOldKey)
+	require.NotEqual(t, 0, len(oldStakedData))
+
+	retCode := validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationNewKey))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = validatorSc.Execute(createRotateValidatorKeyInput(rotationKey2, rotationNewKey))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "already scheduled"))
+
+	eei.returnMessage = ""
+	retCode = validatorSc.Execute(createExecuteKeyRotationsInput(rotationOwner))
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.True(t, strings.Contains(eei.returnMessage, "end of epoch address only"))
+
+	eei.output = make([][]byte, 0)
+	retCode = validatorSc.Execute(createExecuteKeyRotationsInput([]byte("endOfEpoch")))
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{rotationOldKey, rotationNewKey}, eei.output)
+
+	assert.Equal(t, 0, len(eei.GetStorageFromAddress([]byte("staking"), rotationOldKey)))
+	assert.Equal(t, oldStakedData, eei.GetStorageFromAddress([]byte("staking"), rotationNewKey))
+
+	registrationData, _ := validatorSc.getOrCreateRegistrationData(rotationOwner)
+	assert.Equal(t, [][]byte{rotationNewKey, rotationKey2}, registrationData.BlsPubKeys)
+
+	eei.output = make([][]byte, 0)
+	retCode = validatorSc.Execute(createExecuteKeyRotationsInput([]byte("endOfEpoch")))
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+}
+
+func TestStakingSC_ExecuteKeyRotationsShouldDropTheRotationsOfUnStakedKeys(t *testing.T) {
+	t.Parallel()
+
+	validatorSc, stakingSc, eei := createKeyRotationContracts(t)
+
+	retCode := validatorSc.Execute(createRotateValidatorKeyInput(rotationOldKey, rotationNewKey))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	eei.SetSCAddress([]byte("staking"))
+	stakedData, _ := stakingSc.getOrCreateRegisteredData(rotationOldKey)
+	stakedData.Staked = false
+	_ = stakingSc.saveStakingData(rotationOldKey, stakedData)
+
+	eei.output = make([][]byte, 0)
+	retCode = stakingSc.Execute(createExecuteKeyRotationsInput([]byte("validator")))
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, 0, len(eei.output))
+	assert.Equal(t, 0, len(eei.GetStorage(rotationNewKey)))
+	assert.NotEqual(t, 0, len(eei.GetStorage(rotationOldKey)))
+
+	keyRotations, _ := stakingSc.getKeyRotations()
+	assert.Equal(t, 0, len(keyRotations.Rotations))
+}
@@ -52,6 +52,8 @@ type stakingSC struct {
 	minNodePrice                     *big.Int
 	validatorToDelegationEnableEpoch uint32
 	flagValidatorToDelegation        atomic.Flag
+	keyRotationEnableEpoch           uint32
+	flagKeyRotation                  atomic.Flag
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -131,11 +133,13 @@ func NewStakingSmartContract(
 		minNodePrice:                     minStakeValue,
 		correctLastUnjailedEpoch:         args.EpochConfig.EnableEpochs.CorrectLastUnjailedEnableEpoch,
 		validatorToDelegationEnableEpoch: args.EpochConfig.EnableEpochs.ValidatorToDelegationEnableEpoch,
+		keyRotationEnableEpoch:           args.EpochConfig.EnableEpochs.ValidatorKeyRotationEnableEpoch,
 	}
 	log.Debug("staking: enable epoch for stake", "epoch", reg.enableStakingEpoch)
 	log.Debug("staking: enable epoch for staking v2", "epoch", reg.stakingV2Epoch)
 	log.Debug("staking: enable epoch for correct last unjailed", "epoch", reg.correctLastUnjailedEpoch)
 	log.Debug("staking: enable epoch for validator to delegation", "epoch", reg.validatorToDelegationEnableEpoch)
+	log.Debug("staking: enable epoch for key rotation", "epoch", reg.keyRotationEnableEpoch)
 
 	var conversionOk bool
 	reg.stakeValue, conversionOk = big.NewInt(0).SetString(args.StakingSCConfig.GenesisNodePrice, conversionBase)
@@ -215,6 +219,10 @@ func (s *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return s.cleanAdditionalQueue(args)
 	case "changeOwnerAndRewardAddress":
 		return s.changeOwnerAndRewardAddress(args)
+	case "scheduleKeyRotation":
+		return s.scheduleKeyRotation(args)
+	case executeKeyRotationsFunction:
+		return s.executeKeyRotations(args)
 	}
 
 	return vmcommon.UserError
@@ -1862,6 +1870,9 @@ func (s *stakingSC) EpochConfirmed(epoch uint32, _ uint64) {
 
 	s.flagValidatorToDelegation.Toggle(epoch >= s.validatorToDelegationEnableEpoch)
 	log.Debug("stakingSC: validator to delegation", "enabled", s.flagValidatorToDelegation.IsSet())
+
+	s.flagKeyRotation.Toggle(epoch >= s.keyRotationEnableEpoch)
+	log.Debug("stakingSC: key rotation", "enabled", s.flagKeyRotation.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
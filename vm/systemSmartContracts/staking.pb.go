@@ -630,6 +630,100 @@ func (m *WaitingList) GetLastJailedKey() []byte {
 	return nil
 }
 
+type KeyRotation struct {
+	OldKey       []byte `protobuf:"bytes,1,opt,name=OldKey,proto3" json:"OldKey"`
+	NewKey       []byte `protobuf:"bytes,2,opt,name=NewKey,proto3" json:"NewKey"`
+	OwnerAddress []byte `protobuf:"bytes,3,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
+}
+
+func (m *KeyRotation) Reset()      { *m = KeyRotation{} }
+func (*KeyRotation) ProtoMessage() {}
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+func (m *KeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotation.Merge(m, src)
+}
+func (m *KeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotation proto.InternalMessageInfo
+
+func (m *KeyRotation) GetOldKey() []byte {
+	if m != nil {
+		return m.OldKey
+	}
+	return nil
+}
+
+func (m *KeyRotation) GetNewKey() []byte {
+	if m != nil {
+		return m.NewKey
+	}
+	return nil
+}
+
+func (m *KeyRotation) GetOwnerAddress() []byte {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
+type KeyRotationsList struct {
+	Rotations []*KeyRotation `protobuf:"bytes,1,rep,name=Rotations,proto3" json:"Rotations"`
+}
+
+func (m *KeyRotationsList) Reset()      { *m = KeyRotationsList{} }
+func (*KeyRotationsList) ProtoMessage() {}
+func (*KeyRotationsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{7}
+}
+func (m *KeyRotationsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyRotationsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyRotationsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRotationsList.Merge(m, src)
+}
+func (m *KeyRotationsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyRotationsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRotationsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyRotationsList proto.InternalMessageInfo
+
+func (m *KeyRotationsList) GetRotations() []*KeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StakedDataV1_0)(nil), "proto.StakedDataV1_0")
 	proto.RegisterType((*StakedDataV1_1)(nil), "proto.StakedDataV1_1")
@@ -637,60 +731,66 @@ func init() {
 	proto.RegisterType((*StakingNodesConfig)(nil), "proto.StakingNodesConfig")
 	proto.RegisterType((*ElementInList)(nil), "proto.ElementInList")
 	proto.RegisterType((*WaitingList)(nil), "proto.WaitingList")
+	proto.RegisterType((*KeyRotation)(nil), "proto.KeyRotation")
+	proto.RegisterType((*KeyRotationsList)(nil), "proto.KeyRotationsList")
 }
 
 func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
 
 var fileDescriptor_289e7c8aea278311 = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xce, 0x25, 0x69, 0xda, 0x5e, 0x92, 0xf6, 0xfb, 0x2c, 0x06, 0x8b, 0xc1, 0x8e, 0x22, 0x21,
-	0x45, 0x42, 0x4d, 0x28, 0x20, 0x31, 0x30, 0x35, 0xa5, 0x48, 0x85, 0x10, 0xaa, 0x8b, 0x28, 0x12,
-	0x0b, 0xba, 0xc4, 0x57, 0xc7, 0x6a, 0x72, 0x57, 0xd9, 0x67, 0xda, 0x6e, 0x88, 0x5f, 0xc0, 0x3f,
-	0x60, 0x45, 0xfd, 0x0b, 0xfc, 0x01, 0x06, 0x86, 0x8e, 0x9d, 0x4c, 0xeb, 0x2e, 0xc8, 0x53, 0x7f,
-	0x02, 0xba, 0x3b, 0x27, 0x3e, 0x77, 0x44, 0x0c, 0x0c, 0x99, 0xee, 0x9e, 0xe7, 0xee, 0x79, 0xef,
-	0xed, 0xfb, 0xbc, 0xaf, 0xd5, 0xc0, 0x7a, 0xc0, 0xf1, 0xa1, 0x47, 0xdd, 0xf6, 0x91, 0xcf, 0x38,
-	0x33, 0x96, 0xe4, 0x72, 0x77, 0xc3, 0xf5, 0xf8, 0x38, 0x1c, 0xb6, 0x47, 0x6c, 0xda, 0x71, 0x99,
-	0xcb, 0x3a, 0x92, 0x1e, 0x86, 0x07, 0x12, 0x49, 0x20, 0x77, 0x4a, 0xd5, 0x3c, 0x5b, 0x82, 0x6b,
-	0x03, 0x8e, 0x0f, 0x89, 0xf3, 0x0c, 0x73, 0xbc, 0xbf, 0xf9, 0xfe, 0x81, 0xf1, 0x04, 0xd6, 0x11,
-	0x71, 0xbd, 0x80, 0x13, 0xbf, 0xcf, 0xe8, 0x88, 0x98, 0xa0, 0x01, 0x5a, 0xe5, 0xee, 0xff, 0x49,
-	0x64, 0xe7, 0x0f, 0x50, 0x1e, 0x1a, 0x9b, 0xb0, 0xaa, 0x42, 0x29, 0x59, 0x51, 0xca, 0xd6, 0x93,
-	0xc8, 0xd6, 0x69, 0xa4, 0x03, 0xa3, 0x09, 0x2b, 0x0a, 0x9a, 0xa5, 0x06, 0x68, 0xad, 0x74, 0x61,
-	0x12, 0xd9, 0x29, 0x83, 0xd2, 0x55, 0xe4, 0xf3, 0x86, 0xea, 0x81, 0xcb, 0x59, 0x3e, 0xb9, 0x03,
-	0x94, 0x87, 0xba, 0x70, 0xe7, 0x88, 0x8d, 0xc6, 0xe6, 0x52, 0x03, 0xb4, 0xea, 0x79, 0xa1, 0x3c,
-	0x40, 0x79, 0xa8, 0x2a, 0x70, 0x8c, 0x7d, 0x67, 0xcb, 0x71, 0x7c, 0x12, 0x04, 0x66, 0xa5, 0x01,
-	0x5a, 0xb5, 0x59, 0x05, 0xb4, 0x03, 0x94, 0x87, 0x46, 0x00, 0xa1, 0x8c, 0xb3, 0x8f, 0x27, 0x21,
-	0x31, 0x97, 0xa5, 0x6a, 0x90, 0x44, 0xb6, 0xc6, 0x9e, 0xfd, 0xb4, 0xb7, 0xa6, 0x98, 0x8f, 0x3b,
-	0x43, 0xcf, 0x6d, 0xef, 0x52, 0xfe, 0x54, 0xf3, 0x6b, 0x67, 0xe2, 0x33, 0xea, 0xf4, 0x09, 0x3f,
-	0x66, 0xfe, 0x61, 0x87, 0x48, 0xb4, 0xe1, 0xb2, 0x8e, 0x83, 0x39, 0x6e, 0x77, 0x3d, 0x77, 0x97,
-	0xf2, 0x6d, 0x2c, 0xea, 0x8d, 0xb4, 0x80, 0xa2, 0xec, 0x2f, 0xb0, 0x37, 0x21, 0x0e, 0x62, 0x21,
-	0x75, 0xcc, 0x95, 0xac, 0xec, 0x1a, 0x8d, 0x74, 0x90, 0x49, 0x54, 0x41, 0x57, 0x6f, 0x4b, 0x52,
-	0xa7, 0x34, 0xa0, 0x8a, 0xa9, 0x8b, 0xa0, 0xee, 0x82, 0x2e, 0xcb, 0x43, 0x61, 0xb1, 0x82, 0x66,
-	0x35, 0xb3, 0x38, 0x4d, 0x26, 0x5d, 0x8d, 0x7b, 0x70, 0xf9, 0x2d, 0xf6, 0xb8, 0x47, 0x5d, 0xb3,
-	0x26, 0x2f, 0x55, 0x93, 0xc8, 0x9e, 0x51, 0x68, 0xb6, 0x69, 0xfe, 0xa8, 0xdc, 0x6a, 0xd6, 0xcd,
-	0x45, 0xb3, 0x2e, 0x9a, 0xf5, 0xdf, 0x6c, 0x56, 0xe3, 0x3e, 0x5c, 0xed, 0x87, 0xd3, 0x34, 0x5a,
-	0x5d, 0x9a, 0x59, 0x4f, 0x22, 0x3b, 0x23, 0x51, 0xb6, 0x95, 0x5e, 0x4c, 0x70, 0x30, 0x56, 0x5e,
-	0xac, 0x69, 0x5e, 0xcc, 0xd9, 0xbf, 0xe5, 0xc5, 0x3c, 0x60, 0xf3, 0xd3, 0x72, 0x6e, 0x9c, 0x1e,
-	0x2e, 0xbe, 0xfd, 0x8b, 0x71, 0x5a, 0x8c, 0xd3, 0x9f, 0x8e, 0x93, 0xf1, 0x18, 0xd6, 0x5e, 0x1f,
-	0x53, 0xe2, 0xcf, 0x1a, 0x67, 0x5d, 0x3e, 0xfb, 0x5f, 0x12, 0xd9, 0x39, 0x1e, 0xe5, 0x50, 0xf3,
-	0x12, 0x40, 0x63, 0xa0, 0xfe, 0x91, 0xeb, 0x33, 0x87, 0x04, 0xdb, 0x8c, 0x1e, 0x78, 0xae, 0x70,
-	0xe9, 0x95, 0x47, 0xfb, 0xe1, 0x54, 0x92, 0x72, 0x0c, 0x4b, 0xca, 0x25, 0x8d, 0x46, 0x3a, 0x90,
-	0x12, 0x7c, 0x32, 0x97, 0x14, 0x35, 0x49, 0x46, 0x23, 0x1d, 0xe8, 0x53, 0x2b, 0x24, 0xa5, 0x4c,
-	0xa2, 0xd1, 0x48, 0x07, 0x7a, 0xfb, 0x08, 0x49, 0x39, 0x93, 0x68, 0x34, 0xd2, 0x41, 0xf3, 0x0b,
-	0x80, 0xf5, 0x9d, 0x09, 0x99, 0x12, 0xca, 0x77, 0x69, 0xcf, 0x0b, 0xb8, 0x28, 0x55, 0xb7, 0x37,
-	0xd8, 0x0b, 0x87, 0x13, 0x6f, 0xf4, 0x92, 0x9c, 0xca, 0x3f, 0x2f, 0x2d, 0x95, 0xce, 0xa3, 0x1c,
-	0x12, 0x4f, 0xef, 0xf9, 0xe4, 0x83, 0xc7, 0xc2, 0x40, 0x88, 0x8a, 0x52, 0x24, 0x9f, 0xd6, 0x68,
-	0xa4, 0x03, 0xd1, 0x5c, 0x7d, 0x72, 0xc2, 0xc5, 0xf5, 0x92, 0xbc, 0x2e, 0x9b, 0x2b, 0xa5, 0xd0,
-	0x6c, 0xd3, 0xfc, 0x06, 0x60, 0x35, 0x6d, 0x34, 0x99, 0x5f, 0x0b, 0xae, 0x3c, 0xf7, 0xfc, 0x80,
-	0x67, 0xb9, 0xd5, 0x92, 0xc8, 0x9e, 0x73, 0x68, 0xbe, 0x13, 0x0f, 0xf4, 0xb0, 0xba, 0x58, 0xcc,
-	0x1e, 0x48, 0x29, 0x34, 0xdb, 0x88, 0x41, 0xe8, 0x11, 0xea, 0xf2, 0xb1, 0x4c, 0xa3, 0xae, 0x06,
-	0x41, 0x31, 0x28, 0x5d, 0xc5, 0x94, 0x89, 0xeb, 0xaa, 0x72, 0x22, 0x60, 0x39, 0xfb, 0xf2, 0xe4,
-	0x0e, 0x50, 0x1e, 0x76, 0xfb, 0xe7, 0x57, 0x56, 0xe1, 0xe2, 0xca, 0x2a, 0xdc, 0x5c, 0x59, 0xe0,
-	0x63, 0x6c, 0x81, 0xaf, 0xb1, 0x05, 0xbe, 0xc7, 0x16, 0x38, 0x8f, 0x2d, 0x70, 0x11, 0x5b, 0xe0,
-	0x32, 0xb6, 0xc0, 0xaf, 0xd8, 0x2a, 0xdc, 0xc4, 0x16, 0xf8, 0x7c, 0x6d, 0x15, 0xce, 0xaf, 0xad,
-	0xc2, 0xc5, 0xb5, 0x55, 0x78, 0x77, 0x27, 0x38, 0x0d, 0x38, 0x99, 0x0e, 0xa6, 0xd8, 0xe7, 0xdb,
-	0x8c, 0x72, 0x1f, 0x8f, 0x78, 0x30, 0xac, 0xc8, 0x9f, 0x06, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x9c, 0xc2, 0xf8, 0xd3, 0x61, 0x0c, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x3f, 0x6f, 0xf3, 0x44,
+	0x18, 0xcf, 0x35, 0x69, 0xda, 0x5e, 0x92, 0xf7, 0x7d, 0xb1, 0x18, 0x2c, 0x06, 0x3b, 0xb2, 0x84,
+	0x14, 0x09, 0xbd, 0x09, 0x7d, 0x41, 0x62, 0x60, 0x40, 0x4d, 0x29, 0x52, 0x21, 0xb8, 0xd5, 0x45,
+	0x14, 0x89, 0x05, 0x5d, 0xe2, 0xab, 0x63, 0x35, 0xb9, 0xab, 0xec, 0x33, 0x69, 0x37, 0xc4, 0xca,
+	0xc2, 0x37, 0x60, 0x45, 0xfd, 0x0a, 0x7c, 0x01, 0x06, 0x86, 0x8e, 0x9d, 0x4c, 0xeb, 0x2e, 0xc8,
+	0x53, 0x3f, 0x02, 0xba, 0x3b, 0x27, 0x77, 0x2e, 0x0b, 0x42, 0x0c, 0x0c, 0x99, 0xee, 0x7e, 0xbf,
+	0x7b, 0x7e, 0xcf, 0xf3, 0xe8, 0xf9, 0x63, 0x25, 0xb0, 0x93, 0x70, 0x7c, 0x11, 0xd1, 0xb0, 0x7f,
+	0x19, 0x33, 0xce, 0xac, 0x6d, 0x79, 0xbc, 0xf3, 0x3a, 0x8c, 0xf8, 0x2c, 0x9d, 0xf4, 0xa7, 0x6c,
+	0x31, 0x08, 0x59, 0xc8, 0x06, 0x92, 0x9e, 0xa4, 0xe7, 0x12, 0x49, 0x20, 0x6f, 0x4a, 0xe5, 0xdd,
+	0x6c, 0xc3, 0x17, 0x63, 0x8e, 0x2f, 0x48, 0xf0, 0x29, 0xe6, 0xf8, 0x6c, 0xff, 0xdb, 0xf7, 0xad,
+	0x8f, 0x60, 0x07, 0x91, 0x30, 0x4a, 0x38, 0x89, 0x7d, 0x46, 0xa7, 0xc4, 0x06, 0x5d, 0xd0, 0x6b,
+	0x0c, 0xdf, 0x2a, 0x32, 0xb7, 0xfa, 0x80, 0xaa, 0xd0, 0xda, 0x87, 0x2d, 0xe5, 0x4a, 0xc9, 0xb6,
+	0xa4, 0xec, 0x65, 0x91, 0xb9, 0x26, 0x8d, 0x4c, 0x60, 0x79, 0xb0, 0xa9, 0xa0, 0x5d, 0xef, 0x82,
+	0xde, 0xee, 0x10, 0x16, 0x99, 0x5b, 0x32, 0xa8, 0x3c, 0x45, 0x3e, 0x5f, 0x51, 0xd3, 0x71, 0x43,
+	0xe7, 0x53, 0x79, 0x40, 0x55, 0x68, 0x0a, 0x8f, 0x2e, 0xd9, 0x74, 0x66, 0x6f, 0x77, 0x41, 0xaf,
+	0x53, 0x15, 0xca, 0x07, 0x54, 0x85, 0xaa, 0x02, 0x4b, 0x1c, 0x07, 0x07, 0x41, 0x10, 0x93, 0x24,
+	0xb1, 0x9b, 0x5d, 0xd0, 0x6b, 0xaf, 0x2a, 0x60, 0x3c, 0xa0, 0x2a, 0xb4, 0x12, 0x08, 0xa5, 0x9f,
+	0x33, 0x3c, 0x4f, 0x89, 0xbd, 0x23, 0x55, 0xe3, 0x22, 0x73, 0x0d, 0xf6, 0xe6, 0x0f, 0xf7, 0x60,
+	0x81, 0xf9, 0x6c, 0x30, 0x89, 0xc2, 0xfe, 0x31, 0xe5, 0x1f, 0x1b, 0xfd, 0x3a, 0x9a, 0xc7, 0x8c,
+	0x06, 0x3e, 0xe1, 0x4b, 0x16, 0x5f, 0x0c, 0x88, 0x44, 0xaf, 0x43, 0x36, 0x08, 0x30, 0xc7, 0xfd,
+	0x61, 0x14, 0x1e, 0x53, 0x7e, 0x88, 0x45, 0xbd, 0x91, 0xe1, 0x50, 0x94, 0xfd, 0x73, 0x1c, 0xcd,
+	0x49, 0x80, 0x58, 0x4a, 0x03, 0x7b, 0x57, 0x97, 0xdd, 0xa0, 0x91, 0x09, 0xb4, 0x44, 0x15, 0x74,
+	0xef, 0xb9, 0xa4, 0xec, 0x94, 0x01, 0x54, 0x31, 0x4d, 0x11, 0x34, 0xbb, 0x60, 0xca, 0xaa, 0x50,
+	0xb4, 0x58, 0x41, 0xbb, 0xa5, 0x5b, 0x5c, 0x26, 0x53, 0x9e, 0xd6, 0xbb, 0x70, 0xe7, 0x6b, 0x1c,
+	0xf1, 0x88, 0x86, 0x76, 0x5b, 0x1a, 0xb5, 0x8a, 0xcc, 0x5d, 0x51, 0x68, 0x75, 0xf1, 0x7e, 0x6f,
+	0x3e, 0x1b, 0xd6, 0xfd, 0xcd, 0xb0, 0x6e, 0x86, 0xf5, 0xff, 0x39, 0xac, 0xd6, 0x7b, 0x70, 0xcf,
+	0x4f, 0x17, 0xa5, 0xb7, 0x8e, 0x6c, 0x66, 0xa7, 0xc8, 0x5c, 0x4d, 0x22, 0x7d, 0x95, 0xbd, 0x98,
+	0xe3, 0x64, 0xa6, 0x7a, 0xf1, 0xc2, 0xe8, 0xc5, 0x9a, 0xfd, 0xaf, 0x7a, 0xb1, 0x76, 0xe8, 0xfd,
+	0xb0, 0x53, 0x59, 0xa7, 0x37, 0x9b, 0x6f, 0xff, 0x66, 0x9d, 0x36, 0xeb, 0xf4, 0x6f, 0xd7, 0xc9,
+	0xfa, 0x10, 0xb6, 0x4f, 0x96, 0x94, 0xc4, 0xab, 0xc1, 0x79, 0x29, 0xc3, 0xbe, 0x2a, 0x32, 0xb7,
+	0xc2, 0xa3, 0x0a, 0xf2, 0xee, 0x01, 0xb4, 0xc6, 0xea, 0x87, 0x9c, 0xcf, 0x02, 0x92, 0x1c, 0x32,
+	0x7a, 0x1e, 0x85, 0xa2, 0x4b, 0x5f, 0x46, 0xd4, 0x4f, 0x17, 0x92, 0x94, 0x6b, 0x58, 0x57, 0x5d,
+	0x32, 0x68, 0x64, 0x02, 0x29, 0xc1, 0x57, 0x6b, 0xc9, 0x96, 0x21, 0xd1, 0x34, 0x32, 0x81, 0xb9,
+	0xb5, 0x42, 0x52, 0xd7, 0x12, 0x83, 0x46, 0x26, 0x30, 0xc7, 0x47, 0x48, 0x1a, 0x5a, 0x62, 0xd0,
+	0xc8, 0x04, 0xde, 0xcf, 0x00, 0x76, 0x8e, 0xe6, 0x64, 0x41, 0x28, 0x3f, 0xa6, 0xa3, 0x28, 0xe1,
+	0xa2, 0x54, 0xc3, 0xd1, 0xf8, 0x34, 0x9d, 0xcc, 0xa3, 0xe9, 0x17, 0xe4, 0xda, 0x06, 0xba, 0x54,
+	0x26, 0x8f, 0x2a, 0x48, 0x84, 0x3e, 0x8d, 0xc9, 0x77, 0x11, 0x4b, 0x13, 0x21, 0xda, 0x92, 0x22,
+	0x19, 0xda, 0xa0, 0x91, 0x09, 0xc4, 0x70, 0xf9, 0xe4, 0x8a, 0x0b, 0xf3, 0xba, 0x34, 0x97, 0xc3,
+	0x55, 0x52, 0x68, 0x75, 0xf1, 0x7e, 0x05, 0xb0, 0x55, 0x0e, 0x9a, 0xcc, 0xaf, 0x07, 0x77, 0x3f,
+	0x8b, 0xe2, 0x84, 0xeb, 0xdc, 0xda, 0x45, 0xe6, 0xae, 0x39, 0xb4, 0xbe, 0x89, 0x00, 0x23, 0x9c,
+	0x70, 0x9d, 0x8f, 0x0c, 0x50, 0x52, 0x68, 0x75, 0x11, 0x8b, 0x30, 0x22, 0x34, 0xe4, 0x33, 0x99,
+	0x46, 0x47, 0x2d, 0x82, 0x62, 0x50, 0x79, 0x8a, 0x2d, 0x13, 0xe6, 0xaa, 0x72, 0xc2, 0x61, 0x43,
+	0x7f, 0x79, 0x2a, 0x0f, 0xa8, 0x0a, 0xbd, 0x1f, 0x01, 0x6c, 0x09, 0x9a, 0x71, 0xcc, 0x23, 0x46,
+	0x45, 0xb0, 0x93, 0x79, 0xa0, 0x73, 0x97, 0xc1, 0x14, 0x83, 0xca, 0x53, 0xd8, 0xf8, 0x64, 0xa9,
+	0xd3, 0x96, 0x36, 0x8a, 0x41, 0xe5, 0xf9, 0xb7, 0x81, 0xae, 0xff, 0xa3, 0x81, 0x1e, 0xc3, 0x57,
+	0x46, 0x32, 0x89, 0xac, 0xe7, 0x27, 0x70, 0x6f, 0x4d, 0xd8, 0xa0, 0x5b, 0xef, 0xb5, 0xde, 0x58,
+	0xea, 0x0f, 0x48, 0xdf, 0xb0, 0x55, 0x0b, 0xbd, 0x36, 0x44, 0xfa, 0x3a, 0xf4, 0x6f, 0x1f, 0x9c,
+	0xda, 0xdd, 0x83, 0x53, 0x7b, 0x7a, 0x70, 0xc0, 0xf7, 0xb9, 0x03, 0x7e, 0xc9, 0x1d, 0xf0, 0x5b,
+	0xee, 0x80, 0xdb, 0xdc, 0x01, 0x77, 0xb9, 0x03, 0xee, 0x73, 0x07, 0xfc, 0x99, 0x3b, 0xb5, 0xa7,
+	0xdc, 0x01, 0x3f, 0x3d, 0x3a, 0xb5, 0xdb, 0x47, 0xa7, 0x76, 0xf7, 0xe8, 0xd4, 0xbe, 0x79, 0x3b,
+	0xb9, 0x4e, 0x38, 0x59, 0x8c, 0x17, 0x38, 0xe6, 0x87, 0x8c, 0xf2, 0x18, 0x4f, 0x79, 0x32, 0x69,
+	0xca, 0xe0, 0x1f, 0xfc, 0x35, 0x00, 0xa7, 0x4e, 0xaf, 0x15, 0x44, 0x0d, 0x00, 0x00,
 }
 
 func (this *StakedDataV1_0) Equal(that interface{}) bool {
@@ -990,6 +1090,65 @@ func (this *WaitingList) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *KeyRotation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyRotation)
+	if !ok {
+		that2, ok := that.(KeyRotation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OldKey, that1.OldKey) {
+		return false
+	}
+	if !bytes.Equal(this.NewKey, that1.NewKey) {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	return true
+}
+func (this *KeyRotationsList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyRotationsList)
+	if !ok {
+		that2, ok := that.(KeyRotationsList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Rotations) != len(that1.Rotations) {
+		return false
+	}
+	for i := range this.Rotations {
+		if !this.Rotations[i].Equal(that1.Rotations[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StakedDataV1_0) GoString() string {
 	if this == nil {
 		return "nil"
@@ -1096,6 +1255,30 @@ func (this *WaitingList) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *KeyRotation) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&systemSmartContracts.KeyRotation{")
+	s = append(s, "OldKey: "+fmt.Sprintf("%#v", this.OldKey)+",\n")
+	s = append(s, "NewKey: "+fmt.Sprintf("%#v", this.NewKey)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyRotationsList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.KeyRotationsList{")
+	if this.Rotations != nil {
+		s = append(s, "Rotations: "+fmt.Sprintf("%#v", this.Rotations)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringStaking(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1597,6 +1780,87 @@ func (m *WaitingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewKey) > 0 {
+		i -= len(m.NewKey)
+		copy(dAtA[i:], m.NewKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldKey) > 0 {
+		i -= len(m.OldKey)
+		copy(dAtA[i:], m.OldKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OldKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyRotationsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRotationsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyRotationsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -1838,6 +2102,42 @@ func (m *WaitingList) Size() (n int) {
 	return n
 }
 
+func (m *KeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyRotationsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1950,6 +2250,33 @@ func (this *WaitingList) String() string {
 	}, "")
 	return s
 }
+func (this *KeyRotation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KeyRotation{`,
+		`OldKey:` + fmt.Sprintf("%v", this.OldKey) + `,`,
+		`NewKey:` + fmt.Sprintf("%v", this.NewKey) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyRotationsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRotations := "[]*KeyRotation{"
+	for _, f := range this.Rotations {
+		repeatedStringForRotations += strings.Replace(f.String(), "KeyRotation", "KeyRotation", 1) + ","
+	}
+	repeatedStringForRotations += "}"
+	s := strings.Join([]string{`&KeyRotationsList{`,
+		`Rotations:` + repeatedStringForRotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringStaking(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3518,6 +3845,248 @@ func (m *WaitingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *KeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldKey = append(m.OldKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldKey == nil {
+				m.OldKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKey = append(m.NewKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewKey == nil {
+				m.NewKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRotationsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRotationsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRotationsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, &KeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -61,6 +61,10 @@ type validatorSC struct {
 	flagValidatorToDelegation        atomic.Flag
 	enableUnbondTokensV2Epoch        uint32
 	flagUnbondTokensV2               atomic.Flag
+	unDelegateQueueEnableEpoch       uint32
+	flagUnDelegateQueue              atomic.Flag
+	keyRotationEnableEpoch           uint32
+	flagKeyRotation                  atomic.Flag
 	shardCoordinator                 sharding.Coordinator
 }
 
@@ -174,6 +178,8 @@ func NewValidatorSmartContract(
 		governanceSCAddress:              args.GovernanceSCAddress,
 		enableUnbondTokensV2Epoch:        args.EpochConfig.EnableEpochs.UnbondTokensV2EnableEpoch,
 		validatorToDelegationEnableEpoch: args.EpochConfig.EnableEpochs.ValidatorToDelegationEnableEpoch,
+		unDelegateQueueEnableEpoch:       args.EpochConfig.EnableEpochs.UnDelegateQueueEnableEpoch,
+		keyRotationEnableEpoch:           args.EpochConfig.EnableEpochs.ValidatorKeyRotationEnableEpoch,
 		shardCoordinator:                 args.ShardCoordinator,
 	}
 	log.Debug("validator: enable epoch for staking v2", "epoch", reg.stakingV2Epoch)
@@ -181,6 +187,8 @@ func NewValidatorSmartContract(
 	log.Debug("validator: enable epoch for double key protection", "epoch", reg.enableDoubleKeyEpoch)
 	log.Debug("validator: enable epoch for unbond tokens v2", "epoch", reg.enableUnbondTokensV2Epoch)
 	log.Debug("validator: enable epoch for validator to delegation", "epoch", reg.validatorToDelegationEnableEpoch)
+	log.Debug("validator: enable epoch for unDelegate queue", "epoch", reg.unDelegateQueueEnableEpoch)
+	log.Debug("validator: enable epoch for key rotation", "epoch", reg.keyRotationEnableEpoch)
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
 
@@ -241,10 +249,16 @@ func (v *validatorSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		return v.getUnStakedTokensList(args)
 	case "reStakeUnStakedNodes":
 		return v.reStakeUnStakedNodes(args)
+	case "reStakeUnStakedTokens":
+		return v.reStakeUnStakedTokens(args)
 	case "mergeValidatorData":
 		return v.mergeValidatorData(args)
 	case "changeOwnerOfValidatorData":
 		return v.changeOwnerOfValidatorData(args)
+	case "rotateValidatorKey":
+		return v.rotateValidatorKey(args)
+	case executeKeyRotationsFunction:
+		return v.executeKeyRotations(args)
 	}
 
 	v.eei.AddReturnMessage("invalid method to call")
@@ -1592,6 +1606,63 @@ func (v *validatorSC) unStakeTokens(args *vmcommon.ContractCallInput) vmcommon.R
 	return vmcommon.Ok
 }
 
+// reStakeUnStakedTokens moves back in the staked value a part of the value unStaked in the current epoch
+func (v *validatorSC) reStakeUnStakedTokens(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !v.flagUnDelegateQueue.IsSet() {
+		v.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.UserError
+	}
+	registrationData, returnCode := v.basicCheckForUnStakeUnBond(args, args.CallerAddr)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	err := v.eei.UseGas(v.gasCost.MetaChainSystemSCsCost.UnStakeTokens)
+	if err != nil {
+		v.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 1 {
+		v.eei.AddReturnMessage("should have specified one argument containing the value to be re-staked")
+		return vmcommon.UserError
+	}
+
+	reStakeValue := big.NewInt(0).SetBytes(args.Arguments[0])
+	if reStakeValue.Cmp(zero) <= 0 {
+		v.eei.AddReturnMessage("invalid value to re-stake")
+		return vmcommon.UserError
+	}
+
+	unStakedEpoch := v.eei.BlockChainHook().CurrentEpoch()
+	if registrationData.NumRegistered == 0 {
+		unStakedEpoch = 0
+	}
+	lenUnStakedInfo := len(registrationData.UnstakedInfo)
+	if lenUnStakedInfo == 0 || registrationData.UnstakedInfo[lenUnStakedInfo-1].UnstakedEpoch != unStakedEpoch {
+		v.eei.AddReturnMessage("no value was unStaked in the current epoch")
+		return vmcommon.UserError
+	}
+	lastUnstakedInfo := registrationData.UnstakedInfo[lenUnStakedInfo-1]
+	if lastUnstakedInfo.UnstakedValue.Cmp(reStakeValue) < 0 {
+		v.eei.AddReturnMessage("can not re-stake a bigger value than the one unStaked in the current epoch")
+		return vmcommon.UserError
+	}
+
+	lastUnstakedInfo.UnstakedValue.Sub(lastUnstakedInfo.UnstakedValue, reStakeValue)
+	if lastUnstakedInfo.UnstakedValue.Cmp(zero) == 0 {
+		registrationData.UnstakedInfo = registrationData.UnstakedInfo[:lenUnStakedInfo-1]
+	}
+	registrationData.TotalUnstaked.Sub(registrationData.TotalUnstaked, reStakeValue)
+	registrationData.TotalStakeValue.Add(registrationData.TotalStakeValue, reStakeValue)
+
+	err = v.saveRegistrationData(args.CallerAddr, registrationData)
+	if err != nil {
+		v.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
 func (v *validatorSC) getMinUnStakeTokensValue() (*big.Int, error) {
 	if v.flagDelegationMgr.IsSet() {
 		delegationManagement, err := getDelegationManagement(v.eei, v.marshalizer, v.delegationMgrSCAddress)
@@ -2152,6 +2223,12 @@ func (v *validatorSC) EpochConfirmed(epoch uint32, _ uint64) {
 
 	v.flagUnbondTokensV2.Toggle(epoch >= v.enableUnbondTokensV2Epoch)
 	log.Debug("validatorSC: unbond tokens v2", "enabled", v.flagUnbondTokensV2.IsSet())
+
+	v.flagUnDelegateQueue.Toggle(epoch >= v.unDelegateQueueEnableEpoch)
+	log.Debug("validatorSC: unDelegate queue", "enabled", v.flagUnDelegateQueue.IsSet())
+
+	v.flagKeyRotation.Toggle(epoch >= v.keyRotationEnableEpoch)
+	log.Debug("validatorSC: key rotation", "enabled", v.flagKeyRotation.IsSet())
 }
 
 // CanUseContract returns true if contract can be used
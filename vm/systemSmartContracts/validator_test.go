@@ -3483,6 +3483,65 @@ func TestStakingValidatorSC_UnstakeTokensShouldWork(t *testing.T) {
 	assert.Equal(t, expected, recovered)
 }
 
+func TestStakingValidatorSC_ReStakeUnStakedTokensShouldWork(t *testing.T) {
+	t.Parallel()
+
+	minStakeValue := big.NewInt(1000)
+	unbondPeriod := uint64(10)
+	epoch := uint32(56)
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return epoch
+		},
+	}
+	args := createMockArgumentsForValidatorSC()
+	args.EpochConfig.EnableEpochs.StakingV2EnableEpoch = 0
+	eei := createVmContextWithStakingSc(minStakeValue, unbondPeriod, blockChainHook)
+	args.Eei = eei
+	caller := []byte("caller")
+	sc, _ := NewValidatorSmartContract(args)
+	_ = sc.saveRegistrationData(
+		caller,
+		&ValidatorDataV2{
+			RewardAddress:   caller,
+			TotalStakeValue: big.NewInt(1000),
+			LockedStake:     big.NewInt(1000),
+			MaxStakePerNode: big.NewInt(0),
+			BlsPubKeys:      [][]byte{[]byte("key")},
+			NumRegistered:   1,
+			UnstakedInfo: []*UnstakedValue{
+				{
+					UnstakedEpoch: epoch - 1,
+					UnstakedValue: big.NewInt(5),
+				},
+			},
+			TotalUnstaked: big.NewInt(5),
+		},
+	)
+
+	callFunctionAndCheckResult(t, "reStakeUnStakedTokens", sc, caller, [][]byte{big.NewInt(1).Bytes()}, zero, vmcommon.UserError)
+
+	callFunctionAndCheckResult(t, "unStakeTokens", sc, caller, [][]byte{big.NewInt(10).Bytes()}, zero, vmcommon.Ok)
+	callFunctionAndCheckResult(t, "reStakeUnStakedTokens", sc, caller, [][]byte{big.NewInt(11).Bytes()}, zero, vmcommon.UserError)
+	callFunctionAndCheckResult(t, "reStakeUnStakedTokens", sc, caller, [][]byte{big.NewInt(4).Bytes()}, zero, vmcommon.Ok)
+
+	recovered, err := sc.getOrCreateRegistrationData(caller)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(994), recovered.TotalStakeValue)
+	assert.Equal(t, big.NewInt(11), recovered.TotalUnstaked)
+	assert.Equal(t, []*UnstakedValue{
+		{UnstakedEpoch: epoch - 1, UnstakedValue: big.NewInt(5)},
+		{UnstakedEpoch: epoch, UnstakedValue: big.NewInt(6)},
+	}, recovered.UnstakedInfo)
+
+	callFunctionAndCheckResult(t, "reStakeUnStakedTokens", sc, caller, [][]byte{big.NewInt(6).Bytes()}, zero, vmcommon.Ok)
+
+	recovered, _ = sc.getOrCreateRegistrationData(caller)
+	assert.Equal(t, big.NewInt(1000), recovered.TotalStakeValue)
+	assert.Equal(t, big.NewInt(5), recovered.TotalUnstaked)
+	assert.Equal(t, 1, len(recovered.UnstakedInfo))
+}
+
 func TestStakingValidatorSC_UnstakeTokensHavingUnstakedShouldWork(t *testing.T) {
 	t.Parallel()
 